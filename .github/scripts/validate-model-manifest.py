@@ -76,6 +76,20 @@ def main() -> int:
         if not models_by_id[model_id]["available"]:
             fail(f"providerDefault '{model_id}' is not available")
 
+    # Optional generation defaults (read by the API server)
+    temperature = manifest.get("defaultTemperature")
+    if temperature is not None and (
+        isinstance(temperature, bool)
+        or not isinstance(temperature, (int, float))
+        or not 0 <= temperature <= 2
+    ):
+        fail(f"defaultTemperature must be a number between 0 and 2, got {temperature!r}")
+    max_tokens = manifest.get("defaultMaxTokens")
+    if max_tokens is not None and (
+        isinstance(max_tokens, bool) or not isinstance(max_tokens, int) or max_tokens <= 0
+    ):
+        fail(f"defaultMaxTokens must be a positive integer, got {max_tokens!r}")
+
     # No models removed vs committed version
     committed = load_committed_manifest()
     committed_ids = {m["id"] for m in committed["models"]}
@@ -22,13 +22,17 @@ const (
 )
 
 type ProjectSettings struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *ObjectReference       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	GroupAccess   *string                `protobuf:"bytes,3,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
-	Repositories  *string                `protobuf:"bytes,5,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata                 *ObjectReference       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ProjectId                string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupAccess              *string                `protobuf:"bytes,3,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
+	Repositories             *string                `protobuf:"bytes,5,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
+	ResourceLimits           *string                `protobuf:"bytes,6,opt,name=resource_limits,json=resourceLimits,proto3,oneof" json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32                 `protobuf:"varint,7,opt,name=inactivity_timeout_seconds,json=inactivityTimeoutSeconds,proto3,oneof" json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string                `protobuf:"bytes,8,opt,name=model_overrides,json=modelOverrides,proto3,oneof" json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64               `protobuf:"fixed64,9,opt,name=monthly_budget_usd,json=monthlyBudgetUsd,proto3,oneof" json:"monthly_budget_usd,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *ProjectSettings) Reset() {
@@ -89,13 +93,45 @@ func (x *ProjectSettings) GetRepositories() string {
 	return ""
 }
 
+func (x *ProjectSettings) GetResourceLimits() string {
+	if x != nil && x.ResourceLimits != nil {
+		return *x.ResourceLimits
+	}
+	return ""
+}
+
+func (x *ProjectSettings) GetInactivityTimeoutSeconds() int32 {
+	if x != nil && x.InactivityTimeoutSeconds != nil {
+		return *x.InactivityTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ProjectSettings) GetModelOverrides() string {
+	if x != nil && x.ModelOverrides != nil {
+		return *x.ModelOverrides
+	}
+	return ""
+}
+
+func (x *ProjectSettings) GetMonthlyBudgetUsd() float64 {
+	if x != nil && x.MonthlyBudgetUsd != nil {
+		return *x.MonthlyBudgetUsd
+	}
+	return 0
+}
+
 type CreateProjectSettingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	GroupAccess   *string                `protobuf:"bytes,2,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
-	Repositories  *string                `protobuf:"bytes,4,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId                string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupAccess              *string                `protobuf:"bytes,2,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
+	Repositories             *string                `protobuf:"bytes,4,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
+	ResourceLimits           *string                `protobuf:"bytes,5,opt,name=resource_limits,json=resourceLimits,proto3,oneof" json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32                 `protobuf:"varint,6,opt,name=inactivity_timeout_seconds,json=inactivityTimeoutSeconds,proto3,oneof" json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string                `protobuf:"bytes,7,opt,name=model_overrides,json=modelOverrides,proto3,oneof" json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64               `protobuf:"fixed64,8,opt,name=monthly_budget_usd,json=monthlyBudgetUsd,proto3,oneof" json:"monthly_budget_usd,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *CreateProjectSettingsRequest) Reset() {
@@ -149,6 +185,34 @@ func (x *CreateProjectSettingsRequest) GetRepositories() string {
 	return ""
 }
 
+func (x *CreateProjectSettingsRequest) GetResourceLimits() string {
+	if x != nil && x.ResourceLimits != nil {
+		return *x.ResourceLimits
+	}
+	return ""
+}
+
+func (x *CreateProjectSettingsRequest) GetInactivityTimeoutSeconds() int32 {
+	if x != nil && x.InactivityTimeoutSeconds != nil {
+		return *x.InactivityTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CreateProjectSettingsRequest) GetModelOverrides() string {
+	if x != nil && x.ModelOverrides != nil {
+		return *x.ModelOverrides
+	}
+	return ""
+}
+
+func (x *CreateProjectSettingsRequest) GetMonthlyBudgetUsd() float64 {
+	if x != nil && x.MonthlyBudgetUsd != nil {
+		return *x.MonthlyBudgetUsd
+	}
+	return 0
+}
+
 type GetProjectSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -194,13 +258,17 @@ func (x *GetProjectSettingsRequest) GetId() string {
 }
 
 type UpdateProjectSettingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	GroupAccess   *string                `protobuf:"bytes,3,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
-	Repositories  *string                `protobuf:"bytes,5,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId                *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	GroupAccess              *string                `protobuf:"bytes,3,opt,name=group_access,json=groupAccess,proto3,oneof" json:"group_access,omitempty"`
+	Repositories             *string                `protobuf:"bytes,5,opt,name=repositories,proto3,oneof" json:"repositories,omitempty"`
+	ResourceLimits           *string                `protobuf:"bytes,6,opt,name=resource_limits,json=resourceLimits,proto3,oneof" json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32                 `protobuf:"varint,7,opt,name=inactivity_timeout_seconds,json=inactivityTimeoutSeconds,proto3,oneof" json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string                `protobuf:"bytes,8,opt,name=model_overrides,json=modelOverrides,proto3,oneof" json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64               `protobuf:"fixed64,9,opt,name=monthly_budget_usd,json=monthlyBudgetUsd,proto3,oneof" json:"monthly_budget_usd,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *UpdateProjectSettingsRequest) Reset() {
@@ -261,6 +329,34 @@ func (x *UpdateProjectSettingsRequest) GetRepositories() string {
 	return ""
 }
 
+func (x *UpdateProjectSettingsRequest) GetResourceLimits() string {
+	if x != nil && x.ResourceLimits != nil {
+		return *x.ResourceLimits
+	}
+	return ""
+}
+
+func (x *UpdateProjectSettingsRequest) GetInactivityTimeoutSeconds() int32 {
+	if x != nil && x.InactivityTimeoutSeconds != nil {
+		return *x.InactivityTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *UpdateProjectSettingsRequest) GetModelOverrides() string {
+	if x != nil && x.ModelOverrides != nil {
+		return *x.ModelOverrides
+	}
+	return ""
+}
+
+func (x *UpdateProjectSettingsRequest) GetMonthlyBudgetUsd() float64 {
+	if x != nil && x.MonthlyBudgetUsd != nil {
+		return *x.MonthlyBudgetUsd
+	}
+	return 0
+}
+
 type DeleteProjectSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -546,33 +642,57 @@ var File_ambient_v1_project_settings_proto protoreflect.FileDescriptor
 const file_ambient_v1_project_settings_proto_rawDesc = "" +
 	"\n" +
 	"!ambient/v1/project_settings.proto\x12\n" +
-	"ambient.v1\x1a\x17ambient/v1/common.proto\"\xf2\x01\n" +
+	"ambient.v1\x1a\x17ambient/v1/common.proto\"\xa2\x04\n" +
 	"\x0fProjectSettings\x127\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1b.ambient.v1.ObjectReferenceR\bmetadata\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12&\n" +
 	"\fgroup_access\x18\x03 \x01(\tH\x00R\vgroupAccess\x88\x01\x01\x12'\n" +
-	"\frepositories\x18\x05 \x01(\tH\x01R\frepositories\x88\x01\x01B\x0f\n" +
+	"\frepositories\x18\x05 \x01(\tH\x01R\frepositories\x88\x01\x01\x12,\n" +
+	"\x0fresource_limits\x18\x06 \x01(\tH\x02R\x0eresourceLimits\x88\x01\x01\x12A\n" +
+	"\x1ainactivity_timeout_seconds\x18\a \x01(\x05H\x03R\x18inactivityTimeoutSeconds\x88\x01\x01\x12,\n" +
+	"\x0fmodel_overrides\x18\b \x01(\tH\x04R\x0emodelOverrides\x88\x01\x01\x121\n" +
+	"\x12monthly_budget_usd\x18\t \x01(\x01H\x05R\x10monthlyBudgetUsd\x88\x01\x01B\x0f\n" +
 	"\r_group_accessB\x0f\n" +
-	"\r_repositoriesJ\x04\b\x04\x10\x05R\x0erunner_secrets\"\xc6\x01\n" +
+	"\r_repositoriesB\x12\n" +
+	"\x10_resource_limitsB\x1d\n" +
+	"\x1b_inactivity_timeout_secondsB\x12\n" +
+	"\x10_model_overridesB\x15\n" +
+	"\x13_monthly_budget_usdJ\x04\b\x04\x10\x05R\x0erunner_secrets\"\xf6\x03\n" +
 	"\x1cCreateProjectSettingsRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12&\n" +
 	"\fgroup_access\x18\x02 \x01(\tH\x00R\vgroupAccess\x88\x01\x01\x12'\n" +
-	"\frepositories\x18\x04 \x01(\tH\x01R\frepositories\x88\x01\x01B\x0f\n" +
+	"\frepositories\x18\x04 \x01(\tH\x01R\frepositories\x88\x01\x01\x12,\n" +
+	"\x0fresource_limits\x18\x05 \x01(\tH\x02R\x0eresourceLimits\x88\x01\x01\x12A\n" +
+	"\x1ainactivity_timeout_seconds\x18\x06 \x01(\x05H\x03R\x18inactivityTimeoutSeconds\x88\x01\x01\x12,\n" +
+	"\x0fmodel_overrides\x18\a \x01(\tH\x04R\x0emodelOverrides\x88\x01\x01\x121\n" +
+	"\x12monthly_budget_usd\x18\b \x01(\x01H\x05R\x10monthlyBudgetUsd\x88\x01\x01B\x0f\n" +
 	"\r_group_accessB\x0f\n" +
-	"\r_repositoriesJ\x04\b\x03\x10\x04R\x0erunner_secrets\"+\n" +
+	"\r_repositoriesB\x12\n" +
+	"\x10_resource_limitsB\x1d\n" +
+	"\x1b_inactivity_timeout_secondsB\x12\n" +
+	"\x10_model_overridesB\x15\n" +
+	"\x13_monthly_budget_usdJ\x04\b\x03\x10\x04R\x0erunner_secrets\"+\n" +
 	"\x19GetProjectSettingsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xea\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9a\x04\n" +
 	"\x1cUpdateProjectSettingsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12&\n" +
 	"\fgroup_access\x18\x03 \x01(\tH\x01R\vgroupAccess\x88\x01\x01\x12'\n" +
-	"\frepositories\x18\x05 \x01(\tH\x02R\frepositories\x88\x01\x01B\r\n" +
+	"\frepositories\x18\x05 \x01(\tH\x02R\frepositories\x88\x01\x01\x12,\n" +
+	"\x0fresource_limits\x18\x06 \x01(\tH\x03R\x0eresourceLimits\x88\x01\x01\x12A\n" +
+	"\x1ainactivity_timeout_seconds\x18\a \x01(\x05H\x04R\x18inactivityTimeoutSeconds\x88\x01\x01\x12,\n" +
+	"\x0fmodel_overrides\x18\b \x01(\tH\x05R\x0emodelOverrides\x88\x01\x01\x121\n" +
+	"\x12monthly_budget_usd\x18\t \x01(\x01H\x06R\x10monthlyBudgetUsd\x88\x01\x01B\r\n" +
 	"\v_project_idB\x0f\n" +
 	"\r_group_accessB\x0f\n" +
-	"\r_repositoriesJ\x04\b\x04\x10\x05R\x0erunner_secrets\".\n" +
+	"\r_repositoriesB\x12\n" +
+	"\x10_resource_limitsB\x1d\n" +
+	"\x1b_inactivity_timeout_secondsB\x12\n" +
+	"\x10_model_overridesB\x15\n" +
+	"\x13_monthly_budget_usdJ\x04\b\x04\x10\x05R\x0erunner_secrets\".\n" +
 	"\x1cDeleteProjectSettingsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
 	"\x1aListProjectSettingsRequest\x12\x12\n" +
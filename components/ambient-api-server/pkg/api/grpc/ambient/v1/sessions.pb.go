@@ -55,6 +55,7 @@ type Session struct {
 	KubeCrUid            *string                `protobuf:"bytes,30,opt,name=kube_cr_uid,json=kubeCrUid,proto3,oneof" json:"kube_cr_uid,omitempty"`
 	KubeNamespace        *string                `protobuf:"bytes,31,opt,name=kube_namespace,json=kubeNamespace,proto3,oneof" json:"kube_namespace,omitempty"`
 	AgentId              *string                `protobuf:"bytes,32,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	StoppedReason        *string                `protobuf:"bytes,33,opt,name=stopped_reason,json=stoppedReason,proto3,oneof" json:"stopped_reason,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -306,6 +307,13 @@ func (x *Session) GetAgentId() string {
 	return ""
 }
 
+func (x *Session) GetStoppedReason() string {
+	if x != nil && x.StoppedReason != nil {
+		return *x.StoppedReason
+	}
+	return ""
+}
+
 type CreateSessionRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -723,6 +731,7 @@ type UpdateSessionStatusRequest struct {
 	ReconciledWorkflow *string                `protobuf:"bytes,9,opt,name=reconciled_workflow,json=reconciledWorkflow,proto3,oneof" json:"reconciled_workflow,omitempty"`
 	KubeCrUid          *string                `protobuf:"bytes,10,opt,name=kube_cr_uid,json=kubeCrUid,proto3,oneof" json:"kube_cr_uid,omitempty"`
 	KubeNamespace      *string                `protobuf:"bytes,11,opt,name=kube_namespace,json=kubeNamespace,proto3,oneof" json:"kube_namespace,omitempty"`
+	StoppedReason      *string                `protobuf:"bytes,12,opt,name=stopped_reason,json=stoppedReason,proto3,oneof" json:"stopped_reason,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -834,6 +843,13 @@ func (x *UpdateSessionStatusRequest) GetKubeNamespace() string {
 	return ""
 }
 
+func (x *UpdateSessionStatusRequest) GetStoppedReason() string {
+	if x != nil && x.StoppedReason != nil {
+		return *x.StoppedReason
+	}
+	return ""
+}
+
 type DeleteSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1315,7 +1331,7 @@ var File_ambient_v1_sessions_proto protoreflect.FileDescriptor
 const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"\n" +
 	"\x19ambient/v1/sessions.proto\x12\n" +
-	"ambient.v1\x1a\x17ambient/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x0e\n" +
+	"ambient.v1\x1a\x17ambient/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf3\x0e\n" +
 	"\aSession\x127\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1b.ambient.v1.ObjectReferenceR\bmetadata\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
@@ -1355,7 +1371,8 @@ const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"kubeCrName\x88\x01\x01\x12#\n" +
 	"\vkube_cr_uid\x18\x1e \x01(\tH\x1aR\tkubeCrUid\x88\x01\x01\x12*\n" +
 	"\x0ekube_namespace\x18\x1f \x01(\tH\x1bR\rkubeNamespace\x88\x01\x01\x12\x1e\n" +
-	"\bagent_id\x18  \x01(\tH\x1cR\aagentId\x88\x01\x01B\v\n" +
+	"\bagent_id\x18  \x01(\tH\x1cR\aagentId\x88\x01\x01\x12*\n" +
+	"\x0estopped_reason\x18! \x01(\tH\x1dR\rstoppedReason\x88\x01\x01B\v\n" +
 	"\t_repo_urlB\t\n" +
 	"\a_promptB\x15\n" +
 	"\x13_created_by_user_idB\x13\n" +
@@ -1386,7 +1403,8 @@ const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"\r_kube_cr_nameB\x0e\n" +
 	"\f_kube_cr_uidB\x11\n" +
 	"\x0f_kube_namespaceB\v\n" +
-	"\t_agent_idJ\x04\b\t\x10\n" +
+	"\t_agent_idB\x11\n" +
+	"\x0f_stopped_reasonJ\x04\b\t\x10\n" +
 	"R\vinteractive\"\x91\b\n" +
 	"\x14CreateSessionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
@@ -1473,7 +1491,7 @@ const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"\x16_environment_variablesB\t\n" +
 	"\a_labelsB\x0e\n" +
 	"\f_annotationsB\r\n" +
-	"\v_project_idJ\x04\b\b\x10\tR\vinteractive\"\xbe\x05\n" +
+	"\v_project_idJ\x04\b\b\x10\tR\vinteractive\"\xfd\x05\n" +
 	"\x1aUpdateSessionStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05phase\x18\x02 \x01(\tH\x00R\x05phase\x88\x01\x01\x12>\n" +
@@ -1489,7 +1507,9 @@ const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"\x13reconciled_workflow\x18\t \x01(\tH\aR\x12reconciledWorkflow\x88\x01\x01\x12#\n" +
 	"\vkube_cr_uid\x18\n" +
 	" \x01(\tH\bR\tkubeCrUid\x88\x01\x01\x12*\n" +
-	"\x0ekube_namespace\x18\v \x01(\tH\tR\rkubeNamespace\x88\x01\x01B\b\n" +
+	"\x0ekube_namespace\x18\v \x01(\tH\tR\rkubeNamespace\x88\x01\x01\x12*\n" +
+	"\x0estopped_reason\x18\f \x01(\tH\n" +
+	"R\rstoppedReason\x88\x01\x01B\b\n" +
 	"\x06_phaseB\r\n" +
 	"\v_start_timeB\x12\n" +
 	"\x10_completion_timeB\x11\n" +
@@ -1499,7 +1519,8 @@ const file_ambient_v1_sessions_proto_rawDesc = "" +
 	"\x11_reconciled_reposB\x16\n" +
 	"\x14_reconciled_workflowB\x0e\n" +
 	"\f_kube_cr_uidB\x11\n" +
-	"\x0f_kube_namespace\"&\n" +
+	"\x0f_kube_namespaceB\x11\n" +
+	"\x0f_stopped_reason\"&\n" +
 	"\x14DeleteSessionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
 	"\x13ListSessionsRequest\x12\x12\n" +
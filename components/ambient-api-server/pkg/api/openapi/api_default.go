@@ -7351,12 +7351,14 @@ func (r ApiApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetRequest) Size(size
 	return r
 }
 
-func (r ApiApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetRequest) Execute() (*SessionList, *http.Response, error) {
+func (r ApiApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetRequest) Execute() (*ScheduledSessionRunList, *http.Response, error) {
 	return r.ApiService.ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetExecute(r)
 }
 
 /*
-ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGet List sessions triggered by this scheduled session
+ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGet List the firings of this scheduled session
+
+Scheduled and manual firings, newest first, including skipped and failed ones.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id The id of record
@@ -7374,13 +7376,13 @@ func (a *DefaultAPIService) ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGet(c
 
 // Execute executes the request
 //
-//	@return SessionList
-func (a *DefaultAPIService) ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetExecute(r ApiApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetRequest) (*SessionList, *http.Response, error) {
+//	@return ScheduledSessionRunList
+func (a *DefaultAPIService) ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetExecute(r ApiApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGetRequest) (*ScheduledSessionRunList, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *SessionList
+		localVarReturnValue *ScheduledSessionRunList
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.ApiAmbientV1ProjectsIdScheduledSessionsSsIdRunsGet")
@@ -37,6 +37,22 @@ type Credential struct {
 	Email       *string `json:"email,omitempty"`
 	Labels      *string `json:"labels,omitempty"`
 	Annotations *string `json:"annotations,omitempty"`
+	// When the token stops working. The expiry notifier warns bound agents ahead of it.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Last time the token was fetched, including the periodic re-fetches of running credential sidecars
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Last time the token was replaced
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	// When bound agents were warned the credential is expiring soon. Cleared on rotation or when expires_at changes.
+	ExpiryNotifiedAt *time.Time `json:"expiry_notified_at,omitempty"`
+	// How the credential yields tokens. \"token\" stores a static token; \"oauth\" stores a refresh token and mints access tokens on fetch.
+	AuthType *string `json:"auth_type,omitempty"`
+	// OAuth refresh token for auth_type oauth; write-only, stored encrypted
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	// OAuth client the refresh token was issued to; its id and secret come from server configuration
+	OauthClient *string `json:"oauth_client,omitempty"`
+	// Space-separated scopes to request on refresh; omit to keep the grant's original scopes
+	OauthScopes *string `json:"oauth_scopes,omitempty"`
 }
 
 type _Credential Credential
@@ -49,6 +65,8 @@ func NewCredential(name string, provider string) *Credential {
 	this := Credential{}
 	this.Name = name
 	this.Provider = provider
+	var authType string = "token"
+	this.AuthType = &authType
 	return &this
 }
 
@@ -57,6 +75,8 @@ func NewCredential(name string, provider string) *Credential {
 // but it doesn't guarantee that properties required by API are set
 func NewCredentialWithDefaults() *Credential {
 	this := Credential{}
+	var authType string = "token"
+	this.AuthType = &authType
 	return &this
 }
 
@@ -460,6 +480,262 @@ func (o *Credential) SetAnnotations(v string) {
 	o.Annotations = &v
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *Credential) GetExpiresAt() time.Time {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetExpiresAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *Credential) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given time.Time and assigns it to the ExpiresAt field.
+func (o *Credential) SetExpiresAt(v time.Time) {
+	o.ExpiresAt = &v
+}
+
+// GetLastUsedAt returns the LastUsedAt field value if set, zero value otherwise.
+func (o *Credential) GetLastUsedAt() time.Time {
+	if o == nil || IsNil(o.LastUsedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.LastUsedAt
+}
+
+// GetLastUsedAtOk returns a tuple with the LastUsedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetLastUsedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.LastUsedAt) {
+		return nil, false
+	}
+	return o.LastUsedAt, true
+}
+
+// HasLastUsedAt returns a boolean if a field has been set.
+func (o *Credential) HasLastUsedAt() bool {
+	if o != nil && !IsNil(o.LastUsedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastUsedAt gets a reference to the given time.Time and assigns it to the LastUsedAt field.
+func (o *Credential) SetLastUsedAt(v time.Time) {
+	o.LastUsedAt = &v
+}
+
+// GetRotatedAt returns the RotatedAt field value if set, zero value otherwise.
+func (o *Credential) GetRotatedAt() time.Time {
+	if o == nil || IsNil(o.RotatedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.RotatedAt
+}
+
+// GetRotatedAtOk returns a tuple with the RotatedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetRotatedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.RotatedAt) {
+		return nil, false
+	}
+	return o.RotatedAt, true
+}
+
+// HasRotatedAt returns a boolean if a field has been set.
+func (o *Credential) HasRotatedAt() bool {
+	if o != nil && !IsNil(o.RotatedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetRotatedAt gets a reference to the given time.Time and assigns it to the RotatedAt field.
+func (o *Credential) SetRotatedAt(v time.Time) {
+	o.RotatedAt = &v
+}
+
+// GetExpiryNotifiedAt returns the ExpiryNotifiedAt field value if set, zero value otherwise.
+func (o *Credential) GetExpiryNotifiedAt() time.Time {
+	if o == nil || IsNil(o.ExpiryNotifiedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ExpiryNotifiedAt
+}
+
+// GetExpiryNotifiedAtOk returns a tuple with the ExpiryNotifiedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetExpiryNotifiedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ExpiryNotifiedAt) {
+		return nil, false
+	}
+	return o.ExpiryNotifiedAt, true
+}
+
+// HasExpiryNotifiedAt returns a boolean if a field has been set.
+func (o *Credential) HasExpiryNotifiedAt() bool {
+	if o != nil && !IsNil(o.ExpiryNotifiedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiryNotifiedAt gets a reference to the given time.Time and assigns it to the ExpiryNotifiedAt field.
+func (o *Credential) SetExpiryNotifiedAt(v time.Time) {
+	o.ExpiryNotifiedAt = &v
+}
+
+// GetAuthType returns the AuthType field value if set, zero value otherwise.
+func (o *Credential) GetAuthType() string {
+	if o == nil || IsNil(o.AuthType) {
+		var ret string
+		return ret
+	}
+	return *o.AuthType
+}
+
+// GetAuthTypeOk returns a tuple with the AuthType field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetAuthTypeOk() (*string, bool) {
+	if o == nil || IsNil(o.AuthType) {
+		return nil, false
+	}
+	return o.AuthType, true
+}
+
+// HasAuthType returns a boolean if a field has been set.
+func (o *Credential) HasAuthType() bool {
+	if o != nil && !IsNil(o.AuthType) {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthType gets a reference to the given string and assigns it to the AuthType field.
+func (o *Credential) SetAuthType(v string) {
+	o.AuthType = &v
+}
+
+// GetRefreshToken returns the RefreshToken field value if set, zero value otherwise.
+func (o *Credential) GetRefreshToken() string {
+	if o == nil || IsNil(o.RefreshToken) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshToken
+}
+
+// GetRefreshTokenOk returns a tuple with the RefreshToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetRefreshTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshToken) {
+		return nil, false
+	}
+	return o.RefreshToken, true
+}
+
+// HasRefreshToken returns a boolean if a field has been set.
+func (o *Credential) HasRefreshToken() bool {
+	if o != nil && !IsNil(o.RefreshToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshToken gets a reference to the given string and assigns it to the RefreshToken field.
+func (o *Credential) SetRefreshToken(v string) {
+	o.RefreshToken = &v
+}
+
+// GetOauthClient returns the OauthClient field value if set, zero value otherwise.
+func (o *Credential) GetOauthClient() string {
+	if o == nil || IsNil(o.OauthClient) {
+		var ret string
+		return ret
+	}
+	return *o.OauthClient
+}
+
+// GetOauthClientOk returns a tuple with the OauthClient field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetOauthClientOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthClient) {
+		return nil, false
+	}
+	return o.OauthClient, true
+}
+
+// HasOauthClient returns a boolean if a field has been set.
+func (o *Credential) HasOauthClient() bool {
+	if o != nil && !IsNil(o.OauthClient) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthClient gets a reference to the given string and assigns it to the OauthClient field.
+func (o *Credential) SetOauthClient(v string) {
+	o.OauthClient = &v
+}
+
+// GetOauthScopes returns the OauthScopes field value if set, zero value otherwise.
+func (o *Credential) GetOauthScopes() string {
+	if o == nil || IsNil(o.OauthScopes) {
+		var ret string
+		return ret
+	}
+	return *o.OauthScopes
+}
+
+// GetOauthScopesOk returns a tuple with the OauthScopes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Credential) GetOauthScopesOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthScopes) {
+		return nil, false
+	}
+	return o.OauthScopes, true
+}
+
+// HasOauthScopes returns a boolean if a field has been set.
+func (o *Credential) HasOauthScopes() bool {
+	if o != nil && !IsNil(o.OauthScopes) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthScopes gets a reference to the given string and assigns it to the OauthScopes field.
+func (o *Credential) SetOauthScopes(v string) {
+	o.OauthScopes = &v
+}
+
 func (o Credential) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -505,6 +781,30 @@ func (o Credential) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Annotations) {
 		toSerialize["annotations"] = o.Annotations
 	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expires_at"] = o.ExpiresAt
+	}
+	if !IsNil(o.LastUsedAt) {
+		toSerialize["last_used_at"] = o.LastUsedAt
+	}
+	if !IsNil(o.RotatedAt) {
+		toSerialize["rotated_at"] = o.RotatedAt
+	}
+	if !IsNil(o.ExpiryNotifiedAt) {
+		toSerialize["expiry_notified_at"] = o.ExpiryNotifiedAt
+	}
+	if !IsNil(o.AuthType) {
+		toSerialize["auth_type"] = o.AuthType
+	}
+	if !IsNil(o.RefreshToken) {
+		toSerialize["refresh_token"] = o.RefreshToken
+	}
+	if !IsNil(o.OauthClient) {
+		toSerialize["oauth_client"] = o.OauthClient
+	}
+	if !IsNil(o.OauthScopes) {
+		toSerialize["oauth_scopes"] = o.OauthScopes
+	}
 	return toSerialize, nil
 }
 
@@ -13,6 +13,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // checks if the CredentialPatchRequest type satisfies the MappedNullable interface at compile time
@@ -24,11 +25,16 @@ type CredentialPatchRequest struct {
 	Description *string `json:"description,omitempty"`
 	Provider    *string `json:"provider,omitempty"`
 	// Credential token value; write-only, never returned in GET/LIST responses
-	Token       *string `json:"token,omitempty"`
-	Url         *string `json:"url,omitempty"`
-	Email       *string `json:"email,omitempty"`
-	Labels      *string `json:"labels,omitempty"`
-	Annotations *string `json:"annotations,omitempty"`
+	Token       *string    `json:"token,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	Labels      *string    `json:"labels,omitempty"`
+	Annotations *string    `json:"annotations,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	// Replacement OAuth refresh token; discards the cached access token
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	OauthClient  *string `json:"oauth_client,omitempty"`
+	OauthScopes  *string `json:"oauth_scopes,omitempty"`
 }
 
 // NewCredentialPatchRequest instantiates a new CredentialPatchRequest object
@@ -304,6 +310,134 @@ func (o *CredentialPatchRequest) SetAnnotations(v string) {
 	o.Annotations = &v
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *CredentialPatchRequest) GetExpiresAt() time.Time {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CredentialPatchRequest) GetExpiresAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *CredentialPatchRequest) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given time.Time and assigns it to the ExpiresAt field.
+func (o *CredentialPatchRequest) SetExpiresAt(v time.Time) {
+	o.ExpiresAt = &v
+}
+
+// GetRefreshToken returns the RefreshToken field value if set, zero value otherwise.
+func (o *CredentialPatchRequest) GetRefreshToken() string {
+	if o == nil || IsNil(o.RefreshToken) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshToken
+}
+
+// GetRefreshTokenOk returns a tuple with the RefreshToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CredentialPatchRequest) GetRefreshTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshToken) {
+		return nil, false
+	}
+	return o.RefreshToken, true
+}
+
+// HasRefreshToken returns a boolean if a field has been set.
+func (o *CredentialPatchRequest) HasRefreshToken() bool {
+	if o != nil && !IsNil(o.RefreshToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshToken gets a reference to the given string and assigns it to the RefreshToken field.
+func (o *CredentialPatchRequest) SetRefreshToken(v string) {
+	o.RefreshToken = &v
+}
+
+// GetOauthClient returns the OauthClient field value if set, zero value otherwise.
+func (o *CredentialPatchRequest) GetOauthClient() string {
+	if o == nil || IsNil(o.OauthClient) {
+		var ret string
+		return ret
+	}
+	return *o.OauthClient
+}
+
+// GetOauthClientOk returns a tuple with the OauthClient field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CredentialPatchRequest) GetOauthClientOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthClient) {
+		return nil, false
+	}
+	return o.OauthClient, true
+}
+
+// HasOauthClient returns a boolean if a field has been set.
+func (o *CredentialPatchRequest) HasOauthClient() bool {
+	if o != nil && !IsNil(o.OauthClient) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthClient gets a reference to the given string and assigns it to the OauthClient field.
+func (o *CredentialPatchRequest) SetOauthClient(v string) {
+	o.OauthClient = &v
+}
+
+// GetOauthScopes returns the OauthScopes field value if set, zero value otherwise.
+func (o *CredentialPatchRequest) GetOauthScopes() string {
+	if o == nil || IsNil(o.OauthScopes) {
+		var ret string
+		return ret
+	}
+	return *o.OauthScopes
+}
+
+// GetOauthScopesOk returns a tuple with the OauthScopes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CredentialPatchRequest) GetOauthScopesOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthScopes) {
+		return nil, false
+	}
+	return o.OauthScopes, true
+}
+
+// HasOauthScopes returns a boolean if a field has been set.
+func (o *CredentialPatchRequest) HasOauthScopes() bool {
+	if o != nil && !IsNil(o.OauthScopes) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthScopes gets a reference to the given string and assigns it to the OauthScopes field.
+func (o *CredentialPatchRequest) SetOauthScopes(v string) {
+	o.OauthScopes = &v
+}
+
 func (o CredentialPatchRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -338,6 +472,18 @@ func (o CredentialPatchRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Annotations) {
 		toSerialize["annotations"] = o.Annotations
 	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expires_at"] = o.ExpiresAt
+	}
+	if !IsNil(o.RefreshToken) {
+		toSerialize["refresh_token"] = o.RefreshToken
+	}
+	if !IsNil(o.OauthClient) {
+		toSerialize["oauth_client"] = o.OauthClient
+	}
+	if !IsNil(o.OauthScopes) {
+		toSerialize["oauth_scopes"] = o.OauthScopes
+	}
 	return toSerialize, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // checks if the CredentialTokenResponse type satisfies the MappedNullable interface at compile time
@@ -28,6 +29,8 @@ type CredentialTokenResponse struct {
 	Provider string `json:"provider"`
 	// Decrypted token value
 	Token string `json:"token"`
+	// When the token stops working, if it expires
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 type _CredentialTokenResponse CredentialTokenResponse
@@ -124,6 +127,38 @@ func (o *CredentialTokenResponse) SetToken(v string) {
 	o.Token = v
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *CredentialTokenResponse) GetExpiresAt() time.Time {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CredentialTokenResponse) GetExpiresAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *CredentialTokenResponse) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given time.Time and assigns it to the ExpiresAt field.
+func (o *CredentialTokenResponse) SetExpiresAt(v time.Time) {
+	o.ExpiresAt = &v
+}
+
 func (o CredentialTokenResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -137,6 +172,9 @@ func (o CredentialTokenResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize["credential_id"] = o.CredentialId
 	toSerialize["provider"] = o.Provider
 	toSerialize["token"] = o.Token
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expires_at"] = o.ExpiresAt
+	}
 	return toSerialize, nil
 }
 
@@ -13,6 +13,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // checks if the ProjectHome type satisfies the MappedNullable interface at compile time
@@ -20,8 +21,12 @@ var _ MappedNullable = &ProjectHome{}
 
 // ProjectHome struct for ProjectHome
 type ProjectHome struct {
-	ProjectId *string            `json:"project_id,omitempty"`
-	Agents    []ProjectHomeAgent `json:"agents,omitempty"`
+	ProjectId                 *string                       `json:"project_id,omitempty"`
+	Agents                    []ProjectHomeAgent            `json:"agents,omitempty"`
+	SessionCounts             []ProjectHomeSessionCount     `json:"session_counts,omitempty"`
+	UpcomingScheduledSessions []ProjectHomeScheduledSession `json:"upcoming_scheduled_sessions,omitempty"`
+	RecentApplicationSyncs    []ProjectHomeApplicationSync  `json:"recent_application_syncs,omitempty"`
+	GeneratedAt               *time.Time                    `json:"generated_at,omitempty"`
 }
 
 // NewProjectHome instantiates a new ProjectHome object
@@ -105,6 +110,134 @@ func (o *ProjectHome) SetAgents(v []ProjectHomeAgent) {
 	o.Agents = v
 }
 
+// GetSessionCounts returns the SessionCounts field value if set, zero value otherwise.
+func (o *ProjectHome) GetSessionCounts() []ProjectHomeSessionCount {
+	if o == nil || IsNil(o.SessionCounts) {
+		var ret []ProjectHomeSessionCount
+		return ret
+	}
+	return o.SessionCounts
+}
+
+// GetSessionCountsOk returns a tuple with the SessionCounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHome) GetSessionCountsOk() ([]ProjectHomeSessionCount, bool) {
+	if o == nil || IsNil(o.SessionCounts) {
+		return nil, false
+	}
+	return o.SessionCounts, true
+}
+
+// HasSessionCounts returns a boolean if a field has been set.
+func (o *ProjectHome) HasSessionCounts() bool {
+	if o != nil && !IsNil(o.SessionCounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetSessionCounts gets a reference to the given []ProjectHomeSessionCount and assigns it to the SessionCounts field.
+func (o *ProjectHome) SetSessionCounts(v []ProjectHomeSessionCount) {
+	o.SessionCounts = v
+}
+
+// GetUpcomingScheduledSessions returns the UpcomingScheduledSessions field value if set, zero value otherwise.
+func (o *ProjectHome) GetUpcomingScheduledSessions() []ProjectHomeScheduledSession {
+	if o == nil || IsNil(o.UpcomingScheduledSessions) {
+		var ret []ProjectHomeScheduledSession
+		return ret
+	}
+	return o.UpcomingScheduledSessions
+}
+
+// GetUpcomingScheduledSessionsOk returns a tuple with the UpcomingScheduledSessions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHome) GetUpcomingScheduledSessionsOk() ([]ProjectHomeScheduledSession, bool) {
+	if o == nil || IsNil(o.UpcomingScheduledSessions) {
+		return nil, false
+	}
+	return o.UpcomingScheduledSessions, true
+}
+
+// HasUpcomingScheduledSessions returns a boolean if a field has been set.
+func (o *ProjectHome) HasUpcomingScheduledSessions() bool {
+	if o != nil && !IsNil(o.UpcomingScheduledSessions) {
+		return true
+	}
+
+	return false
+}
+
+// SetUpcomingScheduledSessions gets a reference to the given []ProjectHomeScheduledSession and assigns it to the UpcomingScheduledSessions field.
+func (o *ProjectHome) SetUpcomingScheduledSessions(v []ProjectHomeScheduledSession) {
+	o.UpcomingScheduledSessions = v
+}
+
+// GetRecentApplicationSyncs returns the RecentApplicationSyncs field value if set, zero value otherwise.
+func (o *ProjectHome) GetRecentApplicationSyncs() []ProjectHomeApplicationSync {
+	if o == nil || IsNil(o.RecentApplicationSyncs) {
+		var ret []ProjectHomeApplicationSync
+		return ret
+	}
+	return o.RecentApplicationSyncs
+}
+
+// GetRecentApplicationSyncsOk returns a tuple with the RecentApplicationSyncs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHome) GetRecentApplicationSyncsOk() ([]ProjectHomeApplicationSync, bool) {
+	if o == nil || IsNil(o.RecentApplicationSyncs) {
+		return nil, false
+	}
+	return o.RecentApplicationSyncs, true
+}
+
+// HasRecentApplicationSyncs returns a boolean if a field has been set.
+func (o *ProjectHome) HasRecentApplicationSyncs() bool {
+	if o != nil && !IsNil(o.RecentApplicationSyncs) {
+		return true
+	}
+
+	return false
+}
+
+// SetRecentApplicationSyncs gets a reference to the given []ProjectHomeApplicationSync and assigns it to the RecentApplicationSyncs field.
+func (o *ProjectHome) SetRecentApplicationSyncs(v []ProjectHomeApplicationSync) {
+	o.RecentApplicationSyncs = v
+}
+
+// GetGeneratedAt returns the GeneratedAt field value if set, zero value otherwise.
+func (o *ProjectHome) GetGeneratedAt() time.Time {
+	if o == nil || IsNil(o.GeneratedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.GeneratedAt
+}
+
+// GetGeneratedAtOk returns a tuple with the GeneratedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHome) GetGeneratedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.GeneratedAt) {
+		return nil, false
+	}
+	return o.GeneratedAt, true
+}
+
+// HasGeneratedAt returns a boolean if a field has been set.
+func (o *ProjectHome) HasGeneratedAt() bool {
+	if o != nil && !IsNil(o.GeneratedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetGeneratedAt gets a reference to the given time.Time and assigns it to the GeneratedAt field.
+func (o *ProjectHome) SetGeneratedAt(v time.Time) {
+	o.GeneratedAt = &v
+}
+
 func (o ProjectHome) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -121,6 +254,18 @@ func (o ProjectHome) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Agents) {
 		toSerialize["agents"] = o.Agents
 	}
+	if !IsNil(o.SessionCounts) {
+		toSerialize["session_counts"] = o.SessionCounts
+	}
+	if !IsNil(o.UpcomingScheduledSessions) {
+		toSerialize["upcoming_scheduled_sessions"] = o.UpcomingScheduledSessions
+	}
+	if !IsNil(o.RecentApplicationSyncs) {
+		toSerialize["recent_application_syncs"] = o.RecentApplicationSyncs
+	}
+	if !IsNil(o.GeneratedAt) {
+		toSerialize["generated_at"] = o.GeneratedAt
+	}
 	return toSerialize, nil
 }
 
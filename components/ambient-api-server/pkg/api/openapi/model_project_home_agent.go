@@ -25,6 +25,8 @@ type ProjectHomeAgent struct {
 	SessionPhase     *string `json:"session_phase,omitempty"`
 	InboxUnreadCount *int32  `json:"inbox_unread_count,omitempty"`
 	Summary          *string `json:"summary,omitempty"`
+	CurrentSessionId *string `json:"current_session_id,omitempty"`
+	AgentStatus      *string `json:"agent_status,omitempty"`
 }
 
 // NewProjectHomeAgent instantiates a new ProjectHomeAgent object
@@ -204,6 +206,70 @@ func (o *ProjectHomeAgent) SetSummary(v string) {
 	o.Summary = &v
 }
 
+// GetCurrentSessionId returns the CurrentSessionId field value if set, zero value otherwise.
+func (o *ProjectHomeAgent) GetCurrentSessionId() string {
+	if o == nil || IsNil(o.CurrentSessionId) {
+		var ret string
+		return ret
+	}
+	return *o.CurrentSessionId
+}
+
+// GetCurrentSessionIdOk returns a tuple with the CurrentSessionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHomeAgent) GetCurrentSessionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CurrentSessionId) {
+		return nil, false
+	}
+	return o.CurrentSessionId, true
+}
+
+// HasCurrentSessionId returns a boolean if a field has been set.
+func (o *ProjectHomeAgent) HasCurrentSessionId() bool {
+	if o != nil && !IsNil(o.CurrentSessionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCurrentSessionId gets a reference to the given string and assigns it to the CurrentSessionId field.
+func (o *ProjectHomeAgent) SetCurrentSessionId(v string) {
+	o.CurrentSessionId = &v
+}
+
+// GetAgentStatus returns the AgentStatus field value if set, zero value otherwise.
+func (o *ProjectHomeAgent) GetAgentStatus() string {
+	if o == nil || IsNil(o.AgentStatus) {
+		var ret string
+		return ret
+	}
+	return *o.AgentStatus
+}
+
+// GetAgentStatusOk returns a tuple with the AgentStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectHomeAgent) GetAgentStatusOk() (*string, bool) {
+	if o == nil || IsNil(o.AgentStatus) {
+		return nil, false
+	}
+	return o.AgentStatus, true
+}
+
+// HasAgentStatus returns a boolean if a field has been set.
+func (o *ProjectHomeAgent) HasAgentStatus() bool {
+	if o != nil && !IsNil(o.AgentStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetAgentStatus gets a reference to the given string and assigns it to the AgentStatus field.
+func (o *ProjectHomeAgent) SetAgentStatus(v string) {
+	o.AgentStatus = &v
+}
+
 func (o ProjectHomeAgent) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -229,6 +295,12 @@ func (o ProjectHomeAgent) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Summary) {
 		toSerialize["summary"] = o.Summary
 	}
+	if !IsNil(o.CurrentSessionId) {
+		toSerialize["current_session_id"] = o.CurrentSessionId
+	}
+	if !IsNil(o.AgentStatus) {
+		toSerialize["agent_status"] = o.AgentStatus
+	}
 	return toSerialize, nil
 }
 
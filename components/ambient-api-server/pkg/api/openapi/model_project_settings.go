@@ -23,14 +23,18 @@ var _ MappedNullable = &ProjectSettings{}
 
 // ProjectSettings struct for ProjectSettings
 type ProjectSettings struct {
-	Id           *string    `json:"id,omitempty"`
-	Kind         *string    `json:"kind,omitempty"`
-	Href         *string    `json:"href,omitempty"`
-	CreatedAt    *time.Time `json:"created_at,omitempty"`
-	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
-	ProjectId    string     `json:"project_id"`
-	GroupAccess  *string    `json:"group_access,omitempty"`
-	Repositories *string    `json:"repositories,omitempty"`
+	Id                       *string    `json:"id,omitempty"`
+	Kind                     *string    `json:"kind,omitempty"`
+	Href                     *string    `json:"href,omitempty"`
+	CreatedAt                *time.Time `json:"created_at,omitempty"`
+	UpdatedAt                *time.Time `json:"updated_at,omitempty"`
+	ProjectId                string     `json:"project_id"`
+	GroupAccess              *string    `json:"group_access,omitempty"`
+	Repositories             *string    `json:"repositories,omitempty"`
+	ResourceLimits           *string    `json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32     `json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string    `json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64   `json:"monthly_budget_usd,omitempty"`
 }
 
 type _ProjectSettings ProjectSettings
@@ -301,6 +305,134 @@ func (o *ProjectSettings) SetRepositories(v string) {
 	o.Repositories = &v
 }
 
+// GetResourceLimits returns the ResourceLimits field value if set, zero value otherwise.
+func (o *ProjectSettings) GetResourceLimits() string {
+	if o == nil || IsNil(o.ResourceLimits) {
+		var ret string
+		return ret
+	}
+	return *o.ResourceLimits
+}
+
+// GetResourceLimitsOk returns a tuple with the ResourceLimits field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettings) GetResourceLimitsOk() (*string, bool) {
+	if o == nil || IsNil(o.ResourceLimits) {
+		return nil, false
+	}
+	return o.ResourceLimits, true
+}
+
+// HasResourceLimits returns a boolean if a field has been set.
+func (o *ProjectSettings) HasResourceLimits() bool {
+	if o != nil && !IsNil(o.ResourceLimits) {
+		return true
+	}
+
+	return false
+}
+
+// SetResourceLimits gets a reference to the given string and assigns it to the ResourceLimits field.
+func (o *ProjectSettings) SetResourceLimits(v string) {
+	o.ResourceLimits = &v
+}
+
+// GetInactivityTimeoutSeconds returns the InactivityTimeoutSeconds field value if set, zero value otherwise.
+func (o *ProjectSettings) GetInactivityTimeoutSeconds() int32 {
+	if o == nil || IsNil(o.InactivityTimeoutSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.InactivityTimeoutSeconds
+}
+
+// GetInactivityTimeoutSecondsOk returns a tuple with the InactivityTimeoutSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettings) GetInactivityTimeoutSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.InactivityTimeoutSeconds) {
+		return nil, false
+	}
+	return o.InactivityTimeoutSeconds, true
+}
+
+// HasInactivityTimeoutSeconds returns a boolean if a field has been set.
+func (o *ProjectSettings) HasInactivityTimeoutSeconds() bool {
+	if o != nil && !IsNil(o.InactivityTimeoutSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetInactivityTimeoutSeconds gets a reference to the given int32 and assigns it to the InactivityTimeoutSeconds field.
+func (o *ProjectSettings) SetInactivityTimeoutSeconds(v int32) {
+	o.InactivityTimeoutSeconds = &v
+}
+
+// GetModelOverrides returns the ModelOverrides field value if set, zero value otherwise.
+func (o *ProjectSettings) GetModelOverrides() string {
+	if o == nil || IsNil(o.ModelOverrides) {
+		var ret string
+		return ret
+	}
+	return *o.ModelOverrides
+}
+
+// GetModelOverridesOk returns a tuple with the ModelOverrides field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettings) GetModelOverridesOk() (*string, bool) {
+	if o == nil || IsNil(o.ModelOverrides) {
+		return nil, false
+	}
+	return o.ModelOverrides, true
+}
+
+// HasModelOverrides returns a boolean if a field has been set.
+func (o *ProjectSettings) HasModelOverrides() bool {
+	if o != nil && !IsNil(o.ModelOverrides) {
+		return true
+	}
+
+	return false
+}
+
+// SetModelOverrides gets a reference to the given string and assigns it to the ModelOverrides field.
+func (o *ProjectSettings) SetModelOverrides(v string) {
+	o.ModelOverrides = &v
+}
+
+// GetMonthlyBudgetUsd returns the MonthlyBudgetUsd field value if set, zero value otherwise.
+func (o *ProjectSettings) GetMonthlyBudgetUsd() float64 {
+	if o == nil || IsNil(o.MonthlyBudgetUsd) {
+		var ret float64
+		return ret
+	}
+	return *o.MonthlyBudgetUsd
+}
+
+// GetMonthlyBudgetUsdOk returns a tuple with the MonthlyBudgetUsd field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettings) GetMonthlyBudgetUsdOk() (*float64, bool) {
+	if o == nil || IsNil(o.MonthlyBudgetUsd) {
+		return nil, false
+	}
+	return o.MonthlyBudgetUsd, true
+}
+
+// HasMonthlyBudgetUsd returns a boolean if a field has been set.
+func (o *ProjectSettings) HasMonthlyBudgetUsd() bool {
+	if o != nil && !IsNil(o.MonthlyBudgetUsd) {
+		return true
+	}
+
+	return false
+}
+
+// SetMonthlyBudgetUsd gets a reference to the given float64 and assigns it to the MonthlyBudgetUsd field.
+func (o *ProjectSettings) SetMonthlyBudgetUsd(v float64) {
+	o.MonthlyBudgetUsd = &v
+}
+
 func (o ProjectSettings) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -333,6 +465,18 @@ func (o ProjectSettings) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Repositories) {
 		toSerialize["repositories"] = o.Repositories
 	}
+	if !IsNil(o.ResourceLimits) {
+		toSerialize["resource_limits"] = o.ResourceLimits
+	}
+	if !IsNil(o.InactivityTimeoutSeconds) {
+		toSerialize["inactivity_timeout_seconds"] = o.InactivityTimeoutSeconds
+	}
+	if !IsNil(o.ModelOverrides) {
+		toSerialize["model_overrides"] = o.ModelOverrides
+	}
+	if !IsNil(o.MonthlyBudgetUsd) {
+		toSerialize["monthly_budget_usd"] = o.MonthlyBudgetUsd
+	}
 	return toSerialize, nil
 }
 
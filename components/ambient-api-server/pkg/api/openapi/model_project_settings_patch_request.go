@@ -20,9 +20,13 @@ var _ MappedNullable = &ProjectSettingsPatchRequest{}
 
 // ProjectSettingsPatchRequest struct for ProjectSettingsPatchRequest
 type ProjectSettingsPatchRequest struct {
-	ProjectId    *string `json:"project_id,omitempty"`
-	GroupAccess  *string `json:"group_access,omitempty"`
-	Repositories *string `json:"repositories,omitempty"`
+	ProjectId                *string  `json:"project_id,omitempty"`
+	GroupAccess              *string  `json:"group_access,omitempty"`
+	Repositories             *string  `json:"repositories,omitempty"`
+	ResourceLimits           *string  `json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32   `json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string  `json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64 `json:"monthly_budget_usd,omitempty"`
 }
 
 // NewProjectSettingsPatchRequest instantiates a new ProjectSettingsPatchRequest object
@@ -138,6 +142,134 @@ func (o *ProjectSettingsPatchRequest) SetRepositories(v string) {
 	o.Repositories = &v
 }
 
+// GetResourceLimits returns the ResourceLimits field value if set, zero value otherwise.
+func (o *ProjectSettingsPatchRequest) GetResourceLimits() string {
+	if o == nil || IsNil(o.ResourceLimits) {
+		var ret string
+		return ret
+	}
+	return *o.ResourceLimits
+}
+
+// GetResourceLimitsOk returns a tuple with the ResourceLimits field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettingsPatchRequest) GetResourceLimitsOk() (*string, bool) {
+	if o == nil || IsNil(o.ResourceLimits) {
+		return nil, false
+	}
+	return o.ResourceLimits, true
+}
+
+// HasResourceLimits returns a boolean if a field has been set.
+func (o *ProjectSettingsPatchRequest) HasResourceLimits() bool {
+	if o != nil && !IsNil(o.ResourceLimits) {
+		return true
+	}
+
+	return false
+}
+
+// SetResourceLimits gets a reference to the given string and assigns it to the ResourceLimits field.
+func (o *ProjectSettingsPatchRequest) SetResourceLimits(v string) {
+	o.ResourceLimits = &v
+}
+
+// GetInactivityTimeoutSeconds returns the InactivityTimeoutSeconds field value if set, zero value otherwise.
+func (o *ProjectSettingsPatchRequest) GetInactivityTimeoutSeconds() int32 {
+	if o == nil || IsNil(o.InactivityTimeoutSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.InactivityTimeoutSeconds
+}
+
+// GetInactivityTimeoutSecondsOk returns a tuple with the InactivityTimeoutSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettingsPatchRequest) GetInactivityTimeoutSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.InactivityTimeoutSeconds) {
+		return nil, false
+	}
+	return o.InactivityTimeoutSeconds, true
+}
+
+// HasInactivityTimeoutSeconds returns a boolean if a field has been set.
+func (o *ProjectSettingsPatchRequest) HasInactivityTimeoutSeconds() bool {
+	if o != nil && !IsNil(o.InactivityTimeoutSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetInactivityTimeoutSeconds gets a reference to the given int32 and assigns it to the InactivityTimeoutSeconds field.
+func (o *ProjectSettingsPatchRequest) SetInactivityTimeoutSeconds(v int32) {
+	o.InactivityTimeoutSeconds = &v
+}
+
+// GetModelOverrides returns the ModelOverrides field value if set, zero value otherwise.
+func (o *ProjectSettingsPatchRequest) GetModelOverrides() string {
+	if o == nil || IsNil(o.ModelOverrides) {
+		var ret string
+		return ret
+	}
+	return *o.ModelOverrides
+}
+
+// GetModelOverridesOk returns a tuple with the ModelOverrides field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettingsPatchRequest) GetModelOverridesOk() (*string, bool) {
+	if o == nil || IsNil(o.ModelOverrides) {
+		return nil, false
+	}
+	return o.ModelOverrides, true
+}
+
+// HasModelOverrides returns a boolean if a field has been set.
+func (o *ProjectSettingsPatchRequest) HasModelOverrides() bool {
+	if o != nil && !IsNil(o.ModelOverrides) {
+		return true
+	}
+
+	return false
+}
+
+// SetModelOverrides gets a reference to the given string and assigns it to the ModelOverrides field.
+func (o *ProjectSettingsPatchRequest) SetModelOverrides(v string) {
+	o.ModelOverrides = &v
+}
+
+// GetMonthlyBudgetUsd returns the MonthlyBudgetUsd field value if set, zero value otherwise.
+func (o *ProjectSettingsPatchRequest) GetMonthlyBudgetUsd() float64 {
+	if o == nil || IsNil(o.MonthlyBudgetUsd) {
+		var ret float64
+		return ret
+	}
+	return *o.MonthlyBudgetUsd
+}
+
+// GetMonthlyBudgetUsdOk returns a tuple with the MonthlyBudgetUsd field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectSettingsPatchRequest) GetMonthlyBudgetUsdOk() (*float64, bool) {
+	if o == nil || IsNil(o.MonthlyBudgetUsd) {
+		return nil, false
+	}
+	return o.MonthlyBudgetUsd, true
+}
+
+// HasMonthlyBudgetUsd returns a boolean if a field has been set.
+func (o *ProjectSettingsPatchRequest) HasMonthlyBudgetUsd() bool {
+	if o != nil && !IsNil(o.MonthlyBudgetUsd) {
+		return true
+	}
+
+	return false
+}
+
+// SetMonthlyBudgetUsd gets a reference to the given float64 and assigns it to the MonthlyBudgetUsd field.
+func (o *ProjectSettingsPatchRequest) SetMonthlyBudgetUsd(v float64) {
+	o.MonthlyBudgetUsd = &v
+}
+
 func (o ProjectSettingsPatchRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -157,6 +289,18 @@ func (o ProjectSettingsPatchRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Repositories) {
 		toSerialize["repositories"] = o.Repositories
 	}
+	if !IsNil(o.ResourceLimits) {
+		toSerialize["resource_limits"] = o.ResourceLimits
+	}
+	if !IsNil(o.InactivityTimeoutSeconds) {
+		toSerialize["inactivity_timeout_seconds"] = o.InactivityTimeoutSeconds
+	}
+	if !IsNil(o.ModelOverrides) {
+		toSerialize["model_overrides"] = o.ModelOverrides
+	}
+	if !IsNil(o.MonthlyBudgetUsd) {
+		toSerialize["monthly_budget_usd"] = o.MonthlyBudgetUsd
+	}
 	return toSerialize, nil
 }
 
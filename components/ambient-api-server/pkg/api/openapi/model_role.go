@@ -33,6 +33,7 @@ type Role struct {
 	Description *string    `json:"description,omitempty"`
 	Permissions *string    `json:"permissions,omitempty"`
 	BuiltIn     *bool      `json:"built_in,omitempty"`
+	Level       *int32     `json:"level,omitempty"`
 }
 
 type _Role Role
@@ -367,6 +368,38 @@ func (o *Role) SetBuiltIn(v bool) {
 	o.BuiltIn = &v
 }
 
+// GetLevel returns the Level field value if set, zero value otherwise.
+func (o *Role) GetLevel() int32 {
+	if o == nil || IsNil(o.Level) {
+		var ret int32
+		return ret
+	}
+	return *o.Level
+}
+
+// GetLevelOk returns a tuple with the Level field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Role) GetLevelOk() (*int32, bool) {
+	if o == nil || IsNil(o.Level) {
+		return nil, false
+	}
+	return o.Level, true
+}
+
+// HasLevel returns a boolean if a field has been set.
+func (o *Role) HasLevel() bool {
+	if o != nil && !IsNil(o.Level) {
+		return true
+	}
+
+	return false
+}
+
+// SetLevel gets a reference to the given int32 and assigns it to the Level field.
+func (o *Role) SetLevel(v int32) {
+	o.Level = &v
+}
+
 func (o Role) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -405,6 +438,9 @@ func (o Role) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuiltIn) {
 		toSerialize["built_in"] = o.BuiltIn
 	}
+	if !IsNil(o.Level) {
+		toSerialize["level"] = o.Level
+	}
 	return toSerialize, nil
 }
 
@@ -61,6 +61,7 @@ type Session struct {
 	KubeCrName         *string    `json:"kube_cr_name,omitempty"`
 	KubeCrUid          *string    `json:"kube_cr_uid,omitempty"`
 	KubeNamespace      *string    `json:"kube_namespace,omitempty"`
+	StoppedReason      *string    `json:"stopped_reason,omitempty"`
 }
 
 type _Session Session
@@ -1195,6 +1196,38 @@ func (o *Session) SetKubeNamespace(v string) {
 	o.KubeNamespace = &v
 }
 
+// GetStoppedReason returns the StoppedReason field value if set, zero value otherwise.
+func (o *Session) GetStoppedReason() string {
+	if o == nil || IsNil(o.StoppedReason) {
+		var ret string
+		return ret
+	}
+	return *o.StoppedReason
+}
+
+// GetStoppedReasonOk returns a tuple with the StoppedReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Session) GetStoppedReasonOk() (*string, bool) {
+	if o == nil || IsNil(o.StoppedReason) {
+		return nil, false
+	}
+	return o.StoppedReason, true
+}
+
+// HasStoppedReason returns a boolean if a field has been set.
+func (o *Session) HasStoppedReason() bool {
+	if o != nil && !IsNil(o.StoppedReason) {
+		return true
+	}
+
+	return false
+}
+
+// SetStoppedReason gets a reference to the given string and assigns it to the StoppedReason field.
+func (o *Session) SetStoppedReason(v string) {
+	o.StoppedReason = &v
+}
+
 func (o Session) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -1308,6 +1341,9 @@ func (o Session) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.KubeNamespace) {
 		toSerialize["kube_namespace"] = o.KubeNamespace
 	}
+	if !IsNil(o.StoppedReason) {
+		toSerialize["stopped_reason"] = o.StoppedReason
+	}
 	return toSerialize, nil
 }
 
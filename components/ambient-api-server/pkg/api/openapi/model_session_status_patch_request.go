@@ -31,6 +31,7 @@ type SessionStatusPatchRequest struct {
 	ReconciledWorkflow *string    `json:"reconciled_workflow,omitempty"`
 	KubeCrUid          *string    `json:"kube_cr_uid,omitempty"`
 	KubeNamespace      *string    `json:"kube_namespace,omitempty"`
+	StoppedReason      *string    `json:"stopped_reason,omitempty"`
 }
 
 // NewSessionStatusPatchRequest instantiates a new SessionStatusPatchRequest object
@@ -370,6 +371,38 @@ func (o *SessionStatusPatchRequest) SetKubeNamespace(v string) {
 	o.KubeNamespace = &v
 }
 
+// GetStoppedReason returns the StoppedReason field value if set, zero value otherwise.
+func (o *SessionStatusPatchRequest) GetStoppedReason() string {
+	if o == nil || IsNil(o.StoppedReason) {
+		var ret string
+		return ret
+	}
+	return *o.StoppedReason
+}
+
+// GetStoppedReasonOk returns a tuple with the StoppedReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SessionStatusPatchRequest) GetStoppedReasonOk() (*string, bool) {
+	if o == nil || IsNil(o.StoppedReason) {
+		return nil, false
+	}
+	return o.StoppedReason, true
+}
+
+// HasStoppedReason returns a boolean if a field has been set.
+func (o *SessionStatusPatchRequest) HasStoppedReason() bool {
+	if o != nil && !IsNil(o.StoppedReason) {
+		return true
+	}
+
+	return false
+}
+
+// SetStoppedReason gets a reference to the given string and assigns it to the StoppedReason field.
+func (o *SessionStatusPatchRequest) SetStoppedReason(v string) {
+	o.StoppedReason = &v
+}
+
 func (o SessionStatusPatchRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -410,6 +443,9 @@ func (o SessionStatusPatchRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.KubeNamespace) {
 		toSerialize["kube_namespace"] = o.KubeNamespace
 	}
+	if !IsNil(o.StoppedReason) {
+		toSerialize["stopped_reason"] = o.StoppedReason
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,77 @@
+// Package broker carries change notifications between API server replicas so
+// that watch and stream subscribers on one replica see writes made on another.
+package broker
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+)
+
+// envBroker selects the broker implementation: "postgres" (default) uses
+// LISTEN/NOTIFY on the API server database, "local" disables cross-replica
+// delivery for single-replica deployments.
+const envBroker = "MESSAGE_BROKER"
+
+// Handler receives the notifications published on one channel.
+type Handler interface {
+	// Receive is called with each payload published by another replica.
+	// It runs on the broker's delivery goroutine and must not block.
+	Receive(payload string)
+	// Resync is called after the broker reconnects, when notifications may
+	// have been missed. Handlers re-read whatever state they track.
+	Resync()
+}
+
+// Broker delivers notifications to the other API server replicas. Publishers
+// notify their own in-process subscribers directly; a replica never receives
+// its own notifications back.
+type Broker interface {
+	// Publish sends payload to the channel's subscribers on every other
+	// replica. Inside a database transaction delivery happens on commit, so
+	// receivers can read the rows the notification refers to.
+	Publish(ctx context.Context, channel, payload string) error
+	// Subscribe registers h for channel and returns a function that
+	// unregisters it.
+	Subscribe(channel string, h Handler) func()
+}
+
+var (
+	shared     Broker
+	sharedOnce sync.Once
+)
+
+// Shared returns the process-wide broker for env, creating it on first use.
+func Shared(env *environments.Env) Broker {
+	sharedOnce.Do(func() {
+		kind := strings.ToLower(strings.TrimSpace(os.Getenv(envBroker)))
+		switch kind {
+		case "local":
+			glog.Infof("Message broker: local (cross-replica delivery disabled)")
+			shared = NewLocalBroker()
+		case "", "postgres":
+			glog.Infof("Message broker: postgres LISTEN/NOTIFY")
+			shared = NewPostgresBroker(env.Database.SessionFactory, env.Config.Database.ConnectionString(true))
+		default:
+			glog.Warningf("Ignoring unknown %s=%q; using postgres", envBroker, kind)
+			shared = NewPostgresBroker(env.Database.SessionFactory, env.Config.Database.ConnectionString(true))
+		}
+	})
+	return shared
+}
+
+// NewLocalBroker returns a broker for a single replica: there is nobody else
+// to notify, so Publish and Subscribe do nothing.
+func NewLocalBroker() Broker {
+	return localBroker{}
+}
+
+type localBroker struct{}
+
+func (localBroker) Publish(context.Context, string, string) error { return nil }
+
+func (localBroker) Subscribe(string, Handler) func() { return func() {} }
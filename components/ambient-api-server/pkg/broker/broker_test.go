@@ -0,0 +1,74 @@
+package broker
+
+import (
+	"testing"
+)
+
+type recordingHandler struct {
+	payloads []string
+	resyncs  int
+}
+
+func (h *recordingHandler) Receive(payload string) { h.payloads = append(h.payloads, payload) }
+func (h *recordingHandler) Resync()                { h.resyncs++ }
+
+func newTestBroker(origin string) *postgresBroker {
+	return &postgresBroker{origin: origin, subs: make(map[string][]*subscription)}
+}
+
+func (b *postgresBroker) subscribeForTest(channel string, h Handler) func() {
+	sub := &subscription{h: h}
+	b.subs[channel] = append(b.subs[channel], sub)
+	return func() {
+		for i, s := range b.subs[channel] {
+			if s == sub {
+				b.subs[channel] = append(b.subs[channel][:i], b.subs[channel][i+1:]...)
+			}
+		}
+	}
+}
+
+func TestPayloadRoundTrip(t *testing.T) {
+	origin, payload, ok := decodePayload(encodePayload("replica-a", "sess-1:42"))
+	if !ok || origin != "replica-a" || payload != "sess-1:42" {
+		t.Fatalf("got %q %q %v", origin, payload, ok)
+	}
+	if _, _, ok := decodePayload("no-separator"); ok {
+		t.Fatal("expected malformed payload to be rejected")
+	}
+}
+
+func TestDispatch_SkipsOwnOriginAndRoutesByChannel(t *testing.T) {
+	b := newTestBroker("replica-a")
+	messages := &recordingHandler{}
+	inbox := &recordingHandler{}
+	b.subscribeForTest("session_messages", messages)
+	b.subscribeForTest("inbox_messages", inbox)
+
+	b.dispatch("session_messages", encodePayload("replica-a", "own"))
+	b.dispatch("session_messages", encodePayload("replica-b", "sess-1:7"))
+	b.dispatch("inbox_messages", encodePayload("replica-b", "agent-1:msg-1"))
+	b.dispatch("session_messages", "malformed")
+
+	if len(messages.payloads) != 1 || messages.payloads[0] != "sess-1:7" {
+		t.Errorf("session handler got %v, want [sess-1:7]", messages.payloads)
+	}
+	if len(inbox.payloads) != 1 || inbox.payloads[0] != "agent-1:msg-1" {
+		t.Errorf("inbox handler got %v, want [agent-1:msg-1]", inbox.payloads)
+	}
+}
+
+func TestResyncAll_ReachesEveryHandler(t *testing.T) {
+	b := newTestBroker("replica-a")
+	h1, h2 := &recordingHandler{}, &recordingHandler{}
+	b.subscribeForTest("session_messages", h1)
+	unsubscribe := b.subscribeForTest("inbox_messages", h2)
+
+	b.resyncAll()
+	unsubscribe()
+	b.resyncAll()
+
+	if h1.resyncs != 2 || h2.resyncs != 1 {
+		t.Errorf("resyncs = %d/%d, want 2/1", h1.resyncs, h2.resyncs)
+	}
+}
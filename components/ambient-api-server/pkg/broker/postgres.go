@@ -0,0 +1,169 @@
+package broker
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/lib/pq"
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+// channelPrefix keeps broker channels apart from the "events" channel the
+// rh-trex controllers listen on.
+const channelPrefix = "ambient_"
+
+const listenerPingInterval = 30 * time.Second
+
+type subscription struct {
+	h Handler
+}
+
+// postgresBroker publishes with pg_notify on the caller's database session
+// and receives on a dedicated LISTEN connection. Every payload is prefixed
+// with the publishing replica's origin ID so a replica can drop its own
+// notifications.
+type postgresBroker struct {
+	sessionFactory db.SessionFactory
+	connStr        string
+	origin         string
+
+	mu       sync.RWMutex
+	subs     map[string][]*subscription
+	listener *pq.Listener
+}
+
+// NewPostgresBroker returns a broker backed by LISTEN/NOTIFY. The listen
+// connection is opened on the first Subscribe and reconnects on its own;
+// handlers are resynced after every reconnect.
+func NewPostgresBroker(sessionFactory db.SessionFactory, connStr string) Broker {
+	return &postgresBroker{
+		sessionFactory: sessionFactory,
+		connStr:        connStr,
+		origin:         api.NewID(),
+		subs:           make(map[string][]*subscription),
+	}
+}
+
+func (b *postgresBroker) Publish(ctx context.Context, channel, payload string) error {
+	g2 := b.sessionFactory.New(ctx)
+	return g2.Exec("SELECT pg_notify(?, ?)", channelPrefix+channel, encodePayload(b.origin, payload)).Error
+}
+
+func (b *postgresBroker) Subscribe(channel string, h Handler) func() {
+	sub := &subscription{h: h}
+
+	b.mu.Lock()
+	b.ensureListener()
+	if len(b.subs[channel]) == 0 {
+		if err := b.listener.Listen(channelPrefix + channel); err != nil && err != pq.ErrChannelAlreadyOpen {
+			glog.Errorf("Message broker: listen on %s: %v", channel, err)
+		}
+	}
+	b.subs[channel] = append(b.subs[channel], sub)
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			subs := b.subs[channel]
+			for i, s := range subs {
+				if s == sub {
+					b.subs[channel] = append(subs[:i], subs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+}
+
+// ensureListener starts the LISTEN connection. Callers hold b.mu.
+func (b *postgresBroker) ensureListener() {
+	if b.listener != nil {
+		return
+	}
+	b.listener = pq.NewListener(b.connStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventDisconnected:
+			glog.Warningf("Message broker: listen connection lost: %v", err)
+		case pq.ListenerEventReconnected:
+			glog.Infof("Message broker: listen connection re-established")
+		case pq.ListenerEventConnectionAttemptFailed:
+			glog.V(2).Infof("Message broker: reconnect attempt failed: %v", err)
+		}
+	})
+	go b.run(b.listener)
+}
+
+func (b *postgresBroker) run(l *pq.Listener) {
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case n, ok := <-l.Notify:
+			if !ok {
+				return
+			}
+			// pq sends nil after a reconnect: anything published while the
+			// connection was down is lost, so every handler catches up.
+			if n == nil {
+				b.resyncAll()
+				continue
+			}
+			b.dispatch(strings.TrimPrefix(n.Channel, channelPrefix), n.Extra)
+		case <-ticker.C:
+			go func() {
+				if err := l.Ping(); err != nil {
+					glog.V(2).Infof("Message broker: listener ping: %v", err)
+				}
+			}()
+		}
+	}
+}
+
+func (b *postgresBroker) dispatch(channel, raw string) {
+	origin, payload, ok := decodePayload(raw)
+	if !ok {
+		glog.Warningf("Message broker: dropping malformed notification on %s", channel)
+		return
+	}
+	if origin == b.origin {
+		return
+	}
+	for _, s := range b.handlers(channel) {
+		s.h.Receive(payload)
+	}
+}
+
+func (b *postgresBroker) resyncAll() {
+	b.mu.RLock()
+	var all []*subscription
+	for _, subs := range b.subs {
+		all = append(all, subs...)
+	}
+	b.mu.RUnlock()
+	for _, s := range all {
+		s.h.Resync()
+	}
+}
+
+func (b *postgresBroker) handlers(channel string) []*subscription {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	out := make([]*subscription, len(b.subs[channel]))
+	copy(out, b.subs[channel])
+	return out
+}
+
+func encodePayload(origin, payload string) string {
+	return origin + " " + payload
+}
+
+func decodePayload(raw string) (origin, payload string, ok bool) {
+	return strings.Cut(raw, " ")
+}
@@ -93,6 +93,26 @@ func usernameFromJWT(tokenString string) string {
 	return ""
 }
 
+// GRPCCallerUsername returns the username claimed by the bearer JWT in the
+// incoming gRPC metadata, or "" for the service token and anonymous calls.
+// Like usernameFromJWT it does not verify the token; callers use it only to
+// label records of calls the auth interceptor has already judged.
+func GRPCCallerUsername(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return ""
+	}
+	token, err := extractBearerToken(authHeader[0])
+	if err != nil {
+		return ""
+	}
+	return usernameFromJWT(token)
+}
+
 type serviceCallerStream struct {
 	grpc.ServerStream
 	ctx context.Context
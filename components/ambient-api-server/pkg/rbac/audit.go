@@ -0,0 +1,210 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/logger"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeDenied  = "denied"
+	AuditOutcomeFailure = "failure"
+)
+
+// auditWriteTimeout bounds how long a request waits on its audit write.
+const auditWriteTimeout = 5 * time.Second
+
+// AuditRecord is one audited API call: who made it, what it touched and how
+// it ended.
+type AuditRecord struct {
+	Subject      string
+	CallerType   string
+	Method       string
+	Path         string
+	Resource     string
+	Action       string
+	ProjectID    string
+	AgentID      string
+	SessionID    string
+	CredentialID string
+	Outcome      string
+	StatusCode   int
+	OperationID  string
+	OccurredAt   time.Time
+}
+
+// AuditRecorder persists audit records. The audit events plugin provides
+// the implementation so this package stays free of plugin imports.
+type AuditRecorder interface {
+	Record(ctx context.Context, record *AuditRecord) error
+}
+
+// AuditMiddleware wraps an authorization middleware and records every
+// mutating call, every credential token fetch and every denied request,
+// whether the wrapped middleware or the handler rejected it.
+type AuditMiddleware struct {
+	authz    auth.AuthorizationMiddleware
+	recorder AuditRecorder
+}
+
+var _ auth.AuthorizationMiddleware = &AuditMiddleware{}
+
+func NewAuditMiddleware(authz auth.AuthorizationMiddleware, recorder AuditRecorder) *AuditMiddleware {
+	return &AuditMiddleware{authz: authz, recorder: recorder}
+}
+
+type auditSubjectKey struct{}
+
+// auditSubject is filled in once the authorizer has resolved the caller, so
+// the record reflects the identity RBAC actually evaluated. authorized stays
+// false when the authorizer rejected the call itself.
+type auditSubject struct {
+	username   string
+	callerType string
+	authorized bool
+}
+
+func (m *AuditMiddleware) AuthorizeApi(next http.Handler) http.Handler {
+	authorized := m.authz.AuthorizeApi(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subject, ok := r.Context().Value(auditSubjectKey{}).(*auditSubject); ok {
+			ctx := r.Context()
+			subject.authorized = true
+			subject.callerType = middleware.CallerTypeUser
+			if middleware.IsServiceCaller(ctx) {
+				subject.callerType = middleware.CallerTypeService
+			}
+			if result := GetAuthResult(ctx); result != nil && result.Username != "" {
+				subject.username = result.Username
+			}
+		}
+		next.ServeHTTP(w, r)
+	}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Resolve scope before the handler consumes a create request's body.
+		scope := ExtractRequestScope(r)
+		subject := &auditSubject{}
+		rec := &statusRecorder{ResponseWriter: w}
+		authorized.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), auditSubjectKey{}, subject)))
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		outcome := auditOutcome(status, subject.authorized)
+		action := pathToAction(r.Method, r.URL.Path)
+		if !shouldAudit(r.Method, action, outcome) {
+			return
+		}
+
+		record := newAuditRecord(r, scope, subject, action, outcome, status)
+		// The write runs outside the request transaction: a failed audit
+		// write must not roll back the change it describes, and a denied or
+		// failed call is kept even though its own transaction rolls back.
+		ctx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+		defer cancel()
+		if err := m.recorder.Record(ctx, record); err != nil {
+			glog.Errorf("audit: failed to record %s %s by %q: %v", record.Method, record.Path, record.Subject, err)
+		}
+	})
+}
+
+func newAuditRecord(r *http.Request, scope RequestScope, subject *auditSubject, action, outcome string, status int) *AuditRecord {
+	ctx := r.Context()
+
+	username := subject.username
+	if username == "" {
+		username = auth.GetUsernameFromContext(ctx)
+	}
+	callerType := subject.callerType
+	if callerType == "" {
+		callerType = middleware.CallerTypeUser
+		if middleware.IsServiceCaller(ctx) || middleware.IsConfiguredServiceAccount(username) {
+			callerType = middleware.CallerTypeService
+		}
+	}
+	if username == "" && callerType == middleware.CallerTypeService {
+		username = "service-token"
+	}
+
+	return &AuditRecord{
+		Subject:      username,
+		CallerType:   callerType,
+		Method:       r.Method,
+		Path:         r.URL.Path,
+		Resource:     pathToResource(r.URL.Path),
+		Action:       action,
+		ProjectID:    scope.ProjectID,
+		AgentID:      scope.AgentID,
+		SessionID:    scope.SessionID,
+		CredentialID: scope.CredentialID,
+		Outcome:      outcome,
+		StatusCode:   status,
+		OperationID:  logger.GetOperationID(ctx),
+		OccurredAt:   time.Now().UTC(),
+	}
+}
+
+// shouldAudit keeps the trail to changes and secret reads: reads that
+// succeed are not recorded, denied ones are.
+func shouldAudit(method, action, outcome string) bool {
+	if outcome == AuditOutcomeDenied {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return action == string(ActionFetchToken)
+	}
+	return true
+}
+
+// auditOutcome classifies a response. Rejections by the authorizer are
+// denials whatever their status, since singleton reads are denied with 404.
+func auditOutcome(status int, authorized bool) string {
+	switch {
+	case !authorized && status >= http.StatusBadRequest:
+		return AuditOutcomeDenied
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return AuditOutcomeDenied
+	case status >= http.StatusBadRequest:
+		return AuditOutcomeFailure
+	default:
+		return AuditOutcomeSuccess
+	}
+}
+
+// statusRecorder captures the response status. It unwraps to the original
+// writer so streaming handlers can still find the http.Flusher.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Flush() {
+	_ = http.NewResponseController(s.ResponseWriter).Flush()
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
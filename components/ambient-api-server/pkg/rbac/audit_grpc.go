@@ -0,0 +1,208 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+)
+
+// AuditMethodGRPC is the Method of audit records for gRPC calls; their Path
+// is the full gRPC method name.
+const AuditMethodGRPC = "GRPC"
+
+// AuditUnaryInterceptor records unary gRPC calls under the same rules as
+// AuditMiddleware: every mutation and every denied call. It is registered
+// before the environment loads its services, so the recorder is looked up
+// per call and nothing is recorded while it returns nil.
+func AuditUnaryInterceptor(recorder func() AuditRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		outcome := grpcAuditOutcome(code)
+		resource, action := grpcMethodToResourceAction(info.FullMethod)
+		if !shouldAuditGRPC(action, outcome) {
+			return resp, err
+		}
+		rec := recorder()
+		if rec == nil {
+			return resp, err
+		}
+
+		record := newGRPCAuditRecord(ctx, info.FullMethod, req, resource, action, outcome, code)
+		// As for HTTP, the write runs outside the call's transaction.
+		writeCtx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+		defer cancel()
+		if recErr := rec.Record(writeCtx, record); recErr != nil {
+			glog.Errorf("audit: failed to record %s by %q: %v", record.Path, record.Subject, recErr)
+		}
+		return resp, err
+	}
+}
+
+func newGRPCAuditRecord(ctx context.Context, fullMethod string, req interface{}, resource, action, outcome string, code codes.Code) *AuditRecord {
+	username := auth.GetUsernameFromContext(ctx)
+	if username == "" {
+		username = middleware.GRPCCallerUsername(ctx)
+	}
+	callerType := middleware.CallerTypeUser
+	if middleware.IsServiceCaller(ctx) || middleware.IsConfiguredServiceAccount(username) {
+		callerType = middleware.CallerTypeService
+	}
+	if username == "" && callerType == middleware.CallerTypeService {
+		username = "service-token"
+	}
+
+	record := &AuditRecord{
+		Subject:     username,
+		CallerType:  callerType,
+		Method:      AuditMethodGRPC,
+		Path:        fullMethod,
+		Resource:    resource,
+		Action:      action,
+		Outcome:     outcome,
+		StatusCode:  grpcCodeToHTTPStatus(code),
+		OperationID: logger.GetOperationID(ctx),
+		OccurredAt:  time.Now().UTC(),
+	}
+	fillGRPCScope(record, req)
+	return record
+}
+
+// fillGRPCScope copies the project, agent, session and credential a request
+// names into the record. A bare id belongs to the RPC's own resource.
+func fillGRPCScope(record *AuditRecord, req interface{}) {
+	if r, ok := req.(interface{ GetProjectId() string }); ok {
+		record.ProjectID = r.GetProjectId()
+	}
+	if r, ok := req.(interface{ GetAgentId() string }); ok {
+		record.AgentID = r.GetAgentId()
+	}
+	if r, ok := req.(interface{ GetSessionId() string }); ok {
+		record.SessionID = r.GetSessionId()
+	}
+	if r, ok := req.(interface{ GetCredentialId() string }); ok {
+		record.CredentialID = r.GetCredentialId()
+	}
+	r, ok := req.(interface{ GetId() string })
+	if !ok || r.GetId() == "" {
+		return
+	}
+	switch Resource(record.Resource) {
+	case ResourceProject:
+		record.ProjectID = r.GetId()
+	case ResourceAgent:
+		record.AgentID = r.GetId()
+	case ResourceSession:
+		record.SessionID = r.GetId()
+	case ResourceCredential:
+		record.CredentialID = r.GetId()
+	}
+}
+
+// grpcMethodToResourceAction maps "/ambient.v1.CredentialService/DeleteCredential"
+// to ("credential", "delete").
+func grpcMethodToResourceAction(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	resource := snakeCase(strings.TrimSuffix(service, "Service"))
+
+	var action Action
+	switch {
+	case method == "PushSessionMessage":
+		return string(ResourceSessionMessage), string(ActionMessage)
+	case strings.HasPrefix(method, "Create"):
+		action = ActionCreate
+	case strings.HasPrefix(method, "Update"):
+		action = ActionUpdate
+	case strings.HasPrefix(method, "Delete"):
+		action = ActionDelete
+	case strings.HasPrefix(method, "Get"):
+		action = ActionRead
+	case strings.HasPrefix(method, "List"):
+		action = ActionList
+	case strings.HasPrefix(method, "Watch"):
+		action = ActionWatch
+	default:
+		action = Action(snakeCase(method))
+	}
+	return resource, string(action)
+}
+
+// shouldAuditGRPC mirrors shouldAudit: reads are kept only when denied.
+func shouldAuditGRPC(action, outcome string) bool {
+	if outcome == AuditOutcomeDenied {
+		return true
+	}
+	switch Action(action) {
+	case ActionRead, ActionList, ActionWatch:
+		return false
+	}
+	return true
+}
+
+func grpcAuditOutcome(code codes.Code) string {
+	switch code {
+	case codes.OK:
+		return AuditOutcomeSuccess
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return AuditOutcomeDenied
+	default:
+		return AuditOutcomeFailure
+	}
+}
+
+// grpcCodeToHTTPStatus keeps StatusCode comparable with HTTP records.
+func grpcCodeToHTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
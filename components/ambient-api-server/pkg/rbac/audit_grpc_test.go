@@ -0,0 +1,101 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+)
+
+func callAudited(t *testing.T, recorder *fakeRecorder, ctx context.Context, fullMethod string, req interface{}, err error) {
+	t.Helper()
+	interceptor := AuditUnaryInterceptor(func() AuditRecorder { return recorder })
+	_, got := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: fullMethod}, func(context.Context, interface{}) (interface{}, error) {
+		return nil, err
+	})
+	if got != err {
+		t.Fatalf("interceptor changed the handler error: %v", got)
+	}
+}
+
+func TestAuditUnaryInterceptor_RecordsMutation(t *testing.T) {
+	recorder := &fakeRecorder{}
+	ctx := middleware.WithCallerType(context.Background(), middleware.CallerTypeService)
+	callAudited(t, recorder, ctx, "/ambient.v1.CredentialService/DeleteCredential", &pb.DeleteCredentialRequest{Id: "cred-1"}, nil)
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("records = %d, want 1", len(recorder.records))
+	}
+	r := recorder.records[0]
+	if r.Method != AuditMethodGRPC || r.Path != "/ambient.v1.CredentialService/DeleteCredential" {
+		t.Errorf("method/path = %q %q", r.Method, r.Path)
+	}
+	if r.Resource != "credential" || r.Action != "delete" || r.CredentialID != "cred-1" {
+		t.Errorf("resource/action/credential = %q %q %q", r.Resource, r.Action, r.CredentialID)
+	}
+	if r.Outcome != AuditOutcomeSuccess || r.StatusCode != http.StatusOK {
+		t.Errorf("outcome = %q %d", r.Outcome, r.StatusCode)
+	}
+	if r.Subject != "service-token" || r.CallerType != middleware.CallerTypeService {
+		t.Errorf("subject = %q %q", r.Subject, r.CallerType)
+	}
+}
+
+func TestAuditUnaryInterceptor_RecordsDeniedRead(t *testing.T) {
+	recorder := &fakeRecorder{}
+	ctx := auth.SetUsernameContext(context.Background(), "mallory")
+	callAudited(t, recorder, ctx, "/ambient.v1.AgentService/GetAgent", &pb.GetAgentRequest{Id: "agent-1"}, status.Error(codes.PermissionDenied, "no"))
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("records = %d, want 1", len(recorder.records))
+	}
+	r := recorder.records[0]
+	if r.Outcome != AuditOutcomeDenied || r.StatusCode != http.StatusForbidden {
+		t.Errorf("outcome = %q %d", r.Outcome, r.StatusCode)
+	}
+	if r.Subject != "mallory" || r.CallerType != middleware.CallerTypeUser || r.AgentID != "agent-1" {
+		t.Errorf("record = %+v", r)
+	}
+}
+
+func TestAuditUnaryInterceptor_SkipsSuccessfulReads(t *testing.T) {
+	recorder := &fakeRecorder{}
+	ctx := auth.SetUsernameContext(context.Background(), "alice")
+	callAudited(t, recorder, ctx, "/ambient.v1.AgentService/GetAgent", &pb.GetAgentRequest{Id: "agent-1"}, nil)
+	callAudited(t, recorder, ctx, "/ambient.v1.AgentService/ListAgents", &pb.ListAgentsRequest{}, nil)
+
+	if len(recorder.records) != 0 {
+		t.Fatalf("records = %d, want 0", len(recorder.records))
+	}
+}
+
+func TestAuditUnaryInterceptor_FailedMutation(t *testing.T) {
+	recorder := &fakeRecorder{}
+	ctx := auth.SetUsernameContext(context.Background(), "alice")
+	callAudited(t, recorder, ctx, "/ambient.v1.ProjectSettingsService/UpdateProjectSettings", &pb.UpdateProjectSettingsRequest{Id: "ps-1"}, status.Error(codes.InvalidArgument, "bad"))
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("records = %d, want 1", len(recorder.records))
+	}
+	r := recorder.records[0]
+	if r.Resource != "project_settings" || r.Action != "update" || r.Outcome != AuditOutcomeFailure || r.StatusCode != http.StatusBadRequest {
+		t.Errorf("record = %+v", r)
+	}
+}
+
+func TestAuditUnaryInterceptor_NoRecorder(t *testing.T) {
+	interceptor := AuditUnaryInterceptor(func() AuditRecorder { return nil })
+	_, err := interceptor(context.Background(), &pb.DeleteAgentRequest{Id: "a"}, &grpc.UnaryServerInfo{FullMethod: "/ambient.v1.AgentService/DeleteAgent"}, func(context.Context, interface{}) (interface{}, error) {
+		return &pb.DeleteAgentResponse{}, nil
+	})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+}
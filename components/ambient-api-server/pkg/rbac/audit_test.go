@@ -0,0 +1,151 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+)
+
+type fakeRecorder struct {
+	records []*AuditRecord
+}
+
+func (f *fakeRecorder) Record(_ context.Context, record *AuditRecord) error {
+	f.records = append(f.records, record)
+	return nil
+}
+
+// fakeAuthz denies callers named "mallory", hides resources from "eve" with
+// a 404 and tags "svc" as a service caller.
+type fakeAuthz struct{}
+
+func (fakeAuthz) AuthorizeApi(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		username := auth.GetUsernameFromContext(ctx)
+		if username == "mallory" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if username == "eve" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if username == "svc" {
+			ctx = middleware.WithCallerType(ctx, middleware.CallerTypeService)
+		}
+		ctx = SetAuthResult(ctx, &AuthResult{Username: username})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func serveAudited(t *testing.T, recorder *fakeRecorder, route, method, path, username string, status int) {
+	t.Helper()
+	router := mux.NewRouter()
+	router.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}).Methods(method)
+	router.Use(NewAuditMiddleware(fakeAuthz{}, recorder).AuthorizeApi)
+
+	req := httptest.NewRequest(method, path, nil)
+	req = req.WithContext(auth.SetUsernameContext(req.Context(), username))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestAuditMiddleware_RecordsMutation(t *testing.T) {
+	recorder := &fakeRecorder{}
+	serveAudited(t, recorder, "/api/ambient/v1/projects/{id}/agents/{agent_id}/start", http.MethodPost,
+		"/api/ambient/v1/projects/proj-1/agents/agent-1/start", "alice", http.StatusCreated)
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(recorder.records))
+	}
+	got := recorder.records[0]
+	if got.Subject != "alice" || got.CallerType != middleware.CallerTypeUser {
+		t.Errorf("subject: got %q/%q", got.Subject, got.CallerType)
+	}
+	if got.Resource != "agent" || got.Action != "start" {
+		t.Errorf("resource/action: got %s:%s, want agent:start", got.Resource, got.Action)
+	}
+	if got.ProjectID != "proj-1" || got.AgentID != "agent-1" {
+		t.Errorf("scope: got project %q agent %q", got.ProjectID, got.AgentID)
+	}
+	if got.Outcome != AuditOutcomeSuccess || got.StatusCode != http.StatusCreated {
+		t.Errorf("outcome: got %s (%d)", got.Outcome, got.StatusCode)
+	}
+}
+
+func TestAuditMiddleware_RecordsTokenFetch(t *testing.T) {
+	recorder := &fakeRecorder{}
+	serveAudited(t, recorder, "/api/ambient/v1/credentials/{id}/token", http.MethodGet,
+		"/api/ambient/v1/credentials/cred-1/token", "svc", http.StatusOK)
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(recorder.records))
+	}
+	got := recorder.records[0]
+	if got.Action != string(ActionFetchToken) || got.CredentialID != "cred-1" {
+		t.Errorf("unexpected record: %+v", got)
+	}
+	if got.CallerType != middleware.CallerTypeService {
+		t.Errorf("caller type: got %q, want service", got.CallerType)
+	}
+}
+
+func TestAuditMiddleware_RecordsDenial(t *testing.T) {
+	recorder := &fakeRecorder{}
+	serveAudited(t, recorder, "/api/ambient/v1/sessions/{id}", http.MethodGet,
+		"/api/ambient/v1/sessions/sess-1", "mallory", http.StatusOK)
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("expected denied read to be recorded, got %d records", len(recorder.records))
+	}
+	got := recorder.records[0]
+	if got.Outcome != AuditOutcomeDenied || got.StatusCode != http.StatusForbidden || got.Subject != "mallory" {
+		t.Errorf("unexpected record: %+v", got)
+	}
+}
+
+func TestAuditMiddleware_RecordsHiddenSingletonAsDenial(t *testing.T) {
+	recorder := &fakeRecorder{}
+	serveAudited(t, recorder, "/api/ambient/v1/projects/{id}", http.MethodGet,
+		"/api/ambient/v1/projects/proj-1", "eve", http.StatusOK)
+
+	if len(recorder.records) != 1 || recorder.records[0].Outcome != AuditOutcomeDenied {
+		t.Fatalf("expected 404 from the authorizer to be recorded as denied, got %+v", recorder.records)
+	}
+}
+
+func TestAuditMiddleware_SkipsSuccessfulReads(t *testing.T) {
+	recorder := &fakeRecorder{}
+	serveAudited(t, recorder, "/api/ambient/v1/sessions/{id}", http.MethodGet,
+		"/api/ambient/v1/sessions/sess-1", "alice", http.StatusOK)
+
+	if len(recorder.records) != 0 {
+		t.Errorf("expected successful read to be skipped, got %+v", recorder.records[0])
+	}
+}
+
+func TestAuditMiddleware_ScopeFromCreateBody(t *testing.T) {
+	recorder := &fakeRecorder{}
+	router := mux.NewRouter()
+	router.HandleFunc("/api/ambient/v1/role_bindings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}).Methods(http.MethodPost)
+	router.Use(NewAuditMiddleware(fakeAuthz{}, recorder).AuthorizeApi)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/ambient/v1/role_bindings", strings.NewReader(`{"project_id":"proj-9","role_id":"r"}`))
+	req = req.WithContext(auth.SetUsernameContext(req.Context(), "alice"))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(recorder.records) != 1 || recorder.records[0].ProjectID != "proj-9" {
+		t.Fatalf("expected project scope from body, got %+v", recorder.records)
+	}
+}
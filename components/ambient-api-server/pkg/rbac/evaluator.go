@@ -136,8 +136,12 @@ func bindingMatchesPermission(permissionsJSON, required string) bool {
 	if len(reqParts) != 2 {
 		return false
 	}
-	reqResource, reqAction := reqParts[0], reqParts[1]
+	return PermissionsAllow(perms, Permission{Resource(reqParts[0]), Action(reqParts[1])})
+}
 
+// PermissionsAllow reports whether a role's permission strings cover the
+// required permission, honouring "*" on either side.
+func PermissionsAllow(perms []string, required Permission) bool {
 	for _, perm := range perms {
 		if perm == "*:*" {
 			return true
@@ -147,8 +151,8 @@ func bindingMatchesPermission(permissionsJSON, required string) bool {
 			continue
 		}
 		r, a := parts[0], parts[1]
-		resourceMatch := r == "*" || r == reqResource
-		actionMatch := a == "*" || a == reqAction
+		resourceMatch := r == Wildcard || r == string(required.Resource)
+		actionMatch := a == Wildcard || a == string(required.Action)
 		if resourceMatch && actionMatch {
 			return true
 		}
@@ -25,11 +25,48 @@ var InternalRoles = map[string]bool{
 	RoleCredentialTokenReader: true,
 }
 
+// Custom roles carry a stored level between MinCustomRoleLevel and
+// MaxRoleLevel. Level 0 is reserved for platform:admin.
+const (
+	MinCustomRoleLevel = 1
+	MaxRoleLevel       = 3
+)
+
+// RoleRef is a role name with the level stored on its row. Built-in names
+// always take their level from RoleLevel; custom roles use the stored one.
+type RoleRef struct {
+	Name  string
+	Level *int
+}
+
+// EffectiveLevel returns the role's level and whether it has one.
+func (r RoleRef) EffectiveLevel() (int, bool) {
+	if level, ok := RoleLevel[r.Name]; ok {
+		return level, true
+	}
+	if r.Level != nil && *r.Level >= MinCustomRoleLevel && *r.Level <= MaxRoleLevel {
+		return *r.Level, true
+	}
+	return 0, false
+}
+
+// IsBuiltInRoleName reports whether name is reserved for a built-in role.
+func IsBuiltInRoleName(name string) bool {
+	_, ok := RoleLevel[name]
+	return ok || InternalRoles[name]
+}
+
 // CanGrant returns true if callerLevel can grant targetRole.
 // platform:admin (level 0) can grant at own level (sole exception).
 // All others must grant strictly below.
 func CanGrant(callerLevel int, targetRoleName string) bool {
-	targetLevel, ok := RoleLevel[targetRoleName]
+	return CanGrantRole(callerLevel, RoleRef{Name: targetRoleName})
+}
+
+// CanGrantRole is CanGrant for a role whose stored level is known, so
+// custom roles can be delegated as well as built-in ones.
+func CanGrantRole(callerLevel int, target RoleRef) bool {
+	targetLevel, ok := target.EffectiveLevel()
 	if !ok {
 		return false
 	}
@@ -42,9 +79,18 @@ func CanGrant(callerLevel int, targetRoleName string) bool {
 // HighestLevel returns the most privileged level across all role names.
 // Lower number = higher privilege. Returns 999 if no roles match.
 func HighestLevel(roleNames []string) int {
-	best := 999
+	roles := make([]RoleRef, 0, len(roleNames))
 	for _, name := range roleNames {
-		if level, ok := RoleLevel[name]; ok && level < best {
+		roles = append(roles, RoleRef{Name: name})
+	}
+	return HighestRoleLevel(roles)
+}
+
+// HighestRoleLevel is HighestLevel over roles with their stored levels.
+func HighestRoleLevel(roles []RoleRef) int {
+	best := 999
+	for _, r := range roles {
+		if level, ok := r.EffectiveLevel(); ok && level < best {
 			best = level
 		}
 	}
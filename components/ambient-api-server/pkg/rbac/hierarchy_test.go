@@ -64,3 +64,60 @@ func TestInternalRoles(t *testing.T) {
 		t.Error("project:owner should not be internal")
 	}
 }
+
+func TestCanGrantRole_CustomRoles(t *testing.T) {
+	level := func(l int) *int { return &l }
+	tests := []struct {
+		name        string
+		callerLevel int
+		target      RoleRef
+		want        bool
+	}{
+		{"owner can grant level 2 custom role", 1, RoleRef{Name: "agent:starter", Level: level(2)}, true},
+		{"owner cannot grant level 1 custom role", 1, RoleRef{Name: "project:lead", Level: level(1)}, false},
+		{"admin can grant custom role", 0, RoleRef{Name: "project:lead", Level: level(1)}, true},
+		{"custom role without level is not grantable", 0, RoleRef{Name: "agent:starter"}, false},
+		{"custom role cannot claim admin level", 0, RoleRef{Name: "shadow:admin", Level: level(0)}, false},
+		{"built-in level wins over stored level", 1, RoleRef{Name: RoleProjectOwner, Level: level(3)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanGrantRole(tt.callerLevel, tt.target); got != tt.want {
+				t.Errorf("CanGrantRole(%d, %+v) = %v, want %v", tt.callerLevel, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighestRoleLevel_CustomRoles(t *testing.T) {
+	two := 2
+	roles := []RoleRef{{Name: RoleProjectViewer}, {Name: "agent:starter", Level: &two}}
+	if got := HighestRoleLevel(roles); got != 2 {
+		t.Errorf("HighestRoleLevel = %d, want 2", got)
+	}
+}
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		perm    string
+		wantErr bool
+	}{
+		{"agent:start", false},
+		{"agent:*", false},
+		{"*:read", false},
+		{"*:*", false},
+		{"credential:fetch_token", false},
+		{"agent:launch", true},
+		{"prompt:update", true},
+		{"agent", true},
+		{":start", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.perm, func(t *testing.T) {
+			_, err := ParsePermission(tt.perm)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePermission(%q) error = %v, wantErr %v", tt.perm, err, tt.wantErr)
+			}
+		})
+	}
+}
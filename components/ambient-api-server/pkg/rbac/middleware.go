@@ -428,6 +428,11 @@ func httpMethodToAction(method string) string {
 }
 
 func pathToAction(method, path string) string {
+	// Blackboard paths end in a caller-chosen key, so a key such as "start"
+	// or "token" must not be read as a sub-resource verb.
+	if pathToResource(path) == string(ResourceBlackboard) {
+		return httpMethodToAction(method)
+	}
 	segments := splitPath(path)
 	for i, seg := range segments {
 		if seg == "v1" && i+2 < len(segments) {
@@ -437,8 +442,10 @@ func pathToAction(method, path string) string {
 				return "fetch_token"
 			case "start", "stop":
 				return last
-			case "sync", "refresh":
+			case "sync", "refresh", "rotate":
 				return "update"
+			case "simulate":
+				return "read"
 			}
 		}
 	}
@@ -458,6 +465,12 @@ func pathToResource(path string) string {
 			switch resource {
 			case "scheduled-session":
 				resource = "session"
+			case "document":
+				resource = string(ResourceProjectDocument)
+			case "model", "usage":
+				// A project's model catalog and usage are readable by anyone
+				// who can read the project.
+				resource = string(ResourceProject)
 			}
 			return resource
 		}
@@ -22,6 +22,12 @@ func TestPathToResource(t *testing.T) {
 		{"/api/ambient/v1/roles", "role"},
 		{"/api/ambient/v1/projects/proj-1/scheduled-sessions", "session"},
 		{"/api/ambient/v1/projects/proj-1/scheduled-sessions/ss-1", "session"},
+		{"/api/ambient/v1/projects/proj-1/blackboard", "blackboard"},
+		{"/api/ambient/v1/projects/proj-1/blackboard/plan.owner", "blackboard"},
+		{"/api/ambient/v1/projects/proj-1/documents", "project_document"},
+		{"/api/ambient/v1/projects/proj-1/documents/doc-1/revisions/3", "project_document"},
+		{"/api/ambient/v1/projects/proj-1/models", "project"},
+		{"/api/ambient/v1/projects/proj-1/usage", "project"},
 		{"/foo/bar", "unknown"},
 	}
 	for _, tt := range tests {
@@ -48,6 +54,11 @@ func TestPathToAction(t *testing.T) {
 		{http.MethodDelete, "/api/ambient/v1/credentials/abc123", "delete"},
 		{http.MethodGet, "/api/ambient/v1/agents/abc123/start", "start"},
 		{http.MethodGet, "/api/ambient/v1/agents/abc123/stop", "stop"},
+		{http.MethodGet, "/api/ambient/v1/projects/prtest/blackboard/start", "read"},
+		{http.MethodPut, "/api/ambient/v1/projects/prtest/blackboard/token", "update"},
+		{http.MethodDelete, "/api/ambient/v1/projects/prtest/blackboard/sync", "delete"},
+		{http.MethodPost, "/api/ambient/v1/roles/abc123/simulate", "read"},
+		{http.MethodPost, "/api/ambient/v1/credentials/abc123/rotate", "update"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
@@ -1,5 +1,10 @@
 package rbac
 
+import (
+	"fmt"
+	"strings"
+)
+
 type Resource string
 
 const (
@@ -10,10 +15,12 @@ const (
 	ResourceSession         Resource = "session"
 	ResourceSessionMessage  Resource = "session_message"
 	ResourceBlackboard      Resource = "blackboard"
+	ResourceProjectDocument Resource = "project_document"
 	ResourceRole            Resource = "role"
 	ResourceRoleBinding     Resource = "role_binding"
 	ResourceCredential      Resource = "credential"
 	ResourceApplication     Resource = "application"
+	ResourceAuditEvent      Resource = "audit_event"
 )
 
 type Action string
@@ -26,11 +33,31 @@ const (
 	ActionList       Action = "list"
 	ActionWatch      Action = "watch"
 	ActionStart      Action = "start"
+	ActionStop       Action = "stop"
 	ActionCheckin    Action = "checkin"
 	ActionMessage    Action = "message"
 	ActionFetchToken Action = "fetch_token"
 )
 
+// Wildcard matches any resource or any action in a permission string.
+const Wildcard = "*"
+
+// KnownResources and KnownActions are the values a role permission may
+// name, besides Wildcard.
+var (
+	KnownResources = []Resource{
+		ResourceUser, ResourceProject, ResourceProjectSettings, ResourceAgent,
+		ResourceSession, ResourceSessionMessage, ResourceBlackboard,
+		ResourceProjectDocument, ResourceRole, ResourceRoleBinding,
+		ResourceCredential, ResourceApplication, ResourceAuditEvent,
+	}
+	KnownActions = []Action{
+		ActionCreate, ActionRead, ActionUpdate, ActionDelete, ActionList,
+		ActionWatch, ActionStart, ActionStop, ActionCheckin, ActionMessage,
+		ActionFetchToken,
+	}
+)
+
 type Permission struct {
 	Resource Resource
 	Action   Action
@@ -40,6 +67,50 @@ func (p Permission) String() string {
 	return string(p.Resource) + ":" + string(p.Action)
 }
 
+// ParsePermission parses a "resource:action" role permission. Either half
+// may be Wildcard; otherwise it must be a known resource or action.
+func ParsePermission(s string) (Permission, error) {
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok || resource == "" || action == "" {
+		return Permission{}, fmt.Errorf("permission %q must have the form resource:action", s)
+	}
+	if resource != Wildcard && !isKnownResource(Resource(resource)) {
+		return Permission{}, fmt.Errorf("permission %q names unknown resource %q", s, resource)
+	}
+	if action != Wildcard && !isKnownAction(Action(action)) {
+		return Permission{}, fmt.Errorf("permission %q names unknown action %q", s, action)
+	}
+	return Permission{Resource(resource), Action(action)}, nil
+}
+
+// ValidatePermissions checks every permission of a role.
+func ValidatePermissions(perms []string) error {
+	for _, p := range perms {
+		if _, err := ParsePermission(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isKnownResource(r Resource) bool {
+	for _, known := range KnownResources {
+		if r == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownAction(a Action) bool {
+	for _, known := range KnownActions {
+		if a == known {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	RolePlatformAdmin  = "platform:admin"
 	RolePlatformViewer = "platform:viewer"
@@ -89,8 +160,17 @@ var (
 
 	PermSessionMessageWatch = Permission{ResourceSessionMessage, ActionWatch}
 
-	PermBlackboardWatch = Permission{ResourceBlackboard, ActionWatch}
-	PermBlackboardRead  = Permission{ResourceBlackboard, ActionRead}
+	PermBlackboardWatch  = Permission{ResourceBlackboard, ActionWatch}
+	PermBlackboardRead   = Permission{ResourceBlackboard, ActionRead}
+	PermBlackboardCreate = Permission{ResourceBlackboard, ActionCreate}
+	PermBlackboardUpdate = Permission{ResourceBlackboard, ActionUpdate}
+	PermBlackboardDelete = Permission{ResourceBlackboard, ActionDelete}
+
+	PermProjectDocumentRead   = Permission{ResourceProjectDocument, ActionRead}
+	PermProjectDocumentList   = Permission{ResourceProjectDocument, ActionList}
+	PermProjectDocumentCreate = Permission{ResourceProjectDocument, ActionCreate}
+	PermProjectDocumentUpdate = Permission{ResourceProjectDocument, ActionUpdate}
+	PermProjectDocumentDelete = Permission{ResourceProjectDocument, ActionDelete}
 
 	PermRoleRead          = Permission{ResourceRole, ActionRead}
 	PermRoleList          = Permission{ResourceRole, ActionList}
@@ -114,4 +194,7 @@ var (
 	PermApplicationUpdate = Permission{ResourceApplication, ActionUpdate}
 	PermApplicationDelete = Permission{ResourceApplication, ActionDelete}
 	PermApplicationList   = Permission{ResourceApplication, ActionList}
+
+	PermAuditEventRead  = Permission{ResourceAuditEvent, ActionRead}
+	PermAuditEventWatch = Permission{ResourceAuditEvent, ActionWatch}
 )
@@ -0,0 +1,294 @@
+package agents
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api"
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+type agentGRPCHandler struct {
+	pb.UnimplementedAgentServiceServer
+	service    AgentService
+	generic    services.GenericService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewAgentGRPCHandler(service AgentService, generic services.GenericService, brokerFunc func() *server.EventBroker) pb.AgentServiceServer {
+	return &agentGRPCHandler{
+		service:    service,
+		generic:    generic,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requireServiceCaller guards single-agent reads and mutations.  The
+// project scope checks live in the RBAC middleware, which only runs for
+// REST, so over gRPC only service callers may touch an agent by ID.
+func requireServiceCaller(ctx context.Context) error {
+	if !middleware.IsServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "agents can only be read or modified by ID by service callers over gRPC; use the REST API")
+	}
+	return nil
+}
+
+func (h *agentGRPCHandler) GetAgent(ctx context.Context, req *pb.GetAgentRequest) (*pb.Agent, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	agent, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return agentToProto(agent), nil
+}
+
+func (h *agentGRPCHandler) CreateAgent(ctx context.Context, req *pb.CreateAgentRequest) (*pb.Agent, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("project_id", req.GetProjectId(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("name", req.GetName(), true); err != nil {
+		return nil, err
+	}
+
+	agent := &Agent{
+		ProjectId:            req.GetProjectId(),
+		ParentAgentId:        req.ParentAgentId,
+		OwnerUserId:          req.GetOwnerUserId(),
+		Name:                 req.GetName(),
+		DisplayName:          req.DisplayName,
+		Description:          req.Description,
+		Prompt:               req.Prompt,
+		RepoUrl:              req.RepoUrl,
+		WorkflowId:           req.WorkflowId,
+		LlmModel:             req.GetLlmModel(),
+		LlmTemperature:       unsetTemperature,
+		LlmMaxTokens:         unsetMaxTokens,
+		BotAccountName:       req.BotAccountName,
+		ResourceOverrides:    req.ResourceOverrides,
+		EnvironmentVariables: req.EnvironmentVariables,
+		Labels:               req.Labels,
+		Annotations:          req.Annotations,
+	}
+	if req.LlmTemperature != nil {
+		agent.LlmTemperature = *req.LlmTemperature
+	}
+	if req.LlmMaxTokens != nil {
+		agent.LlmMaxTokens = *req.LlmMaxTokens
+	}
+	if agent.OwnerUserId == "" {
+		agent.OwnerUserId = auth.GetUsernameFromContext(ctx)
+	}
+
+	created, svcErr := h.service.Create(ctx, agent)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return agentToProto(created), nil
+}
+
+func (h *agentGRPCHandler) UpdateAgent(ctx context.Context, req *pb.UpdateAgentRequest) (*pb.Agent, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	found, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	if req.Name != nil {
+		found.Name = *req.Name
+	}
+	if req.DisplayName != nil {
+		found.DisplayName = req.DisplayName
+	}
+	if req.Description != nil {
+		found.Description = req.Description
+	}
+	if req.Prompt != nil {
+		found.Prompt = req.Prompt
+	}
+	if req.RepoUrl != nil {
+		found.RepoUrl = req.RepoUrl
+	}
+	if req.WorkflowId != nil {
+		found.WorkflowId = req.WorkflowId
+	}
+	if req.LlmModel != nil {
+		found.LlmModel = *req.LlmModel
+	}
+	if req.LlmTemperature != nil {
+		found.LlmTemperature = *req.LlmTemperature
+	}
+	if req.LlmMaxTokens != nil {
+		found.LlmMaxTokens = *req.LlmMaxTokens
+	}
+	if req.BotAccountName != nil {
+		found.BotAccountName = req.BotAccountName
+	}
+	if req.ResourceOverrides != nil {
+		found.ResourceOverrides = req.ResourceOverrides
+	}
+	if req.EnvironmentVariables != nil {
+		found.EnvironmentVariables = req.EnvironmentVariables
+	}
+	if req.Labels != nil {
+		found.Labels = req.Labels
+	}
+	if req.Annotations != nil {
+		found.Annotations = req.Annotations
+	}
+
+	updated, svcErr := h.service.Replace(ctx, found)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return agentToProto(updated), nil
+}
+
+func (h *agentGRPCHandler) DeleteAgent(ctx context.Context, req *pb.DeleteAgentRequest) (*pb.DeleteAgentResponse, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	svcErr := h.service.Delete(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return &pb.DeleteAgentResponse{}, nil
+}
+
+func (h *agentGRPCHandler) ListAgents(ctx context.Context, req *pb.ListAgentsRequest) (*pb.ListAgentsResponse, error) {
+	page, size := grpcutil.NormalizePagination(req.GetPage(), req.GetSize())
+
+	listArgs := services.ListArguments{
+		Page: int(page),
+		Size: int64(size),
+	}
+
+	if req.GetProjectId() != "" {
+		projectFilter, err := rbac.TSLEqual("project_id", req.GetProjectId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		rbac.AppendTSLFilter(&listArgs, projectFilter)
+	}
+
+	if !middleware.IsServiceCaller(ctx) {
+		if !rbac.ApplyListFilter(ctx, &listArgs, "project_id", false) {
+			return &pb.ListAgentsResponse{Items: []*pb.Agent{}, Metadata: &pb.ListMeta{Page: int32(page), Size: int32(size), Total: 0}}, nil
+		}
+	}
+
+	var agents []Agent
+	paging, svcErr := h.generic.List(ctx, "id", &listArgs, &agents)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.Agent, 0, len(agents))
+	for i := range agents {
+		items = append(items, agentToProto(&agents[i]))
+	}
+
+	return &pb.ListAgentsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  int32(paging.Page),
+			Size:  int32(paging.Size),
+			Total: int32(paging.Total),
+		},
+	}, nil
+}
+
+func (h *agentGRPCHandler) WatchAgents(req *pb.WatchAgentsRequest, stream grpc.ServerStreamingServer[pb.AgentWatchEvent]) error {
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	ctx := stream.Context()
+	authResult := rbac.GetAuthResult(ctx)
+	isPrivileged := middleware.IsServiceCaller(ctx) ||
+		(authResult != nil && authResult.IsGlobalAdmin)
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			watchEvent := &pb.AgentWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				ResourceId: event.SourceID,
+			}
+
+			if event.EventType != api.DeleteEventType {
+				agent, svcErr := h.service.Get(ctx, event.SourceID)
+				if svcErr != nil {
+					glog.Errorf("WatchAgents: failed to get agent %s: %v", event.SourceID, svcErr)
+					continue
+				}
+
+				if req.GetProjectId() != "" && agent.ProjectId != req.GetProjectId() {
+					continue
+				}
+				if !isPrivileged && !rbac.IsProjectAuthorized(authResult, agent.ProjectId) {
+					continue
+				}
+
+				watchEvent.Agent = agentToProto(agent)
+			} else if !isPrivileged {
+				// Delete events: can't verify project scope (agent gone),
+				// so suppress for non-privileged watchers to prevent ID leakage
+				continue
+			}
+
+			if err := stream.Send(watchEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package agents_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/agents"
+	"github.com/ambient-code/platform/components/ambient-api-server/test"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+)
+
+// Single-agent reads and mutations are only open to service callers over
+// gRPC; users go through REST, where the RBAC middleware scopes them.
+func TestAgentGRPCRequiresServiceCaller(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	token := h.CreateJWTString(account)
+
+	conn, err := grpc.NewClient(
+		h.GRPCAddress(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAgentServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	project, err := newTestProject()
+	Expect(err).NotTo(HaveOccurred())
+	agent, err := newAgentWithProject("grpc-agent", project.ID)
+	Expect(err).NotTo(HaveOccurred())
+
+	expectDenied := func(err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	}
+
+	_, err = client.CreateAgent(ctx, &pb.CreateAgentRequest{ProjectId: project.ID, Name: "user-agent"})
+	expectDenied(err)
+
+	_, err = client.GetAgent(ctx, &pb.GetAgentRequest{Id: agent.ID})
+	expectDenied(err)
+
+	newPrompt := "triage new issues"
+	_, err = client.UpdateAgent(ctx, &pb.UpdateAgentRequest{Id: agent.ID, Prompt: &newPrompt})
+	expectDenied(err)
+
+	_, err = client.DeleteAgent(ctx, &pb.DeleteAgentRequest{Id: agent.ID})
+	expectDenied(err)
+
+	agentService := agents.Service(&environments.Environment().Services)
+	found, svcErr := agentService.Get(context.Background(), agent.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.Prompt).To(BeNil())
+}
+
+func TestAgentGRPCWatch(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	token := h.CreateJWTString(account)
+
+	h.StartControllersServer()
+	time.Sleep(500 * time.Millisecond)
+
+	conn, err := grpc.NewClient(
+		h.GRPCAddress(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAgentServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	project, err := newTestProject()
+	Expect(err).NotTo(HaveOccurred())
+
+	watchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchAgents(watchCtx, &pb.WatchAgentsRequest{ProjectId: project.ID})
+	Expect(err).NotTo(HaveOccurred())
+
+	received := make(chan *pb.AgentWatchEvent, 10)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case received <- event:
+			case <-watchCtx.Done():
+				return
+			}
+		}
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	agentService := agents.Service(&environments.Environment().Services)
+	created, err := newAgentWithProject("watched-agent", project.ID)
+	Expect(err).NotTo(HaveOccurred())
+	resourceID := created.ID
+
+	select {
+	case event := <-received:
+		Expect(event.GetType()).To(Equal(pb.EventType_EVENT_TYPE_CREATED))
+		Expect(event.GetResourceId()).To(Equal(resourceID))
+		Expect(event.GetAgent().GetName()).To(Equal("watched-agent"))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for CREATED watch event")
+	}
+
+	newPrompt := "edited prompt"
+	created.Prompt = &newPrompt
+	_, svcErr := agentService.Replace(context.Background(), created)
+	Expect(svcErr).To(BeNil())
+
+	select {
+	case event := <-received:
+		Expect(event.GetType()).To(Equal(pb.EventType_EVENT_TYPE_UPDATED))
+		Expect(event.GetResourceId()).To(Equal(resourceID))
+		Expect(event.GetAgent().GetPrompt()).To(Equal("edited prompt"))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for UPDATED watch event")
+	}
+
+	Expect(agentService.Delete(context.Background(), resourceID)).To(BeNil())
+
+	select {
+	case event := <-received:
+		Expect(event.GetType()).To(Equal(pb.EventType_EVENT_TYPE_DELETED))
+		Expect(event.GetResourceId()).To(Equal(resourceID))
+		Expect(event.GetAgent()).To(BeNil())
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for DELETED watch event")
+	}
+}
@@ -0,0 +1,40 @@
+package agents
+
+import (
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func agentToProto(a *Agent) *pb.Agent {
+	if a == nil {
+		return nil
+	}
+
+	return &pb.Agent{
+		Metadata: &pb.ObjectReference{
+			Id:        a.ID,
+			CreatedAt: timestamppb.New(a.CreatedAt),
+			UpdatedAt: timestamppb.New(a.UpdatedAt),
+			Kind:      "Agent",
+			Href:      "/api/ambient/v1/projects/" + a.ProjectId + "/agents/" + a.ID,
+		},
+		ProjectId:            a.ProjectId,
+		ParentAgentId:        a.ParentAgentId,
+		OwnerUserId:          a.OwnerUserId,
+		Name:                 a.Name,
+		DisplayName:          a.DisplayName,
+		Description:          a.Description,
+		Prompt:               a.Prompt,
+		RepoUrl:              a.RepoUrl,
+		WorkflowId:           a.WorkflowId,
+		LlmModel:             a.LlmModel,
+		LlmTemperature:       a.LlmTemperature,
+		LlmMaxTokens:         a.LlmMaxTokens,
+		BotAccountName:       a.BotAccountName,
+		ResourceOverrides:    a.ResourceOverrides,
+		EnvironmentVariables: a.EnvironmentVariables,
+		Labels:               a.Labels,
+		Annotations:          a.Annotations,
+		CurrentSessionId:     a.CurrentSessionId,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -362,3 +364,43 @@ func TestAgentCrossProjectIsolation(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(list.Items)).To(Equal(0))
 }
+
+func TestAgentModelCatalog(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	manifestPath := filepath.Join(t.TempDir(), "models.json")
+	Expect(os.WriteFile(manifestPath, []byte(`{
+		"version": 2,
+		"defaultModel": "claude-haiku-4-5",
+		"defaultMaxTokens": 8000,
+		"models": [
+			{"id": "claude-haiku-4-5", "label": "Claude Haiku 4.5", "provider": "anthropic", "available": true, "featureGated": false},
+			{"id": "claude-opus-4-6", "label": "Claude Opus 4.6", "provider": "anthropic", "available": true, "featureGated": true}
+		]
+	}`), 0o600)).To(Succeed())
+	t.Setenv("MODELS_MANIFEST_PATH", manifestPath)
+
+	proj, err := newTestProject()
+	Expect(err).NotTo(HaveOccurred())
+
+	created, resp, err := client.DefaultAPI.ApiAmbientV1ProjectsIdAgentsPost(ctx, proj.ID).
+		Agent(openapi.Agent{ProjectId: proj.ID, Name: "catalog-agent"}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(created.GetLlmModel()).To(Equal("claude-haiku-4-5"))
+	Expect(created.GetLlmTemperature()).To(BeNumerically("~", 0.7, 0.001), "temperature falls back when the manifest does not set one")
+	Expect(created.GetLlmMaxTokens()).To(Equal(int32(8000)))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ProjectsIdAgentsPost(ctx, proj.ID).
+		Agent(openapi.Agent{ProjectId: proj.ID, Name: "unknown-model-agent", LlmModel: openapi.PtrString("gpt-4o")}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ProjectsIdAgentsAgentIdPatch(ctx, proj.ID, *created.Id).
+		AgentPatchRequest(openapi.AgentPatchRequest{LlmModel: openapi.PtrString("claude-opus-4-6")}).Execute()
+	Expect(err).To(HaveOccurred(), "gated models need a project override")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
@@ -48,14 +48,5 @@ const (
 
 func (d *Agent) BeforeCreate(tx *gorm.DB) error {
 	d.ID = api.NewID()
-	if d.LlmModel == "" {
-		d.LlmModel = "claude-sonnet-4-6"
-	}
-	if d.LlmTemperature == unsetTemperature {
-		d.LlmTemperature = 0.7
-	}
-	if d.LlmMaxTokens == unsetMaxTokens {
-		d.LlmMaxTokens = 4000
-	}
 	return nil
 }
@@ -3,6 +3,7 @@ package agents
 import (
 	"net/http"
 
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
@@ -15,12 +16,16 @@ import (
 	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
 	"github.com/openshift-online/rh-trex-ai/plugins/events"
 	"github.com/openshift-online/rh-trex-ai/plugins/generic"
+	"google.golang.org/grpc"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/plugins/inbox"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/models"
 	"github.com/ambient-code/platform/components/ambient-api-server/plugins/roleBindings"
 	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
 )
 
+const EventSource = "Agents"
+
 type ServiceLocator func() AgentService
 
 func NewServiceLocator(env *environments.Env) ServiceLocator {
@@ -29,6 +34,7 @@ func NewServiceLocator(env *environments.Env) ServiceLocator {
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
 			NewAgentDao(&env.Database.SessionFactory),
 			events.Service(&env.Services),
+			models.Service(&env.Services),
 		)
 	}
 }
@@ -55,10 +61,15 @@ func projectPromptFetcher(s *environments.Services) ProjectPromptFetcher {
 	return nil
 }
 
-func notImplemented(w http.ResponseWriter, _ *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusNotImplemented)
-	_, _ = w.Write([]byte(`{"code":"NOT_IMPLEMENTED","reason":"not yet implemented"}`))
+func projectDocumentFetcher(s *environments.Services) ProjectDocumentFetcher {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("ProjectDocumentFetcher"); obj != nil {
+		locator := obj.(func() ProjectDocumentFetcher)
+		return locator()
+	}
+	return nil
 }
 
 func init() {
@@ -72,6 +83,12 @@ func init() {
 		}
 	})
 
+	registry.RegisterService("AgentStarter", func(env interface{}) interface{} {
+		return func(s *environments.Services) interface{} {
+			return NewStartHandler(Service(s), inbox.Service(s), sessions.Service(s), sessions.MessageSvc(s), projectPromptFetcher(s), projectDocumentFetcher(s))
+		}
+	})
+
 	pkgserver.RegisterRoutes("agents", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
 		envServices := services.(*environments.Services)
 		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
@@ -79,7 +96,7 @@ func init() {
 		}
 		agentSvc := Service(envServices)
 		agentHandler := NewAgentHandler(agentSvc, generic.Service(envServices))
-		startHandler := NewStartHandler(agentSvc, inbox.Service(envServices), sessions.Service(envServices), sessions.MessageSvc(envServices), projectPromptFetcher(envServices))
+		startHandler := NewStartHandler(agentSvc, inbox.Service(envServices), sessions.Service(envServices), sessions.MessageSvc(envServices), projectPromptFetcher(envServices), projectDocumentFetcher(envServices))
 		subHandler := NewAgentSubresourceHandler(agentSvc, sessions.Service(envServices), generic.Service(envServices), roleBindings.Service(envServices))
 
 		projectsRouter := apiV1Router.PathPrefix("/projects").Subrouter()
@@ -92,7 +109,6 @@ func init() {
 		projectsRouter.HandleFunc("/{id}/agents/{agent_id}/start", startHandler.StartPreview).Methods(http.MethodGet)
 		projectsRouter.HandleFunc("/{id}/agents/{agent_id}/sessions", subHandler.ListSessions).Methods(http.MethodGet)
 		projectsRouter.HandleFunc("/{id}/agents/{agent_id}/role_bindings", subHandler.ListRoleBindings).Methods(http.MethodGet)
-		projectsRouter.HandleFunc("/{id}/home", notImplemented).Methods(http.MethodGet)
 		projectsRouter.Use(authMiddleware.AuthenticateAccountJWT)
 		projectsRouter.Use(authzMiddleware.AuthorizeApi)
 	})
@@ -101,7 +117,7 @@ func init() {
 		agentServices := Service(services.(*environments.Services))
 
 		manager.Add(&controllers.ControllerConfig{
-			Source: "Agents",
+			Source: EventSource,
 			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
 				api.CreateEventType: {agentServices.OnUpsert},
 				api.UpdateEventType: {agentServices.OnUpsert},
@@ -115,6 +131,19 @@ func init() {
 	presenters.RegisterKind(Agent{}, "Agent")
 	presenters.RegisterKind(&Agent{}, "Agent")
 
+	pkgserver.RegisterGRPCService("agents", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		agentService := Service(envServices)
+		genericService := generic.Service(envServices)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
+		}
+		pb.RegisterAgentServiceServer(grpcServer, NewAgentGRPCHandler(agentService, genericService, brokerFunc))
+	})
+
 	db.RegisterMigration(migration())
 	db.RegisterMigration(agentSchemaExpansionMigration())
 }
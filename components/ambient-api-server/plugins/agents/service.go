@@ -8,6 +8,8 @@ import (
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/logger"
 	"github.com/openshift-online/rh-trex-ai/pkg/services"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/models"
 )
 
 const agentsLockType db.LockType = "agents"
@@ -31,11 +33,12 @@ type AgentService interface {
 	OnDelete(ctx context.Context, id string) error
 }
 
-func NewAgentService(lockFactory db.LockFactory, agentDao AgentDao, events services.EventService) AgentService {
+func NewAgentService(lockFactory db.LockFactory, agentDao AgentDao, events services.EventService, modelSvc models.ModelService) AgentService {
 	return &sqlAgentService{
 		lockFactory: lockFactory,
 		agentDao:    agentDao,
 		events:      events,
+		models:      modelSvc,
 	}
 }
 
@@ -45,6 +48,7 @@ type sqlAgentService struct {
 	lockFactory db.LockFactory
 	agentDao    AgentDao
 	events      services.EventService
+	models      models.ModelService
 }
 
 func (s *sqlAgentService) OnUpsert(ctx context.Context, id string) error {
@@ -75,6 +79,10 @@ func (s *sqlAgentService) Get(ctx context.Context, id string) (*Agent, *errors.S
 }
 
 func (s *sqlAgentService) Create(ctx context.Context, agent *Agent) (*Agent, *errors.ServiceError) {
+	if svcErr := s.applyModel(ctx, agent); svcErr != nil {
+		return nil, svcErr
+	}
+
 	agent, err := s.agentDao.Create(ctx, agent)
 	if err != nil {
 		return nil, services.HandleCreateError("Agent", err)
@@ -112,6 +120,10 @@ func (s *sqlAgentService) Replace(ctx context.Context, agent *Agent) (*Agent, *e
 		}
 	}
 
+	if svcErr := s.checkModelChange(ctx, agent); svcErr != nil {
+		return nil, svcErr
+	}
+
 	agent, err := s.agentDao.Replace(ctx, agent)
 	if err != nil {
 		return nil, services.HandleUpdateError("Agent", err)
@@ -129,6 +141,48 @@ func (s *sqlAgentService) Replace(ctx context.Context, agent *Agent) (*Agent, *e
 	return agent, nil
 }
 
+// applyModel fills unset generation parameters from the model catalog and
+// rejects a model the agent's project may not use.
+func (s *sqlAgentService) applyModel(ctx context.Context, agent *Agent) *errors.ServiceError {
+	if s.models == nil {
+		return nil
+	}
+	defaults := s.models.Defaults()
+	if agent.LlmModel == "" {
+		agent.LlmModel = defaults.Model
+	} else if svcErr := s.models.Validate(ctx, agent.ProjectId, agent.LlmModel); svcErr != nil {
+		return svcErr
+	}
+	if agent.LlmTemperature == unsetTemperature {
+		agent.LlmTemperature = defaults.Temperature
+	}
+	if agent.LlmMaxTokens == unsetMaxTokens {
+		agent.LlmMaxTokens = defaults.MaxTokens
+	}
+	return nil
+}
+
+// checkModelChange validates the model of an agent being replaced. An agent
+// keeps a model that was disabled after it was chosen, so the stored row is
+// only consulted when validation fails.
+func (s *sqlAgentService) checkModelChange(ctx context.Context, agent *Agent) *errors.ServiceError {
+	if s.models == nil || agent.LlmModel == "" {
+		return nil
+	}
+	svcErr := s.models.Validate(ctx, agent.ProjectId, agent.LlmModel)
+	if svcErr == nil {
+		return nil
+	}
+	existing, err := s.agentDao.Get(ctx, agent.ID)
+	if err != nil {
+		return services.HandleGetError("Agent", "id", agent.ID, err)
+	}
+	if existing.LlmModel == agent.LlmModel {
+		return nil
+	}
+	return svcErr
+}
+
 func (s *sqlAgentService) Delete(ctx context.Context, id string) *errors.ServiceError {
 	if err := s.agentDao.Delete(ctx, id); err != nil {
 		return services.HandleDeleteError("Agent", errors.GeneralError("Unable to delete agent: %s", err))
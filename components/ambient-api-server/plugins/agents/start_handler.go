@@ -29,22 +29,43 @@ type ProjectPromptFetcher interface {
 	GetPrompt(ctx context.Context, projectID string) (*string, error)
 }
 
+// StartDocument is a project document included in an agent's start prompt.
+type StartDocument struct {
+	Title string
+	Body  string
+}
+
+// ProjectDocumentFetcher returns the project documents selected for
+// inclusion in agent start prompts, in the order they should appear.
+type ProjectDocumentFetcher interface {
+	StartDocuments(ctx context.Context, projectID string) ([]StartDocument, error)
+}
+
+// maxStartDocumentBytes caps the combined size of the document bodies placed
+// in a start prompt; documents past the cap are listed by title only.
+const maxStartDocumentBytes = 64 * 1024
+
+// agentStartLocks serializes starts per agent across every startHandler
+// instance, so HTTP starts and scheduled starts cannot race each other.
+var agentStartLocks sync.Map
+
 type startHandler struct {
-	agent   AgentService
-	inbox   inbox.InboxMessageService
-	session sessions.SessionService
-	msg     sessions.MessageService
-	project ProjectPromptFetcher
-	locks   sync.Map
+	agent     AgentService
+	inbox     inbox.InboxMessageService
+	session   sessions.SessionService
+	msg       sessions.MessageService
+	project   ProjectPromptFetcher
+	documents ProjectDocumentFetcher
 }
 
-func NewStartHandler(agent AgentService, inboxSvc inbox.InboxMessageService, session sessions.SessionService, msg sessions.MessageService, project ProjectPromptFetcher) *startHandler {
+func NewStartHandler(agent AgentService, inboxSvc inbox.InboxMessageService, session sessions.SessionService, msg sessions.MessageService, project ProjectPromptFetcher, documents ProjectDocumentFetcher) *startHandler {
 	return &startHandler{
-		agent:   agent,
-		inbox:   inboxSvc,
-		session: session,
-		msg:     msg,
-		project: project,
+		agent:     agent,
+		inbox:     inboxSvc,
+		session:   session,
+		msg:       msg,
+		project:   project,
+		documents: documents,
 	}
 }
 
@@ -53,8 +74,50 @@ func (h *startHandler) Start(w http.ResponseWriter, r *http.Request) {
 	projectID := mux.Vars(r)["id"]
 	agentID := mux.Vars(r)["agent_id"]
 
+	var requestPrompt *string
+	var body struct {
+		Prompt string `json:"prompt"`
+	}
+	if r.ContentLength > 0 {
+		if decErr := json.NewDecoder(r.Body).Decode(&body); decErr == nil && body.Prompt != "" {
+			requestPrompt = &body.Prompt
+		}
+	}
+
+	resp, created, err := h.start(ctx, projectID, agentID, requestPrompt)
+	if err != nil {
+		handlers.HandleError(ctx, w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if !created {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// StartAgent starts agentID outside of an HTTP request. If the agent already
+// has an active session, that session's ID is returned with alreadyActive set.
+func (h *startHandler) StartAgent(ctx context.Context, projectID, agentID string, prompt *string) (string, bool, *pkgerrors.ServiceError) {
+	resp, created, err := h.start(ctx, projectID, agentID, prompt)
+	if err != nil {
+		return "", false, err
+	}
+	sessionID := ""
+	if resp.Session.Id != nil {
+		sessionID = *resp.Session.Id
+	}
+	return sessionID, !created, nil
+}
+
+// start creates and starts a session for the agent, or returns the agent's
+// active session with created=false.
+func (h *startHandler) start(ctx context.Context, projectID, agentID string, requestPrompt *string) (*StartResponse, bool, *pkgerrors.ServiceError) {
 	mu := &sync.Mutex{}
-	if existing, loaded := h.locks.LoadOrStore(agentID, mu); loaded {
+	if existing, loaded := agentStartLocks.LoadOrStore(agentID, mu); loaded {
 		mu = existing.(*sync.Mutex)
 	}
 	mu.Lock()
@@ -62,111 +125,83 @@ func (h *startHandler) Start(w http.ResponseWriter, r *http.Request) {
 
 	agent, err := h.agent.Get(ctx, agentID)
 	if err != nil {
-		handlers.HandleError(ctx, w, err)
-		return
+		return nil, false, err
 	}
 
 	if agent.ProjectId != projectID {
-		handlers.HandleError(ctx, w, pkgerrors.Forbidden("agent does not belong to this project"))
-		return
+		return nil, false, pkgerrors.Forbidden("agent does not belong to this project")
 	}
 
 	existing, activeErr := h.session.ActiveByAgentID(ctx, agentID)
 	if activeErr != nil {
-		handlers.HandleError(ctx, w, activeErr)
-		return
+		return nil, false, activeErr
 	}
 	if existing != nil {
-		resp := &StartResponse{
+		return &StartResponse{
 			Session: sessions.PresentSession(existing),
-		}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		_ = json.NewEncoder(w).Encode(resp)
-		return
+		}, false, nil
 	}
 
-	cfg := &handlers.HandlerConfig{
-		Action: func() (interface{}, *pkgerrors.ServiceError) {
-			unread, inboxErr := h.inbox.UnreadByAgentID(ctx, agentID)
-			if inboxErr != nil {
-				return nil, inboxErr
-			}
-
-			var requestPrompt *string
-			var body struct {
-				Prompt string `json:"prompt"`
-			}
-			if r.ContentLength > 0 {
-				if decErr := json.NewDecoder(r.Body).Decode(&body); decErr == nil && body.Prompt != "" {
-					requestPrompt = &body.Prompt
-				}
-			}
-
-			sess := &sessions.Session{
-				Name:      fmt.Sprintf("%s-%d", agent.Name, time.Now().Unix()),
-				Prompt:    agent.Prompt,
-				ProjectId: &agent.ProjectId,
-				AgentId:   &agentID,
-			}
+	unread, inboxErr := h.inbox.UnreadByAgentID(ctx, agentID)
+	if inboxErr != nil {
+		return nil, false, inboxErr
+	}
 
-			username := auth.GetUsernameFromContext(ctx)
-			if username != "" {
-				sess.CreatedByUserId = &username
-			}
+	sess := &sessions.Session{
+		Name:      fmt.Sprintf("%s-%d", agent.Name, time.Now().Unix()),
+		Prompt:    agent.Prompt,
+		ProjectId: &agent.ProjectId,
+		AgentId:   &agentID,
+	}
 
-			created, sessErr := h.session.Create(ctx, sess)
-			if sessErr != nil {
-				return nil, sessErr
-			}
+	username := auth.GetUsernameFromContext(ctx)
+	if username != "" {
+		sess.CreatedByUserId = &username
+	}
 
-			for _, msg := range unread {
-				read := true
-				msgCopy := *msg
-				msgCopy.Read = &read
-				if _, replErr := h.inbox.Replace(ctx, &msgCopy); replErr != nil {
-					glog.Warningf("Start agent %s: mark inbox message %s read: %v", agentID, msg.ID, replErr)
-				}
-			}
+	created, sessErr := h.session.Create(ctx, sess)
+	if sessErr != nil {
+		return nil, false, sessErr
+	}
 
-			peers, peersErr := h.agent.AllByProjectID(ctx, agent.ProjectId)
-			if peersErr != nil {
-				return nil, peersErr
-			}
+	for _, msg := range unread {
+		read := true
+		msgCopy := *msg
+		msgCopy.Read = &read
+		if _, replErr := h.inbox.Replace(ctx, &msgCopy); replErr != nil {
+			glog.Warningf("Start agent %s: mark inbox message %s read: %v", agentID, msg.ID, replErr)
+		}
+	}
 
-			var projectPrompt *string
-			if h.project != nil {
-				if pp, ppErr := h.project.GetPrompt(ctx, agent.ProjectId); ppErr == nil {
-					projectPrompt = pp
-				}
-			}
+	peers, peersErr := h.agent.AllByProjectID(ctx, agent.ProjectId)
+	if peersErr != nil {
+		return nil, false, peersErr
+	}
 
-			prompt := buildStartPrompt(agent, peers, unread, projectPrompt, requestPrompt)
+	projectPrompt, documents := h.workspaceContext(ctx, agent.ProjectId)
 
-			if prompt != "" {
-				if _, pushErr := h.msg.Push(ctx, created.ID, "user", prompt); pushErr != nil {
-					glog.Errorf("Start agent %s: store start prompt for session %s: %v", agentID, created.ID, pushErr)
-				}
-			}
+	prompt := buildStartPrompt(agent, peers, unread, projectPrompt, documents, requestPrompt)
 
-			agentCopy := *agent
-			agentCopy.CurrentSessionId = &created.ID
-			if _, replErr := h.agent.Replace(ctx, &agentCopy); replErr != nil {
-				return nil, replErr
-			}
+	if prompt != "" {
+		if _, pushErr := h.msg.Push(ctx, created.ID, "user", prompt); pushErr != nil {
+			glog.Errorf("Start agent %s: store start prompt for session %s: %v", agentID, created.ID, pushErr)
+		}
+	}
 
-			if _, startErr := h.session.Start(ctx, created.ID); startErr != nil {
-				return nil, startErr
-			}
+	agentCopy := *agent
+	agentCopy.CurrentSessionId = &created.ID
+	if _, replErr := h.agent.Replace(ctx, &agentCopy); replErr != nil {
+		return nil, false, replErr
+	}
 
-			return &StartResponse{
-				Session:        sessions.PresentSession(created),
-				StartingPrompt: prompt,
-			}, nil
-		},
-		ErrorHandler: handlers.HandleError,
+	if _, startErr := h.session.Start(ctx, created.ID); startErr != nil {
+		return nil, false, startErr
 	}
-	handlers.Handle(w, r, cfg, http.StatusCreated)
+
+	return &StartResponse{
+		Session:        sessions.PresentSession(created),
+		StartingPrompt: prompt,
+	}, true, nil
 }
 
 func (h *startHandler) StartPreview(w http.ResponseWriter, r *http.Request) {
@@ -195,14 +230,9 @@ func (h *startHandler) StartPreview(w http.ResponseWriter, r *http.Request) {
 				return nil, peersErr
 			}
 
-			var projectPrompt *string
-			if h.project != nil {
-				if pp, ppErr := h.project.GetPrompt(ctx, agent.ProjectId); ppErr == nil {
-					projectPrompt = pp
-				}
-			}
+			projectPrompt, documents := h.workspaceContext(ctx, agent.ProjectId)
 
-			prompt := buildStartPrompt(agent, peers, unread, projectPrompt, nil)
+			prompt := buildStartPrompt(agent, peers, unread, projectPrompt, documents, nil)
 
 			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 			w.WriteHeader(http.StatusOK)
@@ -217,7 +247,30 @@ func (h *startHandler) StartPreview(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
-func buildStartPrompt(agent *Agent, peers AgentList, unread inbox.InboxMessageList, projectPrompt *string, requestPrompt *string) string {
+// workspaceContext fetches the project prompt and start documents. Either
+// is left empty when it cannot be fetched; a start never fails on context.
+func (h *startHandler) workspaceContext(ctx context.Context, projectID string) (*string, []StartDocument) {
+	var projectPrompt *string
+	if h.project != nil {
+		if pp, ppErr := h.project.GetPrompt(ctx, projectID); ppErr == nil {
+			projectPrompt = pp
+		}
+	}
+
+	var documents []StartDocument
+	if h.documents != nil {
+		docs, docErr := h.documents.StartDocuments(ctx, projectID)
+		if docErr != nil {
+			glog.Warningf("Start prompt for project %s: fetch project documents: %v", projectID, docErr)
+		} else {
+			documents = docs
+		}
+	}
+
+	return projectPrompt, documents
+}
+
+func buildStartPrompt(agent *Agent, peers AgentList, unread inbox.InboxMessageList, projectPrompt *string, documents []StartDocument, requestPrompt *string) string {
 	var sb strings.Builder
 
 	fmt.Fprintf(&sb, "# Agent Start: %s\n\n", agent.Name)
@@ -227,6 +280,23 @@ func buildStartPrompt(agent *Agent, peers AgentList, unread inbox.InboxMessageLi
 		fmt.Fprintf(&sb, "## Workspace Context\n\n%s\n\n", *projectPrompt)
 	}
 
+	if len(documents) > 0 {
+		sb.WriteString("## Project Documents\n\n")
+		remaining := maxStartDocumentBytes
+		var omitted []string
+		for _, d := range documents {
+			if len(d.Body) > remaining {
+				omitted = append(omitted, d.Title)
+				continue
+			}
+			remaining -= len(d.Body)
+			fmt.Fprintf(&sb, "### %s\n\n%s\n\n", d.Title, strings.TrimRight(d.Body, "\n"))
+		}
+		if len(omitted) > 0 {
+			fmt.Fprintf(&sb, "_Not included (start context size limit reached): %s_\n\n", strings.Join(omitted, ", "))
+		}
+	}
+
 	if agent.Prompt != nil && *agent.Prompt != "" {
 		fmt.Fprintf(&sb, "## Standing Instructions\n\n%s\n\n", *agent.Prompt)
 	}
@@ -0,0 +1,302 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api"
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+type applicationGRPCHandler struct {
+	pb.UnimplementedApplicationServiceServer
+	service    ApplicationService
+	generic    services.GenericService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewApplicationGRPCHandler(service ApplicationService, generic services.GenericService, brokerFunc func() *server.EventBroker) pb.ApplicationServiceServer {
+	return &applicationGRPCHandler{
+		service:    service,
+		generic:    generic,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requireServiceCaller guards single-application reads and mutations.  The
+// scope checks live in the RBAC middleware, which only runs for REST, so
+// over gRPC only service callers may touch an application by ID.
+func requireServiceCaller(ctx context.Context) error {
+	if !middleware.IsServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "applications can only be read or modified by ID by service callers over gRPC; use the REST API")
+	}
+	return nil
+}
+
+func (h *applicationGRPCHandler) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.Application, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	app, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return applicationToProto(app), nil
+}
+
+func (h *applicationGRPCHandler) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest) (*pb.Application, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("name", req.GetName(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("source_repo_url", req.GetSourceRepoUrl(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("destination_project", req.GetDestinationProject(), true); err != nil {
+		return nil, err
+	}
+
+	app := &Application{
+		Name:                  req.GetName(),
+		SourceRepoUrl:         req.GetSourceRepoUrl(),
+		SourceTargetRevision:  req.SourceTargetRevision,
+		SourcePath:            req.GetSourcePath(),
+		DestinationAmbientUrl: req.DestinationAmbientUrl,
+		DestinationProject:    req.GetDestinationProject(),
+		CredentialId:          req.CredentialId,
+		AutoSync:              req.AutoSync,
+		AutoPrune:             req.AutoPrune,
+		SelfHeal:              req.SelfHeal,
+		SyncOptions:           req.SyncOptions,
+		Labels:                req.Labels,
+		Annotations:           req.Annotations,
+	}
+	if req.RetryLimit != nil {
+		limit := int(*req.RetryLimit)
+		app.RetryLimit = &limit
+	}
+
+	created, svcErr := h.service.Create(ctx, app)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return applicationToProto(created), nil
+}
+
+func (h *applicationGRPCHandler) UpdateApplication(ctx context.Context, req *pb.UpdateApplicationRequest) (*pb.Application, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	found, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	if req.Name != nil {
+		found.Name = *req.Name
+	}
+	if req.SourceRepoUrl != nil {
+		found.SourceRepoUrl = *req.SourceRepoUrl
+	}
+	if req.SourceTargetRevision != nil {
+		found.SourceTargetRevision = req.SourceTargetRevision
+	}
+	if req.SourcePath != nil {
+		found.SourcePath = *req.SourcePath
+	}
+	if req.DestinationAmbientUrl != nil {
+		found.DestinationAmbientUrl = req.DestinationAmbientUrl
+	}
+	if req.DestinationProject != nil {
+		found.DestinationProject = *req.DestinationProject
+	}
+	if req.CredentialId != nil {
+		found.CredentialId = req.CredentialId
+	}
+	if req.AutoSync != nil {
+		found.AutoSync = req.AutoSync
+	}
+	if req.AutoPrune != nil {
+		found.AutoPrune = req.AutoPrune
+	}
+	if req.SelfHeal != nil {
+		found.SelfHeal = req.SelfHeal
+	}
+	if req.SyncOptions != nil {
+		found.SyncOptions = req.SyncOptions
+	}
+	if req.RetryLimit != nil {
+		limit := int(*req.RetryLimit)
+		found.RetryLimit = &limit
+	}
+	if req.SyncStatus != nil {
+		found.SyncStatus = req.SyncStatus
+	}
+	if req.HealthStatus != nil {
+		found.HealthStatus = req.HealthStatus
+	}
+	if req.SyncRevision != nil {
+		found.SyncRevision = req.SyncRevision
+	}
+	if req.OperationPhase != nil {
+		found.OperationPhase = req.OperationPhase
+	}
+	if req.OperationMessage != nil {
+		found.OperationMessage = req.OperationMessage
+	}
+	if req.ResourceStatus != nil {
+		found.ResourceStatus = req.ResourceStatus
+	}
+	if req.Conditions != nil {
+		found.Conditions = req.Conditions
+	}
+	if req.Labels != nil {
+		found.Labels = req.Labels
+	}
+	if req.Annotations != nil {
+		found.Annotations = req.Annotations
+	}
+	if req.LastSyncedAt != nil {
+		t := req.LastSyncedAt.AsTime()
+		found.LastSyncedAt = &t
+	}
+
+	updated, svcErr := h.service.Replace(ctx, found)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return applicationToProto(updated), nil
+}
+
+func (h *applicationGRPCHandler) DeleteApplication(ctx context.Context, req *pb.DeleteApplicationRequest) (*pb.DeleteApplicationResponse, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	svcErr := h.service.Delete(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return &pb.DeleteApplicationResponse{}, nil
+}
+
+func (h *applicationGRPCHandler) ListApplications(ctx context.Context, req *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
+	page, size := grpcutil.NormalizePagination(req.GetPage(), req.GetSize())
+
+	listArgs := services.ListArguments{
+		Page: int(page),
+		Size: int64(size),
+	}
+
+	if !middleware.IsServiceCaller(ctx) {
+		if !rbac.ApplyListFilter(ctx, &listArgs, "destination_project", false) {
+			return &pb.ListApplicationsResponse{Items: []*pb.Application{}, Metadata: &pb.ListMeta{Page: int32(page), Size: int32(size), Total: 0}}, nil
+		}
+	}
+
+	var apps []Application
+	paging, svcErr := h.generic.List(ctx, "id", &listArgs, &apps)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.Application, 0, len(apps))
+	for i := range apps {
+		items = append(items, applicationToProto(&apps[i]))
+	}
+
+	return &pb.ListApplicationsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  int32(paging.Page),
+			Size:  int32(paging.Size),
+			Total: int32(paging.Total),
+		},
+	}, nil
+}
+
+func (h *applicationGRPCHandler) WatchApplications(req *pb.WatchApplicationsRequest, stream grpc.ServerStreamingServer[pb.ApplicationWatchEvent]) error {
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	ctx := stream.Context()
+	authResult := rbac.GetAuthResult(ctx)
+	isPrivileged := middleware.IsServiceCaller(ctx) ||
+		(authResult != nil && authResult.IsGlobalAdmin)
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			watchEvent := &pb.ApplicationWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				ResourceId: event.SourceID,
+			}
+
+			if event.EventType != api.DeleteEventType {
+				app, svcErr := h.service.Get(ctx, event.SourceID)
+				if svcErr != nil {
+					glog.Errorf("WatchApplications: failed to get application %s: %v", event.SourceID, svcErr)
+					continue
+				}
+
+				if !isPrivileged && !rbac.IsProjectAuthorized(authResult, app.DestinationProject) {
+					continue
+				}
+
+				watchEvent.Application = applicationToProto(app)
+			} else if !isPrivileged {
+				// Delete events: can't verify project scope (application gone),
+				// so suppress for non-privileged watchers to prevent ID leakage
+				continue
+			}
+
+			if err := stream.Send(watchEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
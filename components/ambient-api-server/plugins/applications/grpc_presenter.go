@@ -0,0 +1,52 @@
+package applications
+
+import (
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func applicationToProto(a *Application) *pb.Application {
+	if a == nil {
+		return nil
+	}
+
+	proto := &pb.Application{
+		Metadata: &pb.ObjectReference{
+			Id:        a.ID,
+			CreatedAt: timestamppb.New(a.CreatedAt),
+			UpdatedAt: timestamppb.New(a.UpdatedAt),
+			Kind:      "Application",
+			Href:      "/api/ambient/v1/applications/" + a.ID,
+		},
+		Name:                  a.Name,
+		SourceRepoUrl:         a.SourceRepoUrl,
+		SourceTargetRevision:  a.SourceTargetRevision,
+		SourcePath:            a.SourcePath,
+		DestinationAmbientUrl: a.DestinationAmbientUrl,
+		DestinationProject:    a.DestinationProject,
+		CredentialId:          a.CredentialId,
+		AutoSync:              a.AutoSync,
+		AutoPrune:             a.AutoPrune,
+		SelfHeal:              a.SelfHeal,
+		SyncOptions:           a.SyncOptions,
+		SyncStatus:            a.SyncStatus,
+		HealthStatus:          a.HealthStatus,
+		SyncRevision:          a.SyncRevision,
+		OperationPhase:        a.OperationPhase,
+		OperationMessage:      a.OperationMessage,
+		ResourceStatus:        a.ResourceStatus,
+		Conditions:            a.Conditions,
+		Labels:                a.Labels,
+		Annotations:           a.Annotations,
+	}
+
+	if a.RetryLimit != nil {
+		limit := int32(*a.RetryLimit)
+		proto.RetryLimit = &limit
+	}
+	if a.LastSyncedAt != nil {
+		proto.LastSyncedAt = timestamppb.New(*a.LastSyncedAt)
+	}
+
+	return proto
+}
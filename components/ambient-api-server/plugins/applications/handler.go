@@ -6,6 +6,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
 	"github.com/openshift-online/rh-trex-ai/pkg/api/presenters"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
@@ -19,6 +20,10 @@ type applicationHandler struct {
 	generic     services.GenericService
 }
 
+// errResourceStatusReadOnly rejects user writes to resource_status. The
+// control plane prunes what that list records, so only it may write it.
+var errResourceStatusReadOnly = errors.Forbidden("resource_status is maintained by the control plane and cannot be set")
+
 func NewApplicationHandler(application ApplicationService, generic services.GenericService) *applicationHandler {
 	return &applicationHandler{
 		application: application,
@@ -35,6 +40,9 @@ func (h applicationHandler) Create(w http.ResponseWriter, r *http.Request) {
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
+			if application.ResourceStatus != nil && !middleware.IsServiceCaller(ctx) {
+				return nil, errResourceStatusReadOnly
+			}
 			applicationModel := ConvertApplication(application)
 			applicationModel, err := h.application.Create(ctx, applicationModel)
 			if err != nil {
@@ -56,6 +64,9 @@ func (h applicationHandler) Patch(w http.ResponseWriter, r *http.Request) {
 		Validators: []handlers.Validate{},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
+			if patch.ResourceStatus != nil && !middleware.IsServiceCaller(ctx) {
+				return nil, errResourceStatusReadOnly
+			}
 			id := mux.Vars(r)["id"]
 			found, err := h.application.Get(ctx, id)
 			if err != nil {
@@ -65,7 +65,6 @@ func TestApplicationPost(t *testing.T) {
 		SyncRevision:          openapi.PtrString("test-sync_revision"),
 		OperationPhase:        openapi.PtrString("test-operation_phase"),
 		OperationMessage:      openapi.PtrString("test-operation_message"),
-		ResourceStatus:        openapi.PtrString("test-resource_status"),
 		Conditions:            openapi.PtrString("test-conditions"),
 		Labels:                openapi.PtrString("test-labels"),
 		Annotations:           openapi.PtrString("test-annotations"),
@@ -116,6 +115,34 @@ func TestApplicationPatch(t *testing.T) {
 	Expect(restyResp.StatusCode()).To(Equal(http.StatusBadRequest))
 }
 
+// resource_status records what the control plane may prune, so users cannot
+// write it on create or patch.
+func TestApplicationResourceStatusIsReadOnly(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	_, resp, err := client.DefaultAPI.ApiAmbientV1ApplicationsPost(ctx).Application(openapi.Application{
+		Name:               "test-name",
+		SourceRepoUrl:      "test-source_repo_url",
+		SourcePath:         "test-source_path",
+		DestinationProject: "test-destination_project",
+		ResourceStatus:     openapi.PtrString(`[{"kind":"Credential","name":"x","id":"victim"}]`),
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+
+	applicationModel, err := newApplication(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ApplicationsIdPatch(ctx, applicationModel.ID).ApplicationPatchRequest(openapi.ApplicationPatchRequest{
+		ResourceStatus: openapi.PtrString(`[{"kind":"Credential","name":"x","id":"victim"}]`),
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+}
+
 func TestApplicationPaging(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 
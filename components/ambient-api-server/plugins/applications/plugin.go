@@ -3,6 +3,7 @@ package applications
 import (
 	"net/http"
 
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
@@ -15,8 +16,11 @@ import (
 	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
 	"github.com/openshift-online/rh-trex-ai/plugins/events"
 	"github.com/openshift-online/rh-trex-ai/plugins/generic"
+	"google.golang.org/grpc"
 )
 
+const EventSource = "Applications"
+
 type ServiceLocator func() ApplicationService
 
 func NewServiceLocator(env *environments.Env) ServiceLocator {
@@ -69,7 +73,7 @@ func init() {
 		applicationServices := Service(services.(*environments.Services))
 
 		manager.Add(&controllers.ControllerConfig{
-			Source: "Applications",
+			Source: EventSource,
 			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
 				api.CreateEventType: {applicationServices.OnUpsert},
 				api.UpdateEventType: {applicationServices.OnUpsert},
@@ -83,6 +87,19 @@ func init() {
 	presenters.RegisterKind(Application{}, "Application")
 	presenters.RegisterKind(&Application{}, "Application")
 
+	pkgserver.RegisterGRPCService("applications", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		applicationService := Service(envServices)
+		genericService := generic.Service(envServices)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
+		}
+		pb.RegisterApplicationServiceServer(grpcServer, NewApplicationGRPCHandler(applicationService, genericService, brokerFunc))
+	})
+
 	db.RegisterMigration(migration())
 	db.RegisterMigration(gitopsRolesMigration())
 }
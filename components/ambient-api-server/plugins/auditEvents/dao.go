@@ -0,0 +1,44 @@
+package auditEvents
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+// AuditEventDao has no Replace or Delete: audit events are append-only.
+type AuditEventDao interface {
+	Get(ctx context.Context, id string) (*AuditEvent, error)
+	Create(ctx context.Context, auditEvent *AuditEvent) (*AuditEvent, error)
+}
+
+var _ AuditEventDao = &sqlAuditEventDao{}
+
+type sqlAuditEventDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewAuditEventDao(sessionFactory *db.SessionFactory) AuditEventDao {
+	return &sqlAuditEventDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlAuditEventDao) Get(ctx context.Context, id string) (*AuditEvent, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var auditEvent AuditEvent
+	if err := g2.Take(&auditEvent, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &auditEvent, nil
+}
+
+// Create does not mark the request transaction for rollback on failure: the
+// audit write happens after the audited call and must not undo it.
+func (d *sqlAuditEventDao) Create(ctx context.Context, auditEvent *AuditEvent) (*AuditEvent, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(auditEvent).Error; err != nil {
+		return nil, err
+	}
+	return auditEvent, nil
+}
@@ -0,0 +1,143 @@
+package auditEvents
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+type auditEventGRPCHandler struct {
+	pb.UnimplementedAuditEventServiceServer
+	service    AuditEventService
+	generic    services.GenericService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewAuditEventGRPCHandler(service AuditEventService, generic services.GenericService, brokerFunc func() *server.EventBroker) pb.AuditEventServiceServer {
+	return &auditEventGRPCHandler{
+		service:    service,
+		generic:    generic,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requirePrivileged limits the audit trail to service callers (the SIEM
+// forwarder) and platform admins.
+func requirePrivileged(ctx context.Context) error {
+	if middleware.IsServiceCaller(ctx) {
+		return nil
+	}
+	if authResult := rbac.GetAuthResult(ctx); authResult != nil && authResult.IsGlobalAdmin {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "audit events are restricted to platform admins")
+}
+
+func (h *auditEventGRPCHandler) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if err := requirePrivileged(ctx); err != nil {
+		return nil, err
+	}
+
+	page, size := grpcutil.NormalizePagination(req.GetPage(), req.GetSize())
+	listArgs := services.ListArguments{
+		Page:    int(page),
+		Size:    int64(size),
+		Search:  req.GetSearch(),
+		OrderBy: []string{"occurred_at desc"},
+	}
+
+	var auditEvents []AuditEvent
+	paging, svcErr := h.generic.List(ctx, "id", &listArgs, &auditEvents)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.AuditEvent, 0, len(auditEvents))
+	for i := range auditEvents {
+		items = append(items, auditEventToProto(&auditEvents[i]))
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  int32(paging.Page),
+			Size:  int32(paging.Size),
+			Total: int32(paging.Total),
+		},
+	}, nil
+}
+
+// WatchAuditEvents streams audit events as they are recorded, on whichever
+// replica served the audited call, for forwarding to a SIEM.
+func (h *auditEventGRPCHandler) WatchAuditEvents(req *pb.WatchAuditEventsRequest, stream grpc.ServerStreamingServer[pb.AuditEventWatchEvent]) error {
+	ctx := stream.Context()
+	if err := requirePrivileged(ctx); err != nil {
+		return err
+	}
+
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			auditEvent, svcErr := h.service.Get(ctx, event.SourceID)
+			if svcErr != nil {
+				glog.Errorf("WatchAuditEvents: failed to get audit event %s: %v", event.SourceID, svcErr)
+				continue
+			}
+			if !matchesWatch(req, auditEvent) {
+				continue
+			}
+
+			if err := stream.Send(&pb.AuditEventWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				AuditEvent: auditEventToProto(auditEvent),
+				ResourceId: event.SourceID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func matchesWatch(req *pb.WatchAuditEventsRequest, e *AuditEvent) bool {
+	if r := req.GetResource(); r != "" && r != e.Resource {
+		return false
+	}
+	if o := req.GetOutcome(); o != "" && o != e.Outcome {
+		return false
+	}
+	if p := req.GetProjectId(); p != "" && (e.ProjectId == nil || *e.ProjectId != p) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package auditEvents
+
+import (
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func auditEventToProto(e *AuditEvent) *pb.AuditEvent {
+	if e == nil {
+		return nil
+	}
+
+	return &pb.AuditEvent{
+		Metadata: &pb.ObjectReference{
+			Id:        e.ID,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+			UpdatedAt: timestamppb.New(e.UpdatedAt),
+			Kind:      "AuditEvent",
+			Href:      auditEventsBasePath + e.ID,
+		},
+		Subject:      e.Subject,
+		CallerType:   e.CallerType,
+		Method:       e.Method,
+		Path:         e.Path,
+		Resource:     e.Resource,
+		Action:       e.Action,
+		ProjectId:    e.ProjectId,
+		AgentId:      e.AgentId,
+		SessionId:    e.SessionId,
+		CredentialId: e.CredentialId,
+		Outcome:      e.Outcome,
+		StatusCode:   e.StatusCode,
+		OperationId:  e.OperationId,
+		OccurredAt:   timestamppb.New(e.OccurredAt),
+	}
+}
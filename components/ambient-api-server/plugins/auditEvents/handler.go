@@ -0,0 +1,94 @@
+package auditEvents
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+type auditEventHandler struct {
+	auditEvent AuditEventService
+	generic    services.GenericService
+}
+
+func NewAuditEventHandler(auditEvent AuditEventService, generic services.GenericService) *auditEventHandler {
+	return &auditEventHandler{
+		auditEvent: auditEvent,
+		generic:    generic,
+	}
+}
+
+// requirePlatformAdmin backs up the RBAC middleware: only platform admins
+// and the platform's own service account may read the audit trail.
+func requirePlatformAdmin(ctx context.Context) *errors.ServiceError {
+	if middleware.IsServiceCaller(ctx) {
+		return nil
+	}
+	if authResult := rbac.GetAuthResult(ctx); authResult != nil && authResult.IsGlobalAdmin {
+		return nil
+	}
+	return errors.Forbidden("audit events are restricted to platform admins")
+}
+
+// List — GET /api/ambient/v1/audit_events
+// Filter with the standard search parameter, e.g.
+// search=subject='alice' and outcome='denied'. Newest events come first
+// unless orderBy is given.
+func (h auditEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if svcErr := requirePlatformAdmin(ctx); svcErr != nil {
+				return nil, svcErr
+			}
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			if len(listArgs.OrderBy) == 0 {
+				listArgs.OrderBy = []string{"occurred_at desc"}
+			}
+
+			var auditEvents []AuditEvent
+			paging, svcErr := h.generic.List(ctx, "id", listArgs, &auditEvents)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			list := AuditEventListResponse{
+				Kind:  "AuditEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: make([]AuditEventResponse, 0, len(auditEvents)),
+			}
+			for i := range auditEvents {
+				list.Items = append(list.Items, PresentAuditEvent(&auditEvents[i]))
+			}
+			return list, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get — GET /api/ambient/v1/audit_events/{id}
+func (h auditEventHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if svcErr := requirePlatformAdmin(ctx); svcErr != nil {
+				return nil, svcErr
+			}
+			auditEvent, svcErr := h.auditEvent.Get(ctx, mux.Vars(r)["id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentAuditEvent(auditEvent), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,63 @@
+package auditEvents
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+func migration() *gormigrate.Migration {
+	type AuditEvent struct {
+		db.Model
+		Subject      string  `gorm:"not null;index"`
+		CallerType   string  `gorm:"not null"`
+		Method       string  `gorm:"not null"`
+		Path         string  `gorm:"not null"`
+		Resource     string  `gorm:"not null;index"`
+		Action       string  `gorm:"not null"`
+		ProjectId    *string `gorm:"index"`
+		AgentId      *string
+		SessionId    *string
+		CredentialId *string `gorm:"index"`
+		Outcome      string  `gorm:"not null;index"`
+		StatusCode   int32   `gorm:"not null"`
+		OperationId  *string
+		OccurredAt   time.Time `gorm:"not null;index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170008",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&AuditEvent{}); err != nil {
+				return err
+			}
+			// Append-only: reject UPDATE and DELETE at the database so the
+			// trail holds even against direct SQL from the API's own role.
+			if err := tx.Exec(`CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+				BEGIN
+					RAISE EXCEPTION 'audit_events is append-only';
+				END;
+				$$ LANGUAGE plpgsql`).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events`).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE TRIGGER audit_events_append_only
+				BEFORE UPDATE OR DELETE ON audit_events
+				FOR EACH ROW EXECUTE FUNCTION audit_events_append_only()`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(`DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events`).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`DROP FUNCTION IF EXISTS audit_events_append_only()`).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&AuditEvent{})
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package auditEvents
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+)
+
+var _ AuditEventDao = &auditEventDaoMock{}
+
+type auditEventDaoMock struct {
+	auditEvents AuditEventList
+}
+
+func NewMockAuditEventDao() *auditEventDaoMock {
+	return &auditEventDaoMock{}
+}
+
+func (d *auditEventDaoMock) Get(ctx context.Context, id string) (*AuditEvent, error) {
+	for _, auditEvent := range d.auditEvents {
+		if auditEvent.ID == id {
+			return auditEvent, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *auditEventDaoMock) Create(ctx context.Context, auditEvent *AuditEvent) (*AuditEvent, error) {
+	auditEvent.ID = api.NewID()
+	d.auditEvents = append(d.auditEvents, auditEvent)
+	return auditEvent, nil
+}
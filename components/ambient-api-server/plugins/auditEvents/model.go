@@ -0,0 +1,35 @@
+package auditEvents
+
+import (
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+)
+
+// AuditEvent records one audited API call. Rows are append-only: the table
+// rejects updates and deletes, and the API exposes no way to change them.
+type AuditEvent struct {
+	api.Meta
+	Subject      string    `json:"subject"       gorm:"not null;index"`
+	CallerType   string    `json:"caller_type"   gorm:"not null"`
+	Method       string    `json:"method"        gorm:"not null"`
+	Path         string    `json:"path"          gorm:"not null"`
+	Resource     string    `json:"resource"      gorm:"not null;index"`
+	Action       string    `json:"action"        gorm:"not null"`
+	ProjectId    *string   `json:"project_id"    gorm:"index"`
+	AgentId      *string   `json:"agent_id"`
+	SessionId    *string   `json:"session_id"`
+	CredentialId *string   `json:"credential_id" gorm:"index"`
+	Outcome      string    `json:"outcome"       gorm:"not null;index"`
+	StatusCode   int32     `json:"status_code"   gorm:"not null"`
+	OperationId  *string   `json:"operation_id"`
+	OccurredAt   time.Time `json:"occurred_at"   gorm:"not null;index"`
+}
+
+type AuditEventList []*AuditEvent
+
+func (d *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	d.ID = api.NewID()
+	return nil
+}
@@ -0,0 +1,120 @@
+package auditEvents
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/api/presenters"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/controllers"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+	"github.com/openshift-online/rh-trex-ai/pkg/registry"
+	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/plugins/events"
+	"github.com/openshift-online/rh-trex-ai/plugins/generic"
+	"google.golang.org/grpc"
+)
+
+const EventSource = "AuditEvents"
+
+type ServiceLocator func() AuditEventService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	return func() AuditEventService {
+		return NewAuditEventService(
+			NewAuditEventDao(&env.Database.SessionFactory),
+			events.Service(&env.Services),
+		)
+	}
+}
+
+func Service(s *environments.Services) AuditEventService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("AuditEvents"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+// grpcAuditService is set when the environment loads its services. The gRPC
+// audit interceptor has to be registered before that, at init.
+var grpcAuditService atomic.Pointer[ServiceLocator]
+
+func grpcAuditRecorder() rbac.AuditRecorder {
+	if locator := grpcAuditService.Load(); locator != nil {
+		return (*locator)()
+	}
+	return nil
+}
+
+func init() {
+	registry.RegisterService("AuditEvents", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	// The rbac plugin wraps its authorization middleware with the audit
+	// middleware when this recorder is registered; gRPC calls are recorded
+	// by the interceptor below.
+	registry.RegisterService("AuditRecorder", func(env interface{}) interface{} {
+		locator := NewServiceLocator(env.(*environments.Env))
+		grpcAuditService.Store(&locator)
+		return func() rbac.AuditRecorder {
+			return locator()
+		}
+	})
+
+	pkgserver.RegisterPreAuthGRPCUnaryInterceptor(rbac.AuditUnaryInterceptor(grpcAuditRecorder))
+
+	pkgserver.RegisterRoutes("auditEvents", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
+		envServices := services.(*environments.Services)
+		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
+			authzMiddleware = dbAuthz
+		}
+		auditEventHandler := NewAuditEventHandler(Service(envServices), generic.Service(envServices))
+
+		auditEventsRouter := apiV1Router.PathPrefix("/audit_events").Subrouter()
+		auditEventsRouter.HandleFunc("", auditEventHandler.List).Methods(http.MethodGet)
+		auditEventsRouter.HandleFunc("/{id}", auditEventHandler.Get).Methods(http.MethodGet)
+		auditEventsRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		auditEventsRouter.Use(authzMiddleware.AuthorizeApi)
+	})
+
+	// Audit events only feed watch streams; there is nothing to reconcile.
+	pkgserver.RegisterController("AuditEvents", func(manager *controllers.KindControllerManager, services pkgserver.ServicesInterface) {
+		noop := func(ctx context.Context, id string) error { return nil }
+		manager.Add(&controllers.ControllerConfig{
+			Source: EventSource,
+			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
+				api.CreateEventType: {noop},
+			},
+		})
+	})
+
+	presenters.RegisterPath(AuditEvent{}, "audit_events")
+	presenters.RegisterPath(&AuditEvent{}, "audit_events")
+	presenters.RegisterKind(AuditEvent{}, "AuditEvent")
+	presenters.RegisterKind(&AuditEvent{}, "AuditEvent")
+
+	pkgserver.RegisterGRPCService("audit_events", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
+		}
+		pb.RegisterAuditEventServiceServer(grpcServer, NewAuditEventGRPCHandler(Service(envServices), generic.Service(envServices), brokerFunc))
+	})
+
+	db.RegisterMigration(migration())
+}
@@ -0,0 +1,62 @@
+package auditEvents
+
+import (
+	"net/url"
+	"time"
+)
+
+const auditEventsBasePath = "/api/ambient/v1/audit_events/"
+
+// AuditEventResponse is the wire form of an AuditEvent.
+type AuditEventResponse struct {
+	Id           string     `json:"id"`
+	Kind         string     `json:"kind"`
+	Href         string     `json:"href"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Subject      string     `json:"subject"`
+	CallerType   string     `json:"caller_type"`
+	Method       string     `json:"method"`
+	Path         string     `json:"path"`
+	Resource     string     `json:"resource"`
+	Action       string     `json:"action"`
+	ProjectId    *string    `json:"project_id,omitempty"`
+	AgentId      *string    `json:"agent_id,omitempty"`
+	SessionId    *string    `json:"session_id,omitempty"`
+	CredentialId *string    `json:"credential_id,omitempty"`
+	Outcome      string     `json:"outcome"`
+	StatusCode   int32      `json:"status_code"`
+	OperationId  *string    `json:"operation_id,omitempty"`
+	OccurredAt   time.Time  `json:"occurred_at"`
+}
+
+type AuditEventListResponse struct {
+	Kind  string               `json:"kind"`
+	Page  int32                `json:"page"`
+	Size  int32                `json:"size"`
+	Total int32                `json:"total"`
+	Items []AuditEventResponse `json:"items"`
+}
+
+func PresentAuditEvent(auditEvent *AuditEvent) AuditEventResponse {
+	createdAt := auditEvent.CreatedAt
+	return AuditEventResponse{
+		Id:           auditEvent.ID,
+		Kind:         "AuditEvent",
+		Href:         auditEventsBasePath + url.PathEscape(auditEvent.ID),
+		CreatedAt:    &createdAt,
+		Subject:      auditEvent.Subject,
+		CallerType:   auditEvent.CallerType,
+		Method:       auditEvent.Method,
+		Path:         auditEvent.Path,
+		Resource:     auditEvent.Resource,
+		Action:       auditEvent.Action,
+		ProjectId:    auditEvent.ProjectId,
+		AgentId:      auditEvent.AgentId,
+		SessionId:    auditEvent.SessionId,
+		CredentialId: auditEvent.CredentialId,
+		Outcome:      auditEvent.Outcome,
+		StatusCode:   auditEvent.StatusCode,
+		OperationId:  auditEvent.OperationId,
+		OccurredAt:   auditEvent.OccurredAt,
+	}
+}
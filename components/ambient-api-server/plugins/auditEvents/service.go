@@ -0,0 +1,87 @@
+package auditEvents
+
+import (
+	"context"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+type AuditEventService interface {
+	Get(ctx context.Context, id string) (*AuditEvent, *errors.ServiceError)
+
+	// Record appends an audit event and publishes it for watch streams.
+	Record(ctx context.Context, record *rbac.AuditRecord) error
+}
+
+var _ rbac.AuditRecorder = &sqlAuditEventService{}
+
+func NewAuditEventService(auditEventDao AuditEventDao, events services.EventService) AuditEventService {
+	return &sqlAuditEventService{
+		auditEventDao: auditEventDao,
+		events:        events,
+	}
+}
+
+var _ AuditEventService = &sqlAuditEventService{}
+
+type sqlAuditEventService struct {
+	auditEventDao AuditEventDao
+	events        services.EventService
+}
+
+func (s *sqlAuditEventService) Get(ctx context.Context, id string) (*AuditEvent, *errors.ServiceError) {
+	auditEvent, err := s.auditEventDao.Get(ctx, id)
+	if err != nil {
+		return nil, services.HandleGetError("AuditEvent", "id", id, err)
+	}
+	return auditEvent, nil
+}
+
+func (s *sqlAuditEventService) Record(ctx context.Context, record *rbac.AuditRecord) error {
+	auditEvent, err := s.auditEventDao.Create(ctx, FromRecord(record))
+	if err != nil {
+		return err
+	}
+	if s.events == nil {
+		return nil
+	}
+	if _, evErr := s.events.Create(ctx, &api.Event{
+		Source:    EventSource,
+		SourceID:  auditEvent.ID,
+		EventType: api.CreateEventType,
+	}); evErr != nil {
+		return evErr
+	}
+	return nil
+}
+
+// FromRecord converts a middleware audit record into a storable event.
+func FromRecord(record *rbac.AuditRecord) *AuditEvent {
+	return &AuditEvent{
+		Subject:      record.Subject,
+		CallerType:   record.CallerType,
+		Method:       record.Method,
+		Path:         record.Path,
+		Resource:     record.Resource,
+		Action:       record.Action,
+		ProjectId:    optional(record.ProjectID),
+		AgentId:      optional(record.AgentID),
+		SessionId:    optional(record.SessionID),
+		CredentialId: optional(record.CredentialID),
+		Outcome:      record.Outcome,
+		StatusCode:   int32(record.StatusCode),
+		OperationId:  optional(record.OperationID),
+		OccurredAt:   record.OccurredAt,
+	}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,56 @@
+package auditEvents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+func TestRecord_StoresRecord(t *testing.T) {
+	dao := NewMockAuditEventDao()
+	svc := NewAuditEventService(dao, nil)
+	ctx := context.Background()
+
+	occurred := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	err := svc.Record(ctx, &rbac.AuditRecord{
+		Subject:      "alice",
+		CallerType:   "user",
+		Method:       "GET",
+		Path:         "/api/ambient/v1/credentials/cred-1/token",
+		Resource:     "credential",
+		Action:       "fetch_token",
+		CredentialID: "cred-1",
+		Outcome:      rbac.AuditOutcomeSuccess,
+		StatusCode:   200,
+		OccurredAt:   occurred,
+	})
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	if len(dao.auditEvents) != 1 {
+		t.Fatalf("expected 1 stored event, got %d", len(dao.auditEvents))
+	}
+
+	got, svcErr := svc.Get(ctx, dao.auditEvents[0].ID)
+	if svcErr != nil {
+		t.Fatalf("get: %v", svcErr)
+	}
+	if got.Subject != "alice" || got.Action != "fetch_token" || got.StatusCode != 200 || !got.OccurredAt.Equal(occurred) {
+		t.Errorf("unexpected event: %+v", got)
+	}
+	if got.CredentialId == nil || *got.CredentialId != "cred-1" {
+		t.Errorf("credential id: got %v, want cred-1", got.CredentialId)
+	}
+	if got.ProjectId != nil || got.OperationId != nil {
+		t.Errorf("empty scope should be stored as NULL, got project %v operation %v", got.ProjectId, got.OperationId)
+	}
+}
+
+func TestGet_NotFound(t *testing.T) {
+	svc := NewAuditEventService(NewMockAuditEventDao(), nil)
+	if _, err := svc.Get(context.Background(), "missing"); err == nil || err.HttpCode != 404 {
+		t.Fatalf("got %v, want 404", err)
+	}
+}
@@ -0,0 +1,125 @@
+package blackboard
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+type BlackboardEntryDao interface {
+	// GetByKey returns the entry for key, including one whose TTL has
+	// elapsed but which has not been reaped yet.
+	GetByKey(ctx context.Context, projectID, key string) (*BlackboardEntry, error)
+	// CreateIfAbsent inserts entry unless the project already has a row for
+	// its key, and reports whether it did.
+	CreateIfAbsent(ctx context.Context, entry *BlackboardEntry) (bool, error)
+	// UpdateIfVersion writes entry's value, writer and TTL and bumps its
+	// version, but only while the stored row is still at version. On
+	// success entry is refreshed from the updated row.
+	UpdateIfVersion(ctx context.Context, entry *BlackboardEntry, version int64) (bool, error)
+	// DeleteIfVersion removes the key only while it is still at version.
+	DeleteIfVersion(ctx context.Context, projectID, key string, version int64) (bool, error)
+	// ListLive returns the unexpired entries of a project whose key starts
+	// with prefix, ordered by key.
+	ListLive(ctx context.Context, projectID, prefix string, now time.Time) (BlackboardEntryList, error)
+	// DeleteExpired removes every entry whose TTL elapsed before now and
+	// returns the removed rows.
+	DeleteExpired(ctx context.Context, now time.Time) (BlackboardEntryList, error)
+}
+
+var _ BlackboardEntryDao = &sqlBlackboardEntryDao{}
+
+type sqlBlackboardEntryDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewBlackboardEntryDao(sessionFactory *db.SessionFactory) BlackboardEntryDao {
+	return &sqlBlackboardEntryDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlBlackboardEntryDao) GetByKey(ctx context.Context, projectID, key string) (*BlackboardEntry, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var entry BlackboardEntry
+	if err := g2.Take(&entry, "project_id = ? AND key = ?", projectID, key).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// The conditional writes below do not mark a request transaction for
+// rollback: the service runs them outside one so each commits on its own
+// before watchers are notified.
+
+func (d *sqlBlackboardEntryDao) CreateIfAbsent(ctx context.Context, entry *BlackboardEntry) (bool, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	res := g2.Omit(clause.Associations).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "project_id"}, {Name: "key"}}, DoNothing: true}).
+		Create(entry)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (d *sqlBlackboardEntryDao) UpdateIfVersion(ctx context.Context, entry *BlackboardEntry, version int64) (bool, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	res := g2.Model(entry).
+		Clauses(clause.Returning{}).
+		Where("project_id = ? AND key = ? AND version = ?", entry.ProjectId, entry.Key, version).
+		Updates(map[string]any{
+			"value":      entry.Value,
+			"version":    gorm.Expr("version + 1"),
+			"updated_by": entry.UpdatedBy,
+			"expires_at": entry.ExpiresAt,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// DeleteIfVersion removes the row outright: blackboard keys are routinely
+// reused, and the unique (project_id, key) index does not exclude
+// soft-deleted rows.
+func (d *sqlBlackboardEntryDao) DeleteIfVersion(ctx context.Context, projectID, key string, version int64) (bool, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	res := g2.Unscoped().Where("project_id = ? AND key = ? AND version = ?", projectID, key, version).Delete(&BlackboardEntry{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (d *sqlBlackboardEntryDao) ListLive(ctx context.Context, projectID, prefix string, now time.Time) (BlackboardEntryList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	entries := BlackboardEntryList{}
+	q := g2.Where("project_id = ? AND (expires_at IS NULL OR expires_at > ?)", projectID, now)
+	if prefix != "" {
+		q = q.Where(`key LIKE ? ESCAPE '\'`, escapeLike(prefix)+"%")
+	}
+	if err := q.Order("key ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (d *sqlBlackboardEntryDao) DeleteExpired(ctx context.Context, now time.Time) (BlackboardEntryList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	expired := BlackboardEntryList{}
+	if err := g2.Unscoped().Clauses(clause.Returning{}).Where("expires_at IS NOT NULL AND expires_at <= ?", now).Delete(&expired).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return expired, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
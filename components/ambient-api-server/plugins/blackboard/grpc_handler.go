@@ -0,0 +1,129 @@
+package blackboard
+
+import (
+	"strings"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+type blackboardGRPCHandler struct {
+	pb.UnimplementedBlackboardServiceServer
+	service  BlackboardService
+	watchSvc BlackboardWatchService
+}
+
+func NewBlackboardGRPCHandler(service BlackboardService, watchSvc BlackboardWatchService) pb.BlackboardServiceServer {
+	return &blackboardGRPCHandler{service: service, watchSvc: watchSvc}
+}
+
+func (h *blackboardGRPCHandler) WatchBlackboard(req *pb.WatchBlackboardRequest, stream grpc.ServerStreamingServer[pb.BlackboardWatchEvent]) error {
+	projectID := req.GetProjectId()
+	if projectID == "" {
+		return status.Error(codes.InvalidArgument, "project_id is required")
+	}
+
+	ctx := stream.Context()
+
+	// Service callers (legacy token) and global admins (platform:admin binding)
+	// may watch any project. Other callers need a project-scoped binding.
+	if !middleware.IsServiceCaller(ctx) {
+		authResult := rbac.GetAuthResult(ctx)
+		if authResult == nil || authResult.Username == "" {
+			return status.Error(codes.PermissionDenied, "not authorized to watch this blackboard")
+		}
+		if !authResult.IsGlobalAdmin && !rbac.IsProjectAuthorized(authResult, projectID) {
+			return status.Error(codes.PermissionDenied, "not authorized to watch this blackboard")
+		}
+	}
+
+	prefix := req.GetKeyPrefix()
+
+	// Subscribe before listing so no change between the two is lost; changes
+	// already covered by the replay are skipped below by version.
+	ch, cancel := h.watchSvc.Subscribe(ctx, projectID)
+	defer cancel()
+
+	replayed := map[string]int64{}
+	if req.GetReplay() {
+		entries, svcErr := h.service.List(ctx, projectID, prefix)
+		if svcErr != nil {
+			return grpcutil.ServiceErrorToGRPC(svcErr)
+		}
+		for _, entry := range entries {
+			if err := stream.Send(blackboardEventToProto(api.CreateEventType, entry)); err != nil {
+				return err
+			}
+			replayed[entry.Key] = entry.Version
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if !strings.HasPrefix(event.Entry.Key, prefix) {
+				continue
+			}
+			if v, seen := replayed[event.Entry.Key]; seen {
+				if event.Type != api.DeleteEventType && event.Entry.Version <= v {
+					continue
+				}
+				delete(replayed, event.Entry.Key)
+			}
+			if err := stream.Send(blackboardEventToProto(event.Type, event.Entry)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func blackboardEventToProto(eventType api.EventType, entry *BlackboardEntry) *pb.BlackboardWatchEvent {
+	var t pb.EventType
+	switch eventType {
+	case api.CreateEventType:
+		t = pb.EventType_EVENT_TYPE_CREATED
+	case api.UpdateEventType:
+		t = pb.EventType_EVENT_TYPE_UPDATED
+	case api.DeleteEventType:
+		t = pb.EventType_EVENT_TYPE_DELETED
+	}
+	return &pb.BlackboardWatchEvent{
+		Type:  t,
+		Entry: blackboardEntryToProto(entry),
+		Key:   entry.Key,
+	}
+}
+
+func blackboardEntryToProto(entry *BlackboardEntry) *pb.BlackboardEntry {
+	p := &pb.BlackboardEntry{
+		Metadata: &pb.ObjectReference{
+			Id:        entry.ID,
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+			UpdatedAt: timestamppb.New(entry.UpdatedAt),
+			Kind:      "BlackboardEntry",
+			Href:      PresentBlackboardEntry(entry).Href,
+		},
+		ProjectId: entry.ProjectId,
+		Key:       entry.Key,
+		Value:     entry.Value,
+		Version:   entry.Version,
+		UpdatedBy: entry.UpdatedBy,
+	}
+	if entry.ExpiresAt != nil {
+		p.ExpiresAt = timestamppb.New(*entry.ExpiresAt)
+	}
+	return p
+}
@@ -0,0 +1,197 @@
+package blackboard
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+)
+
+var validIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+type blackboardHandler struct {
+	svc BlackboardService
+}
+
+func NewBlackboardHandler(svc BlackboardService) *blackboardHandler {
+	return &blackboardHandler{svc: svc}
+}
+
+func projectFromRequest(r *http.Request) (string, *errors.ServiceError) {
+	projectID := mux.Vars(r)["id"]
+	if !validIDPattern.MatchString(projectID) {
+		return "", errors.Validation("invalid project id")
+	}
+	return projectID, nil
+}
+
+func ttlFromSeconds(seconds *int64) (time.Duration, *errors.ServiceError) {
+	if seconds == nil {
+		return 0, nil
+	}
+	if *seconds <= 0 {
+		return 0, errors.Validation("ttl_seconds must be positive")
+	}
+	return time.Duration(*seconds) * time.Second, nil
+}
+
+func updatedBy(r *http.Request) *string {
+	if username := auth.GetUsernameFromContext(r.Context()); username != "" {
+		return &username
+	}
+	return nil
+}
+
+// List — GET /api/ambient/v1/projects/{id}/blackboard?prefix=
+func (h *blackboardHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			entries, svcErr := h.svc.List(r.Context(), projectID, r.URL.Query().Get("prefix"))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentBlackboardEntryList(entries), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get — GET /api/ambient/v1/projects/{id}/blackboard/{key}
+func (h *blackboardHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			entry, svcErr := h.svc.Get(r.Context(), projectID, mux.Vars(r)["key"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentBlackboardEntry(entry), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create — POST /api/ambient/v1/projects/{id}/blackboard
+// Fails with 409 when the key already exists.
+func (h *blackboardHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req BlackboardCreateRequest
+	cfg := &handlers.HandlerConfig{
+		Body: &req,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				if req.Value == nil {
+					return errors.Validation("value is required")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			ttl, svcErr := ttlFromSeconds(req.TTLSeconds)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			absent := int64(0)
+			entry, _, svcErr := h.svc.Put(r.Context(), projectID, req.Key, *req.Value, WriteOptions{
+				ExpectedVersion: &absent,
+				TTL:             ttl,
+				UpdatedBy:       updatedBy(r),
+			})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentBlackboardEntry(entry), nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Put — PUT /api/ambient/v1/projects/{id}/blackboard/{key}
+// Creates or replaces the entry, conditionally on expected_version.
+func (h *blackboardHandler) Put(w http.ResponseWriter, r *http.Request) {
+	h.write(w, r, false)
+}
+
+// Patch — PATCH /api/ambient/v1/projects/{id}/blackboard/{key}
+// Like Put, but the key must already exist.
+func (h *blackboardHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	h.write(w, r, true)
+}
+
+func (h *blackboardHandler) write(w http.ResponseWriter, r *http.Request, mustExist bool) {
+	var req BlackboardWriteRequest
+	cfg := &handlers.HandlerConfig{
+		Body: &req,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				if req.Value == nil {
+					return errors.Validation("value is required")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			ttl, svcErr := ttlFromSeconds(req.TTLSeconds)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			entry, _, svcErr := h.svc.Put(r.Context(), projectID, mux.Vars(r)["key"], *req.Value, WriteOptions{
+				ExpectedVersion: req.ExpectedVersion,
+				TTL:             ttl,
+				MustExist:       mustExist,
+				UpdatedBy:       updatedBy(r),
+			})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentBlackboardEntry(entry), nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete — DELETE /api/ambient/v1/projects/{id}/blackboard/{key}?expected_version=
+func (h *blackboardHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			var expected *int64
+			if raw := r.URL.Query().Get("expected_version"); raw != "" {
+				v, err := strconv.ParseInt(raw, 10, 64)
+				if err != nil {
+					return nil, errors.Validation("expected_version must be an integer")
+				}
+				expected = &v
+			}
+			if svcErr := h.svc.Delete(r.Context(), projectID, mux.Vars(r)["key"], expected); svcErr != nil {
+				return nil, svcErr
+			}
+			return nil, nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
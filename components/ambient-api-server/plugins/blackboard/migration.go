@@ -0,0 +1,92 @@
+package blackboard
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+func migration() *gormigrate.Migration {
+	type BlackboardEntry struct {
+		db.Model
+		ProjectId string `gorm:"not null"`
+		Key       string `gorm:"not null"`
+		Value     string `gorm:"type:text"`
+		Version   int64  `gorm:"not null"`
+		UpdatedBy *string
+		ExpiresAt *time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170002",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&BlackboardEntry{}); err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_blackboard_entries_project_key ON blackboard_entries (project_id, key)`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(`DROP INDEX IF EXISTS idx_blackboard_entries_project_key`).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&BlackboardEntry{})
+		},
+	}
+}
+
+// writerPermissions are granted to the built-in roles whose descriptions
+// already promise blackboard writes but which were seeded read/watch only.
+var writerPermissions = map[string][]string{
+	"project:editor": {"blackboard:create", "blackboard:update", "blackboard:delete"},
+	"agent:runner":   {"blackboard:create", "blackboard:update", "blackboard:delete"},
+}
+
+func writerRolesMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170003",
+		Migrate: func(tx *gorm.DB) error {
+			for role, grants := range writerPermissions {
+				var perms string
+				if err := tx.Raw(`SELECT permissions FROM roles WHERE name = ? AND deleted_at IS NULL`, role).Scan(&perms).Error; err != nil {
+					return err
+				}
+				if perms == "" {
+					continue
+				}
+				var permList []string
+				if err := json.Unmarshal([]byte(perms), &permList); err != nil {
+					return err
+				}
+				have := make(map[string]bool, len(permList))
+				for _, p := range permList {
+					have[p] = true
+				}
+				changed := false
+				for _, p := range grants {
+					if !have[p] {
+						permList = append(permList, p)
+						changed = true
+					}
+				}
+				if !changed {
+					continue
+				}
+				updated, err := json.Marshal(permList)
+				if err != nil {
+					return err
+				}
+				if err := tx.Exec(`UPDATE roles SET permissions = ?, updated_at = NOW() WHERE name = ? AND deleted_at IS NULL`, string(updated), role).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	}
+}
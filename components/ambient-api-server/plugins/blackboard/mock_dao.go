@@ -0,0 +1,115 @@
+package blackboard
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var _ BlackboardEntryDao = &blackboardEntryDaoMock{}
+
+type blackboardEntryDaoMock struct {
+	mu      sync.Mutex
+	entries BlackboardEntryList
+}
+
+func NewMockBlackboardEntryDao() *blackboardEntryDaoMock {
+	return &blackboardEntryDaoMock{}
+}
+
+func (d *blackboardEntryDaoMock) GetByKey(ctx context.Context, projectID, key string) (*BlackboardEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.entries {
+		if e.ProjectId == projectID && e.Key == key {
+			copied := *e
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *blackboardEntryDaoMock) CreateIfAbsent(ctx context.Context, entry *BlackboardEntry) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.entries {
+		if e.ProjectId == entry.ProjectId && e.Key == entry.Key {
+			return false, nil
+		}
+	}
+	if entry.ID == "" {
+		if err := entry.BeforeCreate(nil); err != nil {
+			return false, err
+		}
+	}
+	stored := *entry
+	d.entries = append(d.entries, &stored)
+	return true, nil
+}
+
+func (d *blackboardEntryDaoMock) UpdateIfVersion(ctx context.Context, entry *BlackboardEntry, version int64) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.entries {
+		if e.ProjectId == entry.ProjectId && e.Key == entry.Key {
+			if e.Version != version {
+				return false, nil
+			}
+			e.Value = entry.Value
+			e.Version++
+			e.UpdatedBy = entry.UpdatedBy
+			e.ExpiresAt = entry.ExpiresAt
+			*entry = *e
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *blackboardEntryDaoMock) DeleteIfVersion(ctx context.Context, projectID, key string, version int64) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, e := range d.entries {
+		if e.ProjectId == projectID && e.Key == key {
+			if e.Version != version {
+				return false, nil
+			}
+			d.entries = append(d.entries[:i], d.entries[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *blackboardEntryDaoMock) ListLive(ctx context.Context, projectID, prefix string, now time.Time) (BlackboardEntryList, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := BlackboardEntryList{}
+	for _, e := range d.entries {
+		if e.ProjectId == projectID && strings.HasPrefix(e.Key, prefix) && !e.Expired(now) {
+			copied := *e
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result, nil
+}
+
+func (d *blackboardEntryDaoMock) DeleteExpired(ctx context.Context, now time.Time) (BlackboardEntryList, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var kept, expired BlackboardEntryList
+	for _, e := range d.entries {
+		if e.Expired(now) {
+			expired = append(expired, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	d.entries = kept
+	return expired, nil
+}
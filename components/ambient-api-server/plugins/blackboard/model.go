@@ -0,0 +1,49 @@
+package blackboard
+
+import (
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+)
+
+// BlackboardEntry is one key of a project's shared blackboard. Version starts
+// at 1 and increases on every write so callers can compare-and-swap; an entry
+// whose ExpiresAt has passed is treated as absent until the reaper removes it.
+type BlackboardEntry struct {
+	api.Meta
+	ProjectId string     `json:"project_id"`
+	Key       string     `json:"key"`
+	Value     string     `json:"value"`
+	Version   int64      `json:"version"`
+	UpdatedBy *string    `json:"updated_by"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type BlackboardEntryList []*BlackboardEntry
+
+func (d *BlackboardEntry) BeforeCreate(tx *gorm.DB) error {
+	d.ID = api.NewID()
+	return nil
+}
+
+// Expired reports whether the entry's TTL has elapsed at now.
+func (d *BlackboardEntry) Expired(now time.Time) bool {
+	return d.ExpiresAt != nil && !d.ExpiresAt.After(now)
+}
+
+// BlackboardWriteRequest is the body of PUT and PATCH
+// /projects/{id}/blackboard/{key}.
+type BlackboardWriteRequest struct {
+	Value           *string `json:"value"`
+	TTLSeconds      *int64  `json:"ttl_seconds,omitempty"`
+	ExpectedVersion *int64  `json:"expected_version,omitempty"`
+}
+
+// BlackboardCreateRequest is the body of POST /projects/{id}/blackboard,
+// which only succeeds when the key does not exist yet.
+type BlackboardCreateRequest struct {
+	Key        string  `json:"key"`
+	Value      *string `json:"value"`
+	TTLSeconds *int64  `json:"ttl_seconds,omitempty"`
+}
@@ -0,0 +1,128 @@
+package blackboard
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/controllers"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+	"github.com/openshift-online/rh-trex-ai/pkg/registry"
+	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+	"google.golang.org/grpc"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+)
+
+// envReaperInterval overrides how often expired entries are purged, as a Go
+// duration (default 30s).
+const envReaperInterval = "BLACKBOARD_REAPER_INTERVAL"
+
+var (
+	globalWatchSvc     BlackboardWatchService
+	globalWatchSvcOnce sync.Once
+)
+
+func getGlobalWatchSvc(env *environments.Env) BlackboardWatchService {
+	globalWatchSvcOnce.Do(func() {
+		globalWatchSvc = NewBlackboardWatchService(NewBlackboardEntryDao(&env.Database.SessionFactory), broker.Shared(env))
+	})
+	return globalWatchSvc
+}
+
+type ServiceLocator func() BlackboardService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	watchSvc := getGlobalWatchSvc(env)
+	return func() BlackboardService {
+		return NewBlackboardService(
+			NewBlackboardEntryDao(&env.Database.SessionFactory),
+			watchSvc,
+		)
+	}
+}
+
+func Service(s *environments.Services) BlackboardService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("Blackboard"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+func reaperInterval() time.Duration {
+	raw := os.Getenv(envReaperInterval)
+	if raw == "" {
+		return defaultReaperInterval
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		glog.Warningf("Ignoring invalid %s=%q; using %s", envReaperInterval, raw, defaultReaperInterval)
+		return defaultReaperInterval
+	}
+	return d
+}
+
+func init() {
+	registry.RegisterService("Blackboard", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	registry.RegisterService("BlackboardReaper", func(env interface{}) interface{} {
+		e := env.(*environments.Env)
+		return func() *Reaper {
+			return NewReaper(
+				NewServiceLocator(e)(),
+				db.NewAdvisoryLockFactory(e.Database.SessionFactory),
+				reaperInterval(),
+			)
+		}
+	})
+
+	pkgserver.RegisterRoutes("blackboard", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
+		envServices := services.(*environments.Services)
+
+		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
+			authzMiddleware = dbAuthz
+		}
+
+		h := NewBlackboardHandler(Service(envServices))
+
+		blackboardRouter := apiV1Router.PathPrefix("/projects/{id}/blackboard").Subrouter()
+		blackboardRouter.HandleFunc("", h.List).Methods(http.MethodGet)
+		blackboardRouter.HandleFunc("", h.Create).Methods(http.MethodPost)
+		blackboardRouter.HandleFunc("/{key}", h.Get).Methods(http.MethodGet)
+		blackboardRouter.HandleFunc("/{key}", h.Put).Methods(http.MethodPut)
+		blackboardRouter.HandleFunc("/{key}", h.Patch).Methods(http.MethodPatch)
+		blackboardRouter.HandleFunc("/{key}", h.Delete).Methods(http.MethodDelete)
+		blackboardRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		blackboardRouter.Use(authzMiddleware.AuthorizeApi)
+	})
+
+	// The reaper runs alongside the kind controllers in every replica; the
+	// advisory lock in Reaper.Reap keeps each pass single-writer.
+	pkgserver.RegisterController("BlackboardReaper", func(_ *controllers.KindControllerManager, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		if obj := envServices.GetService("BlackboardReaper"); obj != nil {
+			go obj.(func() *Reaper)().Run(context.Background())
+		}
+	})
+
+	pkgserver.RegisterGRPCService("blackboard", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		pb.RegisterBlackboardServiceServer(grpcServer, NewBlackboardGRPCHandler(Service(services.(*environments.Services)), getGlobalWatchSvc(environments.Environment())))
+	})
+
+	db.RegisterMigration(migration())
+	db.RegisterMigration(writerRolesMigration())
+}
@@ -0,0 +1,62 @@
+package blackboard
+
+import (
+	"net/url"
+	"time"
+)
+
+const blackboardBasePath = "/api/ambient/v1/projects/"
+
+// BlackboardEntryResponse is the wire form of a BlackboardEntry.
+type BlackboardEntryResponse struct {
+	Id        string     `json:"id"`
+	Kind      string     `json:"kind"`
+	Href      string     `json:"href"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ProjectId string     `json:"project_id"`
+	Key       string     `json:"key"`
+	Value     string     `json:"value"`
+	Version   int64      `json:"version"`
+	UpdatedBy *string    `json:"updated_by,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type BlackboardEntryListResponse struct {
+	Kind  string                    `json:"kind"`
+	Page  int32                     `json:"page"`
+	Size  int32                     `json:"size"`
+	Total int32                     `json:"total"`
+	Items []BlackboardEntryResponse `json:"items"`
+}
+
+func PresentBlackboardEntry(entry *BlackboardEntry) BlackboardEntryResponse {
+	createdAt, updatedAt := entry.CreatedAt, entry.UpdatedAt
+	return BlackboardEntryResponse{
+		Id:        entry.ID,
+		Kind:      "BlackboardEntry",
+		Href:      blackboardBasePath + url.PathEscape(entry.ProjectId) + "/blackboard/" + url.PathEscape(entry.Key),
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+		ProjectId: entry.ProjectId,
+		Key:       entry.Key,
+		Value:     entry.Value,
+		Version:   entry.Version,
+		UpdatedBy: entry.UpdatedBy,
+		ExpiresAt: entry.ExpiresAt,
+	}
+}
+
+func PresentBlackboardEntryList(entries BlackboardEntryList) BlackboardEntryListResponse {
+	list := BlackboardEntryListResponse{
+		Kind:  "BlackboardEntryList",
+		Page:  1,
+		Size:  int32(len(entries)),
+		Total: int32(len(entries)),
+		Items: make([]BlackboardEntryResponse, 0, len(entries)),
+	}
+	for _, e := range entries {
+		list.Items = append(list.Items, PresentBlackboardEntry(e))
+	}
+	return list
+}
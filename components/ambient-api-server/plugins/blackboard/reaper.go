@@ -0,0 +1,73 @@
+package blackboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+const reaperLockType db.LockType = "blackboard_entries"
+
+const (
+	reaperLockID          = "reaper"
+	defaultReaperInterval = 30 * time.Second
+)
+
+// Reaper deletes expired blackboard entries so watchers see them go away.
+// Reads already hide expired entries, so the interval only bounds how late
+// the delete event arrives. Like the session scheduler, every replica runs
+// one and a non-blocking advisory lock keeps each pass single-writer.
+type Reaper struct {
+	svc         BlackboardService
+	lockFactory db.LockFactory
+	interval    time.Duration
+}
+
+func NewReaper(svc BlackboardService, lockFactory db.LockFactory, interval time.Duration) *Reaper {
+	if interval <= 0 {
+		interval = defaultReaperInterval
+	}
+	return &Reaper{svc: svc, lockFactory: lockFactory, interval: interval}
+}
+
+// Run reaps until ctx is cancelled.
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Reap(ctx)
+		}
+	}
+}
+
+// Reap purges expired entries if this replica holds the reaper lock and
+// returns how many were removed.
+func (r *Reaper) Reap(ctx context.Context) int {
+	if r.lockFactory != nil {
+		owner, acquired, err := r.lockFactory.NewNonBlockingLock(ctx, reaperLockID, reaperLockType)
+		defer r.lockFactory.Unlock(ctx, owner)
+		if err != nil {
+			glog.Errorf("Blackboard reaper: acquire lock: %v", err)
+			return 0
+		}
+		if !acquired {
+			return 0
+		}
+	}
+
+	n, svcErr := r.svc.PurgeExpired(ctx)
+	if svcErr != nil {
+		glog.Errorf("Blackboard reaper: %v", svcErr)
+		return 0
+	}
+	if n > 0 {
+		glog.V(2).Infof("Blackboard reaper removed %d expired entries", n)
+	}
+	return n
+}
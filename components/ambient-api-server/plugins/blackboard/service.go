@@ -0,0 +1,279 @@
+package blackboard
+
+import (
+	"context"
+	stderrors "errors"
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+const (
+	// MaxValueBytes bounds a single value; the blackboard holds coordination
+	// state, not artifacts.
+	MaxValueBytes = 64 * 1024
+	maxKeyLength  = 253
+
+	// writeAttempts bounds how often an unconditional write re-reads a key
+	// that changed under it before giving up with a conflict.
+	writeAttempts = 3
+	// writeTimeout bounds a write, which runs outside the request
+	// transaction and so is not cancelled with it.
+	writeTimeout = 10 * time.Second
+)
+
+// Keys are dotted or colon-separated names ("plan.owner", "lock:deploy").
+// Slashes are excluded so a key is always a single path segment.
+var validKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:\-]*$`)
+
+// WriteOptions controls a conditional write. ExpectedVersion nil writes
+// unconditionally, 0 requires the key to be absent, and n requires the
+// current version to be n. A zero TTL stores an entry that never expires;
+// every write replaces the previous TTL.
+type WriteOptions struct {
+	ExpectedVersion *int64
+	TTL             time.Duration
+	// MustExist rejects the write with 404 when the key is absent (PATCH).
+	MustExist bool
+	UpdatedBy *string
+}
+
+type BlackboardService interface {
+	Get(ctx context.Context, projectID, key string) (*BlackboardEntry, *errors.ServiceError)
+	List(ctx context.Context, projectID, prefix string) (BlackboardEntryList, *errors.ServiceError)
+	// Put writes value under key and reports whether the key was created.
+	Put(ctx context.Context, projectID, key, value string, opts WriteOptions) (*BlackboardEntry, bool, *errors.ServiceError)
+	Delete(ctx context.Context, projectID, key string, expectedVersion *int64) *errors.ServiceError
+	// PurgeExpired removes entries whose TTL has elapsed and notifies
+	// watchers. It returns the number of entries removed.
+	PurgeExpired(ctx context.Context) (int, *errors.ServiceError)
+}
+
+// NewBlackboardService returns a BlackboardService whose writes are single
+// conditional statements on the entry's version, each committed outside the
+// request transaction so watchers only hear about durable changes.
+func NewBlackboardService(dao BlackboardEntryDao, watchSvc BlackboardWatchService) BlackboardService {
+	return &sqlBlackboardService{
+		dao:      dao,
+		watchSvc: watchSvc,
+		now:      time.Now,
+	}
+}
+
+var _ BlackboardService = &sqlBlackboardService{}
+
+type sqlBlackboardService struct {
+	dao      BlackboardEntryDao
+	watchSvc BlackboardWatchService
+	now      func() time.Time
+}
+
+// ValidateKey checks that key is usable as a blackboard key.
+func ValidateKey(key string) *errors.ServiceError {
+	if key == "" {
+		return errors.Validation("key is required")
+	}
+	if len(key) > maxKeyLength {
+		return errors.Validation("key must be at most %d characters", maxKeyLength)
+	}
+	if !validKeyPattern.MatchString(key) {
+		return errors.Validation("key %q may only contain letters, digits, '.', '_', ':' and '-'", key)
+	}
+	return nil
+}
+
+func (s *sqlBlackboardService) Get(ctx context.Context, projectID, key string) (*BlackboardEntry, *errors.ServiceError) {
+	if svcErr := ValidateKey(key); svcErr != nil {
+		return nil, svcErr
+	}
+	entry, err := s.dao.GetByKey(ctx, projectID, key)
+	if err == nil && entry.Expired(s.now()) {
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, services.HandleGetError("BlackboardEntry", "key", key, err)
+	}
+	return entry, nil
+}
+
+func (s *sqlBlackboardService) List(ctx context.Context, projectID, prefix string) (BlackboardEntryList, *errors.ServiceError) {
+	entries, err := s.dao.ListLive(ctx, projectID, prefix, s.now())
+	if err != nil {
+		return nil, errors.GeneralError("Unable to list blackboard entries: %s", err)
+	}
+	return entries, nil
+}
+
+func (s *sqlBlackboardService) Put(ctx context.Context, projectID, key, value string, opts WriteOptions) (*BlackboardEntry, bool, *errors.ServiceError) {
+	if svcErr := ValidateKey(key); svcErr != nil {
+		return nil, false, svcErr
+	}
+	if len(value) > MaxValueBytes {
+		return nil, false, errors.Validation("value must be at most %d bytes", MaxValueBytes)
+	}
+	if opts.TTL < 0 {
+		return nil, false, errors.Validation("ttl must not be negative")
+	}
+
+	ctx, cancel := writeContext()
+	defer cancel()
+
+	for attempt := 0; attempt < writeAttempts; attempt++ {
+		entry, created, done, svcErr := s.tryPut(ctx, projectID, key, value, opts)
+		if svcErr != nil || done {
+			return entry, created, svcErr
+		}
+		if opts.ExpectedVersion != nil {
+			break
+		}
+	}
+	return nil, false, errors.Conflict("blackboard key %q was changed concurrently", key)
+}
+
+// tryPut makes one read-check-write pass. done is false when the key changed
+// between the read and the conditional write, so nothing was written.
+func (s *sqlBlackboardService) tryPut(ctx context.Context, projectID, key, value string, opts WriteOptions) (*BlackboardEntry, bool, bool, *errors.ServiceError) {
+	now := s.now()
+	current, err := s.dao.GetByKey(ctx, projectID, key)
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, false, services.HandleGetError("BlackboardEntry", "key", key, err)
+	}
+	live := current
+	if live != nil && live.Expired(now) {
+		live = nil
+	}
+	if live == nil && opts.MustExist {
+		return nil, false, false, errors.NotFound("BlackboardEntry with key='%s' not found", key)
+	}
+	if svcErr := checkVersion(key, live, opts.ExpectedVersion); svcErr != nil {
+		return nil, false, false, svcErr
+	}
+
+	var expiresAt *time.Time
+	if opts.TTL > 0 {
+		t := now.Add(opts.TTL).UTC()
+		expiresAt = &t
+	}
+
+	// An expired row is reused rather than recreated so versions keep
+	// increasing across expiry and a stale compare-and-swap cannot match.
+	if current == nil {
+		entry := &BlackboardEntry{
+			ProjectId: projectID,
+			Key:       key,
+			Value:     value,
+			Version:   1,
+			UpdatedBy: opts.UpdatedBy,
+			ExpiresAt: expiresAt,
+		}
+		inserted, err := s.dao.CreateIfAbsent(ctx, entry)
+		if err != nil {
+			return nil, false, false, services.HandleCreateError("BlackboardEntry", err)
+		}
+		if !inserted {
+			return nil, false, false, nil
+		}
+		s.notify(ctx, api.CreateEventType, entry)
+		return entry, true, true, nil
+	}
+
+	read := current.Version
+	current.Value = value
+	current.UpdatedBy = opts.UpdatedBy
+	current.ExpiresAt = expiresAt
+	updated, err := s.dao.UpdateIfVersion(ctx, current, read)
+	if err != nil {
+		return nil, false, false, services.HandleUpdateError("BlackboardEntry", err)
+	}
+	if !updated {
+		return nil, false, false, nil
+	}
+	eventType := api.UpdateEventType
+	if live == nil {
+		eventType = api.CreateEventType
+	}
+	s.notify(ctx, eventType, current)
+	return current, live == nil, true, nil
+}
+
+func (s *sqlBlackboardService) Delete(ctx context.Context, projectID, key string, expectedVersion *int64) *errors.ServiceError {
+	if svcErr := ValidateKey(key); svcErr != nil {
+		return svcErr
+	}
+
+	ctx, cancel := writeContext()
+	defer cancel()
+
+	for attempt := 0; attempt < writeAttempts; attempt++ {
+		current, err := s.dao.GetByKey(ctx, projectID, key)
+		if err == nil && current.Expired(s.now()) {
+			err = gorm.ErrRecordNotFound
+		}
+		if err != nil {
+			return services.HandleGetError("BlackboardEntry", "key", key, err)
+		}
+		if svcErr := checkVersion(key, current, expectedVersion); svcErr != nil {
+			return svcErr
+		}
+		deleted, err := s.dao.DeleteIfVersion(ctx, projectID, key, current.Version)
+		if err != nil {
+			return services.HandleDeleteError("BlackboardEntry", errors.GeneralError("Unable to delete blackboard entry: %s", err))
+		}
+		if deleted {
+			s.notify(ctx, api.DeleteEventType, current)
+			return nil
+		}
+		if expectedVersion != nil {
+			break
+		}
+	}
+	return errors.Conflict("blackboard key %q was changed concurrently", key)
+}
+
+func (s *sqlBlackboardService) PurgeExpired(ctx context.Context) (int, *errors.ServiceError) {
+	expired, err := s.dao.DeleteExpired(ctx, s.now())
+	if err != nil {
+		return 0, errors.GeneralError("Unable to purge expired blackboard entries: %s", err)
+	}
+	for _, entry := range expired {
+		s.notify(ctx, api.DeleteEventType, entry)
+	}
+	return len(expired), nil
+}
+
+// writeContext returns a context without the request transaction, so each
+// conditional write commits as soon as it runs. Two writers racing on one
+// version then cannot both succeed, and an event is never published for a
+// write that a later rollback undoes.
+func writeContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), writeTimeout)
+}
+
+func (s *sqlBlackboardService) notify(ctx context.Context, eventType api.EventType, entry *BlackboardEntry) {
+	if s.watchSvc != nil {
+		copied := *entry
+		s.watchSvc.Notify(ctx, &BlackboardEvent{Type: eventType, Entry: &copied})
+	}
+}
+
+func checkVersion(key string, current *BlackboardEntry, expected *int64) *errors.ServiceError {
+	if expected == nil {
+		return nil
+	}
+	switch {
+	case *expected < 0:
+		return errors.Validation("expected_version must not be negative")
+	case *expected == 0 && current != nil:
+		return errors.Conflict("blackboard key %q already exists at version %d", key, current.Version)
+	case *expected > 0 && current == nil:
+		return errors.Conflict("blackboard key %q does not exist; expected version %d", key, *expected)
+	case *expected > 0 && current.Version != *expected:
+		return errors.Conflict("blackboard key %q is at version %d; expected version %d", key, current.Version, *expected)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package blackboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+)
+
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time { return c.t }
+
+func newTestService() (*sqlBlackboardService, *testClock, BlackboardWatchService) {
+	clock := &testClock{t: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)}
+	watchSvc := NewBlackboardWatchService(nil, nil)
+	svc := NewBlackboardService(NewMockBlackboardEntryDao(), watchSvc).(*sqlBlackboardService)
+	svc.now = clock.now
+	return svc, clock, watchSvc
+}
+
+func version(v int64) *int64 { return &v }
+
+func TestPut_CompareAndSwap(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	entry, created, err := svc.Put(ctx, "p1", "plan.owner", "alice", WriteOptions{ExpectedVersion: version(0)})
+	if err != nil {
+		t.Fatalf("create-only put: %v", err)
+	}
+	if !created || entry.Version != 1 {
+		t.Fatalf("got created=%v version=%d, want true/1", created, entry.Version)
+	}
+
+	if _, _, err := svc.Put(ctx, "p1", "plan.owner", "bob", WriteOptions{ExpectedVersion: version(0)}); err == nil || err.HttpCode != http.StatusConflict {
+		t.Fatalf("create-only put on existing key: got %v, want 409", err)
+	}
+	if _, _, err := svc.Put(ctx, "p1", "plan.owner", "bob", WriteOptions{ExpectedVersion: version(7)}); err == nil || err.HttpCode != http.StatusConflict {
+		t.Fatalf("stale version: got %v, want 409", err)
+	}
+
+	entry, created, err = svc.Put(ctx, "p1", "plan.owner", "bob", WriteOptions{ExpectedVersion: version(1)})
+	if err != nil {
+		t.Fatalf("matching version: %v", err)
+	}
+	if created || entry.Version != 2 || entry.Value != "bob" {
+		t.Fatalf("got created=%v version=%d value=%q, want false/2/bob", created, entry.Version, entry.Value)
+	}
+
+	entry, _, err = svc.Put(ctx, "p1", "plan.owner", "carol", WriteOptions{})
+	if err != nil {
+		t.Fatalf("unconditional put: %v", err)
+	}
+	if entry.Version != 3 {
+		t.Errorf("version = %d, want 3", entry.Version)
+	}
+}
+
+func TestPut_ConcurrentCompareAndSwapHasOneWinner(t *testing.T) {
+	svc, _, watchSvc := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, _, err := svc.Put(ctx, "p1", "plan.owner", "seed", WriteOptions{}); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	ch, stop := watchSvc.Subscribe(ctx, "p1")
+	defer stop()
+
+	const writers = 16
+	var wg sync.WaitGroup
+	results := make(chan *errors.ServiceError, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, err := svc.Put(ctx, "p1", "plan.owner", fmt.Sprintf("writer-%d", i), WriteOptions{ExpectedVersion: version(1)})
+			results <- err
+		}(i)
+	}
+	wg.Wait()
+	close(results)
+
+	wins := 0
+	for err := range results {
+		switch {
+		case err == nil:
+			wins++
+		case err.HttpCode != http.StatusConflict:
+			t.Errorf("losing writer got %v, want 409", err)
+		}
+	}
+	if wins != 1 {
+		t.Fatalf("%d writers succeeded at version 1, want exactly 1", wins)
+	}
+	entry, err := svc.Get(ctx, "p1", "plan.owner")
+	if err != nil || entry.Version != 2 {
+		t.Fatalf("got version %v (%v), want 2", entry, err)
+	}
+
+	select {
+	case ev := <-ch:
+		if ev.Type != api.UpdateEventType || ev.Entry.Version != 2 {
+			t.Fatalf("event = %s v%d, want UPDATE v2", ev.Type, ev.Entry.Version)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the winning write's event")
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("rejected write published %s v%d", ev.Type, ev.Entry.Version)
+	default:
+	}
+}
+
+func TestPut_MustExist(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	if _, _, err := svc.Put(ctx, "p1", "missing", "v", WriteOptions{MustExist: true}); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("got %v, want 404", err)
+	}
+}
+
+func TestPut_ValidatesKeyAndValue(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	for _, key := range []string{"", "a/b", ".hidden", "has space"} {
+		if _, _, err := svc.Put(ctx, "p1", key, "v", WriteOptions{}); err == nil || err.HttpCode != http.StatusBadRequest {
+			t.Errorf("key %q: got %v, want 400", key, err)
+		}
+	}
+	big := make([]byte, MaxValueBytes+1)
+	if _, _, err := svc.Put(ctx, "p1", "big", string(big), WriteOptions{}); err == nil || err.HttpCode != http.StatusBadRequest {
+		t.Errorf("oversized value: got %v, want 400", err)
+	}
+	if _, _, err := svc.Put(ctx, "p1", "k", "v", WriteOptions{ExpectedVersion: version(-1)}); err == nil || err.HttpCode != http.StatusBadRequest {
+		t.Errorf("negative expected_version: got %v, want 400", err)
+	}
+}
+
+func TestTTL_ExpiredEntriesAreHiddenAndVersionsStayMonotonic(t *testing.T) {
+	svc, clock, _ := newTestService()
+	ctx := context.Background()
+
+	if _, _, err := svc.Put(ctx, "p1", "lock:deploy", "runner-1", WriteOptions{TTL: time.Minute}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if _, err := svc.Get(ctx, "p1", "lock:deploy"); err != nil {
+		t.Fatalf("get before expiry: %v", err)
+	}
+
+	clock.t = clock.t.Add(2 * time.Minute)
+
+	if _, err := svc.Get(ctx, "p1", "lock:deploy"); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("get after expiry: got %v, want 404", err)
+	}
+	if entries, _ := svc.List(ctx, "p1", ""); len(entries) != 0 {
+		t.Fatalf("list after expiry returned %d entries", len(entries))
+	}
+
+	// An expired key counts as absent for create-only writes, and the
+	// version keeps counting from the expired row.
+	entry, created, err := svc.Put(ctx, "p1", "lock:deploy", "runner-2", WriteOptions{ExpectedVersion: version(0)})
+	if err != nil {
+		t.Fatalf("create-only put over expired key: %v", err)
+	}
+	if !created || entry.Version != 2 || entry.ExpiresAt != nil {
+		t.Fatalf("got created=%v version=%d expires=%v, want true/2/nil", created, entry.Version, entry.ExpiresAt)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	if _, _, err := svc.Put(ctx, "p1", "k", "v", WriteOptions{}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := svc.Delete(ctx, "p1", "k", version(2)); err == nil || err.HttpCode != http.StatusConflict {
+		t.Fatalf("delete with stale version: got %v, want 409", err)
+	}
+	if err := svc.Delete(ctx, "p1", "k", version(1)); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := svc.Delete(ctx, "p1", "k", nil); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("second delete: got %v, want 404", err)
+	}
+}
+
+func TestList_FiltersByProjectAndPrefix(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+
+	for _, key := range []string{"plan.b", "plan.a", "status", "plan_x"} {
+		if _, _, err := svc.Put(ctx, "p1", key, "v", WriteOptions{}); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+	if _, _, err := svc.Put(ctx, "p2", "plan.c", "v", WriteOptions{}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	entries, err := svc.List(ctx, "p1", "plan.")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Key != "plan.a" || entries[1].Key != "plan.b" {
+		t.Fatalf("got %v, want [plan.a plan.b]", keys(entries))
+	}
+}
+
+func TestWatch_ReceivesWritesAndExpiry(t *testing.T) {
+	svc, clock, watchSvc := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, stop := watchSvc.Subscribe(ctx, "p1")
+	defer stop()
+
+	if _, _, err := svc.Put(ctx, "p1", "k", "v1", WriteOptions{TTL: time.Second}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if _, _, err := svc.Put(ctx, "p1", "k", "v2", WriteOptions{TTL: time.Second}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if _, _, err := svc.Put(ctx, "p2", "other", "v", WriteOptions{}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	clock.t = clock.t.Add(time.Minute)
+	n, err := svc.PurgeExpired(ctx)
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("purged %d entries, want 1", n)
+	}
+
+	want := []api.EventType{api.CreateEventType, api.UpdateEventType, api.DeleteEventType}
+	for i, wantType := range want {
+		select {
+		case ev := <-ch:
+			if ev.Type != wantType || ev.Entry.Key != "k" {
+				t.Fatalf("event %d = %s %s, want %s k", i, ev.Type, ev.Entry.Key, wantType)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		}
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected event %s %s", ev.Type, ev.Entry.Key)
+	default:
+	}
+}
+
+func keys(entries BlackboardEntryList) []string {
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.Key)
+	}
+	return out
+}
@@ -0,0 +1,344 @@
+package blackboard
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+)
+
+// blackboardChannel is the broker channel announcing changed entries as
+// "<project_id>:<key>". Keys may contain ':'; project IDs do not.
+const blackboardChannel = "blackboard_entries"
+
+// BlackboardEvent is a change to one entry. Delete events carry the entry as
+// it was last stored, including entries removed because their TTL elapsed.
+// A delete read back from another replica carries the last version this
+// replica delivered, without its value.
+type BlackboardEvent struct {
+	Type  api.EventType
+	Entry *BlackboardEntry
+}
+
+type BlackboardWatchService interface {
+	Subscribe(ctx context.Context, projectID string) (<-chan *BlackboardEvent, func())
+	Notify(ctx context.Context, event *BlackboardEvent)
+}
+
+// entryState is the last change delivered for one key.
+type entryState struct {
+	id      string
+	version int64
+	deleted bool
+}
+
+// projectCursor tracks what has been delivered for one watched project.
+type projectCursor struct {
+	since   time.Time
+	entries map[string]entryState
+}
+
+// stale reports whether event was already delivered or is older than what was.
+func (c *projectCursor) stale(event *BlackboardEvent) bool {
+	st, ok := c.entries[event.Entry.Key]
+	if !ok {
+		return false
+	}
+	if event.Type == api.DeleteEventType {
+		return st.deleted
+	}
+	return st.id == event.Entry.ID && event.Entry.Version <= st.version
+}
+
+func (c *projectCursor) mark(event *BlackboardEvent) {
+	if event.Entry.UpdatedAt.After(c.since) {
+		c.since = event.Entry.UpdatedAt
+	}
+	c.entries[event.Entry.Key] = entryState{
+		id:      event.Entry.ID,
+		version: event.Entry.Version,
+		deleted: event.Type == api.DeleteEventType,
+	}
+}
+
+type blackboardWatchService struct {
+	dao     BlackboardEntryDao
+	broker  broker.Broker
+	now     func() time.Time
+	mu      sync.RWMutex
+	subs    map[string][]chan *BlackboardEvent
+	cursors map[string]*projectCursor
+	// pending holds the keys announced per watched project, and resync
+	// whether a broker reconnect asked for a catch-up. The read-back worker
+	// drains both, so the broker's delivery goroutine never blocks on the
+	// database.
+	pending map[string]map[string]struct{}
+	resync  bool
+	wake    chan struct{}
+}
+
+// NewBlackboardWatchService returns a watch service that delivers to local
+// subscribers directly and reads entries announced by other replicas back
+// through dao. A nil b behaves like broker.NewLocalBroker.
+func NewBlackboardWatchService(dao BlackboardEntryDao, b broker.Broker) BlackboardWatchService {
+	if b == nil {
+		b = broker.NewLocalBroker()
+	}
+	s := newBlackboardWatchService(dao, b)
+	go s.readBackWorker()
+	b.Subscribe(blackboardChannel, s)
+	return s
+}
+
+func newBlackboardWatchService(dao BlackboardEntryDao, b broker.Broker) *blackboardWatchService {
+	return &blackboardWatchService{
+		dao:     dao,
+		broker:  b,
+		now:     time.Now,
+		subs:    make(map[string][]chan *BlackboardEvent),
+		cursors: make(map[string]*projectCursor),
+		pending: make(map[string]map[string]struct{}),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+func (s *blackboardWatchService) Subscribe(ctx context.Context, projectID string) (<-chan *BlackboardEvent, func()) {
+	ch := make(chan *BlackboardEvent, 512)
+
+	s.mu.Lock()
+	s.subs[projectID] = append(s.subs[projectID], ch)
+	if s.cursors[projectID] == nil {
+		s.cursors[projectID] = &projectCursor{since: s.now(), entries: map[string]entryState{}}
+	}
+	s.mu.Unlock()
+
+	var once sync.Once
+	remove := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			subs := s.subs[projectID]
+			for i, sub := range subs {
+				if sub == ch {
+					s.subs[projectID] = append(subs[:i], subs[i+1:]...)
+					close(ch)
+					break
+				}
+			}
+			if len(s.subs[projectID]) == 0 {
+				delete(s.subs, projectID)
+				delete(s.cursors, projectID)
+				delete(s.pending, projectID)
+			}
+		})
+	}
+
+	go func() {
+		<-ctx.Done()
+		remove()
+	}()
+
+	return ch, remove
+}
+
+func (s *blackboardWatchService) Notify(ctx context.Context, event *BlackboardEvent) {
+	s.fanOut(event.Entry.ProjectId, []*BlackboardEvent{event})
+
+	if err := s.broker.Publish(ctx, blackboardChannel, event.Entry.ProjectId+":"+event.Entry.Key); err != nil {
+		glog.Warningf("Blackboard watch: notify other replicas of key %q in project %s: %v", event.Entry.Key, event.Entry.ProjectId, err)
+	}
+}
+
+// Receive handles a change announced by another replica. The key is read
+// back, so the event reflects the entry as it is now.
+func (s *blackboardWatchService) Receive(payload string) {
+	projectID, key, ok := strings.Cut(payload, ":")
+	if !ok || s.dao == nil {
+		return
+	}
+
+	s.mu.Lock()
+	_, watched := s.subs[projectID]
+	if watched {
+		if s.pending[projectID] == nil {
+			s.pending[projectID] = map[string]struct{}{}
+		}
+		s.pending[projectID][key] = struct{}{}
+	}
+	s.mu.Unlock()
+	if watched {
+		s.signal()
+	}
+}
+
+// Resync asks the worker to re-read every watched project, after a broker
+// reconnect may have lost notifications.
+func (s *blackboardWatchService) Resync() {
+	if s.dao == nil {
+		return
+	}
+	s.mu.Lock()
+	s.resync = true
+	s.mu.Unlock()
+	s.signal()
+}
+
+func (s *blackboardWatchService) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *blackboardWatchService) readBackWorker() {
+	for range s.wake {
+		s.drainPending()
+	}
+}
+
+// drainPending reads back everything queued since the last call.
+func (s *blackboardWatchService) drainPending() {
+	s.mu.Lock()
+	pending, resync := s.pending, s.resync
+	s.pending, s.resync = make(map[string]map[string]struct{}), false
+	s.mu.Unlock()
+
+	if resync {
+		s.catchUp()
+	}
+	for projectID, keys := range pending {
+		for key := range keys {
+			s.readBack(projectID, key)
+		}
+	}
+}
+
+// readBack delivers the current state of one announced key: the entry, or a
+// delete when it is gone or its TTL has elapsed.
+func (s *blackboardWatchService) readBack(projectID, key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	entry, err := s.dao.GetByKey(ctx, projectID, key)
+	if err == nil && entry.Expired(s.now()) {
+		err = gorm.ErrRecordNotFound
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		s.fanOut(projectID, []*BlackboardEvent{s.tombstone(projectID, key)})
+	case err != nil:
+		glog.Errorf("Blackboard watch: read key %q announced for project %s: %v", key, projectID, err)
+	default:
+		s.fanOut(projectID, []*BlackboardEvent{s.readEvent(projectID, entry)})
+	}
+}
+
+// catchUp re-reads every watched project: entries changed since the last
+// delivery are sent again, and keys delivered earlier that are now gone are
+// sent as deletes.
+func (s *blackboardWatchService) catchUp() {
+	s.mu.RLock()
+	projects := make([]string, 0, len(s.cursors))
+	for projectID := range s.cursors {
+		projects = append(projects, projectID)
+	}
+	s.mu.RUnlock()
+
+	for _, projectID := range projects {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		entries, err := s.dao.ListLive(ctx, projectID, "", s.now())
+		cancel()
+		if err != nil {
+			glog.Errorf("Blackboard watch: catch up project %s: %v", projectID, err)
+			continue
+		}
+
+		live := make(map[string]struct{}, len(entries))
+		var events []*BlackboardEvent
+		s.mu.RLock()
+		cursor := s.cursors[projectID]
+		for _, entry := range entries {
+			live[entry.Key] = struct{}{}
+			if cursor == nil {
+				continue
+			}
+			if _, known := cursor.entries[entry.Key]; known || entry.UpdatedAt.After(cursor.since) {
+				events = append(events, s.readEventLocked(cursor, entry))
+			}
+		}
+		if cursor != nil {
+			for key, st := range cursor.entries {
+				if _, ok := live[key]; !ok && !st.deleted {
+					events = append(events, tombstoneEvent(projectID, key, st))
+				}
+			}
+		}
+		s.mu.RUnlock()
+
+		s.fanOut(projectID, events)
+	}
+}
+
+// readEvent turns an entry read back from the database into the event a
+// watcher would have seen had the write happened on this replica.
+func (s *blackboardWatchService) readEvent(projectID string, entry *BlackboardEntry) *BlackboardEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readEventLocked(s.cursors[projectID], entry)
+}
+
+func (s *blackboardWatchService) readEventLocked(cursor *projectCursor, entry *BlackboardEntry) *BlackboardEvent {
+	eventType := api.UpdateEventType
+	if cursor != nil {
+		if st, known := cursor.entries[entry.Key]; (!known || st.deleted) && entry.Version == 1 {
+			eventType = api.CreateEventType
+		}
+	}
+	return &BlackboardEvent{Type: eventType, Entry: entry}
+}
+
+func (s *blackboardWatchService) tombstone(projectID, key string) *BlackboardEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var st entryState
+	if cursor := s.cursors[projectID]; cursor != nil {
+		st = cursor.entries[key]
+	}
+	return tombstoneEvent(projectID, key, st)
+}
+
+func tombstoneEvent(projectID, key string, st entryState) *BlackboardEvent {
+	entry := &BlackboardEntry{ProjectId: projectID, Key: key, Version: st.version}
+	entry.ID = st.id
+	return &BlackboardEvent{Type: api.DeleteEventType, Entry: entry}
+}
+
+func (s *blackboardWatchService) fanOut(projectID string, events []*BlackboardEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chans := s.subs[projectID]
+	cursor := s.cursors[projectID]
+	if len(chans) == 0 || cursor == nil {
+		return
+	}
+	for _, event := range events {
+		if cursor.stale(event) {
+			continue
+		}
+		cursor.mark(event)
+		for _, ch := range chans {
+			select {
+			case ch <- event:
+			default:
+			}
+		}
+	}
+}
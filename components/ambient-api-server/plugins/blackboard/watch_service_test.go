@@ -0,0 +1,123 @@
+package blackboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+)
+
+// remoteWrite stores entry as a write made on another replica would, without
+// notifying the watch service.
+func remoteWrite(t *testing.T, dao *blackboardEntryDaoMock, entry *BlackboardEntry) {
+	t.Helper()
+	ctx := context.Background()
+	if entry.Version == 1 {
+		if ok, err := dao.CreateIfAbsent(ctx, entry); err != nil || !ok {
+			t.Fatalf("create %q: ok=%v err=%v", entry.Key, ok, err)
+		}
+		return
+	}
+	if ok, err := dao.UpdateIfVersion(ctx, entry, entry.Version-1); err != nil || !ok {
+		t.Fatalf("update %q: ok=%v err=%v", entry.Key, ok, err)
+	}
+}
+
+func drainEvents(ch <-chan *BlackboardEvent) []*BlackboardEvent {
+	var events []*BlackboardEvent
+	for {
+		select {
+		case event := <-ch:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestWatchService_ReceiveReadsBackOnce(t *testing.T) {
+	dao := NewMockBlackboardEntryDao()
+	svc := newBlackboardWatchService(dao, broker.NewLocalBroker())
+	ch, cancel := svc.Subscribe(context.Background(), "p1")
+	defer cancel()
+
+	remoteWrite(t, dao, &BlackboardEntry{ProjectId: "p1", Key: "plan:owner", Value: "alice", Version: 1})
+	svc.Receive("p1:plan:owner")
+	svc.drainPending()
+	svc.Receive("p1:plan:owner")
+	svc.drainPending()
+
+	got := drainEvents(ch)
+	if len(got) != 1 {
+		t.Fatalf("delivered %d events, want 1", len(got))
+	}
+	if got[0].Type != api.CreateEventType || got[0].Entry.Key != "plan:owner" || got[0].Entry.Value != "alice" {
+		t.Errorf("unexpected event: type=%s entry=%+v", got[0].Type, got[0].Entry)
+	}
+}
+
+func TestWatchService_ReceiveDeliversDeleteOfGoneKey(t *testing.T) {
+	dao := NewMockBlackboardEntryDao()
+	svc := newBlackboardWatchService(dao, broker.NewLocalBroker())
+	ch, cancel := svc.Subscribe(context.Background(), "p1")
+	defer cancel()
+
+	entry := &BlackboardEntry{ProjectId: "p1", Key: "lock", Value: "x", Version: 1}
+	remoteWrite(t, dao, entry)
+	svc.Receive("p1:lock")
+	svc.drainPending()
+	if _, err := dao.DeleteIfVersion(context.Background(), "p1", "lock", 1); err != nil {
+		t.Fatal(err)
+	}
+	svc.Receive("p1:lock")
+	svc.Receive("p1:lock")
+	svc.drainPending()
+
+	got := drainEvents(ch)
+	if len(got) != 2 || got[1].Type != api.DeleteEventType {
+		t.Fatalf("delivered %d events, want create then one delete", len(got))
+	}
+	if got[1].Entry.ID != entry.ID || got[1].Entry.Version != 1 {
+		t.Errorf("delete should carry the last delivered entry: %+v", got[1].Entry)
+	}
+}
+
+func TestWatchService_ResyncRecoversMissedChanges(t *testing.T) {
+	dao := NewMockBlackboardEntryDao()
+	svc := newBlackboardWatchService(dao, broker.NewLocalBroker())
+	ch, cancel := svc.Subscribe(context.Background(), "p1")
+	defer cancel()
+
+	remoteWrite(t, dao, &BlackboardEntry{ProjectId: "p1", Key: "a", Value: "1", Version: 1})
+	remoteWrite(t, dao, &BlackboardEntry{ProjectId: "p1", Key: "b", Value: "1", Version: 1})
+	svc.Receive("p1:a")
+	svc.Receive("p1:b")
+	svc.drainPending()
+	drainEvents(ch)
+
+	// Both notifications below were lost while the broker reconnected.
+	a, _ := dao.GetByKey(context.Background(), "p1", "a")
+	a.Value, a.Version = "2", 2
+	remoteWrite(t, dao, a)
+	if _, err := dao.DeleteIfVersion(context.Background(), "p1", "b", 1); err != nil {
+		t.Fatal(err)
+	}
+	svc.Resync()
+	svc.drainPending()
+
+	got := map[string]*BlackboardEvent{}
+	for _, event := range drainEvents(ch) {
+		got[event.Entry.Key] = event
+	}
+	if len(got) != 2 {
+		t.Fatalf("delivered events for %d keys, want 2", len(got))
+	}
+	if got["a"].Type != api.UpdateEventType || got["a"].Entry.Version != 2 {
+		t.Errorf("missed update not recovered: type=%s entry=%+v", got["a"].Type, got["a"].Entry)
+	}
+	if got["b"].Type != api.DeleteEventType {
+		t.Errorf("missed delete not recovered: type=%s", got["b"].Type)
+	}
+}
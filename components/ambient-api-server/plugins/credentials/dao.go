@@ -2,6 +2,8 @@ package credentials
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -16,6 +18,32 @@ type CredentialDao interface {
 	Delete(ctx context.Context, id string) error
 	FindByIDs(ctx context.Context, ids []string) (CredentialList, error)
 	All(ctx context.Context) (CredentialList, error)
+
+	MarkUsed(ctx context.Context, id string, at time.Time) error
+	ExpiringBefore(ctx context.Context, cutoff time.Time) (CredentialList, error)
+	MarkExpiryNotified(ctx context.Context, id string, at time.Time) error
+	BoundAgentIDs(ctx context.Context, id string) ([]string, error)
+
+	StaleSecrets(ctx context.Context, activeVersion int, afterID string, limit int) (CredentialList, error)
+	SwapSecret(ctx context.Context, id, column, old, replacement string) (bool, error)
+	// StoreRefresh saves a minted access token and its expiry, and the
+	// rotated grant when grant is set, leaving every other column alone.
+	StoreRefresh(ctx context.Context, id, token string, expiresAt *time.Time, grant *string) error
+	CountSecretsByKeyVersion(ctx context.Context) ([]SecretVersionCount, error)
+}
+
+// Secret columns the keyring encrypts.
+const (
+	secretColumnToken      = "token"
+	secretColumnOAuthGrant = "oauth_grant"
+)
+
+// SecretVersionCount is how many credentials hold a secret column
+// encrypted under one key version. Version is nil for plaintext.
+type SecretVersionCount struct {
+	Secret  string
+	Version *string
+	Count   int64
 }
 
 var _ CredentialDao = &sqlCredentialDao{}
@@ -81,3 +109,106 @@ func (d *sqlCredentialDao) All(ctx context.Context) (CredentialList, error) {
 	}
 	return credentials, nil
 }
+
+// MarkUsed records a token fetch without bumping updated_at, so reads do
+// not show up as modifications.
+func (d *sqlCredentialDao) MarkUsed(ctx context.Context, id string, at time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	return g2.Model(&Credential{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
+
+// ExpiringBefore returns credentials expiring by cutoff whose bound agents
+// have not been warned yet. OAuth credentials renew themselves and are
+// never returned.
+func (d *sqlCredentialDao) ExpiringBefore(ctx context.Context, cutoff time.Time) (CredentialList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	credentials := CredentialList{}
+	if err := g2.Where("auth_type <> ? AND expires_at IS NOT NULL AND expires_at <= ? AND expiry_notified_at IS NULL", AuthTypeOAuth, cutoff).
+		Order("expires_at ASC").
+		Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+func (d *sqlCredentialDao) MarkExpiryNotified(ctx context.Context, id string, at time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	return g2.Model(&Credential{}).Where("id = ?", id).UpdateColumn("expiry_notified_at", at).Error
+}
+
+// BoundAgentIDs returns the agents a credential is injected into: those
+// bound to it directly and those in a project bound to it as a whole.
+// Global bindings are left out; they would reach every agent.
+func (d *sqlCredentialDao) BoundAgentIDs(ctx context.Context, id string) ([]string, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var agentIDs []string
+	err := g2.Raw(`
+		SELECT DISTINCT a.id FROM agents a
+		JOIN role_bindings rb ON rb.credential_id = ? AND rb.scope = 'credential' AND rb.deleted_at IS NULL
+			AND (rb.agent_id = a.id OR (rb.agent_id IS NULL AND rb.project_id = a.project_id))
+		WHERE a.deleted_at IS NULL
+		ORDER BY a.id`, id).Scan(&agentIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return agentIDs, nil
+}
+
+// StaleSecrets returns, in ID order after afterID, credentials holding a
+// secret encrypted under a key version other than activeVersion.
+func (d *sqlCredentialDao) StaleSecrets(ctx context.Context, activeVersion int, afterID string, limit int) (CredentialList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	current := fmt.Sprintf("enc:v%d:%%", activeVersion)
+	credentials := CredentialList{}
+	if err := g2.Where("id > ?", afterID).
+		Where("((token LIKE 'enc:v%' AND token NOT LIKE ?) OR (oauth_grant LIKE 'enc:v%' AND oauth_grant NOT LIKE ?))", current, current).
+		Order("id ASC").
+		Limit(limit).
+		Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// SwapSecret replaces a secret column only while it still holds old, so a
+// concurrent write wins over a re-encryption. It leaves updated_at alone.
+func (d *sqlCredentialDao) SwapSecret(ctx context.Context, id, column, old, replacement string) (bool, error) {
+	if column != secretColumnToken && column != secretColumnOAuthGrant {
+		return false, fmt.Errorf("not a secret column: %s", column)
+	}
+	g2 := (*d.sessionFactory).New(ctx)
+	result := g2.Model(&Credential{}).Where("id = ? AND "+column+" = ?", id, old).UpdateColumn(column, replacement)
+	return result.RowsAffected == 1, result.Error
+}
+
+// StoreRefresh does not mark a request transaction for rollback: Token runs
+// it outside one so the refresh commits before the credential lock is
+// released.
+func (d *sqlCredentialDao) StoreRefresh(ctx context.Context, id, token string, expiresAt *time.Time, grant *string) error {
+	updates := map[string]any{
+		secretColumnToken: token,
+		"expires_at":      expiresAt,
+	}
+	if grant != nil {
+		updates[secretColumnOAuthGrant] = *grant
+	}
+	g2 := (*d.sessionFactory).New(ctx)
+	return g2.Model(&Credential{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (d *sqlCredentialDao) CountSecretsByKeyVersion(ctx context.Context) ([]SecretVersionCount, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var counts []SecretVersionCount
+	err := g2.Raw(`
+		SELECT 'token' AS secret, substring(token from '^enc:v([0-9]+):') AS version, count(*) AS count
+		FROM credentials WHERE deleted_at IS NULL AND token IS NOT NULL AND token <> ''
+		GROUP BY 2
+		UNION ALL
+		SELECT 'oauth_grant' AS secret, substring(oauth_grant from '^enc:v([0-9]+):') AS version, count(*) AS count
+		FROM credentials WHERE deleted_at IS NULL AND oauth_grant IS NOT NULL AND oauth_grant <> ''
+		GROUP BY 2`).Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
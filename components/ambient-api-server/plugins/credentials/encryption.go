@@ -63,7 +63,7 @@ func ValidateEncryptionStartup(db *gorm.DB, keyring *crypto.Keyring) {
 	}
 
 	var count int64
-	if err := db.Table("credentials").Where("token LIKE 'enc:v%'").Count(&count).Error; err != nil {
+	if err := db.Table("credentials").Where("token LIKE 'enc:v%' OR oauth_grant LIKE 'enc:v%'").Count(&count).Error; err != nil {
 		fmt.Fprintf(os.Stderr, "FATAL: could not check for encrypted tokens: %v\n", err)
 		os.Exit(1)
 	}
@@ -86,7 +86,7 @@ func ValidateEncryptionStartupFromDAO(ctx context.Context, dao CredentialDao, ke
 	}
 
 	for _, c := range all {
-		if c.Token != nil && crypto.IsEncrypted(*c.Token) {
+		if (c.Token != nil && crypto.IsEncrypted(*c.Token)) || (c.OAuthGrant != nil && crypto.IsEncrypted(*c.OAuthGrant)) {
 			fmt.Fprintf(os.Stderr, "FATAL: encrypted credential tokens found but no keyring configured — set %s\n", envKeyring)
 			os.Exit(1)
 		}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"gopkg.in/resty.v1"
@@ -226,3 +227,77 @@ func TestEncryptedLargeKubeconfig(t *testing.T) {
 	Expect(svcErr).NotTo(HaveOccurred())
 	Expect(*got.Token).To(Equal(kubeconfig))
 }
+
+func rotatedTestKeyring(t *testing.T) *crypto.Keyring {
+	t.Helper()
+	oldKey := make([]byte, 32)
+	newKey := make([]byte, 32)
+	for i := range oldKey {
+		oldKey[i] = byte(i + 42)
+		newKey[i] = byte(i + 7)
+	}
+	kr, err := crypto.NewKeyring(map[string]string{
+		"1": base64.StdEncoding.EncodeToString(oldKey),
+		"2": base64.StdEncoding.EncodeToString(newKey),
+	}, 2)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return kr
+}
+
+func TestLazyReencryptionOnRead(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	created := newEncryptedCredential(t, h.NewID(), "ghp_onOldKey")
+
+	dao := credentials.NewCredentialDao(&environments.Environment().Database.SessionFactory)
+	svc := credentials.NewCredentialService(nil, dao, nil, rotatedTestKeyring(t), nil)
+
+	got, svcErr := svc.Get(context.Background(), created.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(*got.Token).To(Equal("ghp_onOldKey"))
+
+	raw, err := dao.Get(context.Background(), created.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*raw.Token).To(HavePrefix("enc:v2:"), "a read must move the token to the active key")
+	Expect(raw.UpdatedAt).To(BeTemporally("~", created.UpdatedAt, time.Millisecond), "re-encryption is not an update")
+}
+
+func TestReencryptorMovesStaleSecrets(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	created := newEncryptedCredential(t, h.NewID(), "ghp_unread")
+
+	dao := credentials.NewCredentialDao(&environments.Environment().Database.SessionFactory)
+	svc := credentials.NewCredentialService(nil, dao, nil, rotatedTestKeyring(t), nil)
+
+	before, svcErr := svc.EncryptionStatus(context.Background())
+	Expect(svcErr).To(BeNil())
+	Expect(before.ActiveVersion).To(Equal(2))
+	Expect(before.KeyringVersions).To(Equal([]int{1, 2}))
+	Expect(before.Remaining).To(BeNumerically(">=", 1))
+
+	reencryptor := credentials.NewReencryptor(svc, nil, time.Minute, 100)
+	for i := 0; i < 100; i++ {
+		reencryptor.Reencrypt(context.Background())
+		status, svcErr := svc.EncryptionStatus(context.Background())
+		Expect(svcErr).To(BeNil())
+		if status.Remaining == 0 {
+			break
+		}
+	}
+
+	raw, err := dao.Get(context.Background(), created.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*raw.Token).To(HavePrefix("enc:v2:"))
+
+	after, svcErr := svc.EncryptionStatus(context.Background())
+	Expect(svcErr).To(BeNil())
+	Expect(after.Remaining).To(BeZero())
+	for _, v := range after.Versions {
+		if v.Version == 1 {
+			Expect(v.Tokens+v.OAuthGrants).To(BeZero(), "nothing may remain on the retired key")
+		}
+	}
+}
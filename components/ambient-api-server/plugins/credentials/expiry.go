@@ -0,0 +1,132 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/inbox"
+)
+
+const (
+	expiryNotifierLockID       = "expiry-notifier"
+	expiryNotifierFromName     = "credential-expiry"
+	defaultExpiryCheckInterval = 15 * time.Minute
+	defaultExpiryWarningWindow = 72 * time.Hour
+)
+
+// ExpiryNotifier warns the agents a credential is injected into once it is
+// within the warning window of its expiry, then marks the credential so the
+// warning is sent once. Rotating the credential or moving its expiry clears
+// the mark. Like the blackboard reaper, every replica runs one and a
+// non-blocking advisory lock keeps each pass single-writer.
+type ExpiryNotifier struct {
+	svc         CredentialService
+	inbox       inbox.InboxMessageService
+	lockFactory db.LockFactory
+	interval    time.Duration
+	window      time.Duration
+}
+
+func NewExpiryNotifier(svc CredentialService, inboxSvc inbox.InboxMessageService, lockFactory db.LockFactory, interval, window time.Duration) *ExpiryNotifier {
+	if interval <= 0 {
+		interval = defaultExpiryCheckInterval
+	}
+	if window <= 0 {
+		window = defaultExpiryWarningWindow
+	}
+	return &ExpiryNotifier{svc: svc, inbox: inboxSvc, lockFactory: lockFactory, interval: interval, window: window}
+}
+
+// Run checks for expiring credentials until ctx is cancelled.
+func (n *ExpiryNotifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.Notify(ctx, time.Now().UTC())
+		}
+	}
+}
+
+// Notify warns the bound agents of every unmarked credential expiring by
+// now plus the warning window, if this replica holds the notifier lock, and
+// returns how many credentials were marked. A credential whose warning
+// could not be posted to every agent stays unmarked and is retried on the
+// next pass.
+func (n *ExpiryNotifier) Notify(ctx context.Context, now time.Time) int {
+	if n.lockFactory != nil {
+		owner, acquired, err := n.lockFactory.NewNonBlockingLock(ctx, expiryNotifierLockID, credentialsLockType)
+		defer n.lockFactory.Unlock(ctx, owner)
+		if err != nil {
+			glog.Errorf("Credential expiry notifier: acquire lock: %v", err)
+			return 0
+		}
+		if !acquired {
+			return 0
+		}
+	}
+
+	expiring, svcErr := n.svc.ExpiringBefore(ctx, now.Add(n.window))
+	if svcErr != nil {
+		glog.Errorf("Credential expiry notifier: %v", svcErr)
+		return 0
+	}
+
+	marked := 0
+	for _, credential := range expiring {
+		if !n.warnBoundAgents(ctx, credential, now) {
+			continue
+		}
+		if svcErr := n.svc.MarkExpiryNotified(ctx, credential.ID); svcErr != nil {
+			glog.Errorf("Credential expiry notifier: %v", svcErr)
+			continue
+		}
+		marked++
+	}
+	if marked > 0 {
+		glog.V(2).Infof("Credential expiry notifier marked %d expiring credentials", marked)
+	}
+	return marked
+}
+
+func (n *ExpiryNotifier) warnBoundAgents(ctx context.Context, credential *Credential, now time.Time) bool {
+	agentIDs, svcErr := n.svc.BoundAgentIDs(ctx, credential.ID)
+	if svcErr != nil {
+		glog.Errorf("Credential expiry notifier: %v", svcErr)
+		return false
+	}
+	if n.inbox == nil {
+		return len(agentIDs) == 0
+	}
+
+	body := expiryMessage(credential, now)
+	fromName := expiryNotifierFromName
+	ok := true
+	for _, agentID := range agentIDs {
+		if _, svcErr := n.inbox.Create(ctx, &inbox.InboxMessage{
+			AgentId:  agentID,
+			FromName: &fromName,
+			Body:     body,
+		}); svcErr != nil {
+			glog.Errorf("Credential expiry notifier: warn agent %s about credential %s: %v", agentID, credential.ID, svcErr)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func expiryMessage(credential *Credential, now time.Time) string {
+	verb := "expires"
+	if !credential.ExpiresAt.After(now) {
+		verb = "expired"
+	}
+	return fmt.Sprintf("Credential %q (%s, id %s) %s at %s. Ask its owner to rotate it.",
+		credential.Name, credential.Provider, credential.ID, verb, credential.ExpiresAt.UTC().Format(time.RFC3339))
+}
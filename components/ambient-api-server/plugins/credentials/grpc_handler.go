@@ -0,0 +1,284 @@
+package credentials
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api"
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+type credentialGRPCHandler struct {
+	pb.UnimplementedCredentialServiceServer
+	service    CredentialService
+	generic    services.GenericService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewCredentialGRPCHandler(service CredentialService, generic services.GenericService, brokerFunc func() *server.EventBroker) pb.CredentialServiceServer {
+	return &credentialGRPCHandler{
+		service:    service,
+		generic:    generic,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requireServiceCaller guards single-credential reads and mutations.  The
+// per-credential scope checks live in the RBAC middleware, which only runs
+// for REST, so over gRPC only service callers may touch a credential by ID.
+func requireServiceCaller(ctx context.Context) error {
+	if !middleware.IsServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "credentials can only be read or modified by ID by service callers over gRPC; use the REST API")
+	}
+	return nil
+}
+
+func (h *credentialGRPCHandler) GetCredential(ctx context.Context, req *pb.GetCredentialRequest) (*pb.Credential, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	credential, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return credentialToProto(credential), nil
+}
+
+func (h *credentialGRPCHandler) CreateCredential(ctx context.Context, req *pb.CreateCredentialRequest) (*pb.Credential, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("name", req.GetName(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("provider", req.GetProvider(), true); err != nil {
+		return nil, err
+	}
+	if svcErr := validateCredentialAuth(req.GetAuthType(), req.GetProvider(), req.Token, req.RefreshToken, req.OauthClient, req.OauthScopes); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	credential := &Credential{
+		Name:        req.GetName(),
+		Description: req.Description,
+		Provider:    req.GetProvider(),
+		Token:       req.Token,
+		Url:         req.Url,
+		Email:       req.Email,
+		Labels:      req.Labels,
+		Annotations: req.Annotations,
+		AuthType:    req.GetAuthType(),
+	}
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		credential.ExpiresAt = &t
+	}
+	if svcErr := createOAuthGrant(credential, req.RefreshToken, req.OauthClient, req.OauthScopes); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	created, svcErr := h.service.Create(ctx, credential)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return credentialToProto(created), nil
+}
+
+func (h *credentialGRPCHandler) UpdateCredential(ctx context.Context, req *pb.UpdateCredentialRequest) (*pb.Credential, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	found, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	if req.Name != nil {
+		found.Name = *req.Name
+	}
+	if req.Description != nil {
+		found.Description = req.Description
+	}
+	if req.Provider != nil {
+		found.Provider = *req.Provider
+	}
+	if req.Token != nil {
+		if found.isOAuth() {
+			return nil, status.Error(codes.InvalidArgument, "OAuth credentials mint their own tokens; update refresh_token instead")
+		}
+		found.rotate(req.GetToken(), time.Now().UTC())
+	}
+	if req.Url != nil {
+		found.Url = req.Url
+	}
+	if req.Email != nil {
+		found.Email = req.Email
+	}
+	if req.Labels != nil {
+		found.Labels = req.Labels
+	}
+	if req.Annotations != nil {
+		found.Annotations = req.Annotations
+	}
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		found.setExpiry(&t)
+	}
+	if svcErr := patchOAuthGrant(found, req.RefreshToken, req.OauthClient, req.OauthScopes); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	updated, svcErr := h.service.Replace(ctx, found)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return credentialToProto(updated), nil
+}
+
+func (h *credentialGRPCHandler) DeleteCredential(ctx context.Context, req *pb.DeleteCredentialRequest) (*pb.DeleteCredentialResponse, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	svcErr := h.service.Delete(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return &pb.DeleteCredentialResponse{}, nil
+}
+
+func (h *credentialGRPCHandler) ListCredentials(ctx context.Context, req *pb.ListCredentialsRequest) (*pb.ListCredentialsResponse, error) {
+	page, size := grpcutil.NormalizePagination(req.GetPage(), req.GetSize())
+
+	listArgs := services.ListArguments{
+		Page: int(page),
+		Size: int64(size),
+	}
+
+	if !middleware.IsServiceCaller(ctx) {
+		if !rbac.ApplyListFilter(ctx, &listArgs, "id", true) {
+			return &pb.ListCredentialsResponse{Items: []*pb.Credential{}, Metadata: &pb.ListMeta{Page: int32(page), Size: int32(size), Total: 0}}, nil
+		}
+	}
+
+	var credentials []Credential
+	paging, svcErr := h.generic.List(ctx, "id", &listArgs, &credentials)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.Credential, 0, len(credentials))
+	for i := range credentials {
+		items = append(items, credentialToProto(&credentials[i]))
+	}
+
+	return &pb.ListCredentialsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  int32(paging.Page),
+			Size:  int32(paging.Size),
+			Total: int32(paging.Total),
+		},
+	}, nil
+}
+
+// WatchCredentials streams credential changes so consumers (the control
+// plane in particular) see token rotations as they happen.  Events carry
+// metadata only; the token is fetched separately through the REST /token
+// subresource by callers entitled to it.
+func (h *credentialGRPCHandler) WatchCredentials(req *pb.WatchCredentialsRequest, stream grpc.ServerStreamingServer[pb.CredentialWatchEvent]) error {
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	ctx := stream.Context()
+	authResult := rbac.GetAuthResult(ctx)
+	isPrivileged := middleware.IsServiceCaller(ctx) ||
+		(authResult != nil && authResult.IsGlobalAdmin)
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			if !isPrivileged && !isCredentialAuthorized(authResult, event.SourceID) {
+				continue
+			}
+
+			watchEvent := &pb.CredentialWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				ResourceId: event.SourceID,
+			}
+
+			if event.EventType != api.DeleteEventType {
+				credential, svcErr := h.service.Get(ctx, event.SourceID)
+				if svcErr != nil {
+					glog.Errorf("WatchCredentials: failed to get credential %s: %v", event.SourceID, svcErr)
+					continue
+				}
+				watchEvent.Credential = credentialToProto(credential)
+			}
+
+			if err := stream.Send(watchEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// isCredentialAuthorized checks whether the caller's AuthResult grants
+// access to the given credential.
+func isCredentialAuthorized(authResult *rbac.AuthResult, credentialID string) bool {
+	if authResult == nil {
+		return false
+	}
+	if authResult.IsGlobalAdmin {
+		return true
+	}
+	for _, id := range authResult.CredentialIDs {
+		if id == credentialID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package credentials_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/credentials"
+	"github.com/ambient-code/platform/components/ambient-api-server/test"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+)
+
+// Single-credential reads and mutations are only open to service callers
+// over gRPC; users go through REST, where the RBAC middleware scopes them.
+func TestCredentialGRPCRequiresServiceCaller(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	token := h.CreateJWTString(account)
+
+	conn, err := grpc.NewClient(
+		h.GRPCAddress(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewCredentialServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	credential, err := newCredential("grpc-credential")
+	Expect(err).NotTo(HaveOccurred())
+
+	expectDenied := func(err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	}
+
+	secret := "ghp_initial"
+	_, err = client.CreateCredential(ctx, &pb.CreateCredentialRequest{Name: "user-credential", Provider: "github", Token: &secret})
+	expectDenied(err)
+
+	_, err = client.GetCredential(ctx, &pb.GetCredentialRequest{Id: credential.ID})
+	expectDenied(err)
+
+	rotated := "ghp_rotated"
+	_, err = client.UpdateCredential(ctx, &pb.UpdateCredentialRequest{Id: credential.ID, Token: &rotated})
+	expectDenied(err)
+
+	_, err = client.DeleteCredential(ctx, &pb.DeleteCredentialRequest{Id: credential.ID})
+	expectDenied(err)
+
+	credentialService := credentials.Service(&environments.Environment().Services)
+	_, svcErr := credentialService.Get(context.Background(), credential.ID)
+	Expect(svcErr).To(BeNil())
+}
+
+func TestCredentialGRPCWatch(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	token := h.CreateJWTString(account)
+
+	h.StartControllersServer()
+	time.Sleep(500 * time.Millisecond)
+
+	conn, err := grpc.NewClient(
+		h.GRPCAddress(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewCredentialServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	watchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchCredentials(watchCtx, &pb.WatchCredentialsRequest{})
+	Expect(err).NotTo(HaveOccurred())
+
+	received := make(chan *pb.CredentialWatchEvent, 10)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case received <- event:
+			case <-watchCtx.Done():
+				return
+			}
+		}
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	created, err := newCredential("watched-credential")
+	Expect(err).NotTo(HaveOccurred())
+	resourceID := created.ID
+
+	select {
+	case event := <-received:
+		Expect(event.GetType()).To(Equal(pb.EventType_EVENT_TYPE_CREATED))
+		Expect(event.GetResourceId()).To(Equal(resourceID))
+		Expect(event.GetCredential().GetName()).To(Equal("watched-credential"))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for CREATED watch event")
+	}
+
+	credentialService := credentials.Service(&environments.Environment().Services)
+	_, svcErr := credentialService.Rotate(context.Background(), resourceID, "ghp_rotated", nil)
+	Expect(svcErr).To(BeNil())
+
+	select {
+	case event := <-received:
+		Expect(event.GetType()).To(Equal(pb.EventType_EVENT_TYPE_UPDATED))
+		Expect(event.GetResourceId()).To(Equal(resourceID))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for UPDATED watch event after token rotation")
+	}
+}
@@ -0,0 +1,43 @@
+package credentials
+
+import (
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func credentialToProto(c *Credential) *pb.Credential {
+	if c == nil {
+		return nil
+	}
+
+	proto := &pb.Credential{
+		Metadata: &pb.ObjectReference{
+			Id:        c.ID,
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			UpdatedAt: timestamppb.New(c.UpdatedAt),
+			Kind:      "Credential",
+			Href:      "/api/ambient/v1/credentials/" + c.ID,
+		},
+		Name:        c.Name,
+		Description: c.Description,
+		Provider:    c.Provider,
+		Url:         c.Url,
+		Email:       c.Email,
+		Labels:      c.Labels,
+		Annotations: c.Annotations,
+		AuthType:    c.AuthType,
+	}
+	if c.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*c.ExpiresAt)
+	}
+	if c.LastUsedAt != nil {
+		proto.LastUsedAt = timestamppb.New(*c.LastUsedAt)
+	}
+	if c.RotatedAt != nil {
+		proto.RotatedAt = timestamppb.New(*c.RotatedAt)
+	}
+	if c.ExpiryNotifiedAt != nil {
+		proto.ExpiryNotifiedAt = timestamppb.New(*c.ExpiryNotifiedAt)
+	}
+	return proto
+}
@@ -2,15 +2,19 @@ package credentials
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
 	"github.com/openshift-online/rh-trex-ai/pkg/api/presenters"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
 	"github.com/openshift-online/rh-trex-ai/pkg/services"
+	"github.com/openshift-online/rh-trex-ai/pkg/util"
 )
 
 var _ handlers.RestHandler = credentialHandler{}
@@ -33,10 +37,17 @@ func (h credentialHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Body: &credential,
 		Validators: []handlers.Validate{
 			handlers.ValidateEmpty(&credential, "Id", "id"),
+			func() *errors.ServiceError {
+				return validateCredentialAuth(util.NilToEmptyString(credential.AuthType), credential.Provider,
+					credential.Token, credential.RefreshToken, credential.OauthClient, credential.OauthScopes)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 			credentialModel := ConvertCredential(credential)
+			if svcErr := createOAuthGrant(credentialModel, credential.RefreshToken, credential.OauthClient, credential.OauthScopes); svcErr != nil {
+				return nil, svcErr
+			}
 			credentialModel, err := h.credential.Create(ctx, credentialModel)
 			if err != nil {
 				return nil, err
@@ -73,7 +84,10 @@ func (h credentialHandler) Patch(w http.ResponseWriter, r *http.Request) {
 				found.Provider = *patch.Provider
 			}
 			if patch.Token != nil {
-				found.Token = patch.Token
+				if found.isOAuth() {
+					return nil, errors.Validation("OAuth credentials mint their own tokens; patch refresh_token instead")
+				}
+				found.rotate(*patch.Token, time.Now().UTC())
 			}
 			if patch.Url != nil {
 				found.Url = patch.Url
@@ -87,6 +101,12 @@ func (h credentialHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if patch.Annotations != nil {
 				found.Annotations = patch.Annotations
 			}
+			if patch.ExpiresAt != nil {
+				found.setExpiry(patch.ExpiresAt)
+			}
+			if svcErr := patchOAuthGrant(found, patch.RefreshToken, patch.OauthClient, patch.OauthScopes); svcErr != nil {
+				return nil, svcErr
+			}
 
 			credentialModel, err := h.credential.Replace(ctx, found)
 			if err != nil {
@@ -176,10 +196,17 @@ func (h credentialHandler) GetToken(w http.ResponseWriter, r *http.Request) {
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["cred_id"]
 			ctx := r.Context()
-			credential, err := h.credential.Get(ctx, id)
+			credential, err := h.credential.Token(ctx, id)
 			if err != nil {
 				return nil, err
 			}
+			// LastUsedAt means "last fetched": sidecars re-fetch on every
+			// token refresh, so a credential in use by a running session
+			// stays recent. A failed stamp must not keep a session from
+			// its token.
+			if err := h.credential.MarkUsed(ctx, id); err != nil {
+				glog.Warningf("credential %s: %v", id, err)
+			}
 
 			return PresentCredentialToken(credential), nil
 		},
@@ -187,3 +214,133 @@ func (h credentialHandler) GetToken(w http.ResponseWriter, r *http.Request) {
 
 	handlers.HandleGet(w, r, cfg)
 }
+
+func (h credentialHandler) Rotate(w http.ResponseWriter, r *http.Request) {
+	var req CredentialRotateRequest
+	cfg := &handlers.HandlerConfig{
+		Body: &req,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				if req.Token == "" {
+					return errors.Validation("token is required")
+				}
+				return nil
+			},
+			func() *errors.ServiceError {
+				if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+					return errors.Validation("expires_at must be in the future")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["cred_id"]
+			credential, err := h.credential.Rotate(ctx, id, req.Token, req.ExpiresAt)
+			if err != nil {
+				return nil, err
+			}
+			return PresentCredential(credential), nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// EncryptionStatus — GET /api/ambient/v1/credentials/encryption
+// Reports how many stored secrets each key version still encrypts, so an
+// operator can tell when a retired key is safe to remove.
+func (h credentialHandler) EncryptionStatus(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if !middleware.IsServiceCaller(ctx) {
+				if authResult := pkgrbac.GetAuthResult(ctx); authResult == nil || !authResult.IsGlobalAdmin {
+					return nil, errors.Forbidden("credential encryption status is restricted to platform admins")
+				}
+			}
+			return h.credential.EncryptionStatus(ctx)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// validateCredentialAuth checks a new credential carries exactly the inputs
+// its auth type needs.
+func validateCredentialAuth(authType, provider string, token, refreshToken, oauthClient, oauthScopes *string) *errors.ServiceError {
+	switch authType {
+	case "", AuthTypeToken:
+		if refreshToken != nil || oauthClient != nil || oauthScopes != nil {
+			return errors.Validation("refresh_token, oauth_client and oauth_scopes require auth_type oauth")
+		}
+		return nil
+	case AuthTypeOAuth:
+		if token != nil {
+			return errors.Validation("OAuth credentials mint their own tokens; set refresh_token instead of token")
+		}
+		grant := &OAuthGrant{
+			RefreshToken: util.NilToEmptyString(refreshToken),
+			Client:       util.NilToEmptyString(oauthClient),
+		}
+		if err := checkOAuthGrant(provider, grant); err != nil {
+			return errors.Validation("%v", err)
+		}
+		return nil
+	default:
+		return errors.Validation("auth_type must be %q or %q", AuthTypeToken, AuthTypeOAuth)
+	}
+}
+
+// createOAuthGrant stores the grant of a new OAuth credential.
+func createOAuthGrant(credential *Credential, refreshToken, oauthClient, oauthScopes *string) *errors.ServiceError {
+	if !credential.isOAuth() {
+		return nil
+	}
+	grant := &OAuthGrant{
+		RefreshToken: util.NilToEmptyString(refreshToken),
+		Client:       util.NilToEmptyString(oauthClient),
+		Scopes:       parseScopes(util.NilToEmptyString(oauthScopes)),
+	}
+	if err := credential.setOAuthGrant(grant); err != nil {
+		return errors.GeneralError("encode OAuth grant: %v", err)
+	}
+	return nil
+}
+
+// patchOAuthGrant applies patched grant fields, and re-checks the grant
+// against the credential's provider, which the patch may also have changed.
+func patchOAuthGrant(found *Credential, refreshToken, oauthClient, oauthScopes *string) *errors.ServiceError {
+	grantPatched := refreshToken != nil || oauthClient != nil || oauthScopes != nil
+	if !found.isOAuth() {
+		if grantPatched {
+			return errors.Validation("refresh_token, oauth_client and oauth_scopes require auth_type oauth")
+		}
+		return nil
+	}
+
+	grant, err := decodeOAuthGrant(found.OAuthGrant)
+	if err != nil {
+		return errors.GeneralError("credential %s: %v", found.ID, err)
+	}
+	if refreshToken != nil {
+		grant.RefreshToken = *refreshToken
+	}
+	if oauthClient != nil {
+		grant.Client = *oauthClient
+	}
+	if oauthScopes != nil {
+		grant.Scopes = parseScopes(*oauthScopes)
+	}
+	if err := checkOAuthGrant(found.Provider, grant); err != nil {
+		return errors.Validation("%v", err)
+	}
+	if !grantPatched {
+		return nil
+	}
+	if err := found.setOAuthGrant(grant); err != nil {
+		return errors.GeneralError("encode OAuth grant: %v", err)
+	}
+	return nil
+}
@@ -2,15 +2,21 @@ package credentials_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"gopkg.in/resty.v1"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/credentials"
 	"github.com/ambient-code/platform/components/ambient-api-server/test"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
 )
 
 const testProjectID = "test-project"
@@ -197,6 +203,248 @@ func TestCredentialToken(t *testing.T) {
 	Expect(restyResp.StatusCode()).To(Equal(http.StatusUnauthorized), "unauthenticated request to /token must be rejected")
 }
 
+func TestCredentialRotate(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+	jwtToken := ctx.Value(openapi.ContextAccessToken)
+
+	created, err := newCredential(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+
+	expiresAt := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+	restyResp, restyErr := resty.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		SetBody(map[string]interface{}{"token": "rotated-token", "expires_at": expiresAt}).
+		Post(h.RestURL(fmt.Sprintf("/projects/%s/credentials/%s/rotate", testProjectID, created.ID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusOK))
+
+	var rotated openapi.Credential
+	Expect(json.Unmarshal(restyResp.Body(), &rotated)).To(Succeed())
+	Expect(*rotated.Id).To(Equal(created.ID), "rotation must keep the credential ID")
+	Expect(rotated.Token).To(BeNil(), "rotate response must never return the token value")
+	Expect(rotated.RotatedAt).NotTo(BeNil())
+	Expect(*rotated.ExpiresAt).To(BeTemporally("==", expiresAt))
+
+	restyResp, restyErr = resty.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		Get(h.RestURL(fmt.Sprintf("/projects/%s/credentials/%s/token", testProjectID, created.ID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusOK))
+	var tokenResp openapi.CredentialTokenResponse
+	Expect(json.Unmarshal(restyResp.Body(), &tokenResp)).To(Succeed())
+	Expect(tokenResp.Token).To(Equal("rotated-token"))
+	Expect(*tokenResp.ExpiresAt).To(BeTemporally("==", expiresAt))
+
+	used, svcErr := credentials.Service(&environments.Environment().Services).Get(context.Background(), created.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(used.LastUsedAt).NotTo(BeNil(), "token fetch must record last_used_at")
+
+	restyResp, restyErr = resty.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		SetBody(map[string]interface{}{"token": ""}).
+		Post(h.RestURL(fmt.Sprintf("/projects/%s/credentials/%s/rotate", testProjectID, created.ID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusBadRequest), "rotate without a token must be rejected")
+}
+
+func TestCredentialExpiryNotifier(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	svc := credentials.Service(&environments.Environment().Services)
+	credential, err := newCredential(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+	expiresAt := time.Now().Add(time.Hour)
+	_, svcErr := svc.Rotate(context.Background(), credential.ID, "expiring-token", &expiresAt)
+	Expect(svcErr).To(BeNil())
+
+	notifier := credentials.NewExpiryNotifier(svc, nil, nil, time.Minute, 24*time.Hour)
+	Expect(notifier.Notify(context.Background(), time.Now())).To(BeNumerically(">=", 1))
+
+	marked, svcErr := svc.Get(context.Background(), credential.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(marked.ExpiryNotifiedAt).NotTo(BeNil(), "credential within the warning window must be marked")
+
+	expiring, svcErr := svc.ExpiringBefore(context.Background(), time.Now().Add(24*time.Hour))
+	Expect(svcErr).To(BeNil())
+	for _, c := range expiring {
+		Expect(c.ID).NotTo(Equal(credential.ID), "a marked credential must not be warned about twice")
+	}
+
+	later := time.Now().Add(48 * time.Hour)
+	rotated, svcErr := svc.Rotate(context.Background(), credential.ID, "fresh-token", &later)
+	Expect(svcErr).To(BeNil())
+	Expect(rotated.ExpiryNotifiedAt).To(BeNil(), "rotation must clear the expiry mark")
+}
+
+func TestCredentialOAuthToken(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+	jwtToken := ctx.Value(openapi.ContextAccessToken)
+
+	var refreshes atomic.Int32
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.ParseForm()).To(Succeed())
+		Expect(r.PostForm.Get("grant_type")).To(Equal("refresh_token"))
+		Expect(r.PostForm.Get("client_id")).To(Equal("test-client"))
+		n := refreshes.Add(1)
+		Expect(r.PostForm.Get("refresh_token")).To(Equal(fmt.Sprintf("refresh-%d", n-1)), "the rotated refresh token must be used")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  fmt.Sprintf("access-%d", n),
+			"refresh_token": fmt.Sprintf("refresh-%d", n),
+			"expires_in":    3600,
+		})
+	}))
+	defer provider.Close()
+	t.Setenv("GOOGLE_OAUTH_CLIENT_ID", "test-client")
+	t.Setenv("GOOGLE_OAUTH_CLIENT_SECRET", "test-secret")
+	t.Setenv("GOOGLE_OAUTH_TOKEN_URL", provider.URL)
+
+	restyResp, restyErr := resty.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		SetBody(map[string]interface{}{
+			"name":          "google-oauth",
+			"provider":      "google",
+			"auth_type":     "oauth",
+			"refresh_token": "refresh-0",
+			"oauth_client":  "google",
+			"oauth_scopes":  "https://www.googleapis.com/auth/drive.readonly",
+		}).
+		Post(h.RestURL(fmt.Sprintf("/projects/%s/credentials", testProjectID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusCreated))
+	var created openapi.Credential
+	Expect(json.Unmarshal(restyResp.Body(), &created)).To(Succeed())
+	Expect(*created.AuthType).To(Equal("oauth"))
+	Expect(created.RefreshToken).To(BeNil(), "the refresh token must never be returned")
+
+	fetchToken := func() openapi.CredentialTokenResponse {
+		restyResp, restyErr := resty.R().
+			SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+			Get(h.RestURL(fmt.Sprintf("/projects/%s/credentials/%s/token", testProjectID, *created.Id)))
+		Expect(restyErr).NotTo(HaveOccurred())
+		Expect(restyResp.StatusCode()).To(Equal(http.StatusOK))
+		var tokenResp openapi.CredentialTokenResponse
+		Expect(json.Unmarshal(restyResp.Body(), &tokenResp)).To(Succeed())
+		return tokenResp
+	}
+
+	first := fetchToken()
+	Expect(first.Token).To(Equal("access-1"))
+	Expect(first.ExpiresAt).NotTo(BeNil())
+	Expect(fetchToken().Token).To(Equal("access-1"), "a fresh access token must be reused")
+	Expect(refreshes.Load()).To(Equal(int32(1)))
+
+	// Pull the cached token into the refresh window; the next fetch must
+	// mint a new one with the refresh token the provider rotated in.
+	svc := credentials.Service(&environments.Environment().Services)
+	soon := time.Now().Add(time.Minute)
+	found, svcErr := svc.Get(context.Background(), *created.Id)
+	Expect(svcErr).To(BeNil())
+	found.ExpiresAt = &soon
+	_, svcErr = svc.Replace(context.Background(), found)
+	Expect(svcErr).To(BeNil())
+
+	Expect(fetchToken().Token).To(Equal("access-2"))
+	Expect(refreshes.Load()).To(Equal(int32(2)))
+
+	_, svcErr = svc.Rotate(context.Background(), *created.Id, "static", nil)
+	Expect(svcErr).NotTo(BeNil(), "OAuth credentials must not accept a static token")
+
+	restyResp, restyErr = resty.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		SetBody(map[string]interface{}{
+			"name":          "github-oauth",
+			"provider":      "github",
+			"auth_type":     "oauth",
+			"refresh_token": "refresh-0",
+			"oauth_client":  "google",
+		}).
+		Post(h.RestURL(fmt.Sprintf("/projects/%s/credentials", testProjectID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusBadRequest), "a client must match the credential's provider")
+}
+
+// Each token fetch runs in its own request transaction. The refresh must be
+// committed before the next fetch takes the credential lock, or that fetch
+// refreshes again with a grant the provider has already rotated away.
+func TestCredentialOAuthTokenConcurrentFetchRefreshesOnce(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+	jwtToken := ctx.Value(openapi.ContextAccessToken)
+
+	var refreshes atomic.Int32
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := refreshes.Add(1)
+		// Hold the lock long enough for every other fetch to queue on it.
+		time.Sleep(100 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  fmt.Sprintf("access-%d", n),
+			"refresh_token": fmt.Sprintf("refresh-%d", n),
+			"expires_in":    3600,
+		})
+	}))
+	defer provider.Close()
+	t.Setenv("GOOGLE_OAUTH_CLIENT_ID", "test-client")
+	t.Setenv("GOOGLE_OAUTH_CLIENT_SECRET", "test-secret")
+	t.Setenv("GOOGLE_OAUTH_TOKEN_URL", provider.URL)
+
+	restyResp, restyErr := resty.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		SetBody(map[string]interface{}{
+			"name":          "google-oauth-concurrent",
+			"provider":      "google",
+			"auth_type":     "oauth",
+			"refresh_token": "refresh-0",
+			"oauth_client":  "google",
+		}).
+		Post(h.RestURL(fmt.Sprintf("/projects/%s/credentials", testProjectID)))
+	Expect(restyErr).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusCreated))
+	var created openapi.Credential
+	Expect(json.Unmarshal(restyResp.Body(), &created)).To(Succeed())
+
+	const fetches = 8
+	type result struct {
+		status int
+		token  string
+	}
+	results := make(chan result, fetches)
+	for i := 0; i < fetches; i++ {
+		go func() {
+			resp, err := resty.R().
+				SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+				Get(h.RestURL(fmt.Sprintf("/projects/%s/credentials/%s/token", testProjectID, *created.Id)))
+			if err != nil {
+				results <- result{}
+				return
+			}
+			var tokenResp openapi.CredentialTokenResponse
+			_ = json.Unmarshal(resp.Body(), &tokenResp)
+			results <- result{status: resp.StatusCode(), token: tokenResp.Token}
+		}()
+	}
+	for i := 0; i < fetches; i++ {
+		r := <-results
+		Expect(r.status).To(Equal(http.StatusOK))
+		Expect(r.token).To(Equal("access-1"), "every concurrent fetch must get the one minted token")
+	}
+	Expect(refreshes.Load()).To(Equal(int32(1)), "concurrent fetches must share a single refresh")
+}
+
 func TestCredentialDelete(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 
@@ -273,3 +273,63 @@ func dropProjectIDMigration() *gormigrate.Migration {
 		},
 	}
 }
+
+func credentialLifecycleMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170010",
+		Migrate: func(tx *gorm.DB) error {
+			stmts := []string{
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ`,
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ`,
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ`,
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMPTZ`,
+				`CREATE INDEX IF NOT EXISTS idx_credentials_expires_at ON credentials(expires_at)`,
+			}
+			for _, s := range stmts {
+				if err := tx.Exec(s).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec("DROP INDEX IF EXISTS idx_credentials_expires_at").Error; err != nil {
+				return err
+			}
+			cols := []string{"expires_at", "last_used_at", "rotated_at", "expiry_notified_at"}
+			for _, col := range cols {
+				if err := tx.Exec("ALTER TABLE credentials DROP COLUMN IF EXISTS " + col).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func credentialOAuthMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170011",
+		Migrate: func(tx *gorm.DB) error {
+			stmts := []string{
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS auth_type TEXT NOT NULL DEFAULT 'token'`,
+				`ALTER TABLE credentials ADD COLUMN IF NOT EXISTS oauth_grant TEXT`,
+			}
+			for _, s := range stmts {
+				if err := tx.Exec(s).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			cols := []string{"auth_type", "oauth_grant"}
+			for _, col := range cols {
+				if err := tx.Exec("ALTER TABLE credentials DROP COLUMN IF EXISTS " + col).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -2,9 +2,12 @@ package credentials
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/crypto"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 )
 
@@ -12,6 +15,7 @@ var _ CredentialDao = &credentialDaoMock{}
 
 type credentialDaoMock struct {
 	credentials CredentialList
+	boundAgents map[string][]string
 }
 
 func NewMockCredentialDao() *credentialDaoMock {
@@ -36,7 +40,13 @@ func (d *credentialDaoMock) Create(ctx context.Context, credential *Credential)
 }
 
 func (d *credentialDaoMock) Replace(ctx context.Context, credential *Credential) (*Credential, error) {
-	return nil, errors.NotImplemented("Credential").AsError()
+	for i, existing := range d.credentials {
+		if existing.ID == credential.ID {
+			d.credentials[i] = credential
+			return credential, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
 }
 
 func (d *credentialDaoMock) Delete(ctx context.Context, id string) error {
@@ -50,3 +60,111 @@ func (d *credentialDaoMock) FindByIDs(ctx context.Context, ids []string) (Creden
 func (d *credentialDaoMock) All(ctx context.Context) (CredentialList, error) {
 	return d.credentials, nil
 }
+
+func (d *credentialDaoMock) MarkUsed(ctx context.Context, id string, at time.Time) error {
+	credential, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	credential.LastUsedAt = &at
+	return nil
+}
+
+func (d *credentialDaoMock) ExpiringBefore(ctx context.Context, cutoff time.Time) (CredentialList, error) {
+	var expiring CredentialList
+	for _, credential := range d.credentials {
+		if !credential.isOAuth() && credential.ExpiresAt != nil && !credential.ExpiresAt.After(cutoff) && credential.ExpiryNotifiedAt == nil {
+			expiring = append(expiring, credential)
+		}
+	}
+	return expiring, nil
+}
+
+func (d *credentialDaoMock) MarkExpiryNotified(ctx context.Context, id string, at time.Time) error {
+	credential, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	credential.ExpiryNotifiedAt = &at
+	return nil
+}
+
+func (d *credentialDaoMock) BoundAgentIDs(ctx context.Context, id string) ([]string, error) {
+	return d.boundAgents[id], nil
+}
+
+func (d *credentialDaoMock) StaleSecrets(ctx context.Context, activeVersion int, afterID string, limit int) (CredentialList, error) {
+	var stale CredentialList
+	for _, credential := range d.credentials {
+		if credential.ID <= afterID || len(stale) >= limit {
+			continue
+		}
+		for _, secret := range []*string{credential.Token, credential.OAuthGrant} {
+			if secret == nil || *secret == "" {
+				continue
+			}
+			if v, ok := crypto.TokenVersion(*secret); ok && v != activeVersion {
+				stale = append(stale, credential)
+				break
+			}
+		}
+	}
+	return stale, nil
+}
+
+func (d *credentialDaoMock) SwapSecret(ctx context.Context, id, column, old, replacement string) (bool, error) {
+	credential, err := d.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	secret := &credential.Token
+	if column == secretColumnOAuthGrant {
+		secret = &credential.OAuthGrant
+	}
+	if *secret == nil || **secret != old {
+		return false, nil
+	}
+	*secret = &replacement
+	return true, nil
+}
+
+func (d *credentialDaoMock) StoreRefresh(ctx context.Context, id, token string, expiresAt *time.Time, grant *string) error {
+	credential, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	credential.Token = &token
+	credential.ExpiresAt = expiresAt
+	if grant != nil {
+		credential.OAuthGrant = grant
+	}
+	return nil
+}
+
+func (d *credentialDaoMock) CountSecretsByKeyVersion(ctx context.Context) ([]SecretVersionCount, error) {
+	index := map[[2]string]int{}
+	var counts []SecretVersionCount
+	for _, credential := range d.credentials {
+		for column, secret := range map[string]*string{secretColumnToken: credential.Token, secretColumnOAuthGrant: credential.OAuthGrant} {
+			if secret == nil || *secret == "" {
+				continue
+			}
+			var version *string
+			if v, ok := crypto.TokenVersion(*secret); ok {
+				s := strconv.Itoa(v)
+				version = &s
+			}
+			key := [2]string{column, ""}
+			if version != nil {
+				key[1] = *version
+			}
+			if i, ok := index[key]; ok {
+				counts[i].Count++
+				continue
+			}
+			index[key] = len(counts)
+			counts = append(counts, SecretVersionCount{Secret: column, Version: version, Count: 1})
+		}
+	}
+	return counts, nil
+}
@@ -1,6 +1,8 @@
 package credentials
 
 import (
+	"time"
+
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"gorm.io/gorm"
 )
@@ -15,6 +17,19 @@ type Credential struct {
 	Email       *string `json:"email"`
 	Labels      *string `json:"labels"`
 	Annotations *string `json:"annotations"`
+	// AuthType is "token" for a stored secret or "oauth" for an access
+	// token minted on demand from OAuthGrant.
+	AuthType string `json:"auth_type" gorm:"default:token"`
+	// OAuthGrant is the JSON-encoded OAuthGrant, encrypted at rest like
+	// Token. For OAuth credentials Token caches the last access token.
+	OAuthGrant *string `json:"-" gorm:"column:oauth_grant"`
+
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RotatedAt  *time.Time `json:"rotated_at"`
+	// ExpiryNotifiedAt marks a credential the expiry notifier has already
+	// warned bound agents about. Rotating or moving the expiry clears it.
+	ExpiryNotifiedAt *time.Time `json:"expiry_notified_at"`
 }
 
 type CredentialList []*Credential
@@ -35,13 +50,87 @@ func (d *Credential) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// rotate swaps in a new token and re-arms the expiry notifier.
+func (d *Credential) rotate(token string, now time.Time) {
+	d.Token = &token
+	d.RotatedAt = &now
+	d.ExpiryNotifiedAt = nil
+}
+
+// setExpiry moves the expiry, re-arming the expiry notifier if it changed.
+func (d *Credential) setExpiry(expiresAt *time.Time) {
+	changed := (d.ExpiresAt == nil) != (expiresAt == nil) ||
+		(d.ExpiresAt != nil && !d.ExpiresAt.Equal(*expiresAt))
+	if changed {
+		d.ExpiryNotifiedAt = nil
+	}
+	d.ExpiresAt = expiresAt
+}
+
+func (d *Credential) isOAuth() bool {
+	return d.AuthType == AuthTypeOAuth
+}
+
+// setOAuthGrant stores a new grant and drops the cached access token so
+// the next fetch mints one from it.
+func (d *Credential) setOAuthGrant(grant *OAuthGrant) error {
+	raw, err := encodeOAuthGrant(grant)
+	if err != nil {
+		return err
+	}
+	d.OAuthGrant = &raw
+	d.Token = nil
+	d.ExpiresAt = nil
+	d.ExpiryNotifiedAt = nil
+	return nil
+}
+
 type CredentialPatchRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Provider    *string `json:"provider,omitempty"`
-	Token       *string `json:"token,omitempty"`
-	Url         *string `json:"url,omitempty"`
-	Email       *string `json:"email,omitempty"`
-	Labels      *string `json:"labels,omitempty"`
-	Annotations *string `json:"annotations,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Provider    *string    `json:"provider,omitempty"`
+	Token       *string    `json:"token,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	Labels      *string    `json:"labels,omitempty"`
+	Annotations *string    `json:"annotations,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	OauthClient  *string `json:"oauth_client,omitempty"`
+	OauthScopes  *string `json:"oauth_scopes,omitempty"`
+}
+
+// CredentialRotateRequest replaces a credential's token. ExpiresAt belongs
+// to the new token; leaving it out clears the old token's expiry.
+type CredentialRotateRequest struct {
+	Token     string     `json:"token"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CredentialSecretCount counts stored secrets of each kind.
+type CredentialSecretCount struct {
+	Tokens      int64 `json:"tokens"`
+	OAuthGrants int64 `json:"oauth_grants"`
+}
+
+// CredentialKeyVersionCount counts the secrets encrypted under one key
+// version. InKeyring is false for a version this server cannot decrypt.
+type CredentialKeyVersionCount struct {
+	Version   int  `json:"version"`
+	InKeyring bool `json:"in_keyring"`
+	CredentialSecretCount
+}
+
+// CredentialEncryptionStatus reports which key versions stored secrets use.
+// A retired key can be removed from the keyring once its version entry
+// counts nothing. Remaining counts encrypted secrets not yet on the active
+// key; plaintext secrets are counted apart.
+type CredentialEncryptionStatus struct {
+	Enabled         bool                        `json:"enabled"`
+	ActiveVersion   int                         `json:"active_version,omitempty"`
+	KeyringVersions []int                       `json:"keyring_versions"`
+	Versions        []CredentialKeyVersionCount `json:"versions"`
+	Plaintext       CredentialSecretCount       `json:"plaintext"`
+	Remaining       int64                       `json:"remaining"`
 }
@@ -0,0 +1,222 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	AuthTypeToken = "token"
+	AuthTypeOAuth = "oauth"
+)
+
+const (
+	// oauthRefreshSkew mints a new access token this long before the cached
+	// one expires, so a runner never receives a token about to lapse.
+	oauthRefreshSkew = 5 * time.Minute
+	oauthHTTPTimeout = 10 * time.Second
+	// oauthStoreTimeout bounds the reads and write of a refresh, which run
+	// outside the request transaction.
+	oauthStoreTimeout = 10 * time.Second
+)
+
+// OAuthGrant is what an OAuth credential needs to mint access tokens. It is
+// stored JSON-encoded and encrypted at rest, like the token.
+type OAuthGrant struct {
+	RefreshToken string   `json:"refresh_token"`
+	Client       string   `json:"client"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+// OAuthClient is an OAuth application registered with a provider. Grants
+// refer to it by name; its secret stays in the server's environment.
+type OAuthClient struct {
+	Name         string
+	Provider     string
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+}
+
+type oauthClientConfig struct {
+	provider        string
+	idEnv           string
+	secretEnv       string
+	tokenURLEnv     string
+	defaultTokenURL string
+}
+
+// oauthClients are the client references a grant may name. The GitHub
+// client is the GitHub App's, whose user tokens expire and refresh.
+var oauthClients = map[string]oauthClientConfig{
+	"github": {
+		provider:        "github",
+		idEnv:           "GITHUB_CLIENT_ID",
+		secretEnv:       "GITHUB_CLIENT_SECRET",
+		tokenURLEnv:     "GITHUB_OAUTH_TOKEN_URL",
+		defaultTokenURL: "https://github.com/login/oauth/access_token",
+	},
+	"gitlab": {
+		provider:        "gitlab",
+		idEnv:           "GITLAB_OAUTH_CLIENT_ID",
+		secretEnv:       "GITLAB_OAUTH_CLIENT_SECRET",
+		tokenURLEnv:     "GITLAB_OAUTH_TOKEN_URL",
+		defaultTokenURL: "https://gitlab.com/oauth/token",
+	},
+	"google": {
+		provider:        "google",
+		idEnv:           "GOOGLE_OAUTH_CLIENT_ID",
+		secretEnv:       "GOOGLE_OAUTH_CLIENT_SECRET",
+		tokenURLEnv:     "GOOGLE_OAUTH_TOKEN_URL",
+		defaultTokenURL: "https://oauth2.googleapis.com/token",
+	},
+}
+
+// OAuthClientNames lists the client references a grant may name.
+func OAuthClientNames() []string {
+	names := make([]string, 0, len(oauthClients))
+	for name := range oauthClients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupOAuthClient resolves a client reference against the environment.
+func LookupOAuthClient(name string) (*OAuthClient, error) {
+	cfg, ok := oauthClients[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown OAuth client %q", name)
+	}
+	clientID := os.Getenv(cfg.idEnv)
+	clientSecret := os.Getenv(cfg.secretEnv)
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("OAuth client %q not configured: set %s and %s", name, cfg.idEnv, cfg.secretEnv)
+	}
+	tokenURL := os.Getenv(cfg.tokenURLEnv)
+	if tokenURL == "" {
+		tokenURL = cfg.defaultTokenURL
+	}
+	return &OAuthClient{
+		Name:         name,
+		Provider:     cfg.provider,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}, nil
+}
+
+func oauthClientProvider(name string) (string, bool) {
+	cfg, ok := oauthClients[name]
+	return cfg.provider, ok
+}
+
+// OAuthTokenResponse is the provider's answer to a refresh_token grant.
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+	Error        string `json:"error"`
+}
+
+// refreshOAuthAccessToken exchanges a grant's refresh token for a new
+// access token.
+func refreshOAuthAccessToken(ctx context.Context, client *OAuthClient, grant *OAuthGrant) (*OAuthTokenResponse, error) {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {grant.RefreshToken},
+		"client_id":     {client.ClientID},
+		"client_secret": {client.ClientSecret},
+	}
+	if len(grant.Scopes) > 0 {
+		form.Set("scope", strings.Join(grant.Scopes, " "))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oauthHTTPTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub answers form-encoded unless asked for JSON.
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token exchange failed with status %d", resp.StatusCode)
+	}
+
+	var tokenResp OAuthTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	// GitHub reports a bad refresh token with 200 and an error field.
+	if tokenResp.Error != "" {
+		return nil, fmt.Errorf("token exchange failed: %s", tokenResp.Error)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token exchange returned no access token")
+	}
+	return &tokenResp, nil
+}
+
+// checkOAuthGrant reports what keeps a grant from minting tokens for a
+// credential of the given provider.
+func checkOAuthGrant(provider string, grant *OAuthGrant) error {
+	if grant.RefreshToken == "" {
+		return fmt.Errorf("refresh_token is required for OAuth credentials")
+	}
+	clientProvider, ok := oauthClientProvider(grant.Client)
+	if !ok {
+		return fmt.Errorf("oauth_client must be one of %s", strings.Join(OAuthClientNames(), ", "))
+	}
+	if clientProvider != provider {
+		return fmt.Errorf("oauth_client %q issues %s tokens, not %s", grant.Client, clientProvider, provider)
+	}
+	return nil
+}
+
+func encodeOAuthGrant(grant *OAuthGrant) (string, error) {
+	raw, err := json.Marshal(grant)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func decodeOAuthGrant(raw *string) (*OAuthGrant, error) {
+	if raw == nil || *raw == "" {
+		return nil, fmt.Errorf("credential has no OAuth grant")
+	}
+	var grant OAuthGrant
+	if err := json.Unmarshal([]byte(*raw), &grant); err != nil {
+		return nil, fmt.Errorf("decode OAuth grant: %w", err)
+	}
+	return &grant, nil
+}
+
+// parseScopes splits an OAuth scope string, which may be space- or
+// comma-separated.
+func parseScopes(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ',' })
+}
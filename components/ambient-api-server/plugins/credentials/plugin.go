@@ -1,9 +1,15 @@
 package credentials
 
 import (
+	"context"
 	"net/http"
+	"os"
+	"strconv"
+	"time"
 
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"github.com/openshift-online/rh-trex-ai/pkg/api/presenters"
@@ -15,6 +21,25 @@ import (
 	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
 	"github.com/openshift-online/rh-trex-ai/plugins/events"
 	"github.com/openshift-online/rh-trex-ai/plugins/generic"
+	"google.golang.org/grpc"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/inbox"
+)
+
+const EventSource = "Credentials"
+
+// Expiry notifier tuning, as Go durations: how often to look for expiring
+// credentials (default 15m) and how far ahead of expiry to warn (default 72h).
+const (
+	envExpiryCheckInterval = "CREDENTIAL_EXPIRY_CHECK_INTERVAL"
+	envExpiryWarningWindow = "CREDENTIAL_EXPIRY_WARNING_WINDOW"
+)
+
+// Re-encryptor rate limit: how often to run a batch (a Go duration, default
+// 1m) and how many credentials a batch may touch (default 50).
+const (
+	envReencryptInterval  = "CREDENTIAL_REENCRYPT_INTERVAL"
+	envReencryptBatchSize = "CREDENTIAL_REENCRYPT_BATCH_SIZE"
 )
 
 type ServiceLocator func() CredentialService
@@ -42,11 +67,62 @@ func Service(s *environments.Services) CredentialService {
 	return nil
 }
 
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		glog.Warningf("Ignoring invalid %s=%q; using %s", name, raw, def)
+		return def
+	}
+	return d
+}
+
+func intFromEnv(name string, def int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		glog.Warningf("Ignoring invalid %s=%q; using %d", name, raw, def)
+		return def
+	}
+	return n
+}
+
 func init() {
 	registry.RegisterService("Credentials", func(env interface{}) interface{} {
 		return NewServiceLocator(env.(*environments.Env))
 	})
 
+	registry.RegisterService("CredentialExpiryNotifier", func(env interface{}) interface{} {
+		e := env.(*environments.Env)
+		return func() *ExpiryNotifier {
+			return NewExpiryNotifier(
+				NewServiceLocator(e)(),
+				inbox.Service(&e.Services),
+				db.NewAdvisoryLockFactory(e.Database.SessionFactory),
+				durationFromEnv(envExpiryCheckInterval, defaultExpiryCheckInterval),
+				durationFromEnv(envExpiryWarningWindow, defaultExpiryWarningWindow),
+			)
+		}
+	})
+
+	registry.RegisterService("CredentialReencryptor", func(env interface{}) interface{} {
+		e := env.(*environments.Env)
+		return func() *Reencryptor {
+			return NewReencryptor(
+				NewServiceLocator(e)(),
+				db.NewAdvisoryLockFactory(e.Database.SessionFactory),
+				durationFromEnv(envReencryptInterval, defaultReencryptInterval),
+				intFromEnv(envReencryptBatchSize, defaultReencryptBatchSize),
+			)
+		}
+	})
+
 	pkgserver.RegisterRoutes("credentials", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
 		envServices := services.(*environments.Services)
 		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
@@ -57,10 +133,12 @@ func init() {
 		credentialsRouter := apiV1Router.PathPrefix("/credentials").Subrouter()
 		credentialsRouter.HandleFunc("", credentialHandler.List).Methods(http.MethodGet)
 		credentialsRouter.HandleFunc("", credentialHandler.Create).Methods(http.MethodPost)
+		credentialsRouter.HandleFunc("/encryption", credentialHandler.EncryptionStatus).Methods(http.MethodGet)
 		credentialsRouter.HandleFunc("/{cred_id}", credentialHandler.Get).Methods(http.MethodGet)
 		credentialsRouter.HandleFunc("/{cred_id}", credentialHandler.Patch).Methods(http.MethodPatch)
 		credentialsRouter.HandleFunc("/{cred_id}", credentialHandler.Delete).Methods(http.MethodDelete)
 		credentialsRouter.HandleFunc("/{cred_id}/token", credentialHandler.GetToken).Methods(http.MethodGet)
+		credentialsRouter.HandleFunc("/{cred_id}/rotate", credentialHandler.Rotate).Methods(http.MethodPost)
 		credentialsRouter.Use(authMiddleware.AuthenticateAccountJWT)
 		credentialsRouter.Use(authzMiddleware.AuthorizeApi)
 
@@ -71,6 +149,7 @@ func init() {
 		projectCredRouter.HandleFunc("/{id}/credentials/{cred_id}", credentialHandler.Patch).Methods(http.MethodPatch)
 		projectCredRouter.HandleFunc("/{id}/credentials/{cred_id}", credentialHandler.Delete).Methods(http.MethodDelete)
 		projectCredRouter.HandleFunc("/{id}/credentials/{cred_id}/token", credentialHandler.GetToken).Methods(http.MethodGet)
+		projectCredRouter.HandleFunc("/{id}/credentials/{cred_id}/rotate", credentialHandler.Rotate).Methods(http.MethodPost)
 		projectCredRouter.Use(authMiddleware.AuthenticateAccountJWT)
 		projectCredRouter.Use(authzMiddleware.AuthorizeApi)
 	})
@@ -79,7 +158,7 @@ func init() {
 		credentialServices := Service(services.(*environments.Services))
 
 		manager.Add(&controllers.ControllerConfig{
-			Source: "Credentials",
+			Source: EventSource,
 			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
 				api.CreateEventType: {credentialServices.OnUpsert},
 				api.UpdateEventType: {credentialServices.OnUpsert},
@@ -88,11 +167,41 @@ func init() {
 		})
 	})
 
+	// The expiry notifier runs alongside the kind controllers in every
+	// replica; the advisory lock in ExpiryNotifier.Notify keeps each pass
+	// single-writer.
+	pkgserver.RegisterController("CredentialExpiryNotifier", func(_ *controllers.KindControllerManager, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		if obj := envServices.GetService("CredentialExpiryNotifier"); obj != nil {
+			go obj.(func() *ExpiryNotifier)().Run(context.Background())
+		}
+	})
+
+	pkgserver.RegisterController("CredentialReencryptor", func(_ *controllers.KindControllerManager, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		if obj := envServices.GetService("CredentialReencryptor"); obj != nil {
+			go obj.(func() *Reencryptor)().Run(context.Background())
+		}
+	})
+
 	presenters.RegisterPath(Credential{}, "credentials")
 	presenters.RegisterPath(&Credential{}, "credentials")
 	presenters.RegisterKind(Credential{}, "Credential")
 	presenters.RegisterKind(&Credential{}, "Credential")
 
+	pkgserver.RegisterGRPCService("credentials", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		credentialService := Service(envServices)
+		genericService := generic.Service(envServices)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
+		}
+		pb.RegisterCredentialServiceServer(grpcServer, NewCredentialGRPCHandler(credentialService, genericService, brokerFunc))
+	})
+
 	db.RegisterMigration(migration())
 	db.RegisterMigration(rolesMigration())
 	db.RegisterMigration(addProjectIDMigration())
@@ -101,4 +210,6 @@ func init() {
 	db.RegisterMigration(credentialOwnerRoleMigration())
 	db.RegisterMigration(credentialTokenPermMigration())
 	db.RegisterMigration(credentialOwnerRoleBindingPermMigration())
+	db.RegisterMigration(credentialLifecycleMigration())
+	db.RegisterMigration(credentialOAuthMigration())
 }
@@ -21,6 +21,11 @@ func ConvertCredential(credential openapi.Credential) *Credential {
 	c.Email = credential.Email
 	c.Labels = credential.Labels
 	c.Annotations = credential.Annotations
+	c.ExpiresAt = credential.ExpiresAt
+	c.AuthType = AuthTypeToken
+	if credential.AuthType != nil && *credential.AuthType != "" {
+		c.AuthType = *credential.AuthType
+	}
 
 	if credential.CreatedAt != nil {
 		c.CreatedAt = *credential.CreatedAt
@@ -35,18 +40,23 @@ func ConvertCredential(credential openapi.Credential) *Credential {
 func PresentCredential(credential *Credential) openapi.Credential {
 	reference := presenters.PresentReference(credential.ID, credential)
 	return openapi.Credential{
-		Id:          reference.Id,
-		Kind:        reference.Kind,
-		Href:        reference.Href,
-		CreatedAt:   openapi.PtrTime(credential.CreatedAt),
-		UpdatedAt:   openapi.PtrTime(credential.UpdatedAt),
-		Name:        credential.Name,
-		Description: credential.Description,
-		Provider:    credential.Provider,
-		Url:         credential.Url,
-		Email:       credential.Email,
-		Labels:      credential.Labels,
-		Annotations: credential.Annotations,
+		Id:               reference.Id,
+		Kind:             reference.Kind,
+		Href:             reference.Href,
+		CreatedAt:        openapi.PtrTime(credential.CreatedAt),
+		UpdatedAt:        openapi.PtrTime(credential.UpdatedAt),
+		Name:             credential.Name,
+		Description:      credential.Description,
+		Provider:         credential.Provider,
+		Url:              credential.Url,
+		Email:            credential.Email,
+		Labels:           credential.Labels,
+		Annotations:      credential.Annotations,
+		AuthType:         openapi.PtrString(credential.AuthType),
+		ExpiresAt:        credential.ExpiresAt,
+		LastUsedAt:       credential.LastUsedAt,
+		RotatedAt:        credential.RotatedAt,
+		ExpiryNotifiedAt: credential.ExpiryNotifiedAt,
 	}
 }
 
@@ -55,5 +65,6 @@ func PresentCredentialToken(credential *Credential) openapi.CredentialTokenRespo
 		CredentialId: credential.ID,
 		Provider:     credential.Provider,
 		Token:        util.NilToEmptyString(credential.Token),
+		ExpiresAt:    credential.ExpiresAt,
 	}
 }
@@ -0,0 +1,87 @@
+package credentials
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+const (
+	reencryptorLockID         = "reencryptor"
+	defaultReencryptInterval  = time.Minute
+	defaultReencryptBatchSize = 50
+)
+
+// Reencryptor moves credential secrets that reads have not touched onto the
+// active key, at most batchSize credentials per interval so a key rotation
+// never floods the database. It walks the table in ID order and starts over
+// once it reaches the end. Like the expiry notifier, every replica runs one
+// and a non-blocking advisory lock keeps each pass single-writer.
+type Reencryptor struct {
+	svc         CredentialService
+	lockFactory db.LockFactory
+	interval    time.Duration
+	batchSize   int
+	cursor      string
+}
+
+func NewReencryptor(svc CredentialService, lockFactory db.LockFactory, interval time.Duration, batchSize int) *Reencryptor {
+	if interval <= 0 {
+		interval = defaultReencryptInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+	return &Reencryptor{svc: svc, lockFactory: lockFactory, interval: interval, batchSize: batchSize}
+}
+
+// Run re-encrypts a batch every interval until ctx is cancelled.
+func (r *Reencryptor) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Reencrypt(ctx)
+		}
+	}
+}
+
+// Reencrypt moves one batch onto the active key, if this replica holds the
+// re-encryptor lock, and returns how many secrets moved. Progress is logged
+// whenever a batch moves anything.
+func (r *Reencryptor) Reencrypt(ctx context.Context) int {
+	if r.lockFactory != nil {
+		owner, acquired, err := r.lockFactory.NewNonBlockingLock(ctx, reencryptorLockID, credentialsLockType)
+		defer r.lockFactory.Unlock(ctx, owner)
+		if err != nil {
+			glog.Errorf("Credential re-encryptor: acquire lock: %v", err)
+			return 0
+		}
+		if !acquired {
+			return 0
+		}
+	}
+
+	moved, next, svcErr := r.svc.ReencryptStale(ctx, r.cursor, r.batchSize)
+	if svcErr != nil {
+		glog.Errorf("Credential re-encryptor: %v", svcErr)
+		return 0
+	}
+	r.cursor = next
+	if moved == 0 {
+		return 0
+	}
+
+	status, svcErr := r.svc.EncryptionStatus(ctx)
+	if svcErr != nil {
+		glog.Errorf("Credential re-encryptor: %v", svcErr)
+		return moved
+	}
+	glog.Infof("Credential re-encryptor moved %d secrets to key v%d; %d remaining", moved, status.ActiveVersion, status.Remaining)
+	return moved
+}
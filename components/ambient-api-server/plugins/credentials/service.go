@@ -3,6 +3,8 @@ package credentials
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/crypto"
@@ -45,6 +47,24 @@ type CredentialService interface {
 
 	FindByIDs(ctx context.Context, ids []string) (CredentialList, *errors.ServiceError)
 
+	// Rotate swaps the token under the credential's advisory lock, keeping
+	// its ID, and replaces the expiry with the new token's.
+	Rotate(ctx context.Context, id string, token string, expiresAt *time.Time) (*Credential, *errors.ServiceError)
+	// Token returns the credential with a token ready to hand out. For OAuth
+	// credentials it mints a fresh access token when the cached one is
+	// missing or about to expire, one refresh per credential at a time.
+	Token(ctx context.Context, id string) (*Credential, *errors.ServiceError)
+	MarkUsed(ctx context.Context, id string) *errors.ServiceError
+	ExpiringBefore(ctx context.Context, cutoff time.Time) (CredentialList, *errors.ServiceError)
+	MarkExpiryNotified(ctx context.Context, id string) *errors.ServiceError
+	BoundAgentIDs(ctx context.Context, id string) ([]string, *errors.ServiceError)
+
+	// ReencryptStale moves the secrets of up to limit credentials after
+	// afterID onto the active key. It returns how many secrets moved and the
+	// ID to resume from, which is empty once the end of the table is reached.
+	ReencryptStale(ctx context.Context, afterID string, limit int) (int, string, *errors.ServiceError)
+	EncryptionStatus(ctx context.Context) (*CredentialEncryptionStatus, *errors.ServiceError)
+
 	OnUpsert(ctx context.Context, id string) error
 	OnDelete(ctx context.Context, id string) error
 }
@@ -69,36 +89,102 @@ type sqlCredentialService struct {
 	sessionFactory *db.SessionFactory
 }
 
-func (s *sqlCredentialService) encryptToken(credential *Credential) *errors.ServiceError {
-	if s.keyring == nil || credential.Token == nil || *credential.Token == "" {
+// grantAAD binds the encrypted grant to its column as well as its row, so
+// it cannot be swapped into the token column and decrypted there.
+func grantAAD(credentialID string) string {
+	return credentialID + "/oauth_grant"
+}
+
+func (s *sqlCredentialService) encryptSecrets(credential *Credential) *errors.ServiceError {
+	if s.keyring == nil {
 		return nil
 	}
-	ciphertext, err := s.keyring.Encrypt(*credential.Token, credential.ID)
-	if err != nil {
-		return errors.GeneralError("encrypt credential token: %v", err)
+	if credential.Token != nil && *credential.Token != "" {
+		ciphertext, err := s.keyring.Encrypt(*credential.Token, credential.ID)
+		if err != nil {
+			return errors.GeneralError("encrypt credential token: %v", err)
+		}
+		credential.Token = &ciphertext
+	}
+	if credential.OAuthGrant != nil && *credential.OAuthGrant != "" {
+		ciphertext, err := s.keyring.Encrypt(*credential.OAuthGrant, grantAAD(credential.ID))
+		if err != nil {
+			return errors.GeneralError("encrypt credential OAuth grant: %v", err)
+		}
+		credential.OAuthGrant = &ciphertext
 	}
-	credential.Token = &ciphertext
 	return nil
 }
 
-func (s *sqlCredentialService) decryptToken(credential *Credential) *errors.ServiceError {
-	if s.keyring == nil || credential.Token == nil || *credential.Token == "" {
+func (s *sqlCredentialService) decryptSecrets(credential *Credential) *errors.ServiceError {
+	if s.keyring == nil {
 		return nil
 	}
-	if !crypto.IsEncrypted(*credential.Token) {
-		return nil
+	if credential.Token != nil && crypto.IsEncrypted(*credential.Token) {
+		plaintext, err := s.keyring.Decrypt(*credential.Token, credential.ID)
+		if err != nil {
+			return errors.GeneralError("decrypt credential token: %v", err)
+		}
+		credential.Token = &plaintext
 	}
-	plaintext, err := s.keyring.Decrypt(*credential.Token, credential.ID)
-	if err != nil {
-		return errors.GeneralError("decrypt credential token: %v", err)
+	if credential.OAuthGrant != nil && crypto.IsEncrypted(*credential.OAuthGrant) {
+		plaintext, err := s.keyring.Decrypt(*credential.OAuthGrant, grantAAD(credential.ID))
+		if err != nil {
+			return errors.GeneralError("decrypt credential OAuth grant: %v", err)
+		}
+		credential.OAuthGrant = &plaintext
 	}
-	credential.Token = &plaintext
 	return nil
 }
 
+// reencryptStale moves a stored credential's secrets from older key
+// versions onto the active key. Each column is swapped only if it still
+// holds what was read, so a concurrent write is never overwritten.
+func (s *sqlCredentialService) reencryptStale(ctx context.Context, stored *Credential) (int, error) {
+	if s.keyring == nil {
+		return 0, nil
+	}
+	secrets := []struct {
+		column string
+		value  *string
+		aad    string
+	}{
+		{secretColumnToken, stored.Token, stored.ID},
+		{secretColumnOAuthGrant, stored.OAuthGrant, grantAAD(stored.ID)},
+	}
+
+	moved := 0
+	for _, secret := range secrets {
+		if secret.value == nil || *secret.value == "" {
+			continue
+		}
+		// Plaintext secrets are left to encrypt-credentials.
+		version, ok := crypto.TokenVersion(*secret.value)
+		if !ok || version == s.keyring.ActiveVersion() {
+			continue
+		}
+		plaintext, err := s.keyring.Decrypt(*secret.value, secret.aad)
+		if err != nil {
+			return moved, fmt.Errorf("decrypt %s of credential %s: %w", secret.column, stored.ID, err)
+		}
+		ciphertext, err := s.keyring.Encrypt(plaintext, secret.aad)
+		if err != nil {
+			return moved, fmt.Errorf("encrypt %s of credential %s: %w", secret.column, stored.ID, err)
+		}
+		swapped, err := s.credentialDao.SwapSecret(ctx, stored.ID, secret.column, *secret.value, ciphertext)
+		if err != nil {
+			return moved, fmt.Errorf("store %s of credential %s: %w", secret.column, stored.ID, err)
+		}
+		if swapped {
+			moved++
+		}
+	}
+	return moved, nil
+}
+
 func (s *sqlCredentialService) decryptList(credentials CredentialList) *errors.ServiceError {
 	for _, c := range credentials {
-		if err := s.decryptToken(c); err != nil {
+		if err := s.decryptSecrets(c); err != nil {
 			return err
 		}
 	}
@@ -129,7 +215,12 @@ func (s *sqlCredentialService) Get(ctx context.Context, id string) (*Credential,
 	if err != nil {
 		return nil, services.HandleGetError("Credential", "id", id, err)
 	}
-	if svcErr := s.decryptToken(credential); svcErr != nil {
+	// Reads move secrets off retired keys as they go; the re-encryptor
+	// catches credentials nobody reads. Failing here must not fail the read.
+	if _, err := s.reencryptStale(ctx, credential); err != nil {
+		glog.Warningf("Credential re-encryption on read: %v", err)
+	}
+	if svcErr := s.decryptSecrets(credential); svcErr != nil {
 		return nil, svcErr
 	}
 	return credential, nil
@@ -139,7 +230,10 @@ func (s *sqlCredentialService) Create(ctx context.Context, credential *Credentia
 	if credential.ID == "" {
 		credential.ID = api.NewID()
 	}
-	if svcErr := s.encryptToken(credential); svcErr != nil {
+	if credential.AuthType == "" {
+		credential.AuthType = AuthTypeToken
+	}
+	if svcErr := s.encryptSecrets(credential); svcErr != nil {
 		return nil, svcErr
 	}
 	credential, err := s.credentialDao.Create(ctx, credential)
@@ -216,7 +310,7 @@ func (s *sqlCredentialService) Replace(ctx context.Context, credential *Credenti
 		}
 	}
 
-	if svcErr := s.encryptToken(credential); svcErr != nil {
+	if svcErr := s.encryptSecrets(credential); svcErr != nil {
 		return nil, svcErr
 	}
 	credential, err := s.credentialDao.Replace(ctx, credential)
@@ -238,6 +332,258 @@ func (s *sqlCredentialService) Replace(ctx context.Context, credential *Credenti
 	return credential, nil
 }
 
+func (s *sqlCredentialService) Rotate(ctx context.Context, id string, token string, expiresAt *time.Time) (*Credential, *errors.ServiceError) {
+	if !DisableAdvisoryLock {
+		lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, credentialsLockType)
+		if err != nil {
+			return nil, errors.DatabaseAdvisoryLock(err)
+		}
+		defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	}
+
+	credential, err := s.credentialDao.Get(ctx, id)
+	if err != nil {
+		return nil, services.HandleGetError("Credential", "id", id, err)
+	}
+	if credential.isOAuth() {
+		return nil, errors.Validation("OAuth credentials mint their own tokens; patch refresh_token instead")
+	}
+	credential.rotate(token, time.Now().UTC())
+	credential.setExpiry(expiresAt)
+
+	if svcErr := s.encryptSecrets(credential); svcErr != nil {
+		return nil, svcErr
+	}
+	credential, err = s.credentialDao.Replace(ctx, credential)
+	if err != nil {
+		return nil, services.HandleUpdateError("Credential", err)
+	}
+
+	if s.events != nil {
+		_, evErr := s.events.Create(ctx, &api.Event{
+			Source:    "Credentials",
+			SourceID:  credential.ID,
+			EventType: api.UpdateEventType,
+		})
+		if evErr != nil {
+			return nil, services.HandleUpdateError("Credential", evErr)
+		}
+	}
+
+	return credential, nil
+}
+
+func (s *sqlCredentialService) Token(ctx context.Context, id string) (*Credential, *errors.ServiceError) {
+	// Reads and the refresh store run outside the request transaction,
+	// which only commits after Token returns and the lock is gone. Inside
+	// it, the next waiter would read the pre-refresh row and refresh again,
+	// and a re-encryption on read would hold the row against the store.
+	storeCtx, cancel := context.WithTimeout(context.Background(), oauthStoreTimeout)
+	defer cancel()
+
+	credential, svcErr := s.Get(storeCtx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !credential.isOAuth() || oauthTokenFresh(credential, time.Now()) {
+		return credential, nil
+	}
+
+	// Concurrent fetches queue on the lock; all but the first find the
+	// token it minted. Refreshing twice would burn single-use refresh
+	// tokens at providers that rotate them.
+	if !DisableAdvisoryLock {
+		lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, credentialsLockType)
+		if err != nil {
+			return nil, errors.DatabaseAdvisoryLock(err)
+		}
+		defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	}
+
+	credential, svcErr = s.Get(storeCtx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	now := time.Now().UTC()
+	if oauthTokenFresh(credential, now) {
+		return credential, nil
+	}
+
+	grant, err := decodeOAuthGrant(credential.OAuthGrant)
+	if err != nil {
+		return nil, errors.GeneralError("credential %s: %v", id, err)
+	}
+	client, err := LookupOAuthClient(grant.Client)
+	if err != nil {
+		return nil, errors.GeneralError("credential %s: %v", id, err)
+	}
+	resp, err := refreshOAuthAccessToken(ctx, client, grant)
+	if err != nil {
+		return nil, errors.GeneralError("credential %s: refresh %s access token: %v", id, client.Name, err)
+	}
+
+	credential.Token = &resp.AccessToken
+	credential.ExpiresAt = nil
+	if resp.ExpiresIn > 0 {
+		expiresAt := now.Add(time.Duration(resp.ExpiresIn) * time.Second)
+		credential.ExpiresAt = &expiresAt
+	}
+	rotatedGrant := false
+	if resp.RefreshToken != "" && resp.RefreshToken != grant.RefreshToken {
+		grant.RefreshToken = resp.RefreshToken
+		raw, err := encodeOAuthGrant(grant)
+		if err != nil {
+			return nil, errors.GeneralError("credential %s: %v", id, err)
+		}
+		credential.OAuthGrant = &raw
+		rotatedGrant = true
+	}
+
+	minted := *credential
+	if svcErr := s.encryptSecrets(credential); svcErr != nil {
+		return nil, svcErr
+	}
+	var storedGrant *string
+	if rotatedGrant {
+		storedGrant = credential.OAuthGrant
+	}
+	if err := s.credentialDao.StoreRefresh(storeCtx, id, *credential.Token, credential.ExpiresAt, storedGrant); err != nil {
+		return nil, services.HandleUpdateError("Credential", err)
+	}
+	return &minted, nil
+}
+
+// oauthTokenFresh reports whether the cached access token can be handed
+// out as is. Tokens the provider gave no lifetime never go stale.
+func oauthTokenFresh(credential *Credential, now time.Time) bool {
+	if credential.Token == nil || *credential.Token == "" {
+		return false
+	}
+	return credential.ExpiresAt == nil || credential.ExpiresAt.After(now.Add(oauthRefreshSkew))
+}
+
+func (s *sqlCredentialService) MarkUsed(ctx context.Context, id string) *errors.ServiceError {
+	if err := s.credentialDao.MarkUsed(ctx, id, time.Now().UTC()); err != nil {
+		return errors.GeneralError("Unable to record credential use: %s", err)
+	}
+	return nil
+}
+
+// ExpiringBefore returns credentials due to be warned about, without their
+// tokens: the notifier only needs their metadata.
+func (s *sqlCredentialService) ExpiringBefore(ctx context.Context, cutoff time.Time) (CredentialList, *errors.ServiceError) {
+	credentials, err := s.credentialDao.ExpiringBefore(ctx, cutoff)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to list expiring credentials: %s", err)
+	}
+	for _, c := range credentials {
+		c.Token = nil
+		c.OAuthGrant = nil
+	}
+	return credentials, nil
+}
+
+func (s *sqlCredentialService) MarkExpiryNotified(ctx context.Context, id string) *errors.ServiceError {
+	if err := s.credentialDao.MarkExpiryNotified(ctx, id, time.Now().UTC()); err != nil {
+		return errors.GeneralError("Unable to mark credential %s expiring: %s", id, err)
+	}
+	return nil
+}
+
+func (s *sqlCredentialService) BoundAgentIDs(ctx context.Context, id string) ([]string, *errors.ServiceError) {
+	agentIDs, err := s.credentialDao.BoundAgentIDs(ctx, id)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find agents bound to credential %s: %s", id, err)
+	}
+	return agentIDs, nil
+}
+
+func (s *sqlCredentialService) ReencryptStale(ctx context.Context, afterID string, limit int) (int, string, *errors.ServiceError) {
+	if s.keyring == nil {
+		return 0, "", nil
+	}
+	stale, err := s.credentialDao.StaleSecrets(ctx, s.keyring.ActiveVersion(), afterID, limit)
+	if err != nil {
+		return 0, afterID, errors.GeneralError("Unable to find credentials to re-encrypt: %s", err)
+	}
+
+	moved := 0
+	for _, credential := range stale {
+		n, err := s.reencryptStale(ctx, credential)
+		moved += n
+		if err != nil {
+			glog.Errorf("Credential re-encryption: %v", err)
+		}
+	}
+
+	next := ""
+	if len(stale) == limit {
+		next = stale[len(stale)-1].ID
+	}
+	return moved, next, nil
+}
+
+func (s *sqlCredentialService) EncryptionStatus(ctx context.Context) (*CredentialEncryptionStatus, *errors.ServiceError) {
+	counts, err := s.credentialDao.CountSecretsByKeyVersion(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to count credential key versions: %s", err)
+	}
+
+	status := &CredentialEncryptionStatus{
+		Enabled:         s.keyring != nil,
+		KeyringVersions: []int{},
+		Versions:        []CredentialKeyVersionCount{},
+	}
+	byVersion := map[int]*CredentialSecretCount{}
+	if s.keyring != nil {
+		status.ActiveVersion = s.keyring.ActiveVersion()
+		status.KeyringVersions = s.keyring.Versions()
+		sort.Ints(status.KeyringVersions)
+		for _, v := range status.KeyringVersions {
+			byVersion[v] = &CredentialSecretCount{}
+		}
+	}
+
+	for _, c := range counts {
+		target := &status.Plaintext
+		current := false
+		if c.Version != nil {
+			v, err := strconv.Atoi(*c.Version)
+			if err != nil {
+				continue
+			}
+			if byVersion[v] == nil {
+				byVersion[v] = &CredentialSecretCount{}
+			}
+			target = byVersion[v]
+			current = status.Enabled && v == status.ActiveVersion
+		}
+		switch c.Secret {
+		case secretColumnToken:
+			target.Tokens += c.Count
+		case secretColumnOAuthGrant:
+			target.OAuthGrants += c.Count
+		}
+		if status.Enabled && c.Version != nil && !current {
+			status.Remaining += c.Count
+		}
+	}
+
+	inKeyring := map[int]bool{}
+	for _, v := range status.KeyringVersions {
+		inKeyring[v] = true
+	}
+	for v, count := range byVersion {
+		status.Versions = append(status.Versions, CredentialKeyVersionCount{
+			Version:               v,
+			InKeyring:             inKeyring[v],
+			CredentialSecretCount: *count,
+		})
+	}
+	sort.Slice(status.Versions, func(i, j int) bool { return status.Versions[i].Version < status.Versions[j].Version })
+	return status, nil
+}
+
 func (s *sqlCredentialService) Delete(ctx context.Context, id string) *errors.ServiceError {
 	if err := s.credentialDao.Delete(ctx, id); err != nil {
 		return services.HandleDeleteError("Credential", errors.GeneralError("Unable to delete credential: %s", err))
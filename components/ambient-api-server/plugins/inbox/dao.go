@@ -2,6 +2,7 @@ package inbox
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -17,6 +18,9 @@ type InboxMessageDao interface {
 	FindByIDs(ctx context.Context, ids []string) (InboxMessageList, error)
 	All(ctx context.Context) (InboxMessageList, error)
 	UnreadByAgentID(ctx context.Context, agentID string) (InboxMessageList, error)
+	// CreatedByAgentIDSince returns the agent's messages created at or after
+	// since, oldest first.
+	CreatedByAgentIDSince(ctx context.Context, agentID string, since time.Time) (InboxMessageList, error)
 }
 
 var _ InboxMessageDao = &sqlInboxMessageDao{}
@@ -91,3 +95,12 @@ func (d *sqlInboxMessageDao) UnreadByAgentID(ctx context.Context, agentID string
 	}
 	return messages, nil
 }
+
+func (d *sqlInboxMessageDao) CreatedByAgentIDSince(ctx context.Context, agentID string, since time.Time) (InboxMessageList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	messages := InboxMessageList{}
+	if err := g2.Where("agent_id = ? AND created_at >= ?", agentID, since).Order("created_at ASC").Limit(1000).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -2,6 +2,7 @@ package inbox
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -62,3 +63,13 @@ func (d *inboxMessageDaoMock) UnreadByAgentID(ctx context.Context, agentID strin
 	}
 	return result, nil
 }
+
+func (d *inboxMessageDaoMock) CreatedByAgentIDSince(ctx context.Context, agentID string, since time.Time) (InboxMessageList, error) {
+	var result InboxMessageList
+	for _, m := range d.inboxMessages {
+		if m.AgentId == agentID && !m.CreatedAt.Before(since) {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc"
 
 	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
 )
 
 func notImplemented(w http.ResponseWriter, _ *http.Request) {
@@ -31,9 +32,9 @@ var (
 	globalWatchSvcOnce sync.Once
 )
 
-func getGlobalWatchSvc() InboxWatchService {
+func getGlobalWatchSvc(env *environments.Env) InboxWatchService {
 	globalWatchSvcOnce.Do(func() {
-		globalWatchSvc = NewInboxWatchService()
+		globalWatchSvc = NewInboxWatchService(NewInboxMessageDao(&env.Database.SessionFactory), broker.Shared(env))
 	})
 	return globalWatchSvc
 }
@@ -41,7 +42,7 @@ func getGlobalWatchSvc() InboxWatchService {
 type ServiceLocator func() InboxMessageService
 
 func NewServiceLocator(env *environments.Env) ServiceLocator {
-	watchSvc := getGlobalWatchSvc()
+	watchSvc := getGlobalWatchSvc(env)
 	return func() InboxMessageService {
 		return NewInboxMessageService(
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
@@ -106,7 +107,7 @@ func init() {
 	presenters.RegisterKind(&InboxMessage{}, "InboxMessage")
 
 	pkgserver.RegisterGRPCService("inbox", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
-		pb.RegisterInboxServiceServer(grpcServer, NewInboxGRPCHandler(getGlobalWatchSvc()))
+		pb.RegisterInboxServiceServer(grpcServer, NewInboxGRPCHandler(getGlobalWatchSvc(environments.Environment())))
 	})
 
 	db.RegisterMigration(migration())
@@ -92,7 +92,7 @@ func (s *sqlInboxMessageService) Create(ctx context.Context, inboxMessage *Inbox
 	}
 
 	if s.watchSvc != nil {
-		s.watchSvc.Notify(inboxMessage)
+		s.watchSvc.Notify(ctx, inboxMessage)
 	}
 
 	return inboxMessage, nil
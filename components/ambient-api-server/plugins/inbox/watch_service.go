@@ -2,23 +2,85 @@ package inbox
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
 )
 
+// inboxChannel is the broker channel announcing new inbox messages as
+// "<agent_id>:<message_id>".
+const inboxChannel = "inbox_messages"
+
+// recentDeliveries bounds how many delivered message IDs are remembered per
+// watched agent to drop duplicates between notifications and resyncs.
+const recentDeliveries = 256
+
 type InboxWatchService interface {
 	Subscribe(ctx context.Context, agentID string) (<-chan *InboxMessage, func())
-	Notify(msg *InboxMessage)
+	Notify(ctx context.Context, msg *InboxMessage)
+}
+
+// agentCursor tracks what has been delivered for one watched agent.
+type agentCursor struct {
+	since  time.Time
+	recent map[string]struct{}
+	order  []string
+}
+
+func (c *agentCursor) seen(id string) bool {
+	_, ok := c.recent[id]
+	return ok
+}
+
+func (c *agentCursor) mark(msg *InboxMessage) {
+	if msg.CreatedAt.After(c.since) {
+		c.since = msg.CreatedAt
+	}
+	c.recent[msg.ID] = struct{}{}
+	c.order = append(c.order, msg.ID)
+	if len(c.order) > recentDeliveries {
+		delete(c.recent, c.order[0])
+		c.order = c.order[1:]
+	}
 }
 
 type inboxWatchService struct {
-	mu   sync.RWMutex
-	subs map[string][]chan *InboxMessage
+	dao     InboxMessageDao
+	broker  broker.Broker
+	mu      sync.RWMutex
+	subs    map[string][]chan *InboxMessage
+	cursors map[string]*agentCursor
+	// pending holds the message IDs announced per watched agent, and resync
+	// whether a broker reconnect asked for a catch-up. The read-back worker
+	// drains both, so the broker's delivery goroutine never blocks on the
+	// database.
+	pending map[string][]string
+	resync  bool
+	wake    chan struct{}
 }
 
-func NewInboxWatchService() InboxWatchService {
-	return &inboxWatchService{
-		subs: make(map[string][]chan *InboxMessage),
+// NewInboxWatchService returns a watch service that delivers to local
+// subscribers directly and reads messages announced by other replicas back
+// through dao. A nil b behaves like broker.NewLocalBroker.
+func NewInboxWatchService(dao InboxMessageDao, b broker.Broker) InboxWatchService {
+	if b == nil {
+		b = broker.NewLocalBroker()
+	}
+	s := &inboxWatchService{
+		dao:     dao,
+		broker:  b,
+		subs:    make(map[string][]chan *InboxMessage),
+		cursors: make(map[string]*agentCursor),
+		pending: make(map[string][]string),
+		wake:    make(chan struct{}, 1),
 	}
+	go s.readBackWorker()
+	b.Subscribe(inboxChannel, s)
+	return s
 }
 
 func (s *inboxWatchService) Subscribe(ctx context.Context, agentID string) (<-chan *InboxMessage, func()) {
@@ -26,6 +88,9 @@ func (s *inboxWatchService) Subscribe(ctx context.Context, agentID string) (<-ch
 
 	s.mu.Lock()
 	s.subs[agentID] = append(s.subs[agentID], ch)
+	if s.cursors[agentID] == nil {
+		s.cursors[agentID] = &agentCursor{since: time.Now(), recent: map[string]struct{}{}}
+	}
 	s.mu.Unlock()
 
 	var once sync.Once
@@ -38,9 +103,14 @@ func (s *inboxWatchService) Subscribe(ctx context.Context, agentID string) (<-ch
 				if sub == ch {
 					s.subs[agentID] = append(subs[:i], subs[i+1:]...)
 					close(ch)
-					return
+					break
 				}
 			}
+			if len(s.subs[agentID]) == 0 {
+				delete(s.subs, agentID)
+				delete(s.cursors, agentID)
+				delete(s.pending, agentID)
+			}
 		})
 	}
 
@@ -52,19 +122,118 @@ func (s *inboxWatchService) Subscribe(ctx context.Context, agentID string) (<-ch
 	return ch, remove
 }
 
-func (s *inboxWatchService) Notify(msg *InboxMessage) {
+func (s *inboxWatchService) Notify(ctx context.Context, msg *InboxMessage) {
+	s.fanOut(msg.AgentId, InboxMessageList{msg})
+
+	if err := s.broker.Publish(ctx, inboxChannel, msg.AgentId+":"+msg.ID); err != nil {
+		glog.Warningf("Inbox watch: notify other replicas of message %s: %v", msg.ID, err)
+	}
+}
+
+// Receive handles a message announced by another replica.
+func (s *inboxWatchService) Receive(payload string) {
+	agentID, id, ok := strings.Cut(payload, ":")
+	if !ok || s.dao == nil {
+		return
+	}
+
+	s.mu.Lock()
+	_, watched := s.subs[agentID]
+	if watched {
+		s.pending[agentID] = append(s.pending[agentID], id)
+	}
+	s.mu.Unlock()
+	if watched {
+		s.signal()
+	}
+}
+
+// Resync asks the worker to re-read the messages of every watched agent
+// created since the last delivery.
+func (s *inboxWatchService) Resync() {
+	if s.dao == nil {
+		return
+	}
+	s.mu.Lock()
+	s.resync = true
+	s.mu.Unlock()
+	s.signal()
+}
+
+func (s *inboxWatchService) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *inboxWatchService) readBackWorker() {
+	for range s.wake {
+		s.drainPending()
+	}
+}
+
+// drainPending reads back everything queued since the last call.
+func (s *inboxWatchService) drainPending() {
+	s.mu.Lock()
+	pending, resync := s.pending, s.resync
+	s.pending, s.resync = make(map[string][]string), false
+	s.mu.Unlock()
+
+	if resync {
+		s.catchUp()
+	}
+	for agentID, ids := range pending {
+		for _, id := range ids {
+			msg, err := s.dao.Get(context.Background(), id)
+			if err != nil {
+				glog.Errorf("Inbox watch: read message %s announced for agent %s: %v", id, agentID, err)
+				continue
+			}
+			s.fanOut(agentID, InboxMessageList{msg})
+		}
+	}
+}
+
+// catchUp re-reads the messages of every watched agent created since the
+// last delivery.
+func (s *inboxWatchService) catchUp() {
 	s.mu.RLock()
-	chans := make([]chan *InboxMessage, len(s.subs[msg.AgentId]))
-	copy(chans, s.subs[msg.AgentId])
+	since := make(map[string]time.Time, len(s.cursors))
+	for agentID, c := range s.cursors {
+		since[agentID] = c.since
+	}
 	s.mu.RUnlock()
 
-	for _, ch := range chans {
-		func() {
-			defer func() { _ = recover() }()
+	for agentID, t := range since {
+		msgs, err := s.dao.CreatedByAgentIDSince(context.Background(), agentID, t)
+		if err != nil {
+			glog.Errorf("Inbox watch: catch up agent %s: %v", agentID, err)
+			continue
+		}
+		s.fanOut(agentID, msgs)
+	}
+}
+
+func (s *inboxWatchService) fanOut(agentID string, msgs InboxMessageList) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chans := s.subs[agentID]
+	cursor := s.cursors[agentID]
+	if len(chans) == 0 || cursor == nil {
+		return
+	}
+	for _, msg := range msgs {
+		if cursor.seen(msg.ID) {
+			continue
+		}
+		cursor.mark(msg)
+		for _, ch := range chans {
 			select {
 			case ch <- msg:
 			default:
 			}
-		}()
+		}
 	}
 }
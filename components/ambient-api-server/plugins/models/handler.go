@@ -0,0 +1,31 @@
+package models
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+)
+
+type modelHandler struct {
+	models ModelService
+}
+
+func NewModelHandler(models ModelService) *modelHandler {
+	return &modelHandler{models: models}
+}
+
+// List returns the models the project may use, optionally filtered by
+// ?provider=.
+func (h modelHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID := mux.Vars(r)["id"]
+			provider := r.URL.Query().Get("provider")
+			return h.models.ListForProject(r.Context(), projectID, provider)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// DefaultManifestPath is where the ambient-models ConfigMap is mounted.
+	DefaultManifestPath = "/config/models/models.json"
+)
+
+// Generation parameters used when the manifest does not set them.
+const (
+	fallbackModel       = "claude-sonnet-4-6"
+	fallbackTemperature = 0.7
+	fallbackMaxTokens   = int32(4000)
+)
+
+// ManifestPath returns the filesystem path to the models manifest.
+// Defaults to DefaultManifestPath; override via MODELS_MANIFEST_PATH env var.
+func ManifestPath() string {
+	if p := os.Getenv("MODELS_MANIFEST_PATH"); p != "" {
+		return p
+	}
+	return DefaultManifestPath
+}
+
+// ModelEntry is a model in the manifest. The format is shared with the
+// backend and operator; the types are local to avoid importing either.
+type ModelEntry struct {
+	ID           string `json:"id"`
+	Label        string `json:"label"`
+	VertexID     string `json:"vertexId"`
+	Provider     string `json:"provider"`
+	Available    bool   `json:"available"`
+	FeatureGated bool   `json:"featureGated"`
+}
+
+// ModelManifest is the top-level manifest. DefaultTemperature and
+// DefaultMaxTokens are read only by the API server, which applies them to
+// sessions and agents that do not set their own.
+type ModelManifest struct {
+	Version            int               `json:"version"`
+	DefaultModel       string            `json:"defaultModel"`
+	ProviderDefaults   map[string]string `json:"providerDefaults,omitempty"`
+	DefaultTemperature *float64          `json:"defaultTemperature,omitempty"`
+	DefaultMaxTokens   *int32            `json:"defaultMaxTokens,omitempty"`
+	Models             []ModelEntry      `json:"models"`
+}
+
+// LoadManifest reads the model manifest from the given path on the filesystem
+// (mounted ConfigMap). No K8s API call required — the kubelet syncs the
+// ConfigMap volume automatically.
+func LoadManifest(path string) (*ModelManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest %s: %w", path, err)
+	}
+
+	var manifest ModelManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	return &manifest, nil
+}
+
+// cachedManifests stores the last manifest successfully loaded from each
+// path, so a transient read error falls back to the previous good version.
+var cachedManifests sync.Map
+
+// currentManifest reloads the manifest at path, falling back to the cached
+// copy when the read fails. It returns nil when no manifest has ever been
+// loaded from path (the ConfigMap is optional).
+func currentManifest(path string) *ModelManifest {
+	manifest, err := LoadManifest(path)
+	if err != nil {
+		cached, ok := cachedManifests.Load(path)
+		if !ok {
+			glog.V(2).Infof("No model manifest available: %v", err)
+			return nil
+		}
+		glog.Warningf("Failed to load model manifest, using last good copy: %v", err)
+		return cached.(*ModelManifest)
+	}
+	cachedManifests.Store(path, manifest)
+	return manifest
+}
+
+func (m *ModelManifest) entry(id string) (ModelEntry, bool) {
+	for _, e := range m.Models {
+		if e.ID == id {
+			return e, true
+		}
+	}
+	return ModelEntry{}, false
+}
+
+// isDefault reports whether id is the platform default or a provider
+// default. Defaults are always enabled.
+func (m *ModelManifest) isDefault(id string) bool {
+	if id == m.DefaultModel {
+		return true
+	}
+	for _, pd := range m.ProviderDefaults {
+		if id == pd {
+			return true
+		}
+	}
+	return false
+}
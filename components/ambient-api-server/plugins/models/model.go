@@ -0,0 +1,27 @@
+package models
+
+// Model is a catalog entry a project may use.
+type Model struct {
+	Id        string `json:"id"`
+	Label     string `json:"label"`
+	Provider  string `json:"provider"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// ModelList is the response for GET /projects/{id}/models. The defaults are
+// what sessions and agents get when they leave a field unset.
+type ModelList struct {
+	Kind               string  `json:"kind"`
+	DefaultModel       string  `json:"default_model"`
+	DefaultTemperature float64 `json:"default_temperature"`
+	DefaultMaxTokens   int32   `json:"default_max_tokens"`
+	Items              []Model `json:"items"`
+}
+
+// Defaults are the generation parameters applied to sessions and agents
+// that do not set their own.
+type Defaults struct {
+	Model       string
+	Temperature float64
+	MaxTokens   int32
+}
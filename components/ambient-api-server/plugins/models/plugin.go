@@ -0,0 +1,52 @@
+package models
+
+import (
+	"net/http"
+
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+	"github.com/openshift-online/rh-trex-ai/pkg/registry"
+	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+type ServiceLocator func() ModelService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	return func() ModelService {
+		return NewModelService(ManifestPath(), projectSettings.Service(&env.Services))
+	}
+}
+
+func Service(s *environments.Services) ModelService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("Models"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+func init() {
+	registry.RegisterService("Models", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	pkgserver.RegisterRoutes("models", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
+		envServices := services.(*environments.Services)
+		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
+			authzMiddleware = dbAuthz
+		}
+		modelHandler := NewModelHandler(Service(envServices))
+
+		projectsRouter := apiV1Router.PathPrefix("/projects").Subrouter()
+		projectsRouter.HandleFunc("/{id}/models", modelHandler.List).Methods(http.MethodGet)
+		projectsRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		projectsRouter.Use(authzMiddleware.AuthorizeApi)
+	})
+}
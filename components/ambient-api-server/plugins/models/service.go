@@ -0,0 +1,178 @@
+package models
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+// ModelService answers which models a project may use. The catalog is the
+// models manifest, re-read on every call so ConfigMap updates apply without
+// a restart; projects enable or disable entries through
+// ProjectSettings.model_overrides.
+type ModelService interface {
+	ListForProject(ctx context.Context, projectID, provider string) (*ModelList, *errors.ServiceError)
+	Validate(ctx context.Context, projectID, modelID string) *errors.ServiceError
+	Defaults() Defaults
+}
+
+func NewModelService(manifestPath string, settings projectSettings.ProjectSettingsService) ModelService {
+	return &manifestModelService{
+		manifestPath: manifestPath,
+		settings:     settings,
+	}
+}
+
+var _ ModelService = &manifestModelService{}
+
+type manifestModelService struct {
+	manifestPath string
+	settings     projectSettings.ProjectSettingsService
+}
+
+func (s *manifestModelService) ListForProject(ctx context.Context, projectID, provider string) (*ModelList, *errors.ServiceError) {
+	manifest := currentManifest(s.manifestPath)
+	if manifest == nil {
+		return nil, errors.GeneralError("Model manifest unavailable")
+	}
+	overrides, svcErr := s.overrides(ctx, projectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	// When filtering by provider, the provider's default is the default.
+	effectiveDefault := manifest.DefaultModel
+	if provider != "" {
+		if pd, ok := manifest.ProviderDefaults[provider]; ok {
+			effectiveDefault = pd
+		}
+	}
+
+	defaults := defaultsFrom(manifest)
+	list := &ModelList{
+		Kind:               "ModelList",
+		DefaultTemperature: defaults.Temperature,
+		DefaultMaxTokens:   defaults.MaxTokens,
+		Items:              []Model{},
+	}
+	for _, entry := range manifest.Models {
+		if provider != "" && entry.Provider != provider {
+			continue
+		}
+		if !enabled(manifest, entry, overrides) {
+			continue
+		}
+		list.Items = append(list.Items, Model{
+			Id:        entry.ID,
+			Label:     entry.Label,
+			Provider:  entry.Provider,
+			IsDefault: entry.ID == effectiveDefault,
+		})
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Label < list.Items[j].Label
+	})
+	if len(list.Items) > 0 {
+		list.DefaultModel = effectiveDefault
+	}
+	return list, nil
+}
+
+// Validate rejects models that are not in the catalog, not available, or
+// not enabled for the project. With no manifest mounted every model is
+// accepted, so a cluster without the ConfigMap keeps working.
+func (s *manifestModelService) Validate(ctx context.Context, projectID, modelID string) *errors.ServiceError {
+	manifest := currentManifest(s.manifestPath)
+	if manifest == nil {
+		glog.Warningf("No model manifest available, allowing model %q", modelID)
+		return nil
+	}
+	entry, ok := manifest.entry(modelID)
+	if !ok {
+		return errors.Validation("llm_model %q is not in the model catalog", modelID)
+	}
+	if !entry.Available {
+		return errors.Validation("llm_model %q is not available", modelID)
+	}
+	if manifest.isDefault(modelID) {
+		return nil
+	}
+	overrides, svcErr := s.overrides(ctx, projectID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if !enabled(manifest, entry, overrides) {
+		if projectID == "" {
+			return errors.Validation("llm_model %q is not enabled", modelID)
+		}
+		return errors.Validation("llm_model %q is not enabled for project %s", modelID, projectID)
+	}
+	return nil
+}
+
+// Defaults returns the manifest's default model and generation parameters,
+// falling back to built-in values for anything it does not set.
+func (s *manifestModelService) Defaults() Defaults {
+	return defaultsFrom(currentManifest(s.manifestPath))
+}
+
+func (s *manifestModelService) overrides(ctx context.Context, projectID string) (projectSettings.ModelOverrides, *errors.ServiceError) {
+	if projectID == "" || s.settings == nil {
+		return nil, nil
+	}
+	settings, svcErr := s.settings.AllByProjectId(ctx, projectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if len(settings) == 0 {
+		return nil, nil
+	}
+	overrides, err := projectSettings.ParseModelOverrides(settings[0].ModelOverrides)
+	if err != nil {
+		// Writes are validated, so this only happens to rows edited by hand.
+		glog.Warningf("Ignoring model overrides for project %s: %v", projectID, err)
+		return nil, nil
+	}
+	return overrides, nil
+}
+
+// enabled reports whether a project may use entry. Defaults are always
+// enabled; otherwise a project override wins, and without one only models
+// that are not feature gated are enabled.
+func enabled(manifest *ModelManifest, entry ModelEntry, overrides projectSettings.ModelOverrides) bool {
+	if !entry.Available {
+		return false
+	}
+	if manifest.isDefault(entry.ID) {
+		return true
+	}
+	if v, ok := overrides[entry.ID]; ok {
+		return v
+	}
+	return !entry.FeatureGated
+}
+
+func defaultsFrom(manifest *ModelManifest) Defaults {
+	d := Defaults{
+		Model:       fallbackModel,
+		Temperature: fallbackTemperature,
+		MaxTokens:   fallbackMaxTokens,
+	}
+	if manifest == nil {
+		return d
+	}
+	if manifest.DefaultModel != "" {
+		d.Model = manifest.DefaultModel
+	}
+	if manifest.DefaultTemperature != nil {
+		d.Temperature = *manifest.DefaultTemperature
+	}
+	if manifest.DefaultMaxTokens != nil {
+		d.MaxTokens = *manifest.DefaultMaxTokens
+	}
+	return d
+}
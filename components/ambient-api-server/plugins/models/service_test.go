@@ -0,0 +1,162 @@
+package models
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+const testManifest = `{
+  "version": 2,
+  "defaultModel": "claude-sonnet-4-6",
+  "providerDefaults": {"anthropic": "claude-sonnet-4-6", "google": "gemini-2.5-flash"},
+  "defaultTemperature": 0.2,
+  "defaultMaxTokens": 8000,
+  "models": [
+    {"id": "claude-sonnet-4-6", "label": "Claude Sonnet 4.6", "provider": "anthropic", "available": true, "featureGated": false},
+    {"id": "claude-haiku-4-5", "label": "Claude Haiku 4.5", "provider": "anthropic", "available": true, "featureGated": false},
+    {"id": "claude-opus-4-6", "label": "Claude Opus 4.6", "provider": "anthropic", "available": true, "featureGated": true},
+    {"id": "claude-opus-4-7", "label": "Claude Opus 4.7", "provider": "anthropic", "available": false, "featureGated": true},
+    {"id": "gemini-2.5-flash", "label": "Gemini 2.5 Flash", "provider": "google", "available": true, "featureGated": false}
+  ]
+}`
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "models.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	return path
+}
+
+func newTestService(t *testing.T, overrides map[string]string) ModelService {
+	t.Helper()
+	dao := projectSettings.NewMockProjectSettingsDao()
+	for projectID, raw := range overrides {
+		if _, err := dao.Create(context.Background(), &projectSettings.ProjectSettings{ProjectId: projectID, ModelOverrides: &raw}); err != nil {
+			t.Fatalf("seed settings: %v", err)
+		}
+	}
+	settings := projectSettings.NewProjectSettingsService(nil, dao, nil)
+	return NewModelService(writeManifest(t, testManifest), settings)
+}
+
+func ids(list *ModelList) []string {
+	out := make([]string, 0, len(list.Items))
+	for _, m := range list.Items {
+		out = append(out, m.Id)
+	}
+	return out
+}
+
+func TestListForProject_GatedModelsNeedOverride(t *testing.T) {
+	svc := newTestService(t, map[string]string{
+		"proj-opus": `{"claude-opus-4-6": true, "claude-haiku-4-5": false, "claude-opus-4-7": true}`,
+	})
+	ctx := context.Background()
+
+	list, err := svc.ListForProject(ctx, "proj-plain", "")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got, want := ids(list), []string{"claude-haiku-4-5", "claude-sonnet-4-6", "gemini-2.5-flash"}; !slices.Equal(got, want) {
+		t.Errorf("plain project models = %v, want %v", got, want)
+	}
+	if list.DefaultModel != "claude-sonnet-4-6" || list.DefaultTemperature != 0.2 || list.DefaultMaxTokens != 8000 {
+		t.Errorf("unexpected defaults: %+v", list)
+	}
+
+	list, err = svc.ListForProject(ctx, "proj-opus", "")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	// Overrides cannot enable an unavailable model.
+	if got, want := ids(list), []string{"claude-opus-4-6", "claude-sonnet-4-6", "gemini-2.5-flash"}; !slices.Equal(got, want) {
+		t.Errorf("overridden project models = %v, want %v", got, want)
+	}
+}
+
+func TestListForProject_ProviderFilter(t *testing.T) {
+	svc := newTestService(t, nil)
+
+	list, err := svc.ListForProject(context.Background(), "proj-1", "google")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got, want := ids(list), []string{"gemini-2.5-flash"}; !slices.Equal(got, want) {
+		t.Errorf("google models = %v, want %v", got, want)
+	}
+	if list.DefaultModel != "gemini-2.5-flash" || !list.Items[0].IsDefault {
+		t.Errorf("provider default not applied: %+v", list)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	svc := newTestService(t, map[string]string{
+		"proj-opus": `{"claude-opus-4-6": true, "claude-sonnet-4-6": false}`,
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		project string
+		model   string
+		ok      bool
+	}{
+		{"proj-plain", "claude-haiku-4-5", true},
+		{"proj-plain", "claude-opus-4-6", false},
+		{"proj-plain", "claude-opus-4-7", false},
+		{"proj-plain", "gpt-4o", false},
+		{"proj-opus", "claude-opus-4-6", true},
+		// The platform default cannot be disabled.
+		{"proj-opus", "claude-sonnet-4-6", true},
+		{"", "claude-opus-4-6", false},
+	}
+	for _, tt := range tests {
+		err := svc.Validate(ctx, tt.project, tt.model)
+		if tt.ok && err != nil {
+			t.Errorf("Validate(%q, %q) = %v, want nil", tt.project, tt.model, err)
+		}
+		if !tt.ok && (err == nil || err.HttpCode != 400) {
+			t.Errorf("Validate(%q, %q) = %v, want 400", tt.project, tt.model, err)
+		}
+	}
+}
+
+func TestNoManifest_FailsOpenWithFallbackDefaults(t *testing.T) {
+	svc := NewModelService(filepath.Join(t.TempDir(), "missing.json"), nil)
+	ctx := context.Background()
+
+	if err := svc.Validate(ctx, "proj-1", "anything"); err != nil {
+		t.Errorf("validate without manifest: %v", err)
+	}
+	d := svc.Defaults()
+	if d.Model != fallbackModel || d.Temperature != fallbackTemperature || d.MaxTokens != fallbackMaxTokens {
+		t.Errorf("unexpected fallback defaults: %+v", d)
+	}
+	if _, err := svc.ListForProject(ctx, "proj-1", ""); err == nil {
+		t.Error("list without manifest should fail")
+	}
+}
+
+func TestManifestReloadFallsBackToLastGoodCopy(t *testing.T) {
+	path := writeManifest(t, testManifest)
+	svc := NewModelService(path, nil)
+
+	if err := svc.Validate(context.Background(), "", "claude-haiku-4-5"); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("{not json"), 0o600); err != nil {
+		t.Fatalf("corrupt manifest: %v", err)
+	}
+	if err := svc.Validate(context.Background(), "", "gpt-4o"); err == nil {
+		t.Error("a corrupt manifest should fall back to the cached copy, not fail open")
+	}
+	if d := svc.Defaults(); d.MaxTokens != 8000 {
+		t.Errorf("defaults should come from the cached copy, got %+v", d)
+	}
+}
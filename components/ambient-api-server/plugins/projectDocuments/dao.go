@@ -0,0 +1,110 @@
+package projectDocuments
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+type ProjectDocumentDao interface {
+	Get(ctx context.Context, id string) (*ProjectDocument, error)
+	Create(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error)
+	Replace(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error)
+	// Delete removes the document together with its revisions.
+	Delete(ctx context.Context, id string) error
+	// AllByProjectID returns a project's documents ordered by title.
+	AllByProjectID(ctx context.Context, projectID string) (ProjectDocumentList, error)
+	CreateRevision(ctx context.Context, rev *ProjectDocumentRevision) (*ProjectDocumentRevision, error)
+	GetRevision(ctx context.Context, documentID string, revision int32) (*ProjectDocumentRevision, error)
+	// Revisions returns a document's revisions, newest first.
+	Revisions(ctx context.Context, documentID string) (ProjectDocumentRevisionList, error)
+}
+
+var _ ProjectDocumentDao = &sqlProjectDocumentDao{}
+
+type sqlProjectDocumentDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewProjectDocumentDao(sessionFactory *db.SessionFactory) ProjectDocumentDao {
+	return &sqlProjectDocumentDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlProjectDocumentDao) Get(ctx context.Context, id string) (*ProjectDocument, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var doc ProjectDocument
+	if err := g2.Take(&doc, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func (d *sqlProjectDocumentDao) Create(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(doc).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (d *sqlProjectDocumentDao) Replace(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Save(doc).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (d *sqlProjectDocumentDao) Delete(ctx context.Context, id string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Where("document_id = ?", id).Delete(&ProjectDocumentRevision{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	if err := g2.Omit(clause.Associations).Delete(&ProjectDocument{Meta: api.Meta{ID: id}}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlProjectDocumentDao) AllByProjectID(ctx context.Context, projectID string) (ProjectDocumentList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	docs := ProjectDocumentList{}
+	if err := g2.Where("project_id = ?", projectID).Order("title ASC, created_at ASC").Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (d *sqlProjectDocumentDao) CreateRevision(ctx context.Context, rev *ProjectDocumentRevision) (*ProjectDocumentRevision, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(rev).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return rev, nil
+}
+
+func (d *sqlProjectDocumentDao) GetRevision(ctx context.Context, documentID string, revision int32) (*ProjectDocumentRevision, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var rev ProjectDocumentRevision
+	if err := g2.Take(&rev, "document_id = ? AND revision = ?", documentID, revision).Error; err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (d *sqlProjectDocumentDao) Revisions(ctx context.Context, documentID string) (ProjectDocumentRevisionList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	revs := ProjectDocumentRevisionList{}
+	if err := g2.Where("document_id = ?", documentID).Order("revision DESC").Find(&revs).Error; err != nil {
+		return nil, err
+	}
+	return revs, nil
+}
@@ -0,0 +1,190 @@
+package projectDocuments
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+)
+
+var validIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+type projectDocumentHandler struct {
+	svc ProjectDocumentService
+}
+
+func NewProjectDocumentHandler(svc ProjectDocumentService) *projectDocumentHandler {
+	return &projectDocumentHandler{svc: svc}
+}
+
+func projectFromRequest(r *http.Request) (string, *errors.ServiceError) {
+	projectID := mux.Vars(r)["id"]
+	if !validIDPattern.MatchString(projectID) {
+		return "", errors.Validation("invalid project id")
+	}
+	return projectID, nil
+}
+
+func updatedBy(r *http.Request) *string {
+	if username := auth.GetUsernameFromContext(r.Context()); username != "" {
+		return &username
+	}
+	return nil
+}
+
+// List — GET /api/ambient/v1/projects/{id}/documents
+func (h *projectDocumentHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			docs, svcErr := h.svc.List(r.Context(), projectID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocumentList(docs), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get — GET /api/ambient/v1/projects/{id}/documents/{doc_id}
+func (h *projectDocumentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			doc, svcErr := h.svc.Get(r.Context(), projectID, mux.Vars(r)["doc_id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocument(doc), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create — POST /api/ambient/v1/projects/{id}/documents
+func (h *projectDocumentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req ProjectDocumentCreateRequest
+	cfg := &handlers.HandlerConfig{
+		Body: &req,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				if req.Title == "" {
+					return errors.Validation("title is required")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			doc, svcErr := h.svc.Create(r.Context(), &ProjectDocument{
+				ProjectId:    projectID,
+				Title:        req.Title,
+				Body:         req.Body,
+				ContentType:  req.ContentType,
+				Labels:       req.Labels,
+				StartContext: req.StartContext,
+				UpdatedBy:    updatedBy(r),
+			})
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocument(doc), nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Patch — PATCH /api/ambient/v1/projects/{id}/documents/{doc_id}
+// Fails with 409 when expected_revision is set and no longer current.
+func (h *projectDocumentHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	var patch ProjectDocumentPatchRequest
+	cfg := &handlers.HandlerConfig{
+		Body:       &patch,
+		Validators: []handlers.Validate{},
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			doc, svcErr := h.svc.Update(r.Context(), projectID, mux.Vars(r)["doc_id"], &patch, updatedBy(r))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocument(doc), nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete — DELETE /api/ambient/v1/projects/{id}/documents/{doc_id}
+func (h *projectDocumentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if svcErr := h.svc.Delete(r.Context(), projectID, mux.Vars(r)["doc_id"]); svcErr != nil {
+				return nil, svcErr
+			}
+			return nil, nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// ListRevisions — GET /api/ambient/v1/projects/{id}/documents/{doc_id}/revisions
+func (h *projectDocumentHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			revs, svcErr := h.svc.Revisions(r.Context(), projectID, mux.Vars(r)["doc_id"])
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocumentRevisionList(projectID, revs), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// GetRevision — GET /api/ambient/v1/projects/{id}/documents/{doc_id}/revisions/{revision}
+func (h *projectDocumentHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			projectID, svcErr := projectFromRequest(r)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			revision, err := strconv.ParseInt(mux.Vars(r)["revision"], 10, 32)
+			if err != nil || revision < 1 {
+				return nil, errors.Validation("revision must be a positive integer")
+			}
+			rev, svcErr := h.svc.Revision(r.Context(), projectID, mux.Vars(r)["doc_id"], int32(revision))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentProjectDocumentRevision(projectID, rev), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,51 @@
+package projectDocuments
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+func migration() *gormigrate.Migration {
+	type ProjectDocument struct {
+		db.Model
+		ProjectId    string `gorm:"not null;index"`
+		Title        string `gorm:"not null"`
+		Body         string `gorm:"type:text"`
+		ContentType  string `gorm:"not null"`
+		Labels       *string
+		StartContext bool  `gorm:"not null;default:false"`
+		Revision     int32 `gorm:"not null"`
+		UpdatedBy    *string
+	}
+	type ProjectDocumentRevision struct {
+		db.Model
+		DocumentId  string `gorm:"not null;index"`
+		Revision    int32  `gorm:"not null"`
+		Title       string `gorm:"not null"`
+		Body        string `gorm:"type:text"`
+		ContentType string `gorm:"not null"`
+		Labels      *string
+		UpdatedBy   *string
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170004",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ProjectDocument{}, &ProjectDocumentRevision{}); err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_project_document_revisions_document_revision ON project_document_revisions (document_id, revision)`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(`DROP INDEX IF EXISTS idx_project_document_revisions_document_revision`).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropTable(&ProjectDocumentRevision{}); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&ProjectDocument{})
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package projectDocuments
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+var _ ProjectDocumentDao = &projectDocumentDaoMock{}
+
+type projectDocumentDaoMock struct {
+	docs      ProjectDocumentList
+	revisions ProjectDocumentRevisionList
+}
+
+func NewMockProjectDocumentDao() *projectDocumentDaoMock {
+	return &projectDocumentDaoMock{}
+}
+
+func (d *projectDocumentDaoMock) Get(ctx context.Context, id string) (*ProjectDocument, error) {
+	for _, doc := range d.docs {
+		if doc.ID == id {
+			copied := *doc
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *projectDocumentDaoMock) Create(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error) {
+	if err := doc.BeforeCreate(nil); err != nil {
+		return nil, err
+	}
+	stored := *doc
+	d.docs = append(d.docs, &stored)
+	return doc, nil
+}
+
+func (d *projectDocumentDaoMock) Replace(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, error) {
+	for i, existing := range d.docs {
+		if existing.ID == doc.ID {
+			stored := *doc
+			d.docs[i] = &stored
+			return doc, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *projectDocumentDaoMock) Delete(ctx context.Context, id string) error {
+	kept := ProjectDocumentRevisionList{}
+	for _, rev := range d.revisions {
+		if rev.DocumentId != id {
+			kept = append(kept, rev)
+		}
+	}
+	d.revisions = kept
+	for i, doc := range d.docs {
+		if doc.ID == id {
+			d.docs = append(d.docs[:i], d.docs[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (d *projectDocumentDaoMock) AllByProjectID(ctx context.Context, projectID string) (ProjectDocumentList, error) {
+	result := ProjectDocumentList{}
+	for _, doc := range d.docs {
+		if doc.ProjectId == projectID {
+			copied := *doc
+			result = append(result, &copied)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Title < result[j].Title })
+	return result, nil
+}
+
+func (d *projectDocumentDaoMock) CreateRevision(ctx context.Context, rev *ProjectDocumentRevision) (*ProjectDocumentRevision, error) {
+	for _, existing := range d.revisions {
+		if existing.DocumentId == rev.DocumentId && existing.Revision == rev.Revision {
+			return nil, gorm.ErrDuplicatedKey
+		}
+	}
+	if err := rev.BeforeCreate(nil); err != nil {
+		return nil, err
+	}
+	stored := *rev
+	d.revisions = append(d.revisions, &stored)
+	return rev, nil
+}
+
+func (d *projectDocumentDaoMock) GetRevision(ctx context.Context, documentID string, revision int32) (*ProjectDocumentRevision, error) {
+	for _, rev := range d.revisions {
+		if rev.DocumentId == documentID && rev.Revision == revision {
+			copied := *rev
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *projectDocumentDaoMock) Revisions(ctx context.Context, documentID string) (ProjectDocumentRevisionList, error) {
+	result := ProjectDocumentRevisionList{}
+	for _, rev := range d.revisions {
+		if rev.DocumentId == documentID {
+			copied := *rev
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Revision > result[j].Revision })
+	return result, nil
+}
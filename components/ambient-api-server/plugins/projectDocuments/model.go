@@ -0,0 +1,79 @@
+package projectDocuments
+
+import (
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+)
+
+// Content types accepted for a document body.
+const (
+	ContentTypeMarkdown = "markdown"
+	ContentTypeText     = "text"
+)
+
+// ProjectDocument is a titled markdown or plain-text document owned by a
+// project. Revision starts at 1 and increases on every change; each revision
+// is kept in project_document_revisions. Documents with StartContext set are
+// included in the start prompt of every agent in the project.
+type ProjectDocument struct {
+	api.Meta
+	ProjectId    string  `json:"project_id"    gorm:"not null;index"`
+	Title        string  `json:"title"         gorm:"not null"`
+	Body         string  `json:"body"          gorm:"type:text"`
+	ContentType  string  `json:"content_type"  gorm:"not null"`
+	Labels       *string `json:"labels"`
+	StartContext bool    `json:"start_context" gorm:"not null;default:false"`
+	Revision     int32   `json:"revision"      gorm:"not null"`
+	UpdatedBy    *string `json:"updated_by"`
+}
+
+type ProjectDocumentList []*ProjectDocument
+
+func (d *ProjectDocument) BeforeCreate(tx *gorm.DB) error {
+	d.ID = api.NewID()
+	if d.ContentType == "" {
+		d.ContentType = ContentTypeMarkdown
+	}
+	return nil
+}
+
+// ProjectDocumentRevision is an immutable snapshot of a document as it was
+// written at a given revision.
+type ProjectDocumentRevision struct {
+	api.Meta
+	DocumentId  string  `json:"document_id"  gorm:"not null;index"`
+	Revision    int32   `json:"revision"     gorm:"not null"`
+	Title       string  `json:"title"        gorm:"not null"`
+	Body        string  `json:"body"         gorm:"type:text"`
+	ContentType string  `json:"content_type" gorm:"not null"`
+	Labels      *string `json:"labels"`
+	UpdatedBy   *string `json:"updated_by"`
+}
+
+type ProjectDocumentRevisionList []*ProjectDocumentRevision
+
+func (d *ProjectDocumentRevision) BeforeCreate(tx *gorm.DB) error {
+	d.ID = api.NewID()
+	return nil
+}
+
+// ProjectDocumentCreateRequest is the body of POST /projects/{id}/documents.
+type ProjectDocumentCreateRequest struct {
+	Title        string  `json:"title"`
+	Body         string  `json:"body"`
+	ContentType  string  `json:"content_type,omitempty"`
+	Labels       *string `json:"labels,omitempty"`
+	StartContext bool    `json:"start_context,omitempty"`
+}
+
+// ProjectDocumentPatchRequest is the body of PATCH
+// /projects/{id}/documents/{doc_id}. When ExpectedRevision is set the update
+// only applies if the document is still at that revision.
+type ProjectDocumentPatchRequest struct {
+	Title            *string `json:"title,omitempty"`
+	Body             *string `json:"body,omitempty"`
+	ContentType      *string `json:"content_type,omitempty"`
+	Labels           *string `json:"labels,omitempty"`
+	StartContext     *bool   `json:"start_context,omitempty"`
+	ExpectedRevision *int32  `json:"expected_revision,omitempty"`
+}
@@ -0,0 +1,92 @@
+package projectDocuments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+	"github.com/openshift-online/rh-trex-ai/pkg/registry"
+	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/agents"
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+)
+
+// startDocumentAdapter exposes the start-context documents of a project to
+// the agents plugin without it importing this package.
+type startDocumentAdapter struct {
+	svc ProjectDocumentService
+}
+
+func (a *startDocumentAdapter) StartDocuments(ctx context.Context, projectID string) ([]agents.StartDocument, error) {
+	docs, svcErr := a.svc.StartContext(ctx, projectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	out := make([]agents.StartDocument, 0, len(docs))
+	for _, d := range docs {
+		out = append(out, agents.StartDocument{Title: d.Title, Body: d.Body})
+	}
+	return out, nil
+}
+
+type ServiceLocator func() ProjectDocumentService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	return func() ProjectDocumentService {
+		return NewProjectDocumentService(
+			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
+			NewProjectDocumentDao(&env.Database.SessionFactory),
+		)
+	}
+}
+
+func Service(s *environments.Services) ProjectDocumentService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("ProjectDocuments"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+func init() {
+	registry.RegisterService("ProjectDocuments", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	registry.RegisterService("ProjectDocumentFetcher", func(env interface{}) interface{} {
+		locator := NewServiceLocator(env.(*environments.Env))
+		return func() agents.ProjectDocumentFetcher {
+			return &startDocumentAdapter{svc: locator()}
+		}
+	})
+
+	pkgserver.RegisterRoutes("projectDocuments", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
+		envServices := services.(*environments.Services)
+
+		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
+			authzMiddleware = dbAuthz
+		}
+
+		h := NewProjectDocumentHandler(Service(envServices))
+
+		documentsRouter := apiV1Router.PathPrefix("/projects/{id}/documents").Subrouter()
+		documentsRouter.HandleFunc("", h.List).Methods(http.MethodGet)
+		documentsRouter.HandleFunc("", h.Create).Methods(http.MethodPost)
+		documentsRouter.HandleFunc("/{doc_id}", h.Get).Methods(http.MethodGet)
+		documentsRouter.HandleFunc("/{doc_id}", h.Patch).Methods(http.MethodPatch)
+		documentsRouter.HandleFunc("/{doc_id}", h.Delete).Methods(http.MethodDelete)
+		documentsRouter.HandleFunc("/{doc_id}/revisions", h.ListRevisions).Methods(http.MethodGet)
+		documentsRouter.HandleFunc("/{doc_id}/revisions/{revision}", h.GetRevision).Methods(http.MethodGet)
+		documentsRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		documentsRouter.Use(authzMiddleware.AuthorizeApi)
+	})
+
+	db.RegisterMigration(migration())
+}
@@ -0,0 +1,125 @@
+package projectDocuments
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const projectDocumentsBasePath = "/api/ambient/v1/projects/"
+
+// ProjectDocumentResponse is the wire form of a ProjectDocument.
+type ProjectDocumentResponse struct {
+	Id           string     `json:"id"`
+	Kind         string     `json:"kind"`
+	Href         string     `json:"href"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	ProjectId    string     `json:"project_id"`
+	Title        string     `json:"title"`
+	Body         string     `json:"body"`
+	ContentType  string     `json:"content_type"`
+	Labels       *string    `json:"labels,omitempty"`
+	StartContext bool       `json:"start_context"`
+	Revision     int32      `json:"revision"`
+	UpdatedBy    *string    `json:"updated_by,omitempty"`
+}
+
+type ProjectDocumentListResponse struct {
+	Kind  string                    `json:"kind"`
+	Page  int32                     `json:"page"`
+	Size  int32                     `json:"size"`
+	Total int32                     `json:"total"`
+	Items []ProjectDocumentResponse `json:"items"`
+}
+
+// ProjectDocumentRevisionResponse is the wire form of a ProjectDocumentRevision.
+type ProjectDocumentRevisionResponse struct {
+	Id          string     `json:"id"`
+	Kind        string     `json:"kind"`
+	Href        string     `json:"href"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	DocumentId  string     `json:"document_id"`
+	Revision    int32      `json:"revision"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	ContentType string     `json:"content_type"`
+	Labels      *string    `json:"labels,omitempty"`
+	UpdatedBy   *string    `json:"updated_by,omitempty"`
+}
+
+type ProjectDocumentRevisionListResponse struct {
+	Kind  string                            `json:"kind"`
+	Page  int32                             `json:"page"`
+	Size  int32                             `json:"size"`
+	Total int32                             `json:"total"`
+	Items []ProjectDocumentRevisionResponse `json:"items"`
+}
+
+func documentHref(projectID, id string) string {
+	return projectDocumentsBasePath + url.PathEscape(projectID) + "/documents/" + url.PathEscape(id)
+}
+
+func PresentProjectDocument(doc *ProjectDocument) ProjectDocumentResponse {
+	createdAt, updatedAt := doc.CreatedAt, doc.UpdatedAt
+	return ProjectDocumentResponse{
+		Id:           doc.ID,
+		Kind:         "ProjectDocument",
+		Href:         documentHref(doc.ProjectId, doc.ID),
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+		ProjectId:    doc.ProjectId,
+		Title:        doc.Title,
+		Body:         doc.Body,
+		ContentType:  doc.ContentType,
+		Labels:       doc.Labels,
+		StartContext: doc.StartContext,
+		Revision:     doc.Revision,
+		UpdatedBy:    doc.UpdatedBy,
+	}
+}
+
+func PresentProjectDocumentList(docs ProjectDocumentList) ProjectDocumentListResponse {
+	list := ProjectDocumentListResponse{
+		Kind:  "ProjectDocumentList",
+		Page:  1,
+		Size:  int32(len(docs)),
+		Total: int32(len(docs)),
+		Items: make([]ProjectDocumentResponse, 0, len(docs)),
+	}
+	for _, d := range docs {
+		list.Items = append(list.Items, PresentProjectDocument(d))
+	}
+	return list
+}
+
+func PresentProjectDocumentRevision(projectID string, rev *ProjectDocumentRevision) ProjectDocumentRevisionResponse {
+	createdAt := rev.CreatedAt
+	return ProjectDocumentRevisionResponse{
+		Id:          rev.ID,
+		Kind:        "ProjectDocumentRevision",
+		Href:        fmt.Sprintf("%s/revisions/%d", documentHref(projectID, rev.DocumentId), rev.Revision),
+		CreatedAt:   &createdAt,
+		DocumentId:  rev.DocumentId,
+		Revision:    rev.Revision,
+		Title:       rev.Title,
+		Body:        rev.Body,
+		ContentType: rev.ContentType,
+		Labels:      rev.Labels,
+		UpdatedBy:   rev.UpdatedBy,
+	}
+}
+
+func PresentProjectDocumentRevisionList(projectID string, revs ProjectDocumentRevisionList) ProjectDocumentRevisionListResponse {
+	list := ProjectDocumentRevisionListResponse{
+		Kind:  "ProjectDocumentRevisionList",
+		Page:  1,
+		Size:  int32(len(revs)),
+		Total: int32(len(revs)),
+		Items: make([]ProjectDocumentRevisionResponse, 0, len(revs)),
+	}
+	for _, r := range revs {
+		list.Items = append(list.Items, PresentProjectDocumentRevision(projectID, r))
+	}
+	return list
+}
@@ -0,0 +1,241 @@
+package projectDocuments
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+const projectDocumentsLockType db.LockType = "project_documents"
+
+const (
+	// MaxBodyBytes bounds a single document body. Documents are context for
+	// people and agents, not a file store.
+	MaxBodyBytes   = 256 * 1024
+	maxTitleLength = 200
+)
+
+type ProjectDocumentService interface {
+	Get(ctx context.Context, projectID, id string) (*ProjectDocument, *errors.ServiceError)
+	List(ctx context.Context, projectID string) (ProjectDocumentList, *errors.ServiceError)
+	Create(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, *errors.ServiceError)
+	// Update applies patch and records a new revision when anything changed.
+	Update(ctx context.Context, projectID, id string, patch *ProjectDocumentPatchRequest, updatedBy *string) (*ProjectDocument, *errors.ServiceError)
+	Delete(ctx context.Context, projectID, id string) *errors.ServiceError
+	Revisions(ctx context.Context, projectID, id string) (ProjectDocumentRevisionList, *errors.ServiceError)
+	Revision(ctx context.Context, projectID, id string, revision int32) (*ProjectDocumentRevision, *errors.ServiceError)
+	// StartContext returns the project's documents flagged for inclusion in
+	// agent start prompts, ordered by title.
+	StartContext(ctx context.Context, projectID string) (ProjectDocumentList, *errors.ServiceError)
+}
+
+func NewProjectDocumentService(lockFactory db.LockFactory, dao ProjectDocumentDao) ProjectDocumentService {
+	return &sqlProjectDocumentService{
+		lockFactory: lockFactory,
+		dao:         dao,
+	}
+}
+
+var _ ProjectDocumentService = &sqlProjectDocumentService{}
+
+type sqlProjectDocumentService struct {
+	lockFactory db.LockFactory
+	dao         ProjectDocumentDao
+}
+
+func (s *sqlProjectDocumentService) Get(ctx context.Context, projectID, id string) (*ProjectDocument, *errors.ServiceError) {
+	doc, err := s.dao.Get(ctx, id)
+	if err != nil {
+		return nil, services.HandleGetError("ProjectDocument", "id", id, err)
+	}
+	// Documents of other projects are reported as missing rather than
+	// forbidden so their IDs cannot be probed across projects.
+	if doc.ProjectId != projectID {
+		return nil, errors.NotFound("ProjectDocument with id='%s' not found", id)
+	}
+	return doc, nil
+}
+
+func (s *sqlProjectDocumentService) List(ctx context.Context, projectID string) (ProjectDocumentList, *errors.ServiceError) {
+	docs, err := s.dao.AllByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to list project documents: %s", err)
+	}
+	return docs, nil
+}
+
+func (s *sqlProjectDocumentService) Create(ctx context.Context, doc *ProjectDocument) (*ProjectDocument, *errors.ServiceError) {
+	if doc.ContentType == "" {
+		doc.ContentType = ContentTypeMarkdown
+	}
+	if svcErr := validate(doc); svcErr != nil {
+		return nil, svcErr
+	}
+	doc.Revision = 1
+	created, err := s.dao.Create(ctx, doc)
+	if err != nil {
+		return nil, services.HandleCreateError("ProjectDocument", err)
+	}
+	if _, err := s.dao.CreateRevision(ctx, revisionOf(created)); err != nil {
+		return nil, services.HandleCreateError("ProjectDocumentRevision", err)
+	}
+	return created, nil
+}
+
+func (s *sqlProjectDocumentService) Update(ctx context.Context, projectID, id string, patch *ProjectDocumentPatchRequest, updatedBy *string) (*ProjectDocument, *errors.ServiceError) {
+	if s.lockFactory != nil {
+		lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, projectDocumentsLockType)
+		if err != nil {
+			return nil, errors.DatabaseAdvisoryLock(err)
+		}
+		defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	}
+
+	doc, svcErr := s.Get(ctx, projectID, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if patch.ExpectedRevision != nil && *patch.ExpectedRevision != doc.Revision {
+		return nil, errors.Conflict("project document %s is at revision %d; expected revision %d", id, doc.Revision, *patch.ExpectedRevision)
+	}
+
+	updated := *doc
+	if patch.Title != nil {
+		updated.Title = *patch.Title
+	}
+	if patch.Body != nil {
+		updated.Body = *patch.Body
+	}
+	if patch.ContentType != nil {
+		updated.ContentType = *patch.ContentType
+	}
+	if patch.Labels != nil {
+		updated.Labels = patch.Labels
+	}
+	if patch.StartContext != nil {
+		updated.StartContext = *patch.StartContext
+	}
+	if svcErr := validate(&updated); svcErr != nil {
+		return nil, svcErr
+	}
+	if !changed(doc, &updated) {
+		return doc, nil
+	}
+
+	updated.Revision = doc.Revision + 1
+	updated.UpdatedBy = updatedBy
+	saved, err := s.dao.Replace(ctx, &updated)
+	if err != nil {
+		return nil, services.HandleUpdateError("ProjectDocument", err)
+	}
+	if _, err := s.dao.CreateRevision(ctx, revisionOf(saved)); err != nil {
+		return nil, services.HandleCreateError("ProjectDocumentRevision", err)
+	}
+	return saved, nil
+}
+
+func (s *sqlProjectDocumentService) Delete(ctx context.Context, projectID, id string) *errors.ServiceError {
+	if _, svcErr := s.Get(ctx, projectID, id); svcErr != nil {
+		return svcErr
+	}
+	if err := s.dao.Delete(ctx, id); err != nil {
+		return services.HandleDeleteError("ProjectDocument", errors.GeneralError("Unable to delete project document: %s", err))
+	}
+	return nil
+}
+
+func (s *sqlProjectDocumentService) Revisions(ctx context.Context, projectID, id string) (ProjectDocumentRevisionList, *errors.ServiceError) {
+	if _, svcErr := s.Get(ctx, projectID, id); svcErr != nil {
+		return nil, svcErr
+	}
+	revs, err := s.dao.Revisions(ctx, id)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to list project document revisions: %s", err)
+	}
+	return revs, nil
+}
+
+func (s *sqlProjectDocumentService) Revision(ctx context.Context, projectID, id string, revision int32) (*ProjectDocumentRevision, *errors.ServiceError) {
+	if _, svcErr := s.Get(ctx, projectID, id); svcErr != nil {
+		return nil, svcErr
+	}
+	rev, err := s.dao.GetRevision(ctx, id, revision)
+	if err != nil {
+		return nil, services.HandleGetError("ProjectDocumentRevision", "revision", revision, err)
+	}
+	return rev, nil
+}
+
+func (s *sqlProjectDocumentService) StartContext(ctx context.Context, projectID string) (ProjectDocumentList, *errors.ServiceError) {
+	docs, svcErr := s.List(ctx, projectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	selected := ProjectDocumentList{}
+	for _, doc := range docs {
+		if doc.StartContext {
+			selected = append(selected, doc)
+		}
+	}
+	return selected, nil
+}
+
+func validate(doc *ProjectDocument) *errors.ServiceError {
+	title := strings.TrimSpace(doc.Title)
+	if title == "" {
+		return errors.Validation("title is required")
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return errors.Validation("title must be at most %d characters", maxTitleLength)
+	}
+	if len(doc.Body) > MaxBodyBytes {
+		return errors.Validation("body must be at most %d bytes", MaxBodyBytes)
+	}
+	if !utf8.ValidString(doc.Body) {
+		return errors.Validation("body must be valid UTF-8 text")
+	}
+	switch doc.ContentType {
+	case ContentTypeMarkdown, ContentTypeText:
+	default:
+		return errors.Validation("content_type must be %q or %q", ContentTypeMarkdown, ContentTypeText)
+	}
+	if doc.Labels != nil && *doc.Labels != "" {
+		var labels map[string]string
+		if err := json.Unmarshal([]byte(*doc.Labels), &labels); err != nil {
+			return errors.Validation("labels must be a JSON object of string values")
+		}
+	}
+	return nil
+}
+
+func changed(before, after *ProjectDocument) bool {
+	return before.Title != after.Title ||
+		before.Body != after.Body ||
+		before.ContentType != after.ContentType ||
+		before.StartContext != after.StartContext ||
+		derefString(before.Labels) != derefString(after.Labels)
+}
+
+func revisionOf(doc *ProjectDocument) *ProjectDocumentRevision {
+	return &ProjectDocumentRevision{
+		DocumentId:  doc.ID,
+		Revision:    doc.Revision,
+		Title:       doc.Title,
+		Body:        doc.Body,
+		ContentType: doc.ContentType,
+		Labels:      doc.Labels,
+		UpdatedBy:   doc.UpdatedBy,
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,149 @@
+package projectDocuments
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newTestService() *sqlProjectDocumentService {
+	return NewProjectDocumentService(nil, NewMockProjectDocumentDao()).(*sqlProjectDocumentService)
+}
+
+func str(s string) *string { return &s }
+
+func TestCreate_DefaultsAndFirstRevision(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	doc, err := svc.Create(ctx, &ProjectDocument{ProjectId: "p1", Title: "Runbook", Body: "# Deploy"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if doc.Revision != 1 || doc.ContentType != ContentTypeMarkdown {
+		t.Fatalf("got revision=%d content_type=%q, want 1/markdown", doc.Revision, doc.ContentType)
+	}
+	revs, err := svc.Revisions(ctx, "p1", doc.ID)
+	if err != nil {
+		t.Fatalf("revisions: %v", err)
+	}
+	if len(revs) != 1 || revs[0].Revision != 1 || revs[0].Body != "# Deploy" {
+		t.Fatalf("got %d revisions, want the initial snapshot", len(revs))
+	}
+}
+
+func TestCreate_Validation(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	cases := map[string]*ProjectDocument{
+		"empty title":      {ProjectId: "p1", Title: "  "},
+		"long title":       {ProjectId: "p1", Title: strings.Repeat("t", maxTitleLength+1)},
+		"oversized body":   {ProjectId: "p1", Title: "t", Body: strings.Repeat("x", MaxBodyBytes+1)},
+		"bad content type": {ProjectId: "p1", Title: "t", ContentType: "html"},
+		"bad labels":       {ProjectId: "p1", Title: "t", Labels: str(`["a"]`)},
+	}
+	for name, doc := range cases {
+		if _, err := svc.Create(ctx, doc); err == nil || err.HttpCode != http.StatusBadRequest {
+			t.Errorf("%s: got %v, want 400", name, err)
+		}
+	}
+}
+
+func TestUpdate_RecordsRevisions(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	doc, _ := svc.Create(ctx, &ProjectDocument{ProjectId: "p1", Title: "Plan", Body: "v1"})
+
+	updated, err := svc.Update(ctx, "p1", doc.ID, &ProjectDocumentPatchRequest{Body: str("v2")}, str("alice"))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Revision != 2 || updated.Body != "v2" || *updated.UpdatedBy != "alice" {
+		t.Fatalf("got revision=%d body=%q, want 2/v2", updated.Revision, updated.Body)
+	}
+
+	// A patch that changes nothing does not create a revision.
+	same, err := svc.Update(ctx, "p1", doc.ID, &ProjectDocumentPatchRequest{Body: str("v2")}, str("bob"))
+	if err != nil {
+		t.Fatalf("no-op update: %v", err)
+	}
+	if same.Revision != 2 {
+		t.Fatalf("no-op update bumped revision to %d", same.Revision)
+	}
+
+	revs, _ := svc.Revisions(ctx, "p1", doc.ID)
+	if len(revs) != 2 || revs[0].Revision != 2 || revs[1].Revision != 1 {
+		t.Fatalf("got %d revisions, want [2 1]", len(revs))
+	}
+	first, err := svc.Revision(ctx, "p1", doc.ID, 1)
+	if err != nil {
+		t.Fatalf("get revision: %v", err)
+	}
+	if first.Body != "v1" {
+		t.Errorf("revision 1 body = %q, want v1", first.Body)
+	}
+	if _, err := svc.Revision(ctx, "p1", doc.ID, 9); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Errorf("missing revision: got %v, want 404", err)
+	}
+}
+
+func TestUpdate_ExpectedRevision(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	doc, _ := svc.Create(ctx, &ProjectDocument{ProjectId: "p1", Title: "Plan", Body: "v1"})
+	stale := int32(3)
+	if _, err := svc.Update(ctx, "p1", doc.ID, &ProjectDocumentPatchRequest{Body: str("v2"), ExpectedRevision: &stale}, nil); err == nil || err.HttpCode != http.StatusConflict {
+		t.Fatalf("stale expected_revision: got %v, want 409", err)
+	}
+	current := int32(1)
+	if _, err := svc.Update(ctx, "p1", doc.ID, &ProjectDocumentPatchRequest{Body: str("v2"), ExpectedRevision: &current}, nil); err != nil {
+		t.Fatalf("matching expected_revision: %v", err)
+	}
+}
+
+func TestProjectScoping(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	doc, _ := svc.Create(ctx, &ProjectDocument{ProjectId: "p1", Title: "Secret plan"})
+	if _, err := svc.Get(ctx, "p2", doc.ID); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("cross-project get: got %v, want 404", err)
+	}
+	if err := svc.Delete(ctx, "p2", doc.ID); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("cross-project delete: got %v, want 404", err)
+	}
+	if err := svc.Delete(ctx, "p1", doc.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := svc.Revisions(ctx, "p1", doc.ID); err == nil || err.HttpCode != http.StatusNotFound {
+		t.Fatalf("revisions after delete: got %v, want 404", err)
+	}
+}
+
+func TestStartContext_SelectsFlaggedDocuments(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	for _, d := range []*ProjectDocument{
+		{ProjectId: "p1", Title: "Style guide", Body: "tabs", StartContext: true},
+		{ProjectId: "p1", Title: "Architecture", Body: "monolith", StartContext: true},
+		{ProjectId: "p1", Title: "Meeting notes", Body: "..."},
+		{ProjectId: "p2", Title: "Other", Body: "x", StartContext: true},
+	} {
+		if _, err := svc.Create(ctx, d); err != nil {
+			t.Fatalf("create %s: %v", d.Title, err)
+		}
+	}
+
+	docs, err := (&startDocumentAdapter{svc: svc}).StartDocuments(ctx, "p1")
+	if err != nil {
+		t.Fatalf("start documents: %v", err)
+	}
+	if len(docs) != 2 || docs[0].Title != "Architecture" || docs[1].Title != "Style guide" {
+		t.Fatalf("got %+v, want [Architecture Style guide]", docs)
+	}
+}
@@ -51,10 +51,27 @@ func (h *projectSettingsGRPCHandler) CreateProjectSettings(ctx context.Context,
 		return nil, err
 	}
 
+	if svcErr := validateResourceLimits(req.ResourceLimits); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateInactivityTimeout(req.InactivityTimeoutSeconds); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateModelOverrides(req.ModelOverrides); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateMonthlyBudget(req.MonthlyBudgetUsd); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
 	ps := &ProjectSettings{
-		ProjectId:    req.GetProjectId(),
-		GroupAccess:  req.GroupAccess,
-		Repositories: req.Repositories,
+		ProjectId:                req.GetProjectId(),
+		GroupAccess:              req.GroupAccess,
+		Repositories:             req.Repositories,
+		ResourceLimits:           req.ResourceLimits,
+		InactivityTimeoutSeconds: req.InactivityTimeoutSeconds,
+		ModelOverrides:           req.ModelOverrides,
+		MonthlyBudgetUsd:         req.MonthlyBudgetUsd,
 	}
 
 	created, svcErr := h.service.Create(ctx, ps)
@@ -70,6 +87,19 @@ func (h *projectSettingsGRPCHandler) UpdateProjectSettings(ctx context.Context,
 		return nil, err
 	}
 
+	if svcErr := validateResourceLimits(req.ResourceLimits); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateInactivityTimeout(req.InactivityTimeoutSeconds); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateModelOverrides(req.ModelOverrides); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+	if svcErr := validateMonthlyBudget(req.MonthlyBudgetUsd); svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
 	found, svcErr := h.service.Get(ctx, req.GetId())
 	if svcErr != nil {
 		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
@@ -84,6 +114,18 @@ func (h *projectSettingsGRPCHandler) UpdateProjectSettings(ctx context.Context,
 	if req.Repositories != nil {
 		found.Repositories = req.Repositories
 	}
+	if req.ResourceLimits != nil {
+		found.ResourceLimits = req.ResourceLimits
+	}
+	if req.InactivityTimeoutSeconds != nil {
+		found.InactivityTimeoutSeconds = req.InactivityTimeoutSeconds
+	}
+	if req.ModelOverrides != nil {
+		found.ModelOverrides = req.ModelOverrides
+	}
+	if req.MonthlyBudgetUsd != nil {
+		found.MonthlyBudgetUsd = req.MonthlyBudgetUsd
+	}
 
 	updated, svcErr := h.service.Replace(ctx, found)
 	if svcErr != nil {
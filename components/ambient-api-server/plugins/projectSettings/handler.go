@@ -1,6 +1,7 @@
 package projectSettings
 
 import (
+	"math"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -34,6 +35,18 @@ func (h projectSettingsHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Body: &ps,
 		Validators: []handlers.Validate{
 			handlers.ValidateEmpty(&ps, "Id", "id"),
+			func() *errors.ServiceError {
+				return validateResourceLimits(ps.ResourceLimits)
+			},
+			func() *errors.ServiceError {
+				return validateInactivityTimeout(ps.InactivityTimeoutSeconds)
+			},
+			func() *errors.ServiceError {
+				return validateModelOverrides(ps.ModelOverrides)
+			},
+			func() *errors.ServiceError {
+				return validateMonthlyBudget(ps.MonthlyBudgetUsd)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
@@ -54,8 +67,21 @@ func (h projectSettingsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	var patch openapi.ProjectSettingsPatchRequest
 
 	cfg := &handlers.HandlerConfig{
-		Body:       &patch,
-		Validators: []handlers.Validate{},
+		Body: &patch,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				return validateResourceLimits(patch.ResourceLimits)
+			},
+			func() *errors.ServiceError {
+				return validateInactivityTimeout(patch.InactivityTimeoutSeconds)
+			},
+			func() *errors.ServiceError {
+				return validateModelOverrides(patch.ModelOverrides)
+			},
+			func() *errors.ServiceError {
+				return validateMonthlyBudget(patch.MonthlyBudgetUsd)
+			},
+		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 			id := mux.Vars(r)["id"]
@@ -73,6 +99,18 @@ func (h projectSettingsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if patch.Repositories != nil {
 				found.Repositories = patch.Repositories
 			}
+			if patch.ResourceLimits != nil {
+				found.ResourceLimits = patch.ResourceLimits
+			}
+			if patch.InactivityTimeoutSeconds != nil {
+				found.InactivityTimeoutSeconds = patch.InactivityTimeoutSeconds
+			}
+			if patch.ModelOverrides != nil {
+				found.ModelOverrides = patch.ModelOverrides
+			}
+			if patch.MonthlyBudgetUsd != nil {
+				found.MonthlyBudgetUsd = patch.MonthlyBudgetUsd
+			}
 
 			psModel, err := h.projectSettings.Replace(ctx, found)
 			if err != nil {
@@ -161,3 +199,21 @@ func (h projectSettingsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
+
+// validateInactivityTimeout accepts a positive number of seconds, 0 for the
+// control plane default, or -1 to disable the inactivity stop.
+func validateInactivityTimeout(v *int32) *errors.ServiceError {
+	if v != nil && *v < -1 {
+		return errors.Validation("inactivity_timeout_seconds must be -1 (disabled), 0 (default) or a positive number of seconds, got %d", *v)
+	}
+	return nil
+}
+
+// validateMonthlyBudget accepts a non-negative amount in USD; 0 means the
+// project has no budget.
+func validateMonthlyBudget(v *float64) *errors.ServiceError {
+	if v != nil && (*v < 0 || math.IsNaN(*v) || math.IsInf(*v, 0)) {
+		return errors.Validation("monthly_budget_usd must be a non-negative amount, got %v", *v)
+	}
+	return nil
+}
@@ -99,6 +99,33 @@ func TestProjectSettingsPatch(t *testing.T) {
 	Expect(restyResp.StatusCode()).To(Equal(http.StatusBadRequest))
 }
 
+func TestProjectSettingsResourceLimits(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	psModel, err := newProjectSettings(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+
+	limits := `{"max":{"cpu":"4","memory":"8Gi"},"priority_classes":["ambient-high"]}`
+	psOutput, resp, err := client.DefaultAPI.ApiAmbientV1ProjectSettingsIdPatch(ctx, psModel.ID).
+		ProjectSettingsPatchRequest(openapi.ProjectSettingsPatchRequest{ResourceLimits: openapi.PtrString(limits)}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(psOutput.GetResourceLimits()).To(Equal(limits))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ProjectSettingsIdPatch(ctx, psModel.ID).
+		ProjectSettingsPatchRequest(openapi.ProjectSettingsPatchRequest{ResourceLimits: openapi.PtrString(`{"max":{"nvidia.com/gpu":"1"}}`)}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ProjectSettingsIdPatch(ctx, psModel.ID).
+		ProjectSettingsPatchRequest(openapi.ProjectSettingsPatchRequest{ResourceLimits: openapi.PtrString(`{"maximum":{}}`)}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
 func TestProjectSettingsPaging(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 
@@ -139,3 +166,24 @@ func TestProjectSettingsListSearch(t *testing.T) {
 	Expect(list.Total).To(Equal(int32(1)))
 	Expect(*list.Items[0].Id).To(Equal(items[0].ID))
 }
+
+func TestProjectSettingsInactivityTimeout(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	psModel, err := newProjectSettings(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+
+	psOutput, resp, err := client.DefaultAPI.ApiAmbientV1ProjectSettingsIdPatch(ctx, psModel.ID).
+		ProjectSettingsPatchRequest(openapi.ProjectSettingsPatchRequest{InactivityTimeoutSeconds: openapi.PtrInt32(3600)}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(psOutput.GetInactivityTimeoutSeconds()).To(Equal(int32(3600)))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1ProjectSettingsIdPatch(ctx, psModel.ID).
+		ProjectSettingsPatchRequest(openapi.ProjectSettingsPatchRequest{InactivityTimeoutSeconds: openapi.PtrInt32(-2)}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
@@ -58,3 +58,51 @@ func constraintMigration() *gormigrate.Migration {
 		},
 	}
 }
+
+func resourceLimitsMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170005",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings ADD COLUMN IF NOT EXISTS resource_limits TEXT`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings DROP COLUMN IF EXISTS resource_limits`).Error
+		},
+	}
+}
+
+func inactivityTimeoutMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170007",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings ADD COLUMN IF NOT EXISTS inactivity_timeout_seconds INTEGER`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings DROP COLUMN IF EXISTS inactivity_timeout_seconds`).Error
+		},
+	}
+}
+
+func modelOverridesMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170012",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings ADD COLUMN IF NOT EXISTS model_overrides TEXT`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings DROP COLUMN IF EXISTS model_overrides`).Error
+		},
+	}
+}
+
+func monthlyBudgetMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170013",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings ADD COLUMN IF NOT EXISTS monthly_budget_usd DOUBLE PRECISION`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE project_settings DROP COLUMN IF EXISTS monthly_budget_usd`).Error
+		},
+	}
+}
@@ -7,9 +7,13 @@ import (
 
 type ProjectSettings struct {
 	api.Meta
-	ProjectId    string  `json:"project_id" gorm:"uniqueIndex;not null"`
-	GroupAccess  *string `json:"group_access"`
-	Repositories *string `json:"repositories"`
+	ProjectId                string   `json:"project_id" gorm:"uniqueIndex;not null"`
+	GroupAccess              *string  `json:"group_access"`
+	Repositories             *string  `json:"repositories"`
+	ResourceLimits           *string  `json:"resource_limits"`
+	InactivityTimeoutSeconds *int32   `json:"inactivity_timeout_seconds"`
+	ModelOverrides           *string  `json:"model_overrides"`
+	MonthlyBudgetUsd         *float64 `json:"monthly_budget_usd"`
 }
 
 type ProjectSettingsList []*ProjectSettings
@@ -29,7 +33,11 @@ func (d *ProjectSettings) BeforeCreate(tx *gorm.DB) error {
 }
 
 type ProjectSettingsPatchRequest struct {
-	ProjectId    *string `json:"project_id,omitempty"`
-	GroupAccess  *string `json:"group_access,omitempty"`
-	Repositories *string `json:"repositories,omitempty"`
+	ProjectId                *string  `json:"project_id,omitempty"`
+	GroupAccess              *string  `json:"group_access,omitempty"`
+	Repositories             *string  `json:"repositories,omitempty"`
+	ResourceLimits           *string  `json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int32   `json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           *string  `json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         *float64 `json:"monthly_budget_usd,omitempty"`
 }
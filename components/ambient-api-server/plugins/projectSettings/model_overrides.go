@@ -0,0 +1,41 @@
+package projectSettings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+)
+
+// ModelOverrides is the document stored in ProjectSettings.model_overrides:
+// model IDs from the model catalog mapped to whether the project may use
+// them. A model the document does not name keeps the catalog's default,
+// which enables every available model that is not feature gated. The
+// platform default model cannot be disabled.
+type ModelOverrides map[string]bool
+
+// ParseModelOverrides decodes a project's model_overrides. Unset or empty
+// means no overrides.
+func ParseModelOverrides(raw *string) (ModelOverrides, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	var overrides ModelOverrides
+	if err := json.Unmarshal([]byte(*raw), &overrides); err != nil {
+		return nil, fmt.Errorf("model_overrides must be a JSON object of model IDs to booleans: %w", err)
+	}
+	return overrides, nil
+}
+
+func validateModelOverrides(raw *string) *errors.ServiceError {
+	overrides, err := ParseModelOverrides(raw)
+	if err != nil {
+		return errors.Validation("%s", err)
+	}
+	for id := range overrides {
+		if id == "" {
+			return errors.Validation("model_overrides must not name an empty model ID")
+		}
+	}
+	return nil
+}
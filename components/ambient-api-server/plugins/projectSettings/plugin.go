@@ -99,4 +99,8 @@ func init() {
 
 	db.RegisterMigration(migration())
 	db.RegisterMigration(constraintMigration())
+	db.RegisterMigration(resourceLimitsMigration())
+	db.RegisterMigration(inactivityTimeoutMigration())
+	db.RegisterMigration(modelOverridesMigration())
+	db.RegisterMigration(monthlyBudgetMigration())
 }
@@ -16,6 +16,10 @@ func ConvertProjectSettings(ps openapi.ProjectSettings) *ProjectSettings {
 	c.ProjectId = ps.ProjectId
 	c.GroupAccess = ps.GroupAccess
 	c.Repositories = ps.Repositories
+	c.ResourceLimits = ps.ResourceLimits
+	c.InactivityTimeoutSeconds = ps.InactivityTimeoutSeconds
+	c.ModelOverrides = ps.ModelOverrides
+	c.MonthlyBudgetUsd = ps.MonthlyBudgetUsd
 
 	if ps.CreatedAt != nil {
 		c.CreatedAt = *ps.CreatedAt
@@ -30,13 +34,17 @@ func ConvertProjectSettings(ps openapi.ProjectSettings) *ProjectSettings {
 func PresentProjectSettings(ps *ProjectSettings) openapi.ProjectSettings {
 	reference := presenters.PresentReference(ps.ID, ps)
 	return openapi.ProjectSettings{
-		Id:           reference.Id,
-		Kind:         reference.Kind,
-		Href:         reference.Href,
-		CreatedAt:    openapi.PtrTime(ps.CreatedAt),
-		UpdatedAt:    openapi.PtrTime(ps.UpdatedAt),
-		ProjectId:    ps.ProjectId,
-		GroupAccess:  ps.GroupAccess,
-		Repositories: ps.Repositories,
+		Id:                       reference.Id,
+		Kind:                     reference.Kind,
+		Href:                     reference.Href,
+		CreatedAt:                openapi.PtrTime(ps.CreatedAt),
+		UpdatedAt:                openapi.PtrTime(ps.UpdatedAt),
+		ProjectId:                ps.ProjectId,
+		GroupAccess:              ps.GroupAccess,
+		Repositories:             ps.Repositories,
+		ResourceLimits:           ps.ResourceLimits,
+		InactivityTimeoutSeconds: ps.InactivityTimeoutSeconds,
+		ModelOverrides:           ps.ModelOverrides,
+		MonthlyBudgetUsd:         ps.MonthlyBudgetUsd,
 	}
 }
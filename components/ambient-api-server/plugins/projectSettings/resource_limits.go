@@ -0,0 +1,66 @@
+package projectSettings
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+)
+
+// ResourceLimits is the document stored in ProjectSettings.resource_limits.
+// The control plane validates each session's resource_overrides against it
+// before building the runner pod; overrides are rejected when a project has
+// no limits configured.
+type ResourceLimits struct {
+	// Max caps every container request and limit, keyed by resource name.
+	Max map[string]string `json:"max,omitempty"`
+	// PriorityClasses lists the priority classes sessions may request.
+	PriorityClasses []string `json:"priority_classes,omitempty"`
+	// NodeSelectorKeys lists the node labels sessions may select on.
+	NodeSelectorKeys []string `json:"node_selector_keys,omitempty"`
+	// TolerationKeys lists the taint keys sessions may tolerate.
+	TolerationKeys []string `json:"toleration_keys,omitempty"`
+}
+
+// limitableResources are the only resources a ceiling may name. GPUs and
+// other extended resources are deliberately not schedulable from sessions.
+var limitableResources = map[string]bool{
+	"cpu":               true,
+	"memory":            true,
+	"ephemeral-storage": true,
+}
+
+func validateResourceLimits(raw *string) *errors.ServiceError {
+	if raw == nil || *raw == "" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader([]byte(*raw)))
+	dec.DisallowUnknownFields()
+	var limits ResourceLimits
+	if err := dec.Decode(&limits); err != nil {
+		return errors.Validation("resource_limits must be a JSON object: %s", err)
+	}
+	for name, value := range limits.Max {
+		if !limitableResources[name] {
+			return errors.Validation("resource_limits.max: unsupported resource %q (allowed: cpu, memory, ephemeral-storage)", name)
+		}
+		if value == "" {
+			return errors.Validation("resource_limits.max.%s must not be empty", name)
+		}
+	}
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"priority_classes", limits.PriorityClasses},
+		{"node_selector_keys", limits.NodeSelectorKeys},
+		{"toleration_keys", limits.TolerationKeys},
+	} {
+		for i, v := range field.values {
+			if v == "" {
+				return errors.Validation("resource_limits.%s[%d] must not be empty", field.name, i)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+const (
+	homeUpcomingLimit = 10
+	homeSyncLimit     = 10
+)
+
+// HomeWindow is a trailing window the project home counts sessions over.
+type HomeWindow struct {
+	Label    string
+	Duration time.Duration
+}
+
+var HomeWindows = []HomeWindow{
+	{Label: "1h", Duration: time.Hour},
+	{Label: "24h", Duration: 24 * time.Hour},
+	{Label: "7d", Duration: 7 * 24 * time.Hour},
+}
+
+type HomeAgent struct {
+	AgentID          string
+	AgentName        string
+	CurrentSessionID *string
+	SessionPhase     *string
+	InboxUnreadCount int64
+}
+
+type HomeSessionCount struct {
+	Window    string
+	Running   int64
+	Failed    int64
+	Completed int64
+	Total     int64
+}
+
+type HomeScheduledSession struct {
+	ID        string
+	Name      string
+	AgentID   *string
+	Schedule  string
+	NextRunAt *time.Time
+}
+
+type HomeApplicationSync struct {
+	ID               string
+	Name             string
+	SyncStatus       *string
+	HealthStatus     *string
+	OperationPhase   *string
+	OperationMessage *string
+	SyncRevision     *string
+	LastSyncedAt     *time.Time
+}
+
+// ProjectHome is the aggregate dashboard view of one project.
+type ProjectHome struct {
+	ProjectID                 string
+	Agents                    []HomeAgent
+	SessionCounts             []HomeSessionCount
+	UpcomingScheduledSessions []HomeScheduledSession
+	RecentApplicationSyncs    []HomeApplicationSync
+	GeneratedAt               time.Time
+}
+
+// HomeDao assembles the project home with one query per section, reading
+// across the agents, inbox, sessions, scheduled sessions and applications
+// tables directly.
+type HomeDao interface {
+	Home(ctx context.Context, projectID string, now time.Time) (*ProjectHome, error)
+}
+
+var _ HomeDao = &sqlHomeDao{}
+
+type sqlHomeDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewHomeDao(sessionFactory *db.SessionFactory) HomeDao {
+	return &sqlHomeDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlHomeDao) Home(ctx context.Context, projectID string, now time.Time) (*ProjectHome, error) {
+	home := &ProjectHome{ProjectID: projectID, GeneratedAt: now}
+
+	var err error
+	if home.Agents, err = d.agents(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("agents: %w", err)
+	}
+	if home.SessionCounts, err = d.sessionCounts(ctx, projectID, now); err != nil {
+		return nil, fmt.Errorf("session counts: %w", err)
+	}
+	if home.UpcomingScheduledSessions, err = d.upcomingScheduledSessions(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("scheduled sessions: %w", err)
+	}
+	if home.RecentApplicationSyncs, err = d.recentApplicationSyncs(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("application syncs: %w", err)
+	}
+	return home, nil
+}
+
+// agents joins each agent's current session for its phase and counts its
+// unread inbox messages (read is NULL or false).
+func (d *sqlHomeDao) agents(ctx context.Context, projectID string) ([]HomeAgent, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var rows []struct {
+		AgentID          string
+		AgentName        string
+		CurrentSessionID *string
+		SessionPhase     *string
+		InboxUnreadCount int64
+	}
+	err := g2.Table("agents a").
+		Select("a.id AS agent_id, a.name AS agent_name, a.current_session_id, s.phase AS session_phase, COUNT(m.id) AS inbox_unread_count").
+		Joins("LEFT JOIN sessions s ON s.id = a.current_session_id AND s.deleted_at IS NULL").
+		Joins("LEFT JOIN inbox_messages m ON m.agent_id = a.id AND m.deleted_at IS NULL AND (m.read IS NULL OR m.read = false)").
+		Where("a.project_id = ? AND a.deleted_at IS NULL", projectID).
+		Group("a.id, a.name, a.current_session_id, s.phase").
+		Order("a.name ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	agents := make([]HomeAgent, 0, len(rows))
+	for _, r := range rows {
+		agents = append(agents, HomeAgent(r))
+	}
+	return agents, nil
+}
+
+// sessionCounts counts sessions created within each HomeWindow by their
+// current phase, in a single pass over the widest window.
+func (d *sqlHomeDao) sessionCounts(ctx context.Context, projectID string, now time.Time) ([]HomeSessionCount, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+
+	cols := []string{"COALESCE(phase, '') AS phase"}
+	args := []interface{}{}
+	widest := time.Duration(0)
+	for i, w := range HomeWindows {
+		cols = append(cols, fmt.Sprintf("COUNT(*) FILTER (WHERE created_at >= ?) AS w%d", i))
+		args = append(args, now.Add(-w.Duration))
+		if w.Duration > widest {
+			widest = w.Duration
+		}
+	}
+
+	rows, err := g2.Table("sessions").
+		Select(strings.Join(cols, ", "), args...).
+		Where("project_id = ? AND deleted_at IS NULL AND created_at >= ?", projectID, now.Add(-widest)).
+		Group("phase").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]HomeSessionCount, len(HomeWindows))
+	for i, w := range HomeWindows {
+		counts[i].Window = w.Label
+	}
+	for rows.Next() {
+		var phase string
+		perWindow := make([]int64, len(HomeWindows))
+		dest := []interface{}{&phase}
+		for i := range perWindow {
+			dest = append(dest, &perWindow[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, n := range perWindow {
+			counts[i].Total += n
+			switch phase {
+			case "Running":
+				counts[i].Running += n
+			case "Failed":
+				counts[i].Failed += n
+			case "Completed":
+				counts[i].Completed += n
+			}
+		}
+	}
+	return counts, rows.Err()
+}
+
+func (d *sqlHomeDao) upcomingScheduledSessions(ctx context.Context, projectID string) ([]HomeScheduledSession, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var rows []HomeScheduledSession
+	err := g2.Table("scheduled_sessions").
+		Select("id, name, agent_id, schedule, next_run_at").
+		Where("project_id = ? AND deleted_at IS NULL AND enabled = true AND next_run_at IS NOT NULL", projectID).
+		Order("next_run_at ASC").
+		Limit(homeUpcomingLimit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (d *sqlHomeDao) recentApplicationSyncs(ctx context.Context, projectID string) ([]HomeApplicationSync, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var rows []HomeApplicationSync
+	err := g2.Table("applications").
+		Select("id, name, sync_status, health_status, operation_phase, operation_message, sync_revision, last_synced_at").
+		Where("destination_project = ? AND deleted_at IS NULL AND last_synced_at IS NOT NULL", projectID).
+		Order("last_synced_at DESC").
+		Limit(homeSyncLimit).
+		Scan(&rows).Error
+	return rows, err
+}
@@ -2,6 +2,7 @@ package projects
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -12,11 +13,35 @@ import (
 )
 
 type homeHandler struct {
-	agentSvc agents.AgentService
+	agentSvc   agents.AgentService
+	projectSvc ProjectService
+	homeDao    HomeDao
 }
 
-func NewHomeHandler(agentSvc agents.AgentService) *homeHandler {
-	return &homeHandler{agentSvc: agentSvc}
+func NewHomeHandler(agentSvc agents.AgentService, projectSvc ProjectService, homeDao HomeDao) *homeHandler {
+	return &homeHandler{agentSvc: agentSvc, projectSvc: projectSvc, homeDao: homeDao}
+}
+
+// Get returns the project home: every agent with its current session phase
+// and unread inbox count, session counts over HomeWindows, upcoming
+// scheduled-session firings and recent application syncs.
+func (h *homeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *pkgerrors.ServiceError) {
+			ctx := r.Context()
+			projectID := mux.Vars(r)["id"]
+
+			if _, err := h.projectSvc.Get(ctx, projectID); err != nil {
+				return nil, err
+			}
+			home, err := h.homeDao.Home(ctx, projectID, time.Now().UTC())
+			if err != nil {
+				return nil, pkgerrors.GeneralError("Unable to build home for project %s: %s", projectID, err)
+			}
+			return PresentProjectHome(home), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
 }
 
 func (h *homeHandler) ListAgents(w http.ResponseWriter, r *http.Request) {
@@ -10,6 +10,8 @@ import (
 	"gopkg.in/resty.v1"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/agents"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/inbox"
 	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projects"
 	"github.com/ambient-code/platform/components/ambient-api-server/test"
 	"github.com/openshift-online/rh-trex-ai/pkg/environments"
@@ -169,3 +171,50 @@ func TestProjectListSearch(t *testing.T) {
 	Expect(list.Total).To(Equal(int32(1)))
 	Expect(*list.Items[0].Id).To(Equal(projects[0].ID))
 }
+
+func TestProjectHome(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	_, resp, err := client.DefaultAPI.ApiAmbientV1ProjectsIdHomeGet(ctx, "no-such-project").Execute()
+	Expect(err).To(HaveOccurred(), "Expected 404")
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	projectModel, err := newProject("home")
+	Expect(err).NotTo(HaveOccurred())
+
+	envServices := &environments.Environment().Services
+	agent, svcErr := agents.Service(envServices).Create(context.Background(), &agents.Agent{ProjectId: projectModel.ID, Name: "home-agent"})
+	Expect(svcErr).To(BeNil())
+
+	inboxSvc := inbox.Service(envServices)
+	read := true
+	for _, msg := range []*inbox.InboxMessage{
+		{AgentId: agent.ID, Body: "unread one"},
+		{AgentId: agent.ID, Body: "unread two"},
+		{AgentId: agent.ID, Body: "already read", Read: &read},
+	} {
+		_, svcErr = inboxSvc.Create(context.Background(), msg)
+		Expect(svcErr).To(BeNil())
+	}
+
+	home, resp, err := client.DefaultAPI.ApiAmbientV1ProjectsIdHomeGet(ctx, projectModel.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(home.GetProjectId()).To(Equal(projectModel.ID))
+
+	Expect(home.Agents).To(HaveLen(1))
+	Expect(home.Agents[0].GetAgentId()).To(Equal(agent.ID))
+	Expect(home.Agents[0].GetInboxUnreadCount()).To(Equal(int32(2)))
+	Expect(home.Agents[0].GetAgentStatus()).To(Equal("idle"))
+
+	Expect(home.SessionCounts).To(HaveLen(len(projects.HomeWindows)))
+	for i, w := range projects.HomeWindows {
+		Expect(home.SessionCounts[i].GetWindow()).To(Equal(w.Label))
+		Expect(home.SessionCounts[i].GetTotal()).To(Equal(int32(0)))
+	}
+	Expect(home.UpcomingScheduledSessions).To(BeEmpty())
+	Expect(home.RecentApplicationSyncs).To(BeEmpty())
+}
@@ -59,6 +59,17 @@ func Service(s *environments.Services) ProjectService {
 	return nil
 }
 
+func homeDao(s *environments.Services) HomeDao {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("ProjectHomeDao"); obj != nil {
+		locator := obj.(func() HomeDao)
+		return locator()
+	}
+	return nil
+}
+
 func init() {
 	registry.RegisterService("Projects", func(env interface{}) interface{} {
 		return NewServiceLocator(env.(*environments.Env))
@@ -77,13 +88,20 @@ func init() {
 		return loc
 	})
 
+	registry.RegisterService("ProjectHomeDao", func(env interface{}) interface{} {
+		e := env.(*environments.Env)
+		return func() HomeDao {
+			return NewHomeDao(&e.Database.SessionFactory)
+		}
+	})
+
 	pkgserver.RegisterRoutes("projects", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
 		envServices := services.(*environments.Services)
 		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
 			authzMiddleware = dbAuthz
 		}
 		projectHandler := NewProjectHandler(Service(envServices), generic.Service(envServices))
-		homeHandler := NewHomeHandler(agents.Service(envServices))
+		homeHandler := NewHomeHandler(agents.Service(envServices), Service(envServices), homeDao(envServices))
 
 		projectsRouter := apiV1Router.PathPrefix("/projects").Subrouter()
 		projectsRouter.HandleFunc("", projectHandler.List).Methods(http.MethodGet)
@@ -92,6 +110,7 @@ func init() {
 		projectsRouter.HandleFunc("/{id}", projectHandler.Patch).Methods(http.MethodPatch)
 		projectsRouter.HandleFunc("/{id}", projectHandler.Delete).Methods(http.MethodDelete)
 		projectsRouter.HandleFunc("/{id}/agents", homeHandler.ListAgents).Methods(http.MethodGet)
+		projectsRouter.HandleFunc("/{id}/home", homeHandler.Get).Methods(http.MethodGet)
 		projectsRouter.Use(authMiddleware.AuthenticateAccountJWT)
 		projectsRouter.Use(authzMiddleware.AuthorizeApi)
 	})
@@ -44,3 +44,72 @@ func PresentProject(project *Project) openapi.Project {
 		Status:      project.Status,
 	}
 }
+
+func PresentProjectHome(home *ProjectHome) openapi.ProjectHome {
+	out := openapi.ProjectHome{
+		ProjectId:                 openapi.PtrString(home.ProjectID),
+		Agents:                    []openapi.ProjectHomeAgent{},
+		SessionCounts:             []openapi.ProjectHomeSessionCount{},
+		UpcomingScheduledSessions: []openapi.ProjectHomeScheduledSession{},
+		RecentApplicationSyncs:    []openapi.ProjectHomeApplicationSync{},
+		GeneratedAt:               openapi.PtrTime(home.GeneratedAt),
+	}
+	for _, a := range home.Agents {
+		phase := util.NilToEmptyString(a.SessionPhase)
+		out.Agents = append(out.Agents, openapi.ProjectHomeAgent{
+			AgentId:          openapi.PtrString(a.AgentID),
+			AgentName:        openapi.PtrString(a.AgentName),
+			CurrentSessionId: a.CurrentSessionID,
+			SessionPhase:     a.SessionPhase,
+			AgentStatus:      openapi.PtrString(AgentStatus(phase)),
+			InboxUnreadCount: openapi.PtrInt32(int32(a.InboxUnreadCount)),
+		})
+	}
+	for _, c := range home.SessionCounts {
+		out.SessionCounts = append(out.SessionCounts, openapi.ProjectHomeSessionCount{
+			Window:    openapi.PtrString(c.Window),
+			Running:   openapi.PtrInt32(int32(c.Running)),
+			Failed:    openapi.PtrInt32(int32(c.Failed)),
+			Completed: openapi.PtrInt32(int32(c.Completed)),
+			Total:     openapi.PtrInt32(int32(c.Total)),
+		})
+	}
+	for _, s := range home.UpcomingScheduledSessions {
+		out.UpcomingScheduledSessions = append(out.UpcomingScheduledSessions, openapi.ProjectHomeScheduledSession{
+			Id:        openapi.PtrString(s.ID),
+			Name:      openapi.PtrString(s.Name),
+			AgentId:   s.AgentID,
+			Schedule:  openapi.PtrString(s.Schedule),
+			NextRunAt: s.NextRunAt,
+		})
+	}
+	for _, a := range home.RecentApplicationSyncs {
+		out.RecentApplicationSyncs = append(out.RecentApplicationSyncs, openapi.ProjectHomeApplicationSync{
+			Id:               openapi.PtrString(a.ID),
+			Name:             openapi.PtrString(a.Name),
+			SyncStatus:       a.SyncStatus,
+			HealthStatus:     a.HealthStatus,
+			OperationPhase:   a.OperationPhase,
+			OperationMessage: a.OperationMessage,
+			SyncRevision:     a.SyncRevision,
+			LastSyncedAt:     a.LastSyncedAt,
+		})
+	}
+	return out
+}
+
+// AgentStatus summarises an agent from its current session's phase.
+func AgentStatus(sessionPhase string) string {
+	switch sessionPhase {
+	case "Pending", "Creating":
+		return "starting"
+	case "Running":
+		return "running"
+	case "Stopping":
+		return "stopping"
+	case "Failed":
+		return "failed"
+	default:
+		return "idle"
+	}
+}
@@ -16,6 +16,19 @@ func Middleware(s *environments.Services) auth.AuthorizationMiddleware {
 	}
 	if obj := s.GetService("RBACMiddleware"); obj != nil {
 		locator := obj.(MiddlewareLocator)
+		if recorder := auditRecorder(s); recorder != nil {
+			return pkgrbac.NewAuditMiddleware(locator(), recorder)
+		}
+		return locator()
+	}
+	return nil
+}
+
+// auditRecorder returns the recorder registered by the audit events plugin,
+// or nil when that plugin is not linked in.
+func auditRecorder(s *environments.Services) pkgrbac.AuditRecorder {
+	if obj := s.GetService("AuditRecorder"); obj != nil {
+		locator := obj.(func() pkgrbac.AuditRecorder)
 		return locator()
 	}
 	return nil
@@ -0,0 +1,270 @@
+package roleBindings
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api"
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+)
+
+type roleBindingGRPCHandler struct {
+	pb.UnimplementedRoleBindingServiceServer
+	service    RoleBindingService
+	generic    services.GenericService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewRoleBindingGRPCHandler(service RoleBindingService, generic services.GenericService, brokerFunc func() *server.EventBroker) pb.RoleBindingServiceServer {
+	return &roleBindingGRPCHandler{
+		service:    service,
+		generic:    generic,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requireServiceCaller guards mutations.  The escalation checks for user
+// callers (role hierarchy, last-owner protection) live in the REST handler,
+// so over gRPC only service callers may change bindings.
+func requireServiceCaller(ctx context.Context) error {
+	if !middleware.IsServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "role bindings can only be modified by service callers over gRPC; use the REST API")
+	}
+	return nil
+}
+
+func (h *roleBindingGRPCHandler) GetRoleBinding(ctx context.Context, req *pb.GetRoleBindingRequest) (*pb.RoleBinding, error) {
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	rb, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return roleBindingToProto(rb), nil
+}
+
+func (h *roleBindingGRPCHandler) CreateRoleBinding(ctx context.Context, req *pb.CreateRoleBindingRequest) (*pb.RoleBinding, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("role_id", req.GetRoleId(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("scope", req.GetScope(), true); err != nil {
+		return nil, err
+	}
+
+	rb := &RoleBinding{
+		RoleId:       req.GetRoleId(),
+		Scope:        req.GetScope(),
+		UserId:       req.UserId,
+		ProjectId:    req.ProjectId,
+		AgentId:      req.AgentId,
+		SessionId:    req.SessionId,
+		CredentialId: req.CredentialId,
+	}
+
+	created, svcErr := h.service.Create(ctx, rb)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return roleBindingToProto(created), nil
+}
+
+func (h *roleBindingGRPCHandler) UpdateRoleBinding(ctx context.Context, req *pb.UpdateRoleBindingRequest) (*pb.RoleBinding, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	found, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	if req.RoleId != nil {
+		found.RoleId = *req.RoleId
+	}
+	if req.Scope != nil {
+		found.Scope = *req.Scope
+	}
+	if req.UserId != nil {
+		found.UserId = req.UserId
+	}
+	if req.ProjectId != nil {
+		found.ProjectId = req.ProjectId
+	}
+	if req.AgentId != nil {
+		found.AgentId = req.AgentId
+	}
+	if req.SessionId != nil {
+		found.SessionId = req.SessionId
+	}
+	if req.CredentialId != nil {
+		found.CredentialId = req.CredentialId
+	}
+
+	updated, svcErr := h.service.Replace(ctx, found)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return roleBindingToProto(updated), nil
+}
+
+func (h *roleBindingGRPCHandler) DeleteRoleBinding(ctx context.Context, req *pb.DeleteRoleBindingRequest) (*pb.DeleteRoleBindingResponse, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	svcErr := h.service.Delete(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return &pb.DeleteRoleBindingResponse{}, nil
+}
+
+func (h *roleBindingGRPCHandler) ListRoleBindings(ctx context.Context, req *pb.ListRoleBindingsRequest) (*pb.ListRoleBindingsResponse, error) {
+	page, size := grpcutil.NormalizePagination(req.GetPage(), req.GetSize())
+
+	listArgs := services.ListArguments{
+		Page: int(page),
+		Size: int64(size),
+	}
+
+	if !middleware.IsServiceCaller(ctx) {
+		authResult := rbac.GetAuthResult(ctx)
+		if authResult == nil {
+			return &pb.ListRoleBindingsResponse{Items: []*pb.RoleBinding{}, Metadata: &pb.ListMeta{Page: int32(page), Size: int32(size), Total: 0}}, nil
+		}
+		if !authResult.IsGlobalAdmin {
+			// Same visibility as the REST list: own bindings, plus bindings
+			// on authorized projects and credentials.
+			scopeFilter, err := rbac.TSLEqual("user_id", auth.GetUsernameFromContext(ctx))
+			if err != nil {
+				return nil, status.Error(codes.PermissionDenied, "invalid username")
+			}
+			if len(authResult.ProjectIDs) > 0 {
+				projFilter, err := rbac.TSLIn("project_id", authResult.ProjectIDs)
+				if err != nil {
+					return nil, status.Error(codes.PermissionDenied, "invalid project id")
+				}
+				scopeFilter = rbac.TSLOr(scopeFilter, projFilter)
+			}
+			if len(authResult.CredentialIDs) > 0 {
+				credFilter, err := rbac.TSLIn("credential_id", authResult.CredentialIDs)
+				if err != nil {
+					return nil, status.Error(codes.PermissionDenied, "invalid credential id")
+				}
+				scopeFilter = rbac.TSLOr(scopeFilter, credFilter)
+			}
+			rbac.AppendTSLFilter(&listArgs, scopeFilter)
+		}
+	}
+
+	var roleBindings []RoleBinding
+	paging, svcErr := h.generic.List(ctx, "id", &listArgs, &roleBindings)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.RoleBinding, 0, len(roleBindings))
+	for i := range roleBindings {
+		items = append(items, roleBindingToProto(&roleBindings[i]))
+	}
+
+	return &pb.ListRoleBindingsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  int32(paging.Page),
+			Size:  int32(paging.Size),
+			Total: int32(paging.Total),
+		},
+	}, nil
+}
+
+func (h *roleBindingGRPCHandler) WatchRoleBindings(req *pb.WatchRoleBindingsRequest, stream grpc.ServerStreamingServer[pb.RoleBindingWatchEvent]) error {
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	ctx := stream.Context()
+	authResult := rbac.GetAuthResult(ctx)
+	isPrivileged := middleware.IsServiceCaller(ctx) ||
+		(authResult != nil && authResult.IsGlobalAdmin)
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			watchEvent := &pb.RoleBindingWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				ResourceId: event.SourceID,
+			}
+
+			if event.EventType != api.DeleteEventType {
+				rb, svcErr := h.service.Get(ctx, event.SourceID)
+				if svcErr != nil {
+					glog.Errorf("WatchRoleBindings: failed to get role binding %s: %v", event.SourceID, svcErr)
+					continue
+				}
+
+				if !isPrivileged {
+					projectID := ""
+					if rb.ProjectId != nil {
+						projectID = *rb.ProjectId
+					}
+					if !rbac.IsProjectAuthorized(authResult, projectID) {
+						continue
+					}
+				}
+
+				watchEvent.RoleBinding = roleBindingToProto(rb)
+			} else if !isPrivileged {
+				// Delete events: can't verify project scope (binding gone),
+				// so suppress for non-privileged watchers to prevent ID leakage
+				continue
+			}
+
+			if err := stream.Send(watchEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package roleBindings
+
+import (
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func roleBindingToProto(rb *RoleBinding) *pb.RoleBinding {
+	if rb == nil {
+		return nil
+	}
+
+	return &pb.RoleBinding{
+		Metadata: &pb.ObjectReference{
+			Id:        rb.ID,
+			CreatedAt: timestamppb.New(rb.CreatedAt),
+			UpdatedAt: timestamppb.New(rb.UpdatedAt),
+			Kind:      "RoleBinding",
+			Href:      "/api/ambient/v1/role_bindings/" + rb.ID,
+		},
+		RoleId:       rb.RoleId,
+		Scope:        rb.Scope,
+		UserId:       rb.UserId,
+		ProjectId:    rb.ProjectId,
+		AgentId:      rb.AgentId,
+		SessionId:    rb.SessionId,
+		CredentialId: rb.CredentialId,
+	}
+}
@@ -56,36 +56,36 @@ func (h roleBindingHandler) Create(w http.ResponseWriter, r *http.Request) {
 				g := (*h.sessionFactory).New(ctx)
 
 				// a) Look up target role name
-				var targetRoleName string
-				if err := g.Table("roles").Select("name").Where("id = ? AND deleted_at IS NULL", roleBinding.RoleId).Scan(&targetRoleName).Error; err != nil || targetRoleName == "" {
+				var targetRole pkgrbac.RoleRef
+				if err := g.Table("roles").Select("name, level").Where("id = ? AND deleted_at IS NULL", roleBinding.RoleId).Scan(&targetRole).Error; err != nil || targetRole.Name == "" {
 					return nil, errors.Forbidden("target role not found")
 				}
 
 				// b) Level hierarchy check — scoped to the target resource
 				username := auth.GetUsernameFromContext(ctx)
-				var callerRoleNames []string
+				var callerRoles []pkgrbac.RoleRef
 				baseQuery := func(g *gorm.DB) *gorm.DB {
 					return g.Table("role_bindings rb").
-						Select("r.name").
+						Select("r.name, r.level").
 						Joins("JOIN roles r ON r.id = rb.role_id").
 						Where("rb.user_id = ? AND r.deleted_at IS NULL AND rb.deleted_at IS NULL", username)
 				}
 				var scanErr error
 				if roleBinding.Scope == "project" && roleBinding.ProjectId.IsSet() {
-					scanErr = baseQuery(g).Where("rb.project_id = ? OR rb.scope = 'global'", *roleBinding.ProjectId.Get()).Scan(&callerRoleNames).Error
+					scanErr = baseQuery(g).Where("rb.project_id = ? OR rb.scope = 'global'", *roleBinding.ProjectId.Get()).Scan(&callerRoles).Error
 				} else if roleBinding.Scope == "credential" && roleBinding.CredentialId.IsSet() {
-					scanErr = baseQuery(g).Where("rb.credential_id = ? OR rb.scope = 'global'", *roleBinding.CredentialId.Get()).Scan(&callerRoleNames).Error
+					scanErr = baseQuery(g).Where("rb.credential_id = ? OR rb.scope = 'global'", *roleBinding.CredentialId.Get()).Scan(&callerRoles).Error
 				} else {
-					scanErr = baseQuery(g).Scan(&callerRoleNames).Error
+					scanErr = baseQuery(g).Scan(&callerRoles).Error
 				}
 				if scanErr != nil {
 					return nil, errors.GeneralError("authorization check failed")
 				}
-				callerLevel := pkgrbac.HighestLevel(callerRoleNames)
-				if pkgrbac.InternalRoles[targetRoleName] && callerLevel != 0 {
+				callerLevel := pkgrbac.HighestRoleLevel(callerRoles)
+				if pkgrbac.InternalRoles[targetRole.Name] && callerLevel != 0 {
 					return nil, errors.Forbidden("cannot assign internal role")
 				}
-				if !pkgrbac.CanGrant(callerLevel, targetRoleName) {
+				if !pkgrbac.CanGrantRole(callerLevel, targetRole) {
 					return nil, errors.Forbidden("insufficient privileges to grant this role")
 				}
 
@@ -212,15 +212,15 @@ func (h roleBindingHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			{
 				g := (*h.sessionFactory).New(ctx)
 
-				var callerRoleNames []string
+				var callerRoles []pkgrbac.RoleRef
 				if dbErr := g.Table("role_bindings rb").
-					Select("r.name").
+					Select("r.name, r.level").
 					Joins("JOIN roles r ON r.id = rb.role_id").
 					Where("rb.user_id = ? AND r.deleted_at IS NULL AND rb.deleted_at IS NULL", username).
-					Scan(&callerRoleNames).Error; dbErr != nil {
+					Scan(&callerRoles).Error; dbErr != nil {
 					return nil, errors.GeneralError("authorization check failed")
 				}
-				callerLevel := pkgrbac.HighestLevel(callerRoleNames)
+				callerLevel := pkgrbac.HighestRoleLevel(callerRoles)
 
 				// Non-admin callers can only PATCH their own bindings.
 				isOwner := found.UserId != nil && *found.UserId == username
@@ -230,14 +230,14 @@ func (h roleBindingHandler) Patch(w http.ResponseWriter, r *http.Request) {
 
 				// Prevent changing role_id to a role the caller cannot grant.
 				if patch.RoleId != nil && *patch.RoleId != found.RoleId {
-					var targetRoleName string
-					if dbErr := g.Table("roles").Select("name").Where("id = ? AND deleted_at IS NULL", *patch.RoleId).Scan(&targetRoleName).Error; dbErr != nil || targetRoleName == "" {
+					var targetRole pkgrbac.RoleRef
+					if dbErr := g.Table("roles").Select("name, level").Where("id = ? AND deleted_at IS NULL", *patch.RoleId).Scan(&targetRole).Error; dbErr != nil || targetRole.Name == "" {
 						return nil, errors.Forbidden("target role not found")
 					}
-					if pkgrbac.InternalRoles[targetRoleName] {
+					if pkgrbac.InternalRoles[targetRole.Name] {
 						return nil, errors.Forbidden("cannot assign internal role")
 					}
-					if !pkgrbac.CanGrant(callerLevel, targetRoleName) {
+					if !pkgrbac.CanGrantRole(callerLevel, targetRole) {
 						return nil, errors.Forbidden("insufficient privileges to change role")
 					}
 				}
@@ -421,11 +421,12 @@ func (h roleBindingHandler) Delete(w http.ResponseWriter, r *http.Request) {
 					return nil, getErr
 				}
 
-				var roleName string
+				var role pkgrbac.RoleRef
 				g := (*h.sessionFactory).New(ctx)
-				if dbErr := g.Table("roles").Select("name").Where("id = ? AND deleted_at IS NULL", binding.RoleId).Scan(&roleName).Error; dbErr != nil {
+				if dbErr := g.Table("roles").Select("name, level").Where("id = ? AND deleted_at IS NULL", binding.RoleId).Scan(&role).Error; dbErr != nil {
 					return nil, errors.GeneralError("authorization check failed")
 				}
+				roleName := role.Name
 
 				if roleName == pkgrbac.RoleProjectOwner && binding.ProjectId != nil {
 					var count int64
@@ -459,15 +460,15 @@ func (h roleBindingHandler) Delete(w http.ResponseWriter, r *http.Request) {
 					// Asymmetric unbind: project:editor+ can remove credential bindings
 					// from their project without needing credential:owner.
 					// platform:admin can always unbind.
-					var callerAllRoles []string
+					var callerAllRoles []pkgrbac.RoleRef
 					if dbErr := g.Table("role_bindings rb").
-						Select("r.name").
+						Select("r.name, r.level").
 						Joins("JOIN roles r ON r.id = rb.role_id").
 						Where("rb.user_id = ? AND r.deleted_at IS NULL AND rb.deleted_at IS NULL", username).
 						Scan(&callerAllRoles).Error; dbErr != nil {
 						return nil, errors.GeneralError("authorization check failed")
 					}
-					callerLevel := pkgrbac.HighestLevel(callerAllRoles)
+					callerLevel := pkgrbac.HighestRoleLevel(callerAllRoles)
 
 					if callerLevel == 0 {
 						// platform:admin can always unbind
@@ -491,19 +492,19 @@ func (h roleBindingHandler) Delete(w http.ResponseWriter, r *http.Request) {
 				} else {
 					// Non-credential scopes: caller must outrank the binding's role
 					// AND be at least project:owner (level 1)
-					var callerRoleNames []string
+					var callerRoles []pkgrbac.RoleRef
 					baseQuery := g.Table("role_bindings rb").
-						Select("r.name").
+						Select("r.name, r.level").
 						Joins("JOIN roles r ON r.id = rb.role_id").
 						Where("rb.user_id = ? AND r.deleted_at IS NULL AND rb.deleted_at IS NULL", username)
 					if binding.Scope == "project" && binding.ProjectId != nil {
 						baseQuery = baseQuery.Where("rb.project_id = ? OR rb.scope = 'global'", *binding.ProjectId)
 					}
-					if dbErr := baseQuery.Scan(&callerRoleNames).Error; dbErr != nil {
+					if dbErr := baseQuery.Scan(&callerRoles).Error; dbErr != nil {
 						return nil, errors.GeneralError("authorization check failed")
 					}
-					callerLevel := pkgrbac.HighestLevel(callerRoleNames)
-					if callerLevel > 1 || !pkgrbac.CanGrant(callerLevel, roleName) {
+					callerLevel := pkgrbac.HighestRoleLevel(callerRoles)
+					if callerLevel > 1 || !pkgrbac.CanGrantRole(callerLevel, role) {
 						return nil, errors.Forbidden("insufficient privileges to delete this binding")
 					}
 				}
@@ -3,6 +3,7 @@ package roleBindings
 import (
 	"net/http"
 
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
@@ -15,8 +16,11 @@ import (
 	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
 	"github.com/openshift-online/rh-trex-ai/plugins/events"
 	"github.com/openshift-online/rh-trex-ai/plugins/generic"
+	"google.golang.org/grpc"
 )
 
+const EventSource = "RoleBindings"
+
 type ServiceLocator func() RoleBindingService
 
 var registeredSessionFactory *db.SessionFactory
@@ -69,7 +73,7 @@ func init() {
 		roleBindingServices := Service(services.(*environments.Services))
 
 		manager.Add(&controllers.ControllerConfig{
-			Source: "RoleBindings",
+			Source: EventSource,
 			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
 				api.CreateEventType: {roleBindingServices.OnUpsert},
 				api.UpdateEventType: {roleBindingServices.OnUpsert},
@@ -83,6 +87,19 @@ func init() {
 	presenters.RegisterKind(RoleBinding{}, "RoleBinding")
 	presenters.RegisterKind(&RoleBinding{}, "RoleBinding")
 
+	pkgserver.RegisterGRPCService("role_bindings", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		roleBindingService := Service(envServices)
+		genericService := generic.Service(envServices)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
+		}
+		pb.RegisterRoleBindingServiceServer(grpcServer, NewRoleBindingGRPCHandler(roleBindingService, genericService, brokerFunc))
+	})
+
 	db.RegisterMigration(migration())
 	db.RegisterMigration(typedFKMigration())
 	db.RegisterMigration(uniqueBindingMigration())
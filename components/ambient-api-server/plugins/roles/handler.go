@@ -1,7 +1,6 @@
 package roles
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -33,10 +32,19 @@ func (h roleHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Body: &role,
 		Validators: []handlers.Validate{
 			handlers.ValidateEmpty(&role, "Id", "id"),
+			func() *errors.ServiceError {
+				return validateCustomRole(role.Name, role.Level)
+			},
+			func() *errors.ServiceError {
+				_, err := parsePermissions(role.Permissions)
+				return err
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 			roleModel := ConvertRole(role)
+			// Built-in roles are seeded by migrations, never created here.
+			roleModel.BuiltIn = false
 			roleModel, err := h.role.Create(ctx, roleModel)
 			if err != nil {
 				return nil, err
@@ -53,8 +61,12 @@ func (h roleHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	var patch openapi.RolePatchRequest
 
 	cfg := &handlers.HandlerConfig{
-		Body:       &patch,
-		Validators: []handlers.Validate{},
+		Body: &patch,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				return validateLevel(patch.Level)
+			},
+		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 			id := mux.Vars(r)["id"]
@@ -62,6 +74,10 @@ func (h roleHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			perms, err := parsePermissions(patch.Permissions)
+			if err != nil {
+				return nil, err
+			}
 
 			if patch.DisplayName != nil {
 				found.DisplayName = patch.DisplayName
@@ -69,8 +85,15 @@ func (h roleHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if patch.Description != nil {
 				found.Description = patch.Description
 			}
-			if patch.Permissions != nil {
-				_ = json.Unmarshal([]byte(*patch.Permissions), &found.Permissions)
+			if perms != nil {
+				found.Permissions = perms
+			}
+			if patch.Level != nil {
+				if found.BuiltIn {
+					return nil, errors.Validation("the level of built-in role %q is fixed", found.Name)
+				}
+				level := int(*patch.Level)
+				found.Level = &level
 			}
 
 			roleModel, err := h.role.Replace(ctx, found)
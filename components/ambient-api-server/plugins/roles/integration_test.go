@@ -50,8 +50,9 @@ func TestRolePost(t *testing.T) {
 		Name:        "test-name",
 		DisplayName: openapi.PtrString("test-display_name"),
 		Description: openapi.PtrString("test-description"),
-		Permissions: openapi.PtrString("test-permissions"),
+		Permissions: openapi.PtrString(`["agent:read","agent:start"]`),
 		BuiltIn:     openapi.PtrBool(true),
+		Level:       openapi.PtrInt32(2),
 	}
 
 	roleOutput, resp, err := client.DefaultAPI.ApiAmbientV1RolesPost(ctx).Role(roleInput).Execute()
@@ -60,6 +61,8 @@ func TestRolePost(t *testing.T) {
 	Expect(*roleOutput.Id).NotTo(BeEmpty(), "Expected ID assigned on creation")
 	Expect(*roleOutput.Kind).To(Equal("Role"))
 	Expect(*roleOutput.Href).To(Equal(fmt.Sprintf("/api/ambient/v1/roles/%s", *roleOutput.Id)))
+	Expect(roleOutput.GetBuiltIn()).To(BeFalse(), "custom roles are never built in")
+	Expect(roleOutput.GetLevel()).To(Equal(int32(2)))
 
 	jwtToken := ctx.Value(openapi.ContextAccessToken)
 	var restyResp *resty.Response
@@ -142,3 +145,62 @@ func TestRoleListSearch(t *testing.T) {
 	Expect(list.Total).To(Equal(int32(1)))
 	Expect(*list.Items[0].Id).To(Equal(roles[0].ID))
 }
+
+func TestRolePost_ValidatesCustomRole(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	cases := []openapi.Role{
+		{Name: "starter-unknown-action", Permissions: openapi.PtrString(`["agent:launch"]`), Level: openapi.PtrInt32(2)},
+		{Name: "starter-unknown-resource", Permissions: openapi.PtrString(`["prompt:update"]`), Level: openapi.PtrInt32(2)},
+		{Name: "starter-no-level", Permissions: openapi.PtrString(`["agent:start"]`)},
+		{Name: "starter-admin-level", Permissions: openapi.PtrString(`["agent:start"]`), Level: openapi.PtrInt32(0)},
+		{Name: "project:owner", Permissions: openapi.PtrString(`["agent:start"]`), Level: openapi.PtrInt32(2)},
+	}
+	for _, roleInput := range cases {
+		_, resp, err := client.DefaultAPI.ApiAmbientV1RolesPost(ctx).Role(roleInput).Execute()
+		Expect(err).To(HaveOccurred(), "expected %s to be rejected", roleInput.Name)
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest), "role %s", roleInput.Name)
+	}
+}
+
+func TestRoleSimulate(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	roleOutput, _, err := client.DefaultAPI.ApiAmbientV1RolesPost(ctx).Role(openapi.Role{
+		Name:        "starter-" + h.NewID(),
+		Permissions: openapi.PtrString(`["agent:read","agent:start"]`),
+		Level:       openapi.PtrInt32(2),
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+
+	jwtToken := ctx.Value(openapi.ContextAccessToken)
+	simulate := func(body string) (*resty.Response, map[string]interface{}) {
+		var result map[string]interface{}
+		restyResp, err := resty.R().
+			SetHeader("Content-Type", "application/json").
+			SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+			SetBody(body).
+			SetResult(&result).
+			Post(h.RestURL(fmt.Sprintf("/roles/%s/simulate", *roleOutput.Id)))
+		Expect(err).NotTo(HaveOccurred())
+		return restyResp, result
+	}
+
+	resp, result := simulate(`{"user_id":"nobody","resource":"agent","action":"start","project_id":"p1"}`)
+	Expect(resp.StatusCode()).To(Equal(http.StatusOK))
+	Expect(result["permission"]).To(Equal("agent:start"))
+	Expect(result["role_grants"]).To(BeTrue())
+	Expect(result["allowed"]).To(BeFalse(), "a user with no bindings is never allowed")
+
+	_, result = simulate(`{"user_id":"nobody","resource":"agent","action":"update","project_id":"p1"}`)
+	Expect(result["role_grants"]).To(BeFalse(), "the role does not cover editing agents")
+
+	resp, _ = simulate(`{"user_id":"nobody","resource":"agent","action":"launch"}`)
+	Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
+}
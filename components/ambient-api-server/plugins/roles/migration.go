@@ -141,3 +141,36 @@ func editorCredentialUnbindMigration() *gormigrate.Migration {
 		},
 	}
 }
+
+// roleLevelMigration adds the stored delegation level custom roles need and
+// backfills it for the built-in roles.
+func roleLevelMigration() *gormigrate.Migration {
+	builtInLevels := map[string]int{
+		"platform:admin":    0,
+		"project:owner":     1,
+		"platform:viewer":   2,
+		"project:editor":    2,
+		"agent:operator":    2,
+		"credential:viewer": 2,
+		"project:viewer":    3,
+		"agent:observer":    3,
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170009",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec(`ALTER TABLE roles ADD COLUMN IF NOT EXISTS level INTEGER`).Error; err != nil {
+				return err
+			}
+			for name, level := range builtInLevels {
+				if err := tx.Exec(`UPDATE roles SET level = ? WHERE name = ? AND built_in = true`, level, name).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE roles DROP COLUMN IF EXISTS level`).Error
+		},
+	}
+}
@@ -3,6 +3,8 @@ package roles
 import (
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"gorm.io/gorm"
+
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
 )
 
 type Role struct {
@@ -12,6 +14,7 @@ type Role struct {
 	Description *string  `json:"description"`
 	Permissions []string `json:"permissions"  gorm:"type:text;serializer:json"`
 	BuiltIn     bool     `json:"built_in"     gorm:"default:false"`
+	Level       *int     `json:"level"`
 }
 
 type RoleList []*Role
@@ -34,4 +37,10 @@ type RolePatchRequest struct {
 	DisplayName *string  `json:"display_name,omitempty"`
 	Description *string  `json:"description,omitempty"`
 	Permissions []string `json:"permissions,omitempty"`
+	Level       *int     `json:"level,omitempty"`
+}
+
+// Ref returns the role as the RBAC hierarchy sees it.
+func (d *Role) Ref() pkgrbac.RoleRef {
+	return pkgrbac.RoleRef{Name: d.Name, Level: d.Level}
 }
@@ -19,7 +19,10 @@ import (
 
 type ServiceLocator func() RoleService
 
+var registeredSessionFactory *db.SessionFactory
+
 func NewServiceLocator(env *environments.Env) ServiceLocator {
+	registeredSessionFactory = &env.Database.SessionFactory
 	return func() RoleService {
 		return NewRoleService(
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
@@ -51,6 +54,7 @@ func init() {
 			authzMiddleware = dbAuthz
 		}
 		roleHandler := NewRoleHandler(Service(envServices), generic.Service(envServices))
+		simulateHandler := NewRoleSimulateHandler(Service(envServices), registeredSessionFactory)
 
 		rolesRouter := apiV1Router.PathPrefix("/roles").Subrouter()
 		rolesRouter.HandleFunc("", roleHandler.List).Methods(http.MethodGet)
@@ -58,6 +62,7 @@ func init() {
 		rolesRouter.HandleFunc("", roleHandler.Create).Methods(http.MethodPost)
 		rolesRouter.HandleFunc("/{id}", roleHandler.Patch).Methods(http.MethodPatch)
 		rolesRouter.HandleFunc("/{id}", roleHandler.Delete).Methods(http.MethodDelete)
+		rolesRouter.HandleFunc("/{id}/simulate", simulateHandler.Simulate).Methods(http.MethodPost)
 		rolesRouter.Use(authMiddleware.AuthenticateAccountJWT)
 		rolesRouter.Use(authzMiddleware.AuthorizeApi)
 	})
@@ -82,4 +87,5 @@ func init() {
 
 	db.RegisterMigration(migration())
 	db.RegisterMigration(editorCredentialUnbindMigration())
+	db.RegisterMigration(roleLevelMigration())
 }
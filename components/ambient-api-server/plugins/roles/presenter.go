@@ -24,6 +24,10 @@ func ConvertRole(role openapi.Role) *Role {
 	if role.BuiltIn != nil {
 		c.BuiltIn = *role.BuiltIn
 	}
+	if role.Level != nil {
+		level := int(*role.Level)
+		c.Level = &level
+	}
 
 	if role.CreatedAt != nil {
 		c.CreatedAt = *role.CreatedAt
@@ -43,6 +47,10 @@ func PresentRole(role *Role) openapi.Role {
 		s := string(b)
 		permsStr = &s
 	}
+	var level *int32
+	if l, ok := role.Ref().EffectiveLevel(); ok {
+		level = openapi.PtrInt32(int32(l))
+	}
 	return openapi.Role{
 		Id:          reference.Id,
 		Kind:        reference.Kind,
@@ -54,5 +62,6 @@ func PresentRole(role *Role) openapi.Role {
 		Description: role.Description,
 		Permissions: permsStr,
 		BuiltIn:     openapi.PtrBool(role.BuiltIn),
+		Level:       level,
 	}
 }
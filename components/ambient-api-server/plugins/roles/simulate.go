@@ -0,0 +1,112 @@
+package roles
+
+import (
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+// RoleSimulationRequest asks whether a user may perform an action on a
+// resource in a scope.
+type RoleSimulationRequest struct {
+	UserId       string  `json:"user_id"`
+	Resource     string  `json:"resource"`
+	Action       string  `json:"action"`
+	ProjectId    *string `json:"project_id,omitempty"`
+	AgentId      *string `json:"agent_id,omitempty"`
+	SessionId    *string `json:"session_id,omitempty"`
+	CredentialId *string `json:"credential_id,omitempty"`
+}
+
+// RoleSimulation answers a RoleSimulationRequest. RoleGrants says whether
+// the role's permissions alone cover the request; Allowed is what the API
+// authorizer would decide for the user's current bindings.
+type RoleSimulation struct {
+	RoleId     string `json:"role_id"`
+	UserId     string `json:"user_id"`
+	Permission string `json:"permission"`
+	RoleGrants bool   `json:"role_grants"`
+	Allowed    bool   `json:"allowed"`
+}
+
+type roleSimulateHandler struct {
+	role      RoleService
+	evaluator *pkgrbac.Evaluator
+}
+
+func NewRoleSimulateHandler(role RoleService, sessionFactory *db.SessionFactory) *roleSimulateHandler {
+	return &roleSimulateHandler{
+		role:      role,
+		evaluator: pkgrbac.NewEvaluator(sessionFactory),
+	}
+}
+
+func (h roleSimulateHandler) Simulate(w http.ResponseWriter, r *http.Request) {
+	var req RoleSimulationRequest
+	var perm pkgrbac.Permission
+	cfg := &handlers.HandlerConfig{
+		Body: &req,
+		Validators: []handlers.Validate{
+			func() *errors.ServiceError {
+				if req.UserId == "" {
+					return errors.Validation("user_id is required")
+				}
+				return nil
+			},
+			func() *errors.ServiceError {
+				if req.Resource == pkgrbac.Wildcard || req.Action == pkgrbac.Wildcard {
+					return errors.Validation("resource and action must be concrete, not %q", pkgrbac.Wildcard)
+				}
+				parsed, err := pkgrbac.ParsePermission(req.Resource + ":" + req.Action)
+				if err != nil {
+					return errors.Validation("%s", err)
+				}
+				perm = parsed
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			role, svcErr := h.role.Get(ctx, id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			allowed, err := h.evaluator.Evaluate(ctx, req.UserId, perm.Resource, perm.Action, pkgrbac.RequestScope{
+				ProjectID:    deref(req.ProjectId),
+				AgentID:      deref(req.AgentId),
+				SessionID:    deref(req.SessionId),
+				CredentialID: deref(req.CredentialId),
+			})
+			if err != nil {
+				glog.Errorf("role simulate: evaluate %s for %q: %v", perm, req.UserId, err)
+				return nil, errors.GeneralError("unable to evaluate permission")
+			}
+
+			return RoleSimulation{
+				RoleId:     role.ID,
+				UserId:     req.UserId,
+				Permission: perm.String(),
+				RoleGrants: pkgrbac.PermissionsAllow(role.Permissions, perm),
+				Allowed:    allowed,
+			}, nil
+		},
+		ErrorHandler: handlers.HandleError,
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,45 @@
+package roles
+
+import (
+	"encoding/json"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+// parsePermissions decodes the JSON array carried in the API's permissions
+// string and checks each entry against the known resources and actions.
+func parsePermissions(raw *string) ([]string, *errors.ServiceError) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	var perms []string
+	if err := json.Unmarshal([]byte(*raw), &perms); err != nil {
+		return nil, errors.Validation("permissions must be a JSON array of resource:action strings: %s", err)
+	}
+	if err := pkgrbac.ValidatePermissions(perms); err != nil {
+		return nil, errors.Validation("%s", err)
+	}
+	return perms, nil
+}
+
+// validateCustomRole checks a role created through the API. Built-in names
+// are reserved, and a custom role must say where it sits in the hierarchy so
+// that it can be delegated.
+func validateCustomRole(name string, level *int32) *errors.ServiceError {
+	if pkgrbac.IsBuiltInRoleName(name) {
+		return errors.Validation("role name %q is reserved for a built-in role", name)
+	}
+	if level == nil {
+		return errors.Validation("level is required for custom roles (%d to %d)", pkgrbac.MinCustomRoleLevel, pkgrbac.MaxRoleLevel)
+	}
+	return validateLevel(level)
+}
+
+func validateLevel(level *int32) *errors.ServiceError {
+	if level != nil && (*level < pkgrbac.MinCustomRoleLevel || *level > pkgrbac.MaxRoleLevel) {
+		return errors.Validation("level must be between %d and %d, got %d", pkgrbac.MinCustomRoleLevel, pkgrbac.MaxRoleLevel, *level)
+	}
+	return nil
+}
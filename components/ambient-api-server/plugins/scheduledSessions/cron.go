@@ -0,0 +1,52 @@
+package scheduledSessions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser accepts the standard five-field syntax plus descriptors such as
+// "@hourly" and "@every 15m". Timezones come from ScheduledSession.Timezone,
+// not from a CRON_TZ= prefix, so the prefix is rejected to avoid ambiguity.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ValidateSchedule reports whether schedule and timezone can be evaluated.
+func ValidateSchedule(schedule, timezone string) error {
+	_, _, err := parseSchedule(schedule, timezone)
+	return err
+}
+
+// NextRun returns the first firing strictly after the given instant,
+// evaluated in the scheduled session's timezone and returned in UTC.
+func NextRun(schedule, timezone string, after time.Time) (time.Time, error) {
+	sched, loc, err := parseSchedule(schedule, timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := sched.Next(after.In(loc))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule %q never fires", schedule)
+	}
+	return next.UTC(), nil
+}
+
+func parseSchedule(schedule, timezone string) (cron.Schedule, *time.Location, error) {
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		return nil, nil, fmt.Errorf("schedule %q must not embed a timezone; set timezone instead", schedule)
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	sched, err := cronParser.Parse(schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+	return sched, loc, nil
+}
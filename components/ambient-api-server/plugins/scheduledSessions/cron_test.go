@@ -0,0 +1,53 @@
+package scheduledSessions_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/ambient-code/platform/components/ambient-api-server/plugins/scheduledSessions"
+)
+
+func TestNextRun_UsesTimezone(t *testing.T) {
+	after := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC) // Monday 12:00 UTC
+
+	got, err := NextRun("0 9 * * 1-5", "America/New_York", after)
+	if err != nil {
+		t.Fatalf("NextRun: %v", err)
+	}
+	// 12:00 UTC is 07:00 EST, so the next firing is 09:00 EST the same day.
+	want := time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestNextRun_DefaultsToUTC(t *testing.T) {
+	after := time.Date(2026, 3, 2, 12, 30, 0, 0, time.UTC)
+
+	got, err := NextRun("@hourly", "", after)
+	if err != nil {
+		t.Fatalf("NextRun: %v", err)
+	}
+	want := time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestValidateSchedule_Rejects(t *testing.T) {
+	cases := []struct {
+		name, schedule, timezone string
+	}{
+		{"too few fields", "0 9 * *", "UTC"},
+		{"out of range", "61 * * * *", "UTC"},
+		{"unknown timezone", "0 9 * * *", "Mars/Olympus"},
+		{"embedded timezone", "CRON_TZ=UTC 0 9 * * *", "UTC"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateSchedule(tc.schedule, tc.timezone); err == nil {
+				t.Errorf("expected %q in %q to be rejected", tc.schedule, tc.timezone)
+			}
+		})
+	}
+}
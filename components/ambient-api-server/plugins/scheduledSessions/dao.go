@@ -2,6 +2,7 @@ package scheduledSessions
 
 import (
 	"context"
+	"time"
 
 	"github.com/openshift-online/rh-trex-ai/pkg/db"
 	"gorm.io/gorm"
@@ -13,6 +14,9 @@ type ScheduledSessionDao interface {
 	Replace(ctx context.Context, ss *ScheduledSession) (*ScheduledSession, error)
 	Delete(ctx context.Context, id string) error
 	ListByProject(ctx context.Context, projectId string) (ScheduledSessionList, error)
+	ListDue(ctx context.Context, now time.Time) (ScheduledSessionList, error)
+	ClaimRun(ctx context.Context, id string, scheduledFor time.Time, firedAt time.Time, next *time.Time) (bool, error)
+	SetLastRunAt(ctx context.Context, id string, firedAt time.Time) error
 }
 
 type sqlScheduledSessionDao struct {
@@ -61,3 +65,31 @@ func (d *sqlScheduledSessionDao) ListByProject(ctx context.Context, projectId st
 	err := d.db(ctx).Where("project_id = ? AND deleted_at IS NULL", projectId).Find(&list).Error
 	return list, err
 }
+
+func (d *sqlScheduledSessionDao) ListDue(ctx context.Context, now time.Time) (ScheduledSessionList, error) {
+	var list ScheduledSessionList
+	err := d.db(ctx).
+		Where("enabled = ? AND next_run_at IS NOT NULL AND next_run_at <= ? AND deleted_at IS NULL", true, now).
+		Order("next_run_at ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// ClaimRun advances next_run_at only if it still equals scheduledFor, so a
+// firing is claimed by exactly one caller even if two schedulers overlap.
+func (d *sqlScheduledSessionDao) ClaimRun(ctx context.Context, id string, scheduledFor time.Time, firedAt time.Time, next *time.Time) (bool, error) {
+	result := d.db(ctx).Model(&ScheduledSession{}).
+		Where("id = ? AND next_run_at = ? AND deleted_at IS NULL", id, scheduledFor).
+		Updates(map[string]interface{}{
+			"last_run_at": firedAt,
+			"next_run_at": next,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (d *sqlScheduledSessionDao) SetLastRunAt(ctx context.Context, id string, firedAt time.Time) error {
+	return d.db(ctx).Model(&ScheduledSession{}).Where("id = ?", id).Update("last_run_at", firedAt).Error
+}
@@ -0,0 +1,235 @@
+package scheduledSessions
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api"
+	localgrpc "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc"
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+	"github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/pkg/server/grpcutil"
+)
+
+type scheduledSessionGRPCHandler struct {
+	pb.UnimplementedScheduledSessionServiceServer
+	service    ScheduledSessionService
+	brokerFunc func() *server.EventBroker
+}
+
+func NewScheduledSessionGRPCHandler(service ScheduledSessionService, brokerFunc func() *server.EventBroker) pb.ScheduledSessionServiceServer {
+	return &scheduledSessionGRPCHandler{
+		service:    service,
+		brokerFunc: brokerFunc,
+	}
+}
+
+// requireServiceCaller guards single-schedule reads and mutations.  The
+// project scope checks live in the RBAC middleware, which only runs for
+// REST, so over gRPC only service callers may touch a schedule by ID.
+func requireServiceCaller(ctx context.Context) error {
+	if !middleware.IsServiceCaller(ctx) {
+		return status.Error(codes.PermissionDenied, "scheduled sessions can only be read or modified by ID by service callers over gRPC; use the REST API")
+	}
+	return nil
+}
+
+func (h *scheduledSessionGRPCHandler) GetScheduledSession(ctx context.Context, req *pb.GetScheduledSessionRequest) (*pb.ScheduledSession, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	ss, svcErr := h.service.Get(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return scheduledSessionToProto(ss), nil
+}
+
+func (h *scheduledSessionGRPCHandler) CreateScheduledSession(ctx context.Context, req *pb.CreateScheduledSessionRequest) (*pb.ScheduledSession, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("project_id", req.GetProjectId(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("name", req.GetName(), true); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateStringField("schedule", req.GetSchedule(), true); err != nil {
+		return nil, err
+	}
+
+	ss := &ScheduledSession{
+		ProjectId:         req.GetProjectId(),
+		Name:              req.GetName(),
+		Schedule:          req.GetSchedule(),
+		Description:       req.Description,
+		AgentId:           req.AgentId,
+		Timezone:          req.GetTimezone(),
+		Enabled:           true,
+		SessionPrompt:     req.SessionPrompt,
+		Timeout:           req.Timeout,
+		InactivityTimeout: req.InactivityTimeout,
+		StopOnRunFinished: req.StopOnRunFinished,
+		RunnerType:        req.RunnerType,
+	}
+	if ss.Timezone == "" {
+		ss.Timezone = "UTC"
+	}
+	if req.Enabled != nil {
+		ss.Enabled = *req.Enabled
+	}
+
+	created, svcErr := h.service.Create(ctx, ss)
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return scheduledSessionToProto(created), nil
+}
+
+func (h *scheduledSessionGRPCHandler) UpdateScheduledSession(ctx context.Context, req *pb.UpdateScheduledSessionRequest) (*pb.ScheduledSession, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	updated, svcErr := h.service.Patch(ctx, req.GetId(), &ScheduledSessionPatch{
+		Name:              req.Name,
+		Description:       req.Description,
+		AgentId:           req.AgentId,
+		Schedule:          req.Schedule,
+		Timezone:          req.Timezone,
+		Enabled:           req.Enabled,
+		SessionPrompt:     req.SessionPrompt,
+		Timeout:           req.Timeout,
+		InactivityTimeout: req.InactivityTimeout,
+		StopOnRunFinished: req.StopOnRunFinished,
+		RunnerType:        req.RunnerType,
+	})
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return scheduledSessionToProto(updated), nil
+}
+
+func (h *scheduledSessionGRPCHandler) DeleteScheduledSession(ctx context.Context, req *pb.DeleteScheduledSessionRequest) (*pb.DeleteScheduledSessionResponse, error) {
+	if err := requireServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+	if err := grpcutil.ValidateRequiredID(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	svcErr := h.service.Delete(ctx, req.GetId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	return &pb.DeleteScheduledSessionResponse{}, nil
+}
+
+func (h *scheduledSessionGRPCHandler) ListScheduledSessions(ctx context.Context, req *pb.ListScheduledSessionsRequest) (*pb.ListScheduledSessionsResponse, error) {
+	if err := grpcutil.ValidateStringField("project_id", req.GetProjectId(), true); err != nil {
+		return nil, err
+	}
+
+	if !middleware.IsServiceCaller(ctx) && !rbac.IsProjectAuthorized(rbac.GetAuthResult(ctx), req.GetProjectId()) {
+		return &pb.ListScheduledSessionsResponse{Items: []*pb.ScheduledSession{}, Metadata: &pb.ListMeta{Page: 1, Size: 0, Total: 0}}, nil
+	}
+
+	list, svcErr := h.service.ListByProject(ctx, req.GetProjectId())
+	if svcErr != nil {
+		return nil, grpcutil.ServiceErrorToGRPC(svcErr)
+	}
+
+	items := make([]*pb.ScheduledSession, 0, len(list))
+	for _, ss := range list {
+		items = append(items, scheduledSessionToProto(ss))
+	}
+
+	return &pb.ListScheduledSessionsResponse{
+		Items: items,
+		Metadata: &pb.ListMeta{
+			Page:  1,
+			Size:  int32(len(items)),
+			Total: int32(len(items)),
+		},
+	}, nil
+}
+
+func (h *scheduledSessionGRPCHandler) WatchScheduledSessions(req *pb.WatchScheduledSessionsRequest, stream grpc.ServerStreamingServer[pb.ScheduledSessionWatchEvent]) error {
+	broker := h.brokerFunc()
+	if broker == nil {
+		return status.Error(codes.Unavailable, "event broker not available")
+	}
+
+	ctx := stream.Context()
+	authResult := rbac.GetAuthResult(ctx)
+	isPrivileged := middleware.IsServiceCaller(ctx) ||
+		(authResult != nil && authResult.IsGlobalAdmin)
+
+	sub, err := broker.Subscribe(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to event broker: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Source != EventSource {
+				continue
+			}
+
+			watchEvent := &pb.ScheduledSessionWatchEvent{
+				Type:       localgrpc.APIEventTypeToProto(event.EventType),
+				ResourceId: event.SourceID,
+			}
+
+			if event.EventType != api.DeleteEventType {
+				ss, svcErr := h.service.Get(ctx, event.SourceID)
+				if svcErr != nil {
+					glog.Errorf("WatchScheduledSessions: failed to get scheduled session %s: %v", event.SourceID, svcErr)
+					continue
+				}
+
+				if req.GetProjectId() != "" && ss.ProjectId != req.GetProjectId() {
+					continue
+				}
+				if !isPrivileged && !rbac.IsProjectAuthorized(authResult, ss.ProjectId) {
+					continue
+				}
+
+				watchEvent.ScheduledSession = scheduledSessionToProto(ss)
+			} else if !isPrivileged {
+				// Delete events: can't verify project scope (schedule gone),
+				// so suppress for non-privileged watchers to prevent ID leakage
+				continue
+			}
+
+			if err := stream.Send(watchEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package scheduledSessions_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	. "github.com/ambient-code/platform/components/ambient-api-server/plugins/scheduledSessions"
+)
+
+// Single-schedule reads and mutations are only open to service callers over
+// gRPC; users go through REST, where the RBAC middleware scopes them.
+func TestGRPCHandler_RequiresServiceCaller(t *testing.T) {
+	svc := NewInMemoryService()
+	existing, svcErr := svc.Create(context.Background(), &ScheduledSession{
+		ProjectId: "proj-1",
+		Name:      "nightly",
+		Schedule:  "0 2 * * *",
+		Timezone:  "UTC",
+		Enabled:   true,
+	})
+	if svcErr != nil {
+		t.Fatalf("seed schedule: %v", svcErr)
+	}
+	h := NewScheduledSessionGRPCHandler(svc, nil)
+	userCtx := middleware.WithCallerType(context.Background(), middleware.CallerTypeUser)
+
+	enabled := false
+	calls := map[string]func(context.Context) error{
+		"Get": func(ctx context.Context) error {
+			_, err := h.GetScheduledSession(ctx, &pb.GetScheduledSessionRequest{Id: existing.ID})
+			return err
+		},
+		"Create": func(ctx context.Context) error {
+			_, err := h.CreateScheduledSession(ctx, &pb.CreateScheduledSessionRequest{ProjectId: "proj-2", Name: "hourly", Schedule: "0 * * * *"})
+			return err
+		},
+		"Update": func(ctx context.Context) error {
+			_, err := h.UpdateScheduledSession(ctx, &pb.UpdateScheduledSessionRequest{Id: existing.ID, Enabled: &enabled})
+			return err
+		},
+		"Delete": func(ctx context.Context) error {
+			_, err := h.DeleteScheduledSession(ctx, &pb.DeleteScheduledSessionRequest{Id: existing.ID})
+			return err
+		},
+	}
+	for name, call := range calls {
+		for label, ctx := range map[string]context.Context{"user": userCtx, "anonymous": context.Background()} {
+			if code := status.Code(call(ctx)); code != codes.PermissionDenied {
+				t.Errorf("%s as %s caller: code = %v, want PermissionDenied", name, label, code)
+			}
+		}
+	}
+
+	got, svcErr := svc.Get(context.Background(), existing.ID)
+	if svcErr != nil {
+		t.Fatalf("schedule removed by refused delete: %v", svcErr)
+	}
+	if !got.Enabled {
+		t.Error("refused update disabled the schedule")
+	}
+	if list, _ := svc.ListByProject(context.Background(), "proj-2"); len(list) != 0 {
+		t.Errorf("refused create stored %d schedules", len(list))
+	}
+}
+
+func TestGRPCHandler_ServiceCallerAllowed(t *testing.T) {
+	h := NewScheduledSessionGRPCHandler(NewInMemoryService(), nil)
+	ctx := middleware.WithCallerType(context.Background(), middleware.CallerTypeService)
+
+	created, err := h.CreateScheduledSession(ctx, &pb.CreateScheduledSessionRequest{ProjectId: "proj-1", Name: "nightly", Schedule: "0 2 * * *"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := h.GetScheduledSession(ctx, &pb.GetScheduledSessionRequest{Id: created.GetMetadata().GetId()}); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if _, err := h.DeleteScheduledSession(ctx, &pb.DeleteScheduledSessionRequest{Id: created.GetMetadata().GetId()}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+}
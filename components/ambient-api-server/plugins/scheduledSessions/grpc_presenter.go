@@ -0,0 +1,45 @@
+package scheduledSessions
+
+import (
+	"fmt"
+
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func scheduledSessionToProto(ss *ScheduledSession) *pb.ScheduledSession {
+	if ss == nil {
+		return nil
+	}
+
+	proto := &pb.ScheduledSession{
+		Metadata: &pb.ObjectReference{
+			Id:        ss.ID,
+			CreatedAt: timestamppb.New(ss.CreatedAt),
+			UpdatedAt: timestamppb.New(ss.UpdatedAt),
+			Kind:      "ScheduledSession",
+			Href:      fmt.Sprintf(basePath, ss.ProjectId, ss.ID),
+		},
+		Name:              ss.Name,
+		Description:       ss.Description,
+		ProjectId:         ss.ProjectId,
+		AgentId:           ss.AgentId,
+		Schedule:          ss.Schedule,
+		Timezone:          ss.Timezone,
+		Enabled:           ss.Enabled,
+		SessionPrompt:     ss.SessionPrompt,
+		Timeout:           ss.Timeout,
+		InactivityTimeout: ss.InactivityTimeout,
+		StopOnRunFinished: ss.StopOnRunFinished,
+		RunnerType:        ss.RunnerType,
+	}
+
+	if ss.LastRunAt != nil {
+		proto.LastRunAt = timestamppb.New(*ss.LastRunAt)
+	}
+	if ss.NextRunAt != nil {
+		proto.NextRunAt = timestamppb.New(*ss.NextRunAt)
+	}
+
+	return proto
+}
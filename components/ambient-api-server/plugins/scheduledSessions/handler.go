@@ -2,11 +2,13 @@ package scheduledSessions
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
 )
 
 type scheduledSessionHandler struct {
@@ -201,29 +203,48 @@ func (h *scheduledSessionHandler) Trigger(w http.ResponseWriter, r *http.Request
 	cfg := &handlers.HandlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
-			if err := h.svc.Trigger(r.Context(), id); err != nil {
+			run, err := h.svc.Trigger(r.Context(), id)
+			if err != nil {
 				return nil, err
 			}
-			return map[string]string{"status": "triggered"}, nil
+			resp := map[string]interface{}{
+				"status":     "triggered",
+				"run_id":     run.ID,
+				"run_status": run.Status,
+			}
+			if run.SessionId != nil {
+				resp["session_id"] = *run.SessionId
+			}
+			return resp, nil
 		},
 	}
 	handlers.HandleGet(w, r, cfg)
 }
 
 // Runs — GET /api/ambient/v1/projects/{project_id}/scheduled-sessions/{id}/runs
+// Returns one page of this schedule's firings, newest first: started, skipped
+// and failed.
 func (h *scheduledSessionHandler) Runs(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
-			// Returns sessions triggered by this scheduled session.
-			// In production, query sessions where source_scheduled_session_id = id.
-			// For now, return empty list so the endpoint works and is testable.
-			return map[string]interface{}{
-				"kind":  "SessionList",
-				"page":  1,
-				"size":  0,
-				"total": 0,
-				"items": []interface{}{},
-			}, nil
+			id := mux.Vars(r)["id"]
+			query := r.URL.Query()
+			if v := query.Get("page"); v != "" {
+				if n, err := strconv.Atoi(v); err != nil || n < 1 {
+					return nil, errors.Validation("page must be a positive integer")
+				}
+			}
+			if v := query.Get("size"); v != "" {
+				if n, err := strconv.Atoi(v); err != nil || n < 0 {
+					return nil, errors.Validation("size must be a non-negative integer")
+				}
+			}
+			args := services.NewListArguments(query)
+			runs, total, err := h.svc.Runs(r.Context(), id, args)
+			if err != nil {
+				return nil, err
+			}
+			return PresentScheduledSessionRunList(runs, args.Page, total), nil
 		},
 	}
 	handlers.HandleList(w, r, cfg)
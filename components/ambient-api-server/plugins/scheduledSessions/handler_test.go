@@ -136,6 +136,8 @@ func TestCreate_MissingRequiredFields(t *testing.T) {
 	}{
 		{"missing name", openapi.ScheduledSession{Schedule: "* * * * *"}},
 		{"missing schedule", openapi.ScheduledSession{Name: "x"}},
+		{"invalid schedule", openapi.ScheduledSession{Name: "x", Schedule: "every tuesday"}},
+		{"invalid timezone", openapi.ScheduledSession{Name: "x", Schedule: "* * * * *", Timezone: strPtr("Nowhere/Town")}},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -356,8 +358,84 @@ func TestRuns_ReturnsEmptyList(t *testing.T) {
 	}
 	var result map[string]interface{}
 	decodeJSON(t, rr.Body.Bytes(), &result)
-	if result["kind"] != "SessionList" {
-		t.Errorf("expected kind=SessionList, got %v", result["kind"])
+	if result["kind"] != "ScheduledSessionRunList" {
+		t.Errorf("expected kind=ScheduledSessionRunList, got %v", result["kind"])
+	}
+}
+
+func TestRuns_ReturnsRunRecords(t *testing.T) {
+	svc := NewInMemoryService()
+	router := setupRouter(svc)
+
+	ss := newSS(t, svc, "proj-1")
+	if _, err := svc.Trigger(context.Background(), *ss.Id); err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet,
+		fmt.Sprintf("/api/ambient/v1/projects/proj-1/scheduled-sessions/%s/runs", *ss.Id), nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var result openapi.ScheduledSessionRunList
+	decodeJSON(t, rr.Body.Bytes(), &result)
+	if len(result.Items) != 1 {
+		t.Fatalf("expected 1 run, got %d", len(result.Items))
+	}
+	run := result.Items[0]
+	if run.GetKind() != "ScheduledSessionRun" || run.ScheduledSessionId != *ss.Id || run.Trigger != RunTriggerManual || run.Status != RunStatusStarted {
+		t.Errorf("unexpected run: %+v", run)
+	}
+	if run.Href != nil {
+		t.Errorf("expected runs to have no href, got %s", *run.Href)
+	}
+}
+
+func TestRuns_PagesThroughHistory(t *testing.T) {
+	svc := NewInMemoryService()
+	router := setupRouter(svc)
+
+	ss := newSS(t, svc, "proj-1")
+	for i := 0; i < 3; i++ {
+		if _, err := svc.Trigger(context.Background(), *ss.Id); err != nil {
+			t.Fatalf("trigger: %v", err)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet,
+		fmt.Sprintf("/api/ambient/v1/projects/proj-1/scheduled-sessions/%s/runs?page=2&size=2", *ss.Id), nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var result openapi.ScheduledSessionRunList
+	decodeJSON(t, rr.Body.Bytes(), &result)
+	if result.Page != 2 || result.Size != 1 || result.Total != 3 || len(result.Items) != 1 {
+		t.Errorf("expected the last of 3 runs on page 2, got page=%d size=%d total=%d items=%d",
+			result.Page, result.Size, result.Total, len(result.Items))
+	}
+}
+
+func TestRuns_RejectsBadPaging(t *testing.T) {
+	svc := NewInMemoryService()
+	router := setupRouter(svc)
+
+	ss := newSS(t, svc, "proj-1")
+
+	for _, query := range []string{"size=many", "size=-1", "page=0", "page=first"} {
+		req := httptest.NewRequest(http.MethodGet,
+			fmt.Sprintf("/api/ambient/v1/projects/proj-1/scheduled-sessions/%s/runs?%s", *ss.Id, query), nil)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", query, rr.Code)
+		}
 	}
 }
 
@@ -0,0 +1,88 @@
+package scheduledSessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
+)
+
+// AgentStarter starts an agent the same way POST /projects/{id}/agents/{agent_id}/start
+// does. The agents plugin registers an implementation under "AgentStarter";
+// it is looked up by name so this package does not import agents.
+type AgentStarter interface {
+	StartAgent(ctx context.Context, projectID, agentID string, prompt *string) (sessionID string, alreadyActive bool, err *errors.ServiceError)
+}
+
+// SessionLauncher produces the session for one firing of a ScheduledSession.
+// alreadyActive is true when the referenced agent already had a running
+// session, in which case that session's ID is returned and nothing is created.
+type SessionLauncher interface {
+	Launch(ctx context.Context, ss *ScheduledSession) (sessionID string, alreadyActive bool, err *errors.ServiceError)
+}
+
+type sessionLauncher struct {
+	sessions sessions.SessionService
+	agents   AgentStarter
+}
+
+func NewSessionLauncher(sessionSvc sessions.SessionService, agents AgentStarter) SessionLauncher {
+	return &sessionLauncher{sessions: sessionSvc, agents: agents}
+}
+
+func (l *sessionLauncher) Launch(ctx context.Context, ss *ScheduledSession) (string, bool, *errors.ServiceError) {
+	if ss.AgentId != nil && *ss.AgentId != "" {
+		if l.agents == nil {
+			return "", false, errors.GeneralError("cannot start agent %s: agent starter is not configured", *ss.AgentId)
+		}
+		return l.agents.StartAgent(ctx, ss.ProjectId, *ss.AgentId, ss.SessionPrompt)
+	}
+
+	if l.sessions == nil {
+		return "", false, errors.GeneralError("cannot create session: session service is not configured")
+	}
+	projectID := ss.ProjectId
+	sess := &sessions.Session{
+		Name:      fmt.Sprintf("%s-%d", ss.Name, time.Now().Unix()),
+		Prompt:    ss.SessionPrompt,
+		ProjectId: &projectID,
+		Timeout:   ss.Timeout,
+	}
+	created, err := l.sessions.Create(ctx, sess)
+	if err != nil {
+		return "", false, err
+	}
+	if _, err := l.sessions.Start(ctx, created.ID); err != nil {
+		return created.ID, false, err
+	}
+	return created.ID, false, nil
+}
+
+// launchRun fires ss once through launcher and returns the run record
+// describing the outcome. The caller persists it.
+func launchRun(ctx context.Context, launcher SessionLauncher, ss *ScheduledSession, trigger string, scheduledFor *time.Time, firedAt time.Time) *ScheduledSessionRun {
+	run := &ScheduledSessionRun{
+		ScheduledSessionId: ss.ID,
+		ProjectId:          ss.ProjectId,
+		Trigger:            trigger,
+		Status:             RunStatusStarted,
+		ScheduledFor:       scheduledFor,
+		FiredAt:            firedAt,
+	}
+	sessionID, alreadyActive, err := launcher.Launch(ctx, ss)
+	if sessionID != "" {
+		run.SessionId = &sessionID
+	}
+	switch {
+	case err != nil:
+		msg := err.Error()
+		run.Status = RunStatusFailed
+		run.Error = &msg
+	case alreadyActive:
+		run.Status = RunStatusSkipped
+	}
+	return run
+}
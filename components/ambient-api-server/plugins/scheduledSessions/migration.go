@@ -80,3 +80,31 @@ func indexMigration() *gormigrate.Migration {
 		},
 	}
 }
+
+func runsMigration() *gormigrate.Migration {
+	type ScheduledSessionRun struct {
+		db.Model
+		ScheduledSessionId string `gorm:"index;not null"`
+		ProjectId          string `gorm:"index;not null"`
+		SessionId          *string
+		Trigger            string `gorm:"not null"`
+		Status             string `gorm:"not null"`
+		Error              *string
+		ScheduledFor       *time.Time
+		FiredAt            time.Time `gorm:"not null"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170001",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ScheduledSessionRun{}); err != nil {
+				return err
+			}
+			return tx.Exec(`CREATE INDEX IF NOT EXISTS idx_scheduled_sessions_due ON scheduled_sessions(next_run_at) WHERE enabled AND deleted_at IS NULL`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx.Exec(`DROP INDEX IF EXISTS idx_scheduled_sessions_due`)
+			return tx.Migrator().DropTable("scheduled_session_runs")
+		},
+	}
+}
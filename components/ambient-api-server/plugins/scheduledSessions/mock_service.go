@@ -7,13 +7,17 @@ import (
 
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
 )
 
 // InMemoryScheduledSessionService is a zero-dependency service for tests and local dev.
 // It stores state in a map and never touches the database.
+// Without a launcher, Trigger and FireDue record runs that produce no session.
 type InMemoryScheduledSessionService struct {
-	mu   sync.RWMutex
-	data map[string]*ScheduledSession
+	mu       sync.RWMutex
+	data     map[string]*ScheduledSession
+	runs     map[string]ScheduledSessionRunList
+	launcher SessionLauncher
 }
 
 var _ ScheduledSessionService = &InMemoryScheduledSessionService{}
@@ -21,9 +25,18 @@ var _ ScheduledSessionService = &InMemoryScheduledSessionService{}
 func NewInMemoryService() *InMemoryScheduledSessionService {
 	return &InMemoryScheduledSessionService{
 		data: make(map[string]*ScheduledSession),
+		runs: make(map[string]ScheduledSessionRunList),
 	}
 }
 
+// NewInMemoryServiceWithLauncher returns an in-memory service whose firings
+// go through launcher.
+func NewInMemoryServiceWithLauncher(launcher SessionLauncher) *InMemoryScheduledSessionService {
+	s := NewInMemoryService()
+	s.launcher = launcher
+	return s
+}
+
 func (s *InMemoryScheduledSessionService) Get(_ context.Context, id string) (*ScheduledSession, *errors.ServiceError) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -36,8 +49,14 @@ func (s *InMemoryScheduledSessionService) Get(_ context.Context, id string) (*Sc
 }
 
 func (s *InMemoryScheduledSessionService) Create(_ context.Context, ss *ScheduledSession) (*ScheduledSession, *errors.ServiceError) {
-	ss.ID = api.NewID()
+	if err := ValidateSchedule(ss.Schedule, ss.Timezone); err != nil {
+		return nil, errors.Validation("%s", err)
+	}
 	now := time.Now()
+	if svcErr := scheduleNext(ss, now); svcErr != nil {
+		return nil, svcErr
+	}
+	ss.ID = api.NewID()
 	ss.CreatedAt = now
 	ss.UpdatedAt = now
 	if ss.Timezone == "" {
@@ -47,16 +66,19 @@ func (s *InMemoryScheduledSessionService) Create(_ context.Context, ss *Schedule
 	defer s.mu.Unlock()
 	cp := *ss
 	s.data[ss.ID] = &cp
-	return &cp, nil
+	out := cp
+	return &out, nil
 }
 
 func (s *InMemoryScheduledSessionService) Patch(_ context.Context, id string, patch *ScheduledSessionPatch) (*ScheduledSession, *errors.ServiceError) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	ss, ok := s.data[id]
+	stored, ok := s.data[id]
 	if !ok {
 		return nil, errors.NotFound("ScheduledSession with id '%s' not found", id)
 	}
+	cp := *stored
+	ss := &cp
 	if patch.Name != nil {
 		ss.Name = *patch.Name
 	}
@@ -90,9 +112,19 @@ func (s *InMemoryScheduledSessionService) Patch(_ context.Context, id string, pa
 	if patch.RunnerType != nil {
 		ss.RunnerType = patch.RunnerType
 	}
-	ss.UpdatedAt = time.Now()
-	cp := *ss
-	return &cp, nil
+	if err := ValidateSchedule(ss.Schedule, ss.Timezone); err != nil {
+		return nil, errors.Validation("%s", err)
+	}
+	now := time.Now()
+	if patch.Schedule != nil || patch.Timezone != nil || patch.Enabled != nil || (ss.Enabled && ss.NextRunAt == nil) {
+		if svcErr := scheduleNext(ss, now); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+	ss.UpdatedAt = now
+	s.data[id] = ss
+	out := *ss
+	return &out, nil
 }
 
 func (s *InMemoryScheduledSessionService) Delete(_ context.Context, id string) *errors.ServiceError {
@@ -128,7 +160,97 @@ func (s *InMemoryScheduledSessionService) Resume(ctx context.Context, id string)
 	return s.Patch(ctx, id, &ScheduledSessionPatch{Enabled: &enabled})
 }
 
-func (s *InMemoryScheduledSessionService) Trigger(ctx context.Context, id string) *errors.ServiceError {
-	_, err := s.Get(ctx, id)
-	return err
+func (s *InMemoryScheduledSessionService) Trigger(ctx context.Context, id string) (*ScheduledSessionRun, *errors.ServiceError) {
+	ss, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	run := s.fire(ctx, ss, RunTriggerManual, nil, now)
+
+	s.mu.Lock()
+	if stored, ok := s.data[id]; ok {
+		stored.LastRunAt = &now
+	}
+	s.mu.Unlock()
+
+	if run.Status == RunStatusFailed {
+		return nil, errors.GeneralError("failed to trigger scheduled session: %s", *run.Error)
+	}
+	return run, nil
+}
+
+func (s *InMemoryScheduledSessionService) Runs(ctx context.Context, id string, args *services.ListArguments) (ScheduledSessionRunList, int64, *errors.ServiceError) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, 0, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stored := s.runs[id]
+	runs := ScheduledSessionRunList{}
+	skip := int64(args.Page-1) * args.Size
+	for i := len(stored) - 1; i >= 0 && int64(len(runs)) < args.Size; i-- {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		runs = append(runs, stored[i])
+	}
+	return runs, int64(len(stored)), nil
+}
+
+func (s *InMemoryScheduledSessionService) FireDue(ctx context.Context, now time.Time) (int, *errors.ServiceError) {
+	s.mu.Lock()
+	var due ScheduledSessionList
+	for _, ss := range s.data {
+		if !ss.Enabled || ss.NextRunAt == nil || ss.NextRunAt.After(now) {
+			continue
+		}
+		scheduledFor := *ss.NextRunAt
+		ss.LastRunAt = &now
+		ss.NextRunAt = nil
+		if next, err := NextRun(ss.Schedule, ss.Timezone, now); err == nil {
+			ss.NextRunAt = &next
+		}
+		cp := *ss
+		cp.NextRunAt = &scheduledFor
+		due = append(due, &cp)
+	}
+	s.mu.Unlock()
+
+	for _, ss := range due {
+		s.fire(ctx, ss, RunTriggerSchedule, ss.NextRunAt, now)
+	}
+	return len(due), nil
+}
+
+// RunHistory returns the recorded runs for id, oldest first.
+func (s *InMemoryScheduledSessionService) RunHistory(id string) ScheduledSessionRunList {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append(ScheduledSessionRunList(nil), s.runs[id]...)
+}
+
+func (s *InMemoryScheduledSessionService) fire(ctx context.Context, ss *ScheduledSession, trigger string, scheduledFor *time.Time, now time.Time) *ScheduledSessionRun {
+	var run *ScheduledSessionRun
+	if s.launcher != nil {
+		run = launchRun(ctx, s.launcher, ss, trigger, scheduledFor, now)
+	} else {
+		run = &ScheduledSessionRun{
+			ScheduledSessionId: ss.ID,
+			ProjectId:          ss.ProjectId,
+			Trigger:            trigger,
+			Status:             RunStatusStarted,
+			ScheduledFor:       scheduledFor,
+			FiredAt:            now,
+		}
+	}
+	run.ID = api.NewID()
+	run.CreatedAt = now
+	run.UpdatedAt = now
+
+	s.mu.Lock()
+	s.runs[ss.ID] = append(s.runs[ss.ID], run)
+	s.mu.Unlock()
+	return run
 }
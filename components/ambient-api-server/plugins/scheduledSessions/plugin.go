@@ -1,26 +1,105 @@
 package scheduledSessions
 
 import (
+	"context"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/controllers"
 	"github.com/openshift-online/rh-trex-ai/pkg/db"
 	"github.com/openshift-online/rh-trex-ai/pkg/environments"
 	"github.com/openshift-online/rh-trex-ai/pkg/registry"
 	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+	"github.com/openshift-online/rh-trex-ai/plugins/events"
+	"google.golang.org/grpc"
 
+	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
 )
 
+// envSchedulerInterval overrides how often the scheduler checks for due
+// schedules, as a Go duration (default 30s).
+const envSchedulerInterval = "SCHEDULED_SESSIONS_TICK_INTERVAL"
+
+const EventSource = "ScheduledSessions"
+
+type ServiceLocator func() ScheduledSessionService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	return func() ScheduledSessionService {
+		sessionSvc := sessions.Service(&env.Services)
+		return NewScheduledSessionService(
+			NewScheduledSessionDao(&env.Database.SessionFactory),
+			NewScheduledSessionRunDao(&env.Database.SessionFactory),
+			NewSessionLauncher(sessionSvc, agentStarter(&env.Services)),
+			events.Service(&env.Services),
+		)
+	}
+}
+
+func Service(s *environments.Services) ScheduledSessionService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("ScheduledSessions"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+func agentStarter(s *environments.Services) AgentStarter {
+	if obj := s.GetService("AgentStarter"); obj != nil {
+		locator := obj.(func(*environments.Services) interface{})
+		return locator(s).(AgentStarter)
+	}
+	return nil
+}
+
+func schedulerInterval() time.Duration {
+	raw := os.Getenv(envSchedulerInterval)
+	if raw == "" {
+		return defaultSchedulerInterval
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		glog.Warningf("Ignoring invalid %s=%q; using %s", envSchedulerInterval, raw, defaultSchedulerInterval)
+		return defaultSchedulerInterval
+	}
+	return d
+}
+
+func acknowledgeEvent(_ context.Context, _ string) error {
+	return nil
+}
+
 func init() {
+	registry.RegisterService("ScheduledSessions", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	registry.RegisterService("ScheduledSessionScheduler", func(env interface{}) interface{} {
+		e := env.(*environments.Env)
+		return func() *Scheduler {
+			return NewScheduler(
+				NewServiceLocator(e)(),
+				db.NewAdvisoryLockFactory(e.Database.SessionFactory),
+				schedulerInterval(),
+			)
+		}
+	})
+
 	pkgserver.RegisterRoutes("scheduledSessions", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
 		envServices := services.(*environments.Services)
 
-		var svc ScheduledSessionService
-		if obj := envServices.GetService("ScheduledSessions"); obj != nil {
-			svc = obj.(func() ScheduledSessionService)()
-		} else {
+		svc := Service(envServices)
+		if svc == nil {
 			svc = NewInMemoryService()
 		}
 
@@ -45,18 +124,42 @@ func init() {
 		schedRouter.Use(authzMiddleware.AuthorizeApi)
 	})
 
-	// SQL-backed service registered for production.
-	// In unit_testing / dev the in-memory fallback in RegisterRoutes is used.
-	registry.RegisterService("ScheduledSessionsSQL", func(env interface{}) interface{} {
-		e := env.(*environments.Env)
-		return func() ScheduledSessionService {
-			return NewScheduledSessionService(
-				NewScheduledSessionDao(&e.Database.SessionFactory),
-			)
+	// The scheduler runs alongside the kind controllers in every replica;
+	// the advisory lock in Scheduler.Tick keeps firings single-writer.
+	pkgserver.RegisterController("ScheduledSessionScheduler", func(_ *controllers.KindControllerManager, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		if obj := envServices.GetService("ScheduledSessionScheduler"); obj != nil {
+			go obj.(func() *Scheduler)().Run(context.Background())
+		}
+	})
+
+	// Change events only feed gRPC watchers; there is nothing to reconcile,
+	// but registering the source marks them reconciled for the sync loop.
+	pkgserver.RegisterController(EventSource, func(manager *controllers.KindControllerManager, _ pkgserver.ServicesInterface) {
+		manager.Add(&controllers.ControllerConfig{
+			Source: EventSource,
+			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
+				api.CreateEventType: {acknowledgeEvent},
+				api.UpdateEventType: {acknowledgeEvent},
+				api.DeleteEventType: {acknowledgeEvent},
+			},
+		})
+	})
+
+	pkgserver.RegisterGRPCService("scheduled_sessions", func(grpcServer *grpc.Server, services pkgserver.ServicesInterface) {
+		envServices := services.(*environments.Services)
+		svc := Service(envServices)
+		brokerFunc := func() *pkgserver.EventBroker {
+			if obj := envServices.GetService("EventBroker"); obj != nil {
+				return obj.(*pkgserver.EventBroker)
+			}
+			return nil
 		}
+		pb.RegisterScheduledSessionServiceServer(grpcServer, NewScheduledSessionGRPCHandler(svc, brokerFunc))
 	})
 
 	db.RegisterMigration(migration())
 	db.RegisterMigration(indexMigration())
 	db.RegisterMigration(executionFieldsMigration())
+	db.RegisterMigration(runsMigration())
 }
@@ -56,3 +56,37 @@ func ConvertScheduledSession(in openapi.ScheduledSession) *ScheduledSession {
 	}
 	return ss
 }
+
+// PresentScheduledSessionRun has no href: runs are only addressable through
+// their schedule's runs list.
+func PresentScheduledSessionRun(run *ScheduledSessionRun) openapi.ScheduledSessionRun {
+	kind := "ScheduledSessionRun"
+	return openapi.ScheduledSessionRun{
+		Id:                 &run.ID,
+		Kind:               &kind,
+		CreatedAt:          &run.CreatedAt,
+		UpdatedAt:          &run.UpdatedAt,
+		ScheduledSessionId: run.ScheduledSessionId,
+		ProjectId:          run.ProjectId,
+		SessionId:          run.SessionId,
+		Trigger:            run.Trigger,
+		Status:             run.Status,
+		Error:              run.Error,
+		ScheduledFor:       run.ScheduledFor,
+		FiredAt:            run.FiredAt,
+	}
+}
+
+func PresentScheduledSessionRunList(runs ScheduledSessionRunList, page int, total int64) openapi.ScheduledSessionRunList {
+	list := openapi.ScheduledSessionRunList{
+		Kind:  "ScheduledSessionRunList",
+		Page:  int32(page),
+		Size:  int32(len(runs)),
+		Total: int32(total),
+		Items: make([]openapi.ScheduledSessionRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		list.Items = append(list.Items, PresentScheduledSessionRun(run))
+	}
+	return list
+}
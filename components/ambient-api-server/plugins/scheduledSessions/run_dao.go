@@ -0,0 +1,48 @@
+package scheduledSessions
+
+import (
+	"context"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"gorm.io/gorm"
+)
+
+type ScheduledSessionRunDao interface {
+	Create(ctx context.Context, run *ScheduledSessionRun) (*ScheduledSessionRun, error)
+	ListByScheduledSession(ctx context.Context, scheduledSessionId string, page int, size int64) (ScheduledSessionRunList, int64, error)
+}
+
+type sqlScheduledSessionRunDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewScheduledSessionRunDao(sessionFactory *db.SessionFactory) ScheduledSessionRunDao {
+	return &sqlScheduledSessionRunDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlScheduledSessionRunDao) db(ctx context.Context) *gorm.DB {
+	return (*d.sessionFactory).New(ctx)
+}
+
+func (d *sqlScheduledSessionRunDao) Create(ctx context.Context, run *ScheduledSessionRun) (*ScheduledSessionRun, error) {
+	if err := d.db(ctx).Create(run).Error; err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// ListByScheduledSession returns one page of the schedule's runs, newest
+// first, and the total number of runs it has.
+func (d *sqlScheduledSessionRunDao) ListByScheduledSession(ctx context.Context, scheduledSessionId string, page int, size int64) (ScheduledSessionRunList, int64, error) {
+	q := d.db(ctx).Model(&ScheduledSessionRun{}).Where("scheduled_session_id = ? AND deleted_at IS NULL", scheduledSessionId)
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	list := ScheduledSessionRunList{}
+	if size == 0 {
+		return list, total, nil
+	}
+	err := q.Order("fired_at DESC").Offset((page - 1) * int(size)).Limit(int(size)).Find(&list).Error
+	return list, total, err
+}
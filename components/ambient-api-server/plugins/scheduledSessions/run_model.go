@@ -0,0 +1,39 @@
+package scheduledSessions
+
+import (
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+)
+
+const (
+	RunTriggerSchedule = "schedule"
+	RunTriggerManual   = "manual"
+
+	RunStatusStarted = "Started"
+	RunStatusSkipped = "Skipped"
+	RunStatusFailed  = "Failed"
+)
+
+// ScheduledSessionRun records one firing of a ScheduledSession and the
+// session it produced. Skipped runs reference the agent's already-active
+// session; failed runs carry the launch error and no session.
+type ScheduledSessionRun struct {
+	api.Meta
+	ScheduledSessionId string     `json:"scheduled_session_id"`
+	ProjectId          string     `json:"project_id"`
+	SessionId          *string    `json:"session_id,omitempty"`
+	Trigger            string     `json:"trigger"`
+	Status             string     `json:"status"`
+	Error              *string    `json:"error,omitempty"`
+	ScheduledFor       *time.Time `json:"scheduled_for,omitempty"`
+	FiredAt            time.Time  `json:"fired_at"`
+}
+
+type ScheduledSessionRunList []*ScheduledSessionRun
+
+func (r *ScheduledSessionRun) BeforeCreate(tx *gorm.DB) error {
+	r.ID = api.NewID()
+	return nil
+}
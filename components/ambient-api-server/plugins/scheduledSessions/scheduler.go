@@ -0,0 +1,80 @@
+package scheduledSessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+const (
+	schedulerLockType db.LockType = "scheduled_sessions"
+	schedulerLockID               = "scheduler"
+
+	defaultSchedulerInterval = 30 * time.Second
+)
+
+// Scheduler fires due scheduled sessions on a fixed tick. Every API replica
+// runs one; each tick is guarded by a non-blocking Postgres advisory lock so
+// only the replica that wins the lock fires that tick.
+type Scheduler struct {
+	svc         ScheduledSessionService
+	lockFactory db.LockFactory
+	interval    time.Duration
+	now         func() time.Time
+}
+
+func NewScheduler(svc ScheduledSessionService, lockFactory db.LockFactory, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+	return &Scheduler{
+		svc:         svc,
+		lockFactory: lockFactory,
+		interval:    interval,
+		now:         time.Now,
+	}
+}
+
+// Run ticks until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	glog.Infof("Scheduled session scheduler started (interval %s)", s.interval)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			glog.Infof("Scheduled session scheduler stopped")
+			return
+		case <-ticker.C:
+			s.Tick(ctx)
+		}
+	}
+}
+
+// Tick fires all due schedules if this replica holds the scheduler lock.
+// It returns the number of schedules fired.
+func (s *Scheduler) Tick(ctx context.Context) int {
+	if s.lockFactory != nil {
+		owner, acquired, err := s.lockFactory.NewNonBlockingLock(ctx, schedulerLockID, schedulerLockType)
+		defer s.lockFactory.Unlock(ctx, owner)
+		if err != nil {
+			glog.Errorf("Scheduled session scheduler: acquire lock: %v", err)
+			return 0
+		}
+		if !acquired {
+			return 0
+		}
+	}
+
+	fired, svcErr := s.svc.FireDue(ctx, s.now().UTC())
+	if svcErr != nil {
+		glog.Errorf("Scheduled session scheduler: %v", svcErr)
+		return 0
+	}
+	if fired > 0 {
+		glog.V(2).Infof("Scheduled session scheduler fired %d schedule(s)", fired)
+	}
+	return fired
+}
@@ -0,0 +1,235 @@
+package scheduledSessions_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/services"
+
+	. "github.com/ambient-code/platform/components/ambient-api-server/plugins/scheduledSessions"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
+)
+
+type fakeAgentStarter struct {
+	sessions *sessions.InMemorySessionService
+	calls    int
+	active   map[string]string
+}
+
+func (f *fakeAgentStarter) StartAgent(ctx context.Context, projectID, agentID string, _ *string) (string, bool, *errors.ServiceError) {
+	f.calls++
+	if id, ok := f.active[agentID]; ok {
+		return id, true, nil
+	}
+	created, err := f.sessions.Create(ctx, &sessions.Session{Name: agentID, ProjectId: &projectID, AgentId: &agentID})
+	if err != nil {
+		return "", false, err
+	}
+	return created.ID, false, nil
+}
+
+type failingLauncher struct{}
+
+func (failingLauncher) Launch(context.Context, *ScheduledSession) (string, bool, *errors.ServiceError) {
+	return "", false, errors.GeneralError("agent has no prompt")
+}
+
+func newSchedulingService() (*InMemoryScheduledSessionService, *sessions.InMemorySessionService, *fakeAgentStarter) {
+	sessionSvc := sessions.NewInMemorySessionService()
+	starter := &fakeAgentStarter{sessions: sessionSvc, active: map[string]string{}}
+	return NewInMemoryServiceWithLauncher(NewSessionLauncher(sessionSvc, starter)), sessionSvc, starter
+}
+
+func TestCreate_ComputesNextRunOnlyWhenEnabled(t *testing.T) {
+	svc, _, _ := newSchedulingService()
+	ctx := context.Background()
+
+	enabled, err := svc.Create(ctx, &ScheduledSession{Name: "on", ProjectId: "p", Schedule: "@hourly", Enabled: true})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if enabled.NextRunAt == nil || !enabled.NextRunAt.After(time.Now()) {
+		t.Errorf("expected future next_run_at, got %v", enabled.NextRunAt)
+	}
+
+	disabled, err := svc.Create(ctx, &ScheduledSession{Name: "off", ProjectId: "p", Schedule: "@hourly"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if disabled.NextRunAt != nil {
+		t.Errorf("expected nil next_run_at for disabled schedule, got %v", disabled.NextRunAt)
+	}
+
+	suspended, err := svc.Suspend(ctx, enabled.ID)
+	if err != nil {
+		t.Fatalf("suspend: %v", err)
+	}
+	if suspended.NextRunAt != nil {
+		t.Errorf("expected suspend to clear next_run_at, got %v", suspended.NextRunAt)
+	}
+	resumed, err := svc.Resume(ctx, enabled.ID)
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if resumed.NextRunAt == nil {
+		t.Error("expected resume to compute next_run_at")
+	}
+}
+
+func TestCreate_RejectsInvalidSchedule(t *testing.T) {
+	svc, _, _ := newSchedulingService()
+	_, err := svc.Create(context.Background(), &ScheduledSession{Name: "bad", ProjectId: "p", Schedule: "not a cron"})
+	if err == nil || err.HttpCode != 400 {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestFireDue_CreatesSessionOncePerSlot(t *testing.T) {
+	svc, sessionSvc, _ := newSchedulingService()
+	ctx := context.Background()
+	prompt := "nightly triage"
+
+	ss, err := svc.Create(ctx, &ScheduledSession{Name: "nightly", ProjectId: "p", Schedule: "@hourly", Enabled: true, SessionPrompt: &prompt})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if fired, _ := svc.FireDue(ctx, time.Now()); fired != 0 {
+		t.Fatalf("expected nothing due yet, fired %d", fired)
+	}
+
+	due := ss.NextRunAt.Add(time.Second)
+	if fired, _ := svc.FireDue(ctx, due); fired != 1 {
+		t.Fatalf("expected 1 firing, got %d", fired)
+	}
+	if fired, _ := svc.FireDue(ctx, due); fired != 0 {
+		t.Fatalf("expected slot to be consumed, fired %d", fired)
+	}
+
+	runs := svc.RunHistory(ss.ID)
+	if len(runs) != 1 || runs[0].Status != RunStatusStarted || runs[0].Trigger != RunTriggerSchedule {
+		t.Fatalf("unexpected run history: %+v", runs)
+	}
+	if runs[0].ScheduledFor == nil || !runs[0].ScheduledFor.Equal(*ss.NextRunAt) {
+		t.Errorf("expected run scheduled_for %v, got %v", ss.NextRunAt, runs[0].ScheduledFor)
+	}
+
+	launched, svcErr := sessionSvc.Get(ctx, *runs[0].SessionId)
+	if svcErr != nil {
+		t.Fatalf("get launched session: %v", svcErr)
+	}
+	if launched.Prompt == nil || *launched.Prompt != prompt {
+		t.Fatalf("expected session carrying the schedule prompt, got %+v", launched)
+	}
+	if launched.Phase == nil {
+		t.Errorf("expected launched session to be started, got phase %v", launched.Phase)
+	}
+
+	updated, _ := svc.Get(ctx, ss.ID)
+	if updated.LastRunAt == nil || !updated.NextRunAt.After(due) {
+		t.Errorf("expected last_run_at set and next_run_at advanced, got last=%v next=%v", updated.LastRunAt, updated.NextRunAt)
+	}
+}
+
+func TestFireDue_AgentScheduleSkipsWhenAgentActive(t *testing.T) {
+	svc, _, starter := newSchedulingService()
+	ctx := context.Background()
+	agentID := "agent-1"
+
+	ss, err := svc.Create(ctx, &ScheduledSession{Name: "agent-run", ProjectId: "p", AgentId: &agentID, Schedule: "@hourly", Enabled: true})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	starter.active[agentID] = "running-session"
+
+	if fired, _ := svc.FireDue(ctx, ss.NextRunAt.Add(time.Second)); fired != 1 {
+		t.Fatalf("expected 1 firing, got %d", fired)
+	}
+	if starter.calls != 1 {
+		t.Errorf("expected agent starter to be called once, got %d", starter.calls)
+	}
+	runs := svc.RunHistory(ss.ID)
+	if len(runs) != 1 || runs[0].Status != RunStatusSkipped || runs[0].SessionId == nil || *runs[0].SessionId != "running-session" {
+		t.Fatalf("expected skipped run referencing the active session, got %+v", runs)
+	}
+}
+
+func TestTrigger_RecordsManualRun(t *testing.T) {
+	svc, _, starter := newSchedulingService()
+	ctx := context.Background()
+	agentID := "agent-2"
+
+	ss, err := svc.Create(ctx, &ScheduledSession{Name: "manual", ProjectId: "p", AgentId: &agentID, Schedule: "@daily"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	run, svcErr := svc.Trigger(ctx, ss.ID)
+	if svcErr != nil {
+		t.Fatalf("trigger: %v", svcErr)
+	}
+	if run.Trigger != RunTriggerManual || run.SessionId == nil {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	if starter.calls != 1 {
+		t.Errorf("expected agent start, got %d calls", starter.calls)
+	}
+	list, _, _ := svc.Runs(ctx, ss.ID, services.NewListArguments(nil))
+	if len(list) != 1 || list[0].ID != run.ID || *list[0].SessionId != *run.SessionId {
+		t.Errorf("expected runs to list manual run %s, got %+v", run.ID, list)
+	}
+}
+
+func TestRuns_IncludesFailedRunsNewestFirst(t *testing.T) {
+	svc := NewInMemoryServiceWithLauncher(failingLauncher{})
+	ctx := context.Background()
+
+	ss, err := svc.Create(ctx, &ScheduledSession{Name: "broken", ProjectId: "p", Schedule: "@hourly", Enabled: true})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if fired, _ := svc.FireDue(ctx, ss.NextRunAt.Add(time.Second)); fired != 1 {
+		t.Fatalf("expected 1 firing, got %d", fired)
+	}
+	if _, svcErr := svc.Trigger(ctx, ss.ID); svcErr == nil {
+		t.Fatal("expected manual trigger to report the launch failure")
+	}
+
+	list, total, svcErr := svc.Runs(ctx, ss.ID, services.NewListArguments(nil))
+	if svcErr != nil {
+		t.Fatalf("runs: %v", svcErr)
+	}
+	if len(list) != 2 || total != 2 {
+		t.Fatalf("expected both failed firings, got %+v", list)
+	}
+	if list[0].Trigger != RunTriggerManual || list[1].Trigger != RunTriggerSchedule {
+		t.Errorf("expected newest run first, got triggers %s, %s", list[0].Trigger, list[1].Trigger)
+	}
+	for _, run := range list {
+		if run.Status != RunStatusFailed || run.Error == nil || run.SessionId != nil {
+			t.Errorf("expected failed run with error and no session, got %+v", run)
+		}
+	}
+	if list[1].ScheduledFor == nil {
+		t.Error("expected scheduled run to carry scheduled_for")
+	}
+
+	second, total, _ := svc.Runs(ctx, ss.ID, &services.ListArguments{Page: 2, Size: 1})
+	if len(second) != 1 || second[0].ID != list[1].ID || total != 2 {
+		t.Errorf("expected page 2 of size 1 to hold the older run of 2, got %+v (total %d)", second, total)
+	}
+}
+
+func TestSchedulerTick_SkipsSchedulesNotYetDue(t *testing.T) {
+	svc, _, _ := newSchedulingService()
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, &ScheduledSession{Name: "tick", ProjectId: "p", Schedule: "* * * * *", Enabled: true}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	scheduler := NewScheduler(svc, nil, time.Minute)
+	if fired := scheduler.Tick(ctx); fired != 0 {
+		t.Fatalf("expected nothing due, fired %d", fired)
+	}
+}
@@ -2,7 +2,10 @@ package scheduledSessions
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/services"
 	"gorm.io/gorm"
@@ -16,7 +19,9 @@ type ScheduledSessionService interface {
 	ListByProject(ctx context.Context, projectId string) (ScheduledSessionList, *errors.ServiceError)
 	Suspend(ctx context.Context, id string) (*ScheduledSession, *errors.ServiceError)
 	Resume(ctx context.Context, id string) (*ScheduledSession, *errors.ServiceError)
-	Trigger(ctx context.Context, id string) *errors.ServiceError
+	Trigger(ctx context.Context, id string) (*ScheduledSessionRun, *errors.ServiceError)
+	Runs(ctx context.Context, id string, args *services.ListArguments) (ScheduledSessionRunList, int64, *errors.ServiceError)
+	FireDue(ctx context.Context, now time.Time) (int, *errors.ServiceError)
 }
 
 type ScheduledSessionPatch struct {
@@ -34,11 +39,50 @@ type ScheduledSessionPatch struct {
 }
 
 type sqlScheduledSessionService struct {
-	dao ScheduledSessionDao
+	dao      ScheduledSessionDao
+	runDao   ScheduledSessionRunDao
+	launcher SessionLauncher
+	events   services.EventService
 }
 
-func NewScheduledSessionService(dao ScheduledSessionDao) ScheduledSessionService {
-	return &sqlScheduledSessionService{dao: dao}
+func NewScheduledSessionService(dao ScheduledSessionDao, runDao ScheduledSessionRunDao, launcher SessionLauncher, events services.EventService) ScheduledSessionService {
+	return &sqlScheduledSessionService{
+		dao:      dao,
+		runDao:   runDao,
+		launcher: launcher,
+		events:   events,
+	}
+}
+
+// emit records a change event so gRPC watchers see it. The row is already
+// committed, so a failed event is logged rather than returned.
+func (s *sqlScheduledSessionService) emit(ctx context.Context, id string, eventType api.EventType) {
+	if s.events == nil {
+		return
+	}
+	if _, err := s.events.Create(ctx, &api.Event{
+		Source:    EventSource,
+		SourceID:  id,
+		EventType: eventType,
+	}); err != nil {
+		glog.Warningf("Scheduled session %s: failed to record %s event: %v", id, eventType, err)
+	}
+}
+
+// scheduleNext recomputes NextRunAt relative to now. Disabled schedules never
+// fire, so their NextRunAt is cleared; resuming recomputes from the resume
+// time rather than replaying firings missed while suspended.
+func scheduleNext(ss *ScheduledSession, now time.Time) *errors.ServiceError {
+	if !ss.Enabled {
+		ss.NextRunAt = nil
+		return nil
+	}
+	next, err := NextRun(ss.Schedule, ss.Timezone, now)
+	if err != nil {
+		return errors.Validation("%s", err)
+	}
+	ss.NextRunAt = &next
+	return nil
 }
 
 func (s *sqlScheduledSessionService) Get(ctx context.Context, id string) (*ScheduledSession, *errors.ServiceError) {
@@ -53,10 +97,17 @@ func (s *sqlScheduledSessionService) Get(ctx context.Context, id string) (*Sched
 }
 
 func (s *sqlScheduledSessionService) Create(ctx context.Context, ss *ScheduledSession) (*ScheduledSession, *errors.ServiceError) {
+	if err := ValidateSchedule(ss.Schedule, ss.Timezone); err != nil {
+		return nil, errors.Validation("%s", err)
+	}
+	if svcErr := scheduleNext(ss, time.Now()); svcErr != nil {
+		return nil, svcErr
+	}
 	created, err := s.dao.Create(ctx, ss)
 	if err != nil {
 		return nil, errors.GeneralError("failed to create scheduled session: %v", err)
 	}
+	s.emit(ctx, created.ID, api.CreateEventType)
 	return created, nil
 }
 
@@ -98,10 +149,19 @@ func (s *sqlScheduledSessionService) Patch(ctx context.Context, id string, patch
 	if patch.RunnerType != nil {
 		ss.RunnerType = patch.RunnerType
 	}
+	if err := ValidateSchedule(ss.Schedule, ss.Timezone); err != nil {
+		return nil, errors.Validation("%s", err)
+	}
+	if patch.Schedule != nil || patch.Timezone != nil || patch.Enabled != nil || (ss.Enabled && ss.NextRunAt == nil) {
+		if svcErr := scheduleNext(ss, time.Now()); svcErr != nil {
+			return nil, svcErr
+		}
+	}
 	updated, err := s.dao.Replace(ctx, ss)
 	if err != nil {
 		return nil, errors.GeneralError("failed to update scheduled session: %v", err)
 	}
+	s.emit(ctx, updated.ID, api.UpdateEventType)
 	return updated, nil
 }
 
@@ -113,6 +173,7 @@ func (s *sqlScheduledSessionService) Delete(ctx context.Context, id string) *err
 	if err := s.dao.Delete(ctx, id); err != nil {
 		return errors.GeneralError("failed to delete scheduled session: %v", err)
 	}
+	s.emit(ctx, id, api.DeleteEventType)
 	return nil
 }
 
@@ -134,13 +195,75 @@ func (s *sqlScheduledSessionService) Resume(ctx context.Context, id string) (*Sc
 	return s.Patch(ctx, id, &ScheduledSessionPatch{Enabled: &enabled})
 }
 
-func (s *sqlScheduledSessionService) Trigger(ctx context.Context, id string) *errors.ServiceError {
+func (s *sqlScheduledSessionService) Trigger(ctx context.Context, id string) (*ScheduledSessionRun, *errors.ServiceError) {
 	ss, svcErr := s.Get(ctx, id)
 	if svcErr != nil {
-		return svcErr
+		return nil, svcErr
 	}
-	_ = ss
-	// In production this would enqueue an immediate one-off session via the agent start endpoint.
-	// In this session, we record intent and return success.
-	return nil
+	now := time.Now().UTC()
+	run := launchRun(ctx, s.launcher, ss, RunTriggerManual, nil, now)
+	created, err := s.runDao.Create(ctx, run)
+	if err != nil {
+		return nil, errors.GeneralError("failed to record scheduled session run: %v", err)
+	}
+	if err := s.dao.SetLastRunAt(ctx, ss.ID, now); err != nil {
+		return nil, errors.GeneralError("failed to update scheduled session: %v", err)
+	}
+	s.emit(ctx, ss.ID, api.UpdateEventType)
+	if created.Status == RunStatusFailed {
+		return nil, errors.GeneralError("failed to trigger scheduled session: %s", *created.Error)
+	}
+	return created, nil
+}
+
+// Runs returns the page of the schedule's firings that args selects, newest
+// first and including skipped and failed ones, with the total number of runs.
+func (s *sqlScheduledSessionService) Runs(ctx context.Context, id string, args *services.ListArguments) (ScheduledSessionRunList, int64, *errors.ServiceError) {
+	if _, svcErr := s.Get(ctx, id); svcErr != nil {
+		return nil, 0, svcErr
+	}
+	runs, total, err := s.runDao.ListByScheduledSession(ctx, id, args.Page, args.Size)
+	if err != nil {
+		return nil, 0, errors.GeneralError("failed to list scheduled session runs: %v", err)
+	}
+	return runs, total, nil
+}
+
+// FireDue launches every enabled schedule whose NextRunAt has passed. Each
+// firing is claimed by advancing NextRunAt before the session is launched, so
+// a schedule fires at most once per slot; firings missed while no scheduler
+// was running collapse into a single catch-up run.
+func (s *sqlScheduledSessionService) FireDue(ctx context.Context, now time.Time) (int, *errors.ServiceError) {
+	due, err := s.dao.ListDue(ctx, now)
+	if err != nil {
+		return 0, errors.GeneralError("failed to list due scheduled sessions: %v", err)
+	}
+	fired := 0
+	for _, ss := range due {
+		scheduledFor := *ss.NextRunAt
+		var next *time.Time
+		if n, nextErr := NextRun(ss.Schedule, ss.Timezone, now); nextErr != nil {
+			glog.Warningf("Scheduled session %s: %v; no further runs will be scheduled", ss.ID, nextErr)
+		} else {
+			next = &n
+		}
+		claimed, claimErr := s.dao.ClaimRun(ctx, ss.ID, scheduledFor, now, next)
+		if claimErr != nil {
+			glog.Errorf("Scheduled session %s: claim run: %v", ss.ID, claimErr)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		run := launchRun(ctx, s.launcher, ss, RunTriggerSchedule, &scheduledFor, now)
+		if run.Status == RunStatusFailed {
+			glog.Errorf("Scheduled session %s: launch failed: %s", ss.ID, *run.Error)
+		}
+		if _, err := s.runDao.Create(ctx, run); err != nil {
+			glog.Errorf("Scheduled session %s: record run: %v", ss.ID, err)
+		}
+		s.emit(ctx, ss.ID, api.UpdateEventType)
+		fired++
+	}
+	return fired, nil
 }
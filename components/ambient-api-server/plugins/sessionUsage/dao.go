@@ -0,0 +1,94 @@
+package sessionUsage
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+)
+
+// SessionUsageDao has no Replace or Delete: the ledger is append-only.
+type SessionUsageDao interface {
+	Create(ctx context.Context, usage *SessionUsage) (*SessionUsage, error)
+	// Rollup sums the entries matching query, one group per value of
+	// query.GroupBy, most expensive first.
+	Rollup(ctx context.Context, query UsageQuery) ([]UsageGroup, error)
+	// CostSince sums the cost a project has recorded since the given time.
+	CostSince(ctx context.Context, projectID string, since time.Time) (float64, error)
+}
+
+var _ SessionUsageDao = &sqlSessionUsageDao{}
+
+type sqlSessionUsageDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewSessionUsageDao(sessionFactory *db.SessionFactory) SessionUsageDao {
+	return &sqlSessionUsageDao{sessionFactory: sessionFactory}
+}
+
+// Create does not mark a request transaction for rollback on failure. The
+// session message recorder calls it outside the push's transaction, so a
+// failed insert loses only the usage entry.
+func (d *sqlSessionUsageDao) Create(ctx context.Context, usage *SessionUsage) (*SessionUsage, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(usage).Error; err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func (d *sqlSessionUsageDao) Rollup(ctx context.Context, query UsageQuery) ([]UsageGroup, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	column := groupColumns[query.GroupBy]
+	var groups []UsageGroup
+	err := filter(g2.Model(&SessionUsage{}), query).
+		Select(`COALESCE(` + column + `, '') AS "key",
+			SUM(input_tokens) AS input_tokens,
+			SUM(output_tokens) AS output_tokens,
+			SUM(cache_read_tokens) AS cache_read_tokens,
+			SUM(cache_write_tokens) AS cache_write_tokens,
+			SUM(cost_usd) AS cost_usd,
+			COUNT(*) AS entries`).
+		Group(column).
+		Order("cost_usd DESC").
+		Scan(&groups).Error
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (d *sqlSessionUsageDao) CostSince(ctx context.Context, projectID string, since time.Time) (float64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var cost float64
+	err := g2.Model(&SessionUsage{}).
+		Select("COALESCE(SUM(cost_usd), 0)").
+		Where("project_id = ? AND occurred_at >= ?", projectID, since).
+		Scan(&cost).Error
+	return cost, err
+}
+
+func filter(tx *gorm.DB, query UsageQuery) *gorm.DB {
+	for column, value := range map[string]string{
+		"project_id": query.ProjectId,
+		"agent_id":   query.AgentId,
+		"session_id": query.SessionId,
+		"user_id":    query.UserId,
+		"model":      query.Model,
+	} {
+		if value != "" {
+			tx = tx.Where(column+" = ?", value)
+		}
+	}
+	if query.From != nil {
+		tx = tx.Where("occurred_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		tx = tx.Where("occurred_at < ?", *query.To)
+	}
+	return tx
+}
@@ -0,0 +1,127 @@
+package sessionUsage
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Runner event types and the CUSTOM event name that carry token usage.
+const (
+	eventRunFinished = "RUN_FINISHED"
+	eventCustom      = "CUSTOM"
+	customUsageName  = "usage"
+)
+
+// Sample is the token usage one runner event reports for one model. Model
+// is empty when the event does not name one; the session's model applies.
+type Sample struct {
+	RunId            string
+	Model            string
+	InputTokens      int64
+	OutputTokens     int64
+	CacheReadTokens  int64
+	CacheWriteTokens int64
+}
+
+func (s Sample) empty() bool {
+	return s.InputTokens == 0 && s.OutputTokens == 0 && s.CacheReadTokens == 0 && s.CacheWriteTokens == 0
+}
+
+// Extract returns the usage reported by a runner AG-UI event, or nil when
+// the event carries none. Two shapes are understood:
+//
+//   - RUN_FINISHED, whose result holds the SDK's usage for the run and,
+//     when the SDK reports it, a per-model breakdown in model_usage.
+//   - CUSTOM events named "usage", whose value is a single usage object
+//     with an optional model and run_id.
+//
+// Token counts are read in either snake_case (input_tokens) or camelCase
+// (inputTokens). Malformed payloads yield nil: usage is best effort and
+// must never reject a message.
+func Extract(eventType, payload string) []Sample {
+	if eventType != eventRunFinished && eventType != eventCustom {
+		return nil
+	}
+	var event map[string]any
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil
+	}
+	runID := str(event, "run_id", "runId")
+
+	var samples []Sample
+	switch eventType {
+	case eventRunFinished:
+		result, _ := event["result"].(map[string]any)
+		if result == nil {
+			return nil
+		}
+		if perModel, ok := field(result, "model_usage", "modelUsage").(map[string]any); ok && len(perModel) > 0 {
+			for model, raw := range perModel {
+				if counts, ok := raw.(map[string]any); ok {
+					samples = append(samples, sample(runID, model, counts))
+				}
+			}
+			// Map iteration order is random; keep ledger rows stable.
+			sort.Slice(samples, func(i, j int) bool { return samples[i].Model < samples[j].Model })
+		} else if counts, ok := result["usage"].(map[string]any); ok {
+			samples = append(samples, sample(runID, str(result, "model"), counts))
+		}
+	case eventCustom:
+		if event["name"] != customUsageName {
+			return nil
+		}
+		value, ok := event["value"].(map[string]any)
+		if !ok {
+			return nil
+		}
+		if id := str(value, "run_id", "runId"); id != "" {
+			runID = id
+		}
+		samples = append(samples, sample(runID, str(value, "model"), value))
+	}
+
+	out := samples[:0]
+	for _, s := range samples {
+		if !s.empty() {
+			out = append(out, s)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func sample(runID, model string, counts map[string]any) Sample {
+	return Sample{
+		RunId:            runID,
+		Model:            model,
+		InputTokens:      count(counts, "input_tokens", "inputTokens"),
+		OutputTokens:     count(counts, "output_tokens", "outputTokens"),
+		CacheReadTokens:  count(counts, "cache_read_input_tokens", "cacheReadInputTokens"),
+		CacheWriteTokens: count(counts, "cache_creation_input_tokens", "cacheCreationInputTokens"),
+	}
+}
+
+func field(m map[string]any, keys ...string) any {
+	for _, k := range keys {
+		if v, ok := m[k]; ok && v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+func str(m map[string]any, keys ...string) string {
+	s, _ := field(m, keys...).(string)
+	return s
+}
+
+// count reads a token count. Negative and non-numeric values count as zero.
+func count(m map[string]any, keys ...string) int64 {
+	n, _ := field(m, keys...).(float64)
+	if n < 0 {
+		return 0
+	}
+	return int64(n)
+}
@@ -0,0 +1,80 @@
+package sessionUsage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		payload   string
+		want      []Sample
+	}{
+		{
+			name:      "run finished with SDK usage",
+			eventType: "RUN_FINISHED",
+			payload: `{"type": "RUN_FINISHED", "thread_id": "t1", "run_id": "run-1", "result": {"num_turns": 3, "usage": {
+				"input_tokens": 120, "output_tokens": 45, "cache_read_input_tokens": 9000, "cache_creation_input_tokens": 300}}}`,
+			want: []Sample{{RunId: "run-1", InputTokens: 120, OutputTokens: 45, CacheReadTokens: 9000, CacheWriteTokens: 300}},
+		},
+		{
+			name:      "run finished with per-model breakdown",
+			eventType: "RUN_FINISHED",
+			payload: `{"type": "RUN_FINISHED", "runId": "run-2", "result": {
+				"usage": {"input_tokens": 999},
+				"modelUsage": {
+					"claude-sonnet-4-6": {"inputTokens": 100, "outputTokens": 20},
+					"claude-haiku-4-5": {"inputTokens": 7, "outputTokens": 3, "cacheReadInputTokens": 50}}}}`,
+			want: []Sample{
+				{RunId: "run-2", Model: "claude-haiku-4-5", InputTokens: 7, OutputTokens: 3, CacheReadTokens: 50},
+				{RunId: "run-2", Model: "claude-sonnet-4-6", InputTokens: 100, OutputTokens: 20},
+			},
+		},
+		{
+			name:      "custom usage event",
+			eventType: "CUSTOM",
+			payload:   `{"type": "CUSTOM", "name": "usage", "value": {"model": "gemini-2.5-flash", "run_id": "run-3", "input_tokens": 10, "output_tokens": 5}}`,
+			want:      []Sample{{RunId: "run-3", Model: "gemini-2.5-flash", InputTokens: 10, OutputTokens: 5}},
+		},
+		{
+			name:      "run finished without result",
+			eventType: "RUN_FINISHED",
+			payload:   `{"type": "RUN_FINISHED", "run_id": "run-4"}`,
+		},
+		{
+			name:      "run finished with zero usage",
+			eventType: "RUN_FINISHED",
+			payload:   `{"type": "RUN_FINISHED", "result": {"usage": {"input_tokens": 0, "output_tokens": 0}}}`,
+		},
+		{
+			name:      "other custom event",
+			eventType: "CUSTOM",
+			payload:   `{"type": "CUSTOM", "name": "task:progress", "value": {"usage": {"input_tokens": 10}}}`,
+		},
+		{
+			name:      "other event type",
+			eventType: "TEXT_MESSAGE_CONTENT",
+			payload:   `{"type": "TEXT_MESSAGE_CONTENT", "delta": "input_tokens"}`,
+		},
+		{
+			name:      "malformed payload",
+			eventType: "RUN_FINISHED",
+			payload:   `{not json`,
+		},
+		{
+			name:      "negative counts",
+			eventType: "CUSTOM",
+			payload:   `{"type": "CUSTOM", "name": "usage", "value": {"input_tokens": -5, "output_tokens": 2}}`,
+			want:      []Sample{{OutputTokens: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Extract(tt.eventType, tt.payload); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package sessionUsage
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+	"github.com/openshift-online/rh-trex-ai/pkg/handlers"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/middleware"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/rbac"
+)
+
+type sessionUsageHandler struct {
+	usage SessionUsageService
+}
+
+func NewSessionUsageHandler(usage SessionUsageService) *sessionUsageHandler {
+	return &sessionUsageHandler{usage: usage}
+}
+
+// requirePlatformAdmin backs up the RBAC middleware: usage across projects
+// is for platform admins and the platform's own service account.
+func requirePlatformAdmin(ctx context.Context) *errors.ServiceError {
+	if middleware.IsServiceCaller(ctx) {
+		return nil
+	}
+	if authResult := rbac.GetAuthResult(ctx); authResult != nil && authResult.IsGlobalAdmin {
+		return nil
+	}
+	return errors.Forbidden("platform usage is restricted to platform admins")
+}
+
+// Summarize — GET /api/ambient/v1/usage
+// Rolls up usage across the platform. Filter with project_id, agent_id,
+// session_id, user_id, model, from and to (RFC 3339); group with group_by.
+func (h sessionUsageHandler) Summarize(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if svcErr := requirePlatformAdmin(ctx); svcErr != nil {
+				return nil, svcErr
+			}
+			query, svcErr := parseQuery(r.URL.Query())
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			query.ProjectId = r.URL.Query().Get("project_id")
+			return h.usage.Summarize(ctx, query)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// SummarizeProject — GET /api/ambient/v1/projects/{id}/usage
+// Same filters as Summarize, scoped to one project, with the project's
+// budget status when it has one.
+func (h sessionUsageHandler) SummarizeProject(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			query, svcErr := parseQuery(r.URL.Query())
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			query.ProjectId = mux.Vars(r)["id"]
+			summary, svcErr := h.usage.Summarize(ctx, query)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			if summary.Budget, svcErr = h.usage.Budget(ctx, query.ProjectId); svcErr != nil {
+				return nil, svcErr
+			}
+			return summary, nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func parseQuery(values url.Values) (UsageQuery, *errors.ServiceError) {
+	query := UsageQuery{
+		AgentId:   values.Get("agent_id"),
+		SessionId: values.Get("session_id"),
+		UserId:    values.Get("user_id"),
+		Model:     values.Get("model"),
+		GroupBy:   values.Get("group_by"),
+	}
+	for name, dst := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		raw := values.Get(name)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return UsageQuery{}, errors.Validation("%s must be an RFC 3339 timestamp, got %q", name, raw)
+		}
+		*dst = &t
+	}
+	return query, nil
+}
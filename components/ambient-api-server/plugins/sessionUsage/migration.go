@@ -0,0 +1,62 @@
+package sessionUsage
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func migration() *gormigrate.Migration {
+	migrateStatements := []string{
+		`CREATE TABLE IF NOT EXISTS session_usage (
+			id                 VARCHAR(36) PRIMARY KEY,
+			created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			deleted_at         TIMESTAMPTZ,
+			session_id         VARCHAR(36) NOT NULL,
+			project_id         TEXT,
+			agent_id           TEXT,
+			user_id            TEXT,
+			run_id             TEXT,
+			message_seq        BIGINT NOT NULL,
+			model              TEXT NOT NULL,
+			input_tokens       BIGINT NOT NULL DEFAULT 0,
+			output_tokens      BIGINT NOT NULL DEFAULT 0,
+			cache_read_tokens  BIGINT NOT NULL DEFAULT 0,
+			cache_write_tokens BIGINT NOT NULL DEFAULT 0,
+			cost_usd           DOUBLE PRECISION NOT NULL DEFAULT 0,
+			priced             BOOLEAN NOT NULL DEFAULT FALSE,
+			occurred_at        TIMESTAMPTZ NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_session_usage_session ON session_usage(session_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_session_usage_project_occurred ON session_usage(project_id, occurred_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_session_usage_agent ON session_usage(agent_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_session_usage_user ON session_usage(user_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_session_usage_occurred ON session_usage(occurred_at)`,
+		// One entry per model per message, so a redelivered message cannot
+		// be billed twice.
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_session_usage_message_model ON session_usage(session_id, message_seq, model)`,
+	}
+	rollbackStatements := []string{
+		`DROP TABLE IF EXISTS session_usage`,
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610170014",
+		Migrate: func(tx *gorm.DB) error {
+			for _, stmt := range migrateStatements {
+				if err := tx.Exec(stmt).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, stmt := range rollbackStatements {
+				if err := tx.Exec(stmt).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
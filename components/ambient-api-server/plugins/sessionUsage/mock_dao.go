@@ -0,0 +1,101 @@
+package sessionUsage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"github.com/openshift-online/rh-trex-ai/pkg/util"
+)
+
+var _ SessionUsageDao = &sessionUsageDaoMock{}
+
+type sessionUsageDaoMock struct {
+	entries SessionUsageList
+}
+
+func NewMockSessionUsageDao() *sessionUsageDaoMock {
+	return &sessionUsageDaoMock{}
+}
+
+func (d *sessionUsageDaoMock) Create(ctx context.Context, usage *SessionUsage) (*SessionUsage, error) {
+	usage.ID = api.NewID()
+	d.entries = append(d.entries, usage)
+	return usage, nil
+}
+
+func (d *sessionUsageDaoMock) Rollup(ctx context.Context, query UsageQuery) ([]UsageGroup, error) {
+	index := map[string]*UsageGroup{}
+	var groups []*UsageGroup
+	for _, e := range d.entries {
+		if !matches(e, query) {
+			continue
+		}
+		key := groupKey(e, query.GroupBy)
+		g, ok := index[key]
+		if !ok {
+			g = &UsageGroup{Key: key}
+			index[key] = g
+			groups = append(groups, g)
+		}
+		g.InputTokens += e.InputTokens
+		g.OutputTokens += e.OutputTokens
+		g.CacheReadTokens += e.CacheReadTokens
+		g.CacheWriteTokens += e.CacheWriteTokens
+		g.CostUsd += e.CostUsd
+		g.Entries++
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].CostUsd > groups[j].CostUsd })
+	out := make([]UsageGroup, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, *g)
+	}
+	return out, nil
+}
+
+func (d *sessionUsageDaoMock) CostSince(ctx context.Context, projectID string, since time.Time) (float64, error) {
+	var cost float64
+	for _, e := range d.entries {
+		if util.NilToEmptyString(e.ProjectId) == projectID && !e.OccurredAt.Before(since) {
+			cost += e.CostUsd
+		}
+	}
+	return cost, nil
+}
+
+func matches(e *SessionUsage, q UsageQuery) bool {
+	for _, f := range []struct{ want, got string }{
+		{q.ProjectId, util.NilToEmptyString(e.ProjectId)},
+		{q.AgentId, util.NilToEmptyString(e.AgentId)},
+		{q.SessionId, e.SessionId},
+		{q.UserId, util.NilToEmptyString(e.UserId)},
+		{q.Model, e.Model},
+	} {
+		if f.want != "" && f.want != f.got {
+			return false
+		}
+	}
+	if q.From != nil && e.OccurredAt.Before(*q.From) {
+		return false
+	}
+	if q.To != nil && !e.OccurredAt.Before(*q.To) {
+		return false
+	}
+	return true
+}
+
+func groupKey(e *SessionUsage, groupBy string) string {
+	switch groupBy {
+	case GroupBySession:
+		return e.SessionId
+	case GroupByAgent:
+		return util.NilToEmptyString(e.AgentId)
+	case GroupByProject:
+		return util.NilToEmptyString(e.ProjectId)
+	case GroupByUser:
+		return util.NilToEmptyString(e.UserId)
+	default:
+		return e.Model
+	}
+}
@@ -0,0 +1,106 @@
+package sessionUsage
+
+import (
+	"time"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/api"
+	"gorm.io/gorm"
+)
+
+// SessionUsage is one ledger entry: the tokens a session's runner reported
+// for one model in one run, priced when it was recorded. Entries are
+// append-only; rollups are computed from them at query time.
+type SessionUsage struct {
+	api.Meta
+	SessionId        string    `json:"session_id"`
+	ProjectId        *string   `json:"project_id"`
+	AgentId          *string   `json:"agent_id"`
+	UserId           *string   `json:"user_id"`
+	RunId            *string   `json:"run_id"`
+	MessageSeq       int64     `json:"message_seq"`
+	Model            string    `json:"model"`
+	InputTokens      int64     `json:"input_tokens"`
+	OutputTokens     int64     `json:"output_tokens"`
+	CacheReadTokens  int64     `json:"cache_read_tokens"`
+	CacheWriteTokens int64     `json:"cache_write_tokens"`
+	CostUsd          float64   `json:"cost_usd"`
+	Priced           bool      `json:"priced"`
+	OccurredAt       time.Time `json:"occurred_at"`
+}
+
+func (SessionUsage) TableName() string { return "session_usage" }
+
+type SessionUsageList []*SessionUsage
+
+func (d *SessionUsage) BeforeCreate(tx *gorm.DB) error {
+	d.ID = api.NewID()
+	return nil
+}
+
+// Dimensions usage can be grouped by.
+const (
+	GroupByModel   = "model"
+	GroupBySession = "session"
+	GroupByAgent   = "agent"
+	GroupByProject = "project"
+	GroupByUser    = "user"
+)
+
+// groupColumns maps a group_by value to its ledger column.
+var groupColumns = map[string]string{
+	GroupByModel:   "model",
+	GroupBySession: "session_id",
+	GroupByAgent:   "agent_id",
+	GroupByProject: "project_id",
+	GroupByUser:    "user_id",
+}
+
+// UsageQuery selects ledger entries to roll up. Empty fields do not filter;
+// From is inclusive and To exclusive.
+type UsageQuery struct {
+	ProjectId string
+	AgentId   string
+	SessionId string
+	UserId    string
+	Model     string
+	From      *time.Time
+	To        *time.Time
+	GroupBy   string
+}
+
+// UsageTotals are summed token counts and cost.
+type UsageTotals struct {
+	InputTokens      int64   `json:"input_tokens"`
+	OutputTokens     int64   `json:"output_tokens"`
+	CacheReadTokens  int64   `json:"cache_read_tokens"`
+	CacheWriteTokens int64   `json:"cache_write_tokens"`
+	CostUsd          float64 `json:"cost_usd"`
+	Entries          int64   `json:"entries"`
+}
+
+// UsageGroup is the totals for one value of the grouping dimension. Key is
+// empty for entries without one, e.g. sessions not started by an agent.
+type UsageGroup struct {
+	Key string `json:"key"`
+	UsageTotals
+}
+
+// UsageSummary is a rollup of ledger entries over a time range.
+type UsageSummary struct {
+	Kind    string        `json:"kind"`
+	GroupBy string        `json:"group_by"`
+	From    *time.Time    `json:"from,omitempty"`
+	To      *time.Time    `json:"to,omitempty"`
+	Total   UsageTotals   `json:"total"`
+	Items   []UsageGroup  `json:"items"`
+	Budget  *BudgetStatus `json:"budget,omitempty"`
+}
+
+// BudgetStatus is a project's spend against its monthly budget. Periods are
+// calendar months in UTC.
+type BudgetStatus struct {
+	MonthlyBudgetUsd float64   `json:"monthly_budget_usd"`
+	SpentUsd         float64   `json:"spent_usd"`
+	PeriodStart      time.Time `json:"period_start"`
+	Exceeded         bool      `json:"exceeded"`
+}
@@ -0,0 +1,64 @@
+package sessionUsage
+
+import (
+	"net/http"
+
+	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/gorilla/mux"
+	"github.com/openshift-online/rh-trex-ai/pkg/auth"
+	"github.com/openshift-online/rh-trex-ai/pkg/db"
+	"github.com/openshift-online/rh-trex-ai/pkg/environments"
+	"github.com/openshift-online/rh-trex-ai/pkg/registry"
+	pkgserver "github.com/openshift-online/rh-trex-ai/pkg/server"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+type ServiceLocator func() SessionUsageService
+
+func NewServiceLocator(env *environments.Env) ServiceLocator {
+	return func() SessionUsageService {
+		return NewSessionUsageService(
+			NewSessionUsageDao(&env.Database.SessionFactory),
+			PricingPath(),
+			projectSettings.Service(&env.Services),
+		)
+	}
+}
+
+func Service(s *environments.Services) SessionUsageService {
+	if s == nil {
+		return nil
+	}
+	if obj := s.GetService("SessionUsage"); obj != nil {
+		locator := obj.(ServiceLocator)
+		return locator()
+	}
+	return nil
+}
+
+func init() {
+	registry.RegisterService("SessionUsage", func(env interface{}) interface{} {
+		return NewServiceLocator(env.(*environments.Env))
+	})
+
+	pkgserver.RegisterRoutes("sessionUsage", func(apiV1Router *mux.Router, services pkgserver.ServicesInterface, authMiddleware environments.JWTMiddleware, authzMiddleware auth.AuthorizationMiddleware) {
+		envServices := services.(*environments.Services)
+		if dbAuthz := pkgrbac.Middleware(envServices); dbAuthz != nil {
+			authzMiddleware = dbAuthz
+		}
+		usageHandler := NewSessionUsageHandler(Service(envServices))
+
+		usageRouter := apiV1Router.PathPrefix("/usage").Subrouter()
+		usageRouter.HandleFunc("", usageHandler.Summarize).Methods(http.MethodGet)
+		usageRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		usageRouter.Use(authzMiddleware.AuthorizeApi)
+
+		projectsRouter := apiV1Router.PathPrefix("/projects").Subrouter()
+		projectsRouter.HandleFunc("/{id}/usage", usageHandler.SummarizeProject).Methods(http.MethodGet)
+		projectsRouter.Use(authMiddleware.AuthenticateAccountJWT)
+		projectsRouter.Use(authzMiddleware.AuthorizeApi)
+	})
+
+	db.RegisterMigration(migration())
+}
@@ -0,0 +1,116 @@
+package sessionUsage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// DefaultPricingPath is where the ambient-model-pricing ConfigMap is mounted.
+	DefaultPricingPath = "/config/pricing/model-pricing.json"
+)
+
+// PricingPath returns the filesystem path to the model rate table.
+// Defaults to DefaultPricingPath; override via MODEL_PRICING_PATH env var.
+func PricingPath() string {
+	if p := os.Getenv("MODEL_PRICING_PATH"); p != "" {
+		return p
+	}
+	return DefaultPricingPath
+}
+
+// Rate is the price of a model in USD per million tokens.
+type Rate struct {
+	Input      float64 `json:"input"`
+	Output     float64 `json:"output"`
+	CacheRead  float64 `json:"cacheRead"`
+	CacheWrite float64 `json:"cacheWrite"`
+}
+
+// PricingTable maps model IDs, or model ID prefixes such as
+// "claude-sonnet-4", to rates.
+type PricingTable struct {
+	Version int             `json:"version"`
+	Rates   map[string]Rate `json:"rates"`
+}
+
+// LoadPricing reads the rate table from the given path on the filesystem
+// (mounted ConfigMap).
+func LoadPricing(path string) (*PricingTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading pricing %s: %w", path, err)
+	}
+
+	var table PricingTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("parsing pricing: %w", err)
+	}
+
+	return &table, nil
+}
+
+// cachedPricing stores the last table successfully loaded from each path,
+// so a transient read error falls back to the previous good version.
+var cachedPricing sync.Map
+
+// currentPricing reloads the rate table at path, falling back to the cached
+// copy when the read fails. It returns nil when no table has ever been
+// loaded from path (the ConfigMap is optional).
+func currentPricing(path string) *PricingTable {
+	table, err := LoadPricing(path)
+	if err != nil {
+		cached, ok := cachedPricing.Load(path)
+		if !ok {
+			glog.V(2).Infof("No model pricing available: %v", err)
+			return nil
+		}
+		glog.Warningf("Failed to load model pricing, using last good copy: %v", err)
+		return cached.(*PricingTable)
+	}
+	cachedPricing.Store(path, table)
+	return table
+}
+
+// rate returns the rate for model: an exact match, else the longest key
+// that prefixes it, so dated IDs like claude-sonnet-4-5@20250929 price
+// like their family.
+func (t *PricingTable) rate(model string) (Rate, bool) {
+	if t == nil {
+		return Rate{}, false
+	}
+	if r, ok := t.Rates[model]; ok {
+		return r, true
+	}
+	best := ""
+	for key := range t.Rates {
+		if strings.HasPrefix(model, key) && len(key) > len(best) {
+			best = key
+		}
+	}
+	if best == "" {
+		return Rate{}, false
+	}
+	return t.Rates[best], true
+}
+
+// cost prices an entry. It reports false when the model has no rate, in
+// which case the entry is recorded at zero cost.
+func (t *PricingTable) cost(u *SessionUsage) (float64, bool) {
+	r, ok := t.rate(u.Model)
+	if !ok {
+		return 0, false
+	}
+	perToken := func(tokens int64, usdPerMillion float64) float64 {
+		return float64(tokens) * usdPerMillion / 1_000_000
+	}
+	return perToken(u.InputTokens, r.Input) +
+		perToken(u.OutputTokens, r.Output) +
+		perToken(u.CacheReadTokens, r.CacheRead) +
+		perToken(u.CacheWriteTokens, r.CacheWrite), true
+}
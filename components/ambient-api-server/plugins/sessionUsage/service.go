@@ -0,0 +1,128 @@
+package sessionUsage
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+type SessionUsageService interface {
+	// Record prices a ledger entry with the current rate table and stores it.
+	Record(ctx context.Context, usage *SessionUsage) (*SessionUsage, *errors.ServiceError)
+	Summarize(ctx context.Context, query UsageQuery) (*UsageSummary, *errors.ServiceError)
+	// Budget reports a project's spend this month against its budget, or
+	// nil when the project has none.
+	Budget(ctx context.Context, projectID string) (*BudgetStatus, *errors.ServiceError)
+	// CheckBudget rejects new work for a project that has spent its
+	// monthly budget.
+	CheckBudget(ctx context.Context, projectID string) *errors.ServiceError
+}
+
+func NewSessionUsageService(usageDao SessionUsageDao, pricingPath string, settings projectSettings.ProjectSettingsService) SessionUsageService {
+	return &sqlSessionUsageService{
+		usageDao:    usageDao,
+		pricingPath: pricingPath,
+		settings:    settings,
+		now:         time.Now,
+	}
+}
+
+var _ SessionUsageService = &sqlSessionUsageService{}
+
+type sqlSessionUsageService struct {
+	usageDao    SessionUsageDao
+	pricingPath string
+	settings    projectSettings.ProjectSettingsService
+	now         func() time.Time
+}
+
+func (s *sqlSessionUsageService) Record(ctx context.Context, usage *SessionUsage) (*SessionUsage, *errors.ServiceError) {
+	usage.CostUsd, usage.Priced = currentPricing(s.pricingPath).cost(usage)
+	if !usage.Priced {
+		glog.Warningf("No rate for model %q, recording session %s usage at zero cost", usage.Model, usage.SessionId)
+	}
+	if usage.OccurredAt.IsZero() {
+		usage.OccurredAt = s.now().UTC()
+	}
+	created, err := s.usageDao.Create(ctx, usage)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to record session usage: %s", err)
+	}
+	return created, nil
+}
+
+func (s *sqlSessionUsageService) Summarize(ctx context.Context, query UsageQuery) (*UsageSummary, *errors.ServiceError) {
+	if query.GroupBy == "" {
+		query.GroupBy = GroupByModel
+	}
+	if _, ok := groupColumns[query.GroupBy]; !ok {
+		return nil, errors.Validation("group_by must be one of model, session, agent, project or user, got %q", query.GroupBy)
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, errors.Validation("from must be before to")
+	}
+
+	groups, err := s.usageDao.Rollup(ctx, query)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to summarize session usage: %s", err)
+	}
+	summary := &UsageSummary{
+		Kind:    "UsageSummary",
+		GroupBy: query.GroupBy,
+		From:    query.From,
+		To:      query.To,
+		Items:   make([]UsageGroup, 0, len(groups)),
+	}
+	for _, g := range groups {
+		summary.Items = append(summary.Items, g)
+		summary.Total.InputTokens += g.InputTokens
+		summary.Total.OutputTokens += g.OutputTokens
+		summary.Total.CacheReadTokens += g.CacheReadTokens
+		summary.Total.CacheWriteTokens += g.CacheWriteTokens
+		summary.Total.CostUsd += g.CostUsd
+		summary.Total.Entries += g.Entries
+	}
+	return summary, nil
+}
+
+func (s *sqlSessionUsageService) Budget(ctx context.Context, projectID string) (*BudgetStatus, *errors.ServiceError) {
+	if projectID == "" || s.settings == nil {
+		return nil, nil
+	}
+	settings, svcErr := s.settings.AllByProjectId(ctx, projectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if len(settings) == 0 || settings[0].MonthlyBudgetUsd == nil || *settings[0].MonthlyBudgetUsd <= 0 {
+		return nil, nil
+	}
+
+	now := s.now().UTC()
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	spent, err := s.usageDao.CostSince(ctx, projectID, periodStart)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to read project spend: %s", err)
+	}
+	budget := *settings[0].MonthlyBudgetUsd
+	return &BudgetStatus{
+		MonthlyBudgetUsd: budget,
+		SpentUsd:         spent,
+		PeriodStart:      periodStart,
+		Exceeded:         spent >= budget,
+	}, nil
+}
+
+func (s *sqlSessionUsageService) CheckBudget(ctx context.Context, projectID string) *errors.ServiceError {
+	status, svcErr := s.Budget(ctx, projectID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if status != nil && status.Exceeded {
+		return errors.Forbidden("project %s has spent $%.2f of its $%.2f monthly budget", projectID, status.SpentUsd, status.MonthlyBudgetUsd)
+	}
+	return nil
+}
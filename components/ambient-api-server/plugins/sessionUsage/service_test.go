@@ -0,0 +1,167 @@
+package sessionUsage
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+)
+
+const testPricing = `{
+  "version": 1,
+  "rates": {
+    "claude-sonnet-4": {"input": 3.0, "output": 15.0, "cacheRead": 0.3, "cacheWrite": 3.75},
+    "claude-sonnet-4-6": {"input": 2.0, "output": 10.0, "cacheRead": 0.2, "cacheWrite": 2.5}
+  }
+}`
+
+func writePricing(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "model-pricing.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write pricing: %v", err)
+	}
+	return path
+}
+
+func newTestService(t *testing.T, budgets map[string]float64) (*sqlSessionUsageService, *sessionUsageDaoMock) {
+	t.Helper()
+	settingsDao := projectSettings.NewMockProjectSettingsDao()
+	for projectID, budget := range budgets {
+		if _, err := settingsDao.Create(context.Background(), &projectSettings.ProjectSettings{ProjectId: projectID, MonthlyBudgetUsd: &budget}); err != nil {
+			t.Fatalf("seed settings: %v", err)
+		}
+	}
+	dao := NewMockSessionUsageDao()
+	svc := NewSessionUsageService(dao, writePricing(t, testPricing), projectSettings.NewProjectSettingsService(nil, settingsDao, nil)).(*sqlSessionUsageService)
+	svc.now = func() time.Time { return time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) }
+	return svc, dao
+}
+
+func ptr(s string) *string { return &s }
+
+func approx(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestRecord_PricesByExactThenLongestPrefix(t *testing.T) {
+	svc, _ := newTestService(t, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		model  string
+		cost   float64
+		priced bool
+	}{
+		// 1M input at $2 + 1M output at $10 + 1M cache read at $0.2 + 1M cache write at $2.5
+		{"claude-sonnet-4-6", 14.7, true},
+		{"claude-sonnet-4-5@20250929", 22.05, true},
+		{"gemini-2.5-flash", 0, false},
+	}
+	for _, tt := range tests {
+		entry, svcErr := svc.Record(ctx, &SessionUsage{
+			SessionId:        "sess-1",
+			Model:            tt.model,
+			InputTokens:      1_000_000,
+			OutputTokens:     1_000_000,
+			CacheReadTokens:  1_000_000,
+			CacheWriteTokens: 1_000_000,
+		})
+		if svcErr != nil {
+			t.Fatalf("record %s: %v", tt.model, svcErr)
+		}
+		if !approx(entry.CostUsd, tt.cost) || entry.Priced != tt.priced {
+			t.Errorf("%s: cost %v priced %v, want %v %v", tt.model, entry.CostUsd, entry.Priced, tt.cost, tt.priced)
+		}
+		if entry.OccurredAt.IsZero() {
+			t.Errorf("%s: occurred_at not set", tt.model)
+		}
+	}
+}
+
+func TestSummarize_GroupsAndTotals(t *testing.T) {
+	svc, dao := newTestService(t, nil)
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	for _, e := range []*SessionUsage{
+		{SessionId: "s1", ProjectId: ptr("p1"), AgentId: ptr("a1"), Model: "m1", InputTokens: 10, CostUsd: 1, OccurredAt: day(1)},
+		{SessionId: "s1", ProjectId: ptr("p1"), AgentId: ptr("a1"), Model: "m2", InputTokens: 20, CostUsd: 4, OccurredAt: day(2)},
+		{SessionId: "s2", ProjectId: ptr("p1"), Model: "m1", OutputTokens: 5, CostUsd: 2, OccurredAt: day(3)},
+		{SessionId: "s3", ProjectId: ptr("p2"), Model: "m1", InputTokens: 100, CostUsd: 50, OccurredAt: day(3)},
+	} {
+		if _, err := dao.Create(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	summary, svcErr := svc.Summarize(ctx, UsageQuery{ProjectId: "p1", GroupBy: GroupByAgent})
+	if svcErr != nil {
+		t.Fatalf("summarize: %v", svcErr)
+	}
+	if len(summary.Items) != 2 || summary.Items[0].Key != "a1" || summary.Items[1].Key != "" {
+		t.Fatalf("unexpected groups: %+v", summary.Items)
+	}
+	if summary.Total.InputTokens != 30 || summary.Total.OutputTokens != 5 || summary.Total.CostUsd != 7 || summary.Total.Entries != 3 {
+		t.Errorf("unexpected totals: %+v", summary.Total)
+	}
+
+	from, to := day(2), day(3)
+	summary, svcErr = svc.Summarize(ctx, UsageQuery{ProjectId: "p1", From: &from, To: &to})
+	if svcErr != nil {
+		t.Fatalf("summarize: %v", svcErr)
+	}
+	if summary.GroupBy != GroupByModel || len(summary.Items) != 1 || summary.Items[0].Key != "m2" {
+		t.Errorf("time range should keep only day 2, got %+v", summary.Items)
+	}
+
+	if _, svcErr := svc.Summarize(ctx, UsageQuery{GroupBy: "week"}); svcErr == nil || svcErr.HttpCode != 400 {
+		t.Errorf("unknown group_by: got %v, want 400", svcErr)
+	}
+	if _, svcErr := svc.Summarize(ctx, UsageQuery{From: &to, To: &from}); svcErr == nil || svcErr.HttpCode != 400 {
+		t.Errorf("inverted range: got %v, want 400", svcErr)
+	}
+}
+
+func TestCheckBudget(t *testing.T) {
+	svc, dao := newTestService(t, map[string]float64{"capped": 10, "uncapped": 0})
+	ctx := context.Background()
+
+	if err := svc.CheckBudget(ctx, "capped"); err != nil {
+		t.Fatalf("fresh project: %v", err)
+	}
+	// Last month's spend does not count against this month.
+	for _, e := range []*SessionUsage{
+		{SessionId: "s1", ProjectId: ptr("capped"), CostUsd: 100, OccurredAt: time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC)},
+		{SessionId: "s1", ProjectId: ptr("capped"), CostUsd: 6, OccurredAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{SessionId: "s2", ProjectId: ptr("uncapped"), CostUsd: 1000, OccurredAt: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)},
+	} {
+		if _, err := dao.Create(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	status, svcErr := svc.Budget(ctx, "capped")
+	if svcErr != nil || status == nil {
+		t.Fatalf("budget: %+v %v", status, svcErr)
+	}
+	if status.SpentUsd != 6 || status.Exceeded || !status.PeriodStart.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if err := svc.CheckBudget(ctx, "capped"); err != nil {
+		t.Fatalf("under budget: %v", err)
+	}
+
+	if _, err := dao.Create(ctx, &SessionUsage{SessionId: "s1", ProjectId: ptr("capped"), CostUsd: 4, OccurredAt: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.CheckBudget(ctx, "capped"); err == nil || err.HttpCode != 403 {
+		t.Errorf("at budget: got %v, want 403", err)
+	}
+	if err := svc.CheckBudget(ctx, "uncapped"); err != nil {
+		t.Errorf("a zero budget means no budget, got %v", err)
+	}
+	if err := svc.CheckBudget(ctx, "no-settings"); err != nil {
+		t.Errorf("project without settings: %v", err)
+	}
+}
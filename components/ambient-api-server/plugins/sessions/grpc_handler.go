@@ -198,6 +198,9 @@ func (h *sessionGRPCHandler) UpdateSessionStatus(ctx context.Context, req *pb.Up
 	if req.KubeNamespace != nil {
 		patch.KubeNamespace = req.KubeNamespace
 	}
+	if req.StoppedReason != nil {
+		patch.StoppedReason = req.StoppedReason
+	}
 
 	updated, svcErr := h.service.UpdateStatus(ctx, req.GetId(), patch)
 	if svcErr != nil {
@@ -313,7 +316,7 @@ func (h *sessionGRPCHandler) WatchSessionMessages(req *pb.WatchSessionMessagesRe
 		}
 	}
 
-	ch, cancel := h.msgService.Subscribe(ctx, req.GetSessionId())
+	ch, cancel := h.msgService.Subscribe(ctx, req.GetSessionId(), req.GetAfterSeq())
 	defer cancel()
 
 	existing, err := h.msgService.AllBySessionIDAfterSeq(ctx, req.GetSessionId(), req.GetAfterSeq())
@@ -321,14 +324,14 @@ func (h *sessionGRPCHandler) WatchSessionMessages(req *pb.WatchSessionMessagesRe
 		return status.Errorf(codes.Internal, "failed to list messages: %v", err)
 	}
 
-	var maxReplayed int64
+	// Seqs commit out of order, so a message below the highest replayed
+	// seq may still arrive on ch; only the replayed seqs are duplicates.
+	replayed := make(map[int64]struct{}, len(existing))
 	for i := range existing {
 		if err := stream.Send(sessionMessageToProto(&existing[i])); err != nil {
 			return err
 		}
-		if existing[i].Seq > maxReplayed {
-			maxReplayed = existing[i].Seq
-		}
+		replayed[existing[i].Seq] = struct{}{}
 	}
 
 	for {
@@ -339,7 +342,7 @@ func (h *sessionGRPCHandler) WatchSessionMessages(req *pb.WatchSessionMessagesRe
 			if !ok {
 				return nil
 			}
-			if msg.Seq <= maxReplayed {
+			if _, dup := replayed[msg.Seq]; dup || msg.Seq <= req.GetAfterSeq() {
 				continue
 			}
 			if err := stream.Send(sessionMessageToProto(msg)); err != nil {
@@ -45,6 +45,7 @@ func sessionToProto(s *Session) *pb.Session {
 		KubeCrUid:            s.KubeCrUid,
 		KubeNamespace:        s.KubeNamespace,
 		AgentId:              s.AgentId,
+		StoppedReason:        s.StoppedReason,
 	}
 
 	if s.LlmTemperature != nil {
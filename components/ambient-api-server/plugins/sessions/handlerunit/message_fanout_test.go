@@ -0,0 +1,184 @@
+package handlerunit_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+	. "github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
+)
+
+// sharedMessageDao stands in for the session_messages table both replicas use.
+type sharedMessageDao struct {
+	mu   sync.Mutex
+	seq  int64
+	msgs []SessionMessage
+}
+
+func (d *sharedMessageDao) Insert(_ context.Context, msg *SessionMessage) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.seq++
+	msg.Seq = d.seq
+	d.msgs = append(d.msgs, *msg)
+	return nil
+}
+
+func (d *sharedMessageDao) AllBySessionIDAfterSeq(_ context.Context, sessionID string, afterSeq int64) ([]SessionMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var out []SessionMessage
+	for _, m := range d.msgs {
+		if m.SessionID == sessionID && m.Seq > afterSeq {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// hub connects in-process brokers the way NOTIFY connects replicas: a publish
+// reaches every other replica's handlers, never the publisher's own. While
+// down, publishes are dropped.
+type hub struct {
+	mu       sync.Mutex
+	down     bool
+	replicas []*hubBroker
+}
+
+type hubBroker struct {
+	hub      *hub
+	handlers map[string][]broker.Handler
+}
+
+func (h *hub) replica() *hubBroker {
+	b := &hubBroker{hub: h, handlers: map[string][]broker.Handler{}}
+	h.replicas = append(h.replicas, b)
+	return b
+}
+
+func (b *hubBroker) Publish(_ context.Context, channel, payload string) error {
+	b.hub.mu.Lock()
+	defer b.hub.mu.Unlock()
+	if b.hub.down {
+		return nil
+	}
+	for _, r := range b.hub.replicas {
+		if r == b {
+			continue
+		}
+		for _, h := range r.handlers[channel] {
+			h.Receive(payload)
+		}
+	}
+	return nil
+}
+
+func (b *hubBroker) Subscribe(channel string, h broker.Handler) func() {
+	b.handlers[channel] = append(b.handlers[channel], h)
+	return func() {}
+}
+
+func (b *hubBroker) resync() {
+	for _, hs := range b.handlers {
+		for _, h := range hs {
+			h.Resync()
+		}
+	}
+}
+
+func receiveSeqs(t *testing.T, ch <-chan *SessionMessage, n int) []int64 {
+	t.Helper()
+	var seqs []int64
+	for len(seqs) < n {
+		select {
+		case msg := <-ch:
+			seqs = append(seqs, msg.Seq)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %v, want %d messages", seqs, n)
+		}
+	}
+	select {
+	case msg := <-ch:
+		t.Fatalf("unexpected extra message seq %d", msg.Seq)
+	default:
+	}
+	return seqs
+}
+
+func sortedSeqs(seqs []int64) []int64 {
+	slices.Sort(seqs)
+	return seqs
+}
+
+func TestMessageService_FansOutAcrossReplicas(t *testing.T) {
+	dao := &sharedMessageDao{}
+	h := &hub{}
+	replicaA := NewMessageService(dao, h.replica(), nil)
+	replicaB := NewMessageService(dao, h.replica(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onA, _ := replicaA.Subscribe(ctx, "sess-1", 0)
+	onB, _ := replicaB.Subscribe(ctx, "sess-1", 0)
+
+	if _, err := replicaA.Push(ctx, "sess-1", "user", "from A"); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if _, err := replicaB.Push(ctx, "sess-1", "assistant", "from B"); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if _, err := replicaB.Push(ctx, "sess-2", "user", "other session"); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+
+	// Pushes from the other replica are read back asynchronously, so only
+	// the set of seqs is fixed, not their order.
+	if got := sortedSeqs(receiveSeqs(t, onA, 2)); got[0] != 1 || got[1] != 2 {
+		t.Errorf("replica A got seqs %v, want [1 2]", got)
+	}
+	if got := sortedSeqs(receiveSeqs(t, onB, 2)); got[0] != 1 || got[1] != 2 {
+		t.Errorf("replica B got seqs %v, want [1 2]", got)
+	}
+}
+
+func TestMessageService_ResyncCatchesUpFromSeq(t *testing.T) {
+	dao := &sharedMessageDao{}
+	h := &hub{}
+	replicaA := NewMessageService(dao, h.replica(), nil)
+	brokerB := h.replica()
+	replicaB := NewMessageService(dao, brokerB, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onB, _ := replicaB.Subscribe(ctx, "sess-1", 0)
+
+	if _, err := replicaA.Push(ctx, "sess-1", "user", "before outage"); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	receiveSeqs(t, onB, 1)
+
+	h.down = true
+	for i := 0; i < 3; i++ {
+		if _, err := replicaA.Push(ctx, "sess-1", "user", "during outage"); err != nil {
+			t.Fatalf("push: %v", err)
+		}
+	}
+	h.down = false
+
+	brokerB.resync()
+	if got := receiveSeqs(t, onB, 3); got[0] != 2 || got[2] != 4 {
+		t.Errorf("after resync got seqs %v, want [2 3 4]", got)
+	}
+
+	// A late notification for an already caught-up seq is not redelivered.
+	if _, err := replicaA.Push(ctx, "sess-1", "user", "after outage"); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	brokerB.resync()
+	if got := receiveSeqs(t, onB, 1); got[0] != 5 {
+		t.Errorf("got seqs %v, want [5]", got)
+	}
+}
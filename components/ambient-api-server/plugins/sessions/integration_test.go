@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +16,8 @@ import (
 	"gopkg.in/resty.v1"
 
 	"github.com/ambient-code/platform/components/ambient-api-server/pkg/api/openapi"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projectSettings"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/projects"
 	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessions"
 	"github.com/ambient-code/platform/components/ambient-api-server/test"
 	"github.com/openshift-online/rh-trex-ai/pkg/environments"
@@ -963,3 +967,159 @@ func TestSessionStreamRunnerEvents(t *testing.T) {
 	Expect(resp5.Header().Get("Content-Type")).To(ContainSubstring("text/event-stream"))
 	Expect(string(resp5.Body())).To(ContainSubstring("TEXT_MESSAGE_CONTENT"))
 }
+
+func TestSessionStoppedReason(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	sessionModel, err := newSession(h.NewID())
+	Expect(err).NotTo(HaveOccurred())
+
+	statusPatch := openapi.SessionStatusPatchRequest{
+		Phase:         openapi.PtrString("Stopped"),
+		StoppedReason: openapi.PtrString("inactivity"),
+	}
+	patched, resp, err := client.DefaultAPI.ApiAmbientV1SessionsIdStatusPatch(ctx, sessionModel.ID).SessionStatusPatchRequest(statusPatch).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(patched.GetStoppedReason()).To(Equal("inactivity"))
+
+	started, resp, err := client.DefaultAPI.ApiAmbientV1SessionsIdStartPost(ctx, sessionModel.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(started.HasStoppedReason()).To(BeFalse())
+}
+
+func TestSessionModelCatalog(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+
+	manifestPath := filepath.Join(t.TempDir(), "models.json")
+	Expect(os.WriteFile(manifestPath, []byte(`{
+		"version": 2,
+		"defaultModel": "claude-haiku-4-5",
+		"defaultTemperature": 0.2,
+		"defaultMaxTokens": 8000,
+		"models": [
+			{"id": "claude-haiku-4-5", "label": "Claude Haiku 4.5", "provider": "anthropic", "available": true, "featureGated": false},
+			{"id": "claude-opus-4-6", "label": "Claude Opus 4.6", "provider": "anthropic", "available": true, "featureGated": true}
+		]
+	}`), 0o600)).To(Succeed())
+	t.Setenv("MODELS_MANIFEST_PATH", manifestPath)
+
+	created, resp, err := client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(openapi.Session{Name: "catalog-defaults"}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(created.GetLlmModel()).To(Equal("claude-haiku-4-5"))
+	Expect(created.GetLlmTemperature()).To(BeNumerically("~", 0.2, 0.001))
+	Expect(created.GetLlmMaxTokens()).To(Equal(int32(8000)))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(openapi.Session{
+		Name:     "catalog-unknown",
+		LlmModel: openapi.PtrString("gpt-4o"),
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	project, svcErr := projects.Service(&environments.Environment().Services).Create(context.Background(), &projects.Project{Name: "catalog-project"})
+	Expect(svcErr).To(BeNil())
+
+	gated := openapi.Session{
+		Name:      "catalog-gated",
+		ProjectId: openapi.PtrString(project.ID),
+		LlmModel:  openapi.PtrString("claude-opus-4-6"),
+	}
+	_, resp, err = client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(gated).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	_, svcErr = projectSettings.Service(&environments.Environment().Services).Create(context.Background(), &projectSettings.ProjectSettings{
+		ProjectId:      project.ID,
+		ModelOverrides: openapi.PtrString(`{"claude-opus-4-6": true}`),
+	})
+	Expect(svcErr).To(BeNil())
+
+	opus, resp, err := client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(gated).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(opus.GetLlmModel()).To(Equal("claude-opus-4-6"))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1SessionsIdPatch(ctx, *created.Id).
+		SessionPatchRequest(openapi.SessionPatchRequest{LlmModel: openapi.PtrString("claude-opus-4-6")}).Execute()
+	Expect(err).To(HaveOccurred(), "sessions outside the project may not switch to a gated model")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// Disabling a model does not lock out sessions already using it.
+	Expect(os.WriteFile(manifestPath, []byte(`{
+		"version": 2,
+		"defaultModel": "claude-haiku-4-5",
+		"models": [
+			{"id": "claude-haiku-4-5", "label": "Claude Haiku 4.5", "provider": "anthropic", "available": true, "featureGated": false},
+			{"id": "claude-opus-4-6", "label": "Claude Opus 4.6", "provider": "anthropic", "available": false, "featureGated": true}
+		]
+	}`), 0o600)).To(Succeed())
+	renamed, resp, err := client.DefaultAPI.ApiAmbientV1SessionsIdPatch(ctx, *opus.Id).
+		SessionPatchRequest(openapi.SessionPatchRequest{Name: openapi.PtrString("catalog-renamed")}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(renamed.GetLlmModel()).To(Equal("claude-opus-4-6"))
+}
+
+func TestSessionUsageLedger(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	account := h.NewRandAccount()
+	ctx := h.NewAuthenticatedContext(account)
+	jwtToken := ctx.Value(openapi.ContextAccessToken)
+
+	pricingPath := filepath.Join(t.TempDir(), "model-pricing.json")
+	Expect(os.WriteFile(pricingPath, []byte(`{
+		"version": 1,
+		"rates": {"claude-sonnet-4": {"input": 3.0, "output": 15.0, "cacheRead": 0.3, "cacheWrite": 3.75}}
+	}`), 0o600)).To(Succeed())
+	t.Setenv("MODEL_PRICING_PATH", pricingPath)
+
+	services := &environments.Environment().Services
+	project, svcErr := projects.Service(services).Create(context.Background(), &projects.Project{Name: "usage-project"})
+	Expect(svcErr).To(BeNil())
+	budget := 1.0
+	_, svcErr = projectSettings.Service(services).Create(context.Background(), &projectSettings.ProjectSettings{
+		ProjectId:        project.ID,
+		MonthlyBudgetUsd: &budget,
+	})
+	Expect(svcErr).To(BeNil())
+
+	session, resp, err := client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(openapi.Session{
+		Name:      "usage-session",
+		ProjectId: openapi.PtrString(project.ID),
+		LlmModel:  openapi.PtrString("claude-sonnet-4-6"),
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+
+	// 100k output tokens at $15 per million spends $1.50 of the $1 budget.
+	_, err = sessions.MessageSvc(services).Push(context.Background(), *session.Id, "RUN_FINISHED",
+		`{"type": "RUN_FINISHED", "run_id": "run-1", "result": {"usage": {"input_tokens": 0, "output_tokens": 100000}}}`)
+	Expect(err).NotTo(HaveOccurred())
+
+	restyResp, err := resty.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		Get(h.RestURL(fmt.Sprintf("/projects/%s/usage?group_by=session", project.ID)))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(restyResp.StatusCode()).To(Equal(http.StatusOK), string(restyResp.Body()))
+	body := string(restyResp.Body())
+	Expect(body).To(ContainSubstring(`"key":"` + *session.Id + `"`))
+	Expect(body).To(ContainSubstring(`"output_tokens":100000`))
+	Expect(body).To(ContainSubstring(`"exceeded":true`))
+
+	_, resp, err = client.DefaultAPI.ApiAmbientV1SessionsPost(ctx).Session(openapi.Session{
+		Name:      "usage-over-budget",
+		ProjectId: openapi.PtrString(project.ID),
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "a project over its monthly budget may not create sessions")
+	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+}
@@ -158,7 +158,7 @@ func (h *messageHandler) streamMessages(w http.ResponseWriter, r *http.Request,
 
 	afterSeq := cursorFromRequest(r)
 
-	ch, cancel := h.msg.Subscribe(ctx, id)
+	ch, cancel := h.msg.Subscribe(ctx, id, afterSeq)
 	defer cancel()
 
 	existing, err := h.msg.AllBySessionIDAfterSeq(ctx, id, afterSeq)
@@ -191,14 +191,14 @@ func (h *messageHandler) streamMessages(w http.ResponseWriter, r *http.Request,
 		return true
 	}
 
-	var maxReplayed int64
+	// Seqs commit out of order, so a message below the highest replayed
+	// seq may still arrive on ch; only the replayed seqs are duplicates.
+	replayed := make(map[int64]struct{}, len(existing))
 	for i := range existing {
 		if !writeEvent(&existing[i]) {
 			return
 		}
-		if existing[i].Seq > maxReplayed {
-			maxReplayed = existing[i].Seq
-		}
+		replayed[existing[i].Seq] = struct{}{}
 	}
 
 	ticker := time.NewTicker(30 * time.Second)
@@ -217,7 +217,7 @@ func (h *messageHandler) streamMessages(w http.ResponseWriter, r *http.Request,
 			if !ok {
 				return
 			}
-			if msg.Seq <= maxReplayed {
+			if _, dup := replayed[msg.Seq]; dup || msg.Seq <= afterSeq {
 				continue
 			}
 			if !writeEvent(msg) {
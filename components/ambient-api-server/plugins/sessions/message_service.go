@@ -3,25 +3,84 @@ package sessions
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
 )
 
+// messageChannel is the broker channel announcing new session messages as
+// "<session_id>:<seq>".
+const messageChannel = "session_messages"
+
+// deliveredWindow is how many recently delivered seqs are remembered per
+// subscribed session to drop duplicates.
+const deliveredWindow = 1024
+
+// catchUpTimeout bounds one read-back of a session's announced messages.
+const catchUpTimeout = 10 * time.Second
+
 type MessageService interface {
 	Push(ctx context.Context, sessionID, eventType, payload string) (*SessionMessage, error)
-	Subscribe(ctx context.Context, sessionID string) (<-chan *SessionMessage, func())
+	// Subscribe streams the session's messages after afterSeq that are
+	// pushed from now on. Callers read earlier ones with
+	// AllBySessionIDAfterSeq.
+	Subscribe(ctx context.Context, sessionID string, afterSeq int64) (<-chan *SessionMessage, func())
 	AllBySessionIDAfterSeq(ctx context.Context, sessionID string, afterSeq int64) ([]SessionMessage, error)
 }
 
+// sessionCursor is what a subscribed session has been sent. Seqs come from
+// one global sequence and commit out of order, so a seq lower than one
+// already delivered may still be new; only a seq in seen is a duplicate.
+type sessionCursor struct {
+	// floor is the seq Resync reads the session after: the lowest starting
+	// seq of its subscribers, raised as old seqs are pruned from seen.
+	floor int64
+	seen  map[int64]struct{}
+}
+
 type sqlMessageService struct {
-	dao  MessageDao
-	mu   sync.RWMutex
-	subs map[string][]chan *SessionMessage
+	dao     MessageDao
+	broker  broker.Broker
+	usage   UsageRecorder
+	mu      sync.RWMutex
+	subs    map[string][]chan *SessionMessage
+	cursors map[string]*sessionCursor
+	// pending holds, per session, the seq to read announced messages after.
+	// Receive and Resync fill it; the catch-up worker reads the database, so
+	// the broker's delivery goroutine never blocks on it.
+	pending map[string]int64
+	wake    chan struct{}
+}
+
+// NewMessageService returns a MessageService that fans pushes out to local
+// subscribers directly and to other replicas through b. A nil b behaves like
+// broker.NewLocalBroker. Pushed messages are passed to usage, when set, so
+// token usage in runner events reaches the usage ledger.
+func NewMessageService(dao MessageDao, b broker.Broker, usage UsageRecorder) MessageService {
+	if b == nil {
+		b = broker.NewLocalBroker()
+	}
+	s := newMessageService(dao, b, usage)
+	go s.catchUpWorker()
+	b.Subscribe(messageChannel, s)
+	return s
 }
 
-func NewMessageService(dao MessageDao) MessageService {
+func newMessageService(dao MessageDao, b broker.Broker, usage UsageRecorder) *sqlMessageService {
 	return &sqlMessageService{
-		dao:  dao,
-		subs: make(map[string][]chan *SessionMessage),
+		dao:     dao,
+		broker:  b,
+		usage:   usage,
+		subs:    make(map[string][]chan *SessionMessage),
+		cursors: make(map[string]*sessionCursor),
+		pending: make(map[string]int64),
+		wake:    make(chan struct{}, 1),
 	}
 }
 
@@ -35,25 +94,28 @@ func (s *sqlMessageService) Push(ctx context.Context, sessionID, eventType, payl
 		return nil, fmt.Errorf("push session message: %w", err)
 	}
 
-	s.mu.RLock()
-	chans := make([]chan *SessionMessage, len(s.subs[sessionID]))
-	copy(chans, s.subs[sessionID])
-	s.mu.RUnlock()
+	s.fanOut(sessionID, []SessionMessage{*msg})
 
-	for _, ch := range chans {
-		select {
-		case ch <- msg:
-		default:
-		}
+	if s.usage != nil {
+		s.usage.RecordMessage(ctx, msg)
+	}
+
+	if err := s.broker.Publish(ctx, messageChannel, sessionID+":"+strconv.FormatInt(msg.Seq, 10)); err != nil {
+		glog.Warningf("Push session message: notify other replicas for session %s seq %d: %v", sessionID, msg.Seq, err)
 	}
 	return msg, nil
 }
 
-func (s *sqlMessageService) Subscribe(ctx context.Context, sessionID string) (<-chan *SessionMessage, func()) {
+func (s *sqlMessageService) Subscribe(ctx context.Context, sessionID string, afterSeq int64) (<-chan *SessionMessage, func()) {
 	ch := make(chan *SessionMessage, 512)
 
 	s.mu.Lock()
 	s.subs[sessionID] = append(s.subs[sessionID], ch)
+	if c := s.cursors[sessionID]; c == nil {
+		s.cursors[sessionID] = &sessionCursor{floor: afterSeq, seen: make(map[int64]struct{})}
+	} else if afterSeq < c.floor {
+		c.floor = afterSeq
+	}
 	s.mu.Unlock()
 
 	var once sync.Once
@@ -66,9 +128,14 @@ func (s *sqlMessageService) Subscribe(ctx context.Context, sessionID string) (<-
 				if sub == ch {
 					s.subs[sessionID] = append(subs[:i], subs[i+1:]...)
 					close(ch)
-					return
+					break
 				}
 			}
+			if len(s.subs[sessionID]) == 0 {
+				delete(s.subs, sessionID)
+				delete(s.cursors, sessionID)
+				delete(s.pending, sessionID)
+			}
 		})
 	}
 
@@ -83,3 +150,136 @@ func (s *sqlMessageService) Subscribe(ctx context.Context, sessionID string) (<-
 func (s *sqlMessageService) AllBySessionIDAfterSeq(ctx context.Context, sessionID string, afterSeq int64) ([]SessionMessage, error) {
 	return s.dao.AllBySessionIDAfterSeq(ctx, sessionID, afterSeq)
 }
+
+// Receive handles a push announced by another replica. The session is read
+// back from the announced seq, so a notification that arrives after a later
+// seq was delivered still delivers its message.
+func (s *sqlMessageService) Receive(payload string) {
+	sessionID, rawSeq, ok := strings.Cut(payload, ":")
+	seq, err := strconv.ParseInt(rawSeq, 10, 64)
+	if !ok || err != nil {
+		glog.Warningf("Session messages: ignoring malformed notification %q", payload)
+		return
+	}
+
+	s.mu.Lock()
+	c := s.cursors[sessionID]
+	if c == nil {
+		s.mu.Unlock()
+		return
+	}
+	// Seqs at or below the floor were delivered and pruned from seen, or
+	// predate every subscriber.
+	if _, delivered := c.seen[seq]; delivered || seq <= c.floor {
+		s.mu.Unlock()
+		return
+	}
+	s.queueLocked(sessionID, seq-1)
+	s.mu.Unlock()
+	s.signal()
+}
+
+// Resync re-reads every subscribed session from its floor, after a broker
+// reconnect may have lost notifications. Messages that committed late below
+// the highest delivered seq are recovered too.
+func (s *sqlMessageService) Resync() {
+	s.mu.Lock()
+	for sessionID, c := range s.cursors {
+		s.queueLocked(sessionID, c.floor)
+	}
+	s.mu.Unlock()
+	s.signal()
+}
+
+// queueLocked asks the worker to read sessionID after seq after. Callers
+// hold s.mu.
+func (s *sqlMessageService) queueLocked(sessionID string, after int64) {
+	if cur, ok := s.pending[sessionID]; !ok || after < cur {
+		s.pending[sessionID] = after
+	}
+}
+
+func (s *sqlMessageService) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *sqlMessageService) catchUpWorker() {
+	for range s.wake {
+		s.drainPending()
+	}
+}
+
+// drainPending reads back every session queued since the last call.
+func (s *sqlMessageService) drainPending() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[string]int64)
+	s.mu.Unlock()
+
+	for sessionID, after := range pending {
+		s.catchUp(sessionID, after)
+	}
+}
+
+func (s *sqlMessageService) catchUp(sessionID string, after int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), catchUpTimeout)
+	defer cancel()
+	msgs, err := s.dao.AllBySessionIDAfterSeq(ctx, sessionID, after)
+	if err != nil {
+		glog.Errorf("Session messages: catch up session %s after seq %d: %v", sessionID, after, err)
+		return
+	}
+	s.fanOut(sessionID, msgs)
+}
+
+// fanOut delivers msgs to the session's local subscribers, skipping any seq
+// already delivered.
+func (s *sqlMessageService) fanOut(sessionID string, msgs []SessionMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chans := s.subs[sessionID]
+	c := s.cursors[sessionID]
+	if len(chans) == 0 || c == nil {
+		return
+	}
+	seen := c.seen
+	for i := range msgs {
+		msg := &msgs[i]
+		if _, dup := seen[msg.Seq]; dup {
+			continue
+		}
+		for _, ch := range chans {
+			select {
+			case ch <- msg:
+			default:
+			}
+		}
+		seen[msg.Seq] = struct{}{}
+	}
+	if len(seen) > deliveredWindow {
+		if top := pruneSeqs(seen, deliveredWindow/2); top > c.floor {
+			c.floor = top
+		}
+	}
+}
+
+// pruneSeqs keeps the keep highest seqs and returns the highest one removed.
+func pruneSeqs(seen map[int64]struct{}, keep int) int64 {
+	seqs := make([]int64, 0, len(seen))
+	for seq := range seen {
+		seqs = append(seqs, seq)
+	}
+	slices.Sort(seqs)
+	removed := seqs[:len(seqs)-keep]
+	for _, seq := range removed {
+		delete(seen, seq)
+	}
+	if len(removed) == 0 {
+		return 0
+	}
+	return removed[len(removed)-1]
+}
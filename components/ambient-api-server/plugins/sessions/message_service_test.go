@@ -0,0 +1,214 @@
+package sessions
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+)
+
+// fakeMessageDao stores messages with caller-chosen seqs, standing in for
+// pushes committed by other replicas.
+type fakeMessageDao struct {
+	mu   sync.Mutex
+	msgs []SessionMessage
+}
+
+func (d *fakeMessageDao) Insert(_ context.Context, msg *SessionMessage) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.msgs = append(d.msgs, *msg)
+	return nil
+}
+
+func (d *fakeMessageDao) AllBySessionIDAfterSeq(_ context.Context, sessionID string, afterSeq int64) ([]SessionMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var out []SessionMessage
+	for _, m := range d.msgs {
+		if m.SessionID == sessionID && m.Seq > afterSeq {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (d *fakeMessageDao) commit(sessionID string, seq int64) {
+	_ = d.Insert(context.Background(), &SessionMessage{SessionID: sessionID, Seq: seq})
+}
+
+func drainSeqs(ch <-chan *SessionMessage) []int64 {
+	var seqs []int64
+	for {
+		select {
+		case msg := <-ch:
+			seqs = append(seqs, msg.Seq)
+		default:
+			return seqs
+		}
+	}
+}
+
+func TestMessageService_LateNotificationStillDelivered(t *testing.T) {
+	dao := &fakeMessageDao{}
+	svc := newMessageService(dao, broker.NewLocalBroker(), nil)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 0)
+	defer cancel()
+
+	// seq 11 commits and is announced before seq 10, which was allocated
+	// first but committed later.
+	dao.commit("s1", 11)
+	svc.Receive("s1:11")
+	svc.drainPending()
+	dao.commit("s1", 10)
+	svc.Receive("s1:10")
+	svc.drainPending()
+
+	got := drainSeqs(ch)
+	if len(got) != 2 || got[0] != 11 || got[1] != 10 {
+		t.Fatalf("delivered seqs = %v, want [11 10]", got)
+	}
+}
+
+func TestMessageService_DropsDuplicates(t *testing.T) {
+	dao := &fakeMessageDao{}
+	svc := newMessageService(dao, broker.NewLocalBroker(), nil)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 0)
+	defer cancel()
+
+	dao.commit("s1", 5)
+	dao.commit("s1", 7)
+	svc.Receive("s1:5")
+	svc.Receive("s1:7")
+	svc.drainPending()
+	svc.Receive("s1:5")
+	svc.Resync()
+	svc.drainPending()
+
+	got := drainSeqs(ch)
+	if len(got) != 2 || got[0] != 5 || got[1] != 7 {
+		t.Fatalf("delivered seqs = %v, want [5 7]", got)
+	}
+}
+
+func TestMessageService_ResyncRecoversMissedNotification(t *testing.T) {
+	dao := &fakeMessageDao{}
+	svc := newMessageService(dao, broker.NewLocalBroker(), nil)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 0)
+	defer cancel()
+
+	dao.commit("s1", 20)
+	svc.Receive("s1:20")
+	svc.drainPending()
+	// 19 committed late and 21 after it; both notifications were lost.
+	dao.commit("s1", 19)
+	dao.commit("s1", 21)
+	svc.Resync()
+	svc.drainPending()
+
+	got := drainSeqs(ch)
+	if len(got) != 3 || got[0] != 20 || got[1] != 19 || got[2] != 21 {
+		t.Fatalf("delivered seqs = %v, want [20 19 21]", got)
+	}
+}
+
+func TestMessageService_ResyncStartsAtSubscriptionSeq(t *testing.T) {
+	dao := &fakeMessageDao{}
+	svc := newMessageService(dao, broker.NewLocalBroker(), nil)
+	dao.commit("s1", 3)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 3)
+	defer cancel()
+
+	dao.commit("s1", 4)
+	svc.Resync()
+	svc.drainPending()
+
+	got := drainSeqs(ch)
+	if len(got) != 1 || got[0] != 4 {
+		t.Fatalf("delivered seqs = %v, want [4]", got)
+	}
+}
+
+// blockingMessageDao holds every read until release is closed.
+type blockingMessageDao struct {
+	fakeMessageDao
+	release chan struct{}
+}
+
+func (d *blockingMessageDao) AllBySessionIDAfterSeq(ctx context.Context, sessionID string, afterSeq int64) ([]SessionMessage, error) {
+	<-d.release
+	return d.fakeMessageDao.AllBySessionIDAfterSeq(ctx, sessionID, afterSeq)
+}
+
+func TestMessageService_ReceiveDoesNotReadOnDeliveryGoroutine(t *testing.T) {
+	dao := &blockingMessageDao{release: make(chan struct{})}
+	svc := NewMessageService(dao, nil, nil).(*sqlMessageService)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 0)
+	defer cancel()
+
+	dao.commit("s1", 1)
+	returned := make(chan struct{})
+	go func() {
+		svc.Receive("s1:1")
+		svc.Resync()
+		close(returned)
+	}()
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("Receive blocked on the database read")
+	}
+
+	close(dao.release)
+	select {
+	case msg := <-ch:
+		if msg.Seq != 1 {
+			t.Fatalf("delivered seq %d, want 1", msg.Seq)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("announced message was never delivered")
+	}
+}
+
+func TestPruneSeqs_KeepsHighest(t *testing.T) {
+	seen := map[int64]struct{}{}
+	for seq := int64(1); seq <= 10; seq++ {
+		seen[seq] = struct{}{}
+	}
+	if top := pruneSeqs(seen, 3); top != 7 {
+		t.Fatalf("highest pruned = %d, want 7", top)
+	}
+	for seq := int64(8); seq <= 10; seq++ {
+		if _, ok := seen[seq]; !ok || len(seen) != 3 {
+			t.Fatalf("kept %v, want 8..10", seen)
+		}
+	}
+}
+
+func TestMessageService_DropsNotificationBelowPrunedFloor(t *testing.T) {
+	dao := &fakeMessageDao{}
+	svc := newMessageService(dao, broker.NewLocalBroker(), nil)
+	ch, cancel := svc.Subscribe(context.Background(), "s1", 0)
+	defer cancel()
+
+	last := int64(deliveredWindow + 1)
+	for seq := int64(1); seq <= last; seq++ {
+		dao.commit("s1", seq)
+	}
+	svc.Receive("s1:1")
+	svc.drainPending()
+	drainSeqs(ch)
+
+	// seq 1 was pruned from the delivered window; a late duplicate of its
+	// notification must not re-read the session from seq 0.
+	svc.Receive("s1:1")
+	if len(svc.pending) != 0 {
+		t.Fatalf("notification below the floor was queued: %v", svc.pending)
+	}
+	svc.drainPending()
+	if got := drainSeqs(ch); len(got) != 0 {
+		t.Fatalf("redelivered %d messages, want none", len(got))
+	}
+}
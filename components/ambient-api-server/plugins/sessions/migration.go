@@ -215,3 +215,15 @@ func schemaExpansionMigration() *gormigrate.Migration {
 		},
 	}
 }
+
+func stoppedReasonMigration() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "202610170006",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE sessions ADD COLUMN IF NOT EXISTS stopped_reason TEXT`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec(`ALTER TABLE sessions DROP COLUMN IF EXISTS stopped_reason`).Error
+		},
+	}
+}
@@ -105,6 +105,9 @@ func (s *InMemorySessionService) UpdateStatus(_ context.Context, id string, patc
 	if patch.Phase != nil {
 		ss.Phase = patch.Phase
 	}
+	if patch.StoppedReason != nil {
+		ss.StoppedReason = patch.StoppedReason
+	}
 	ss.UpdatedAt = time.Now()
 	cp := *ss
 	return &cp, nil
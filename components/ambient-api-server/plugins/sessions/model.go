@@ -41,6 +41,7 @@ type Session struct {
 	KubeCrName         *string    `json:"kube_cr_name"`
 	KubeCrUid          *string    `json:"kube_cr_uid"`
 	KubeNamespace      *string    `json:"kube_namespace"`
+	StoppedReason      *string    `json:"stopped_reason"`
 }
 
 type SessionList []*Session
@@ -57,20 +58,6 @@ func (l SessionList) Index() SessionIndex {
 func (d *Session) BeforeCreate(tx *gorm.DB) error {
 	d.ID = api.NewID()
 	d.KubeCrName = &d.ID
-
-	if d.LlmModel == nil || *d.LlmModel == "" {
-		defaultModel := "claude-sonnet-4-6"
-		d.LlmModel = &defaultModel
-	}
-	if d.LlmTemperature == nil {
-		defaultTemp := 0.7
-		d.LlmTemperature = &defaultTemp
-	}
-	if d.LlmMaxTokens == nil {
-		defaultTokens := int32(4000)
-		d.LlmMaxTokens = &defaultTokens
-	}
-
 	return nil
 }
 
@@ -104,4 +91,5 @@ type SessionStatusPatchRequest struct {
 	ReconciledWorkflow *string    `json:"reconciled_workflow,omitempty"`
 	KubeCrUid          *string    `json:"kube_cr_uid,omitempty"`
 	KubeNamespace      *string    `json:"kube_namespace,omitempty"`
+	StoppedReason      *string    `json:"stopped_reason,omitempty"`
 }
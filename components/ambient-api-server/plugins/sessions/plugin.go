@@ -5,7 +5,10 @@ import (
 	"sync"
 
 	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-api-server/pkg/broker"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/models"
 	pkgrbac "github.com/ambient-code/platform/components/ambient-api-server/plugins/rbac"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessionUsage"
 	"github.com/gorilla/mux"
 	"github.com/openshift-online/rh-trex-ai/pkg/api"
 	"github.com/openshift-online/rh-trex-ai/pkg/api/presenters"
@@ -30,6 +33,8 @@ func NewServiceLocator(env *environments.Env) ServiceLocator {
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
 			NewSessionDao(&env.Database.SessionFactory),
 			events.Service(&env.Services),
+			models.Service(&env.Services),
+			sessionUsage.Service(&env.Services),
 		)
 	}
 }
@@ -54,7 +59,8 @@ func NewMessageServiceLocator(env *environments.Env) MessageServiceLocator {
 	)
 	return func() MessageService {
 		once.Do(func() {
-			svcInst = NewMessageService(NewMessageDao(&env.Database.SessionFactory))
+			recorder := NewUsageRecorder(NewSessionDao(&env.Database.SessionFactory), sessionUsage.Service(&env.Services))
+			svcInst = NewMessageService(NewMessageDao(&env.Database.SessionFactory), broker.Shared(env), recorder)
 		})
 		return svcInst
 	}
@@ -176,4 +182,5 @@ func init() {
 	db.RegisterMigration(sessionMessagesMigration())
 	db.RegisterMigration(schemaExpansionMigration())
 	db.RegisterMigration(agentIDMigration())
+	db.RegisterMigration(stoppedReasonMigration())
 }
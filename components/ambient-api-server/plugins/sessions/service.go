@@ -9,7 +9,11 @@ import (
 	"github.com/openshift-online/rh-trex-ai/pkg/errors"
 	"github.com/openshift-online/rh-trex-ai/pkg/logger"
 	"github.com/openshift-online/rh-trex-ai/pkg/services"
+	"github.com/openshift-online/rh-trex-ai/pkg/util"
 	"gorm.io/gorm"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/models"
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessionUsage"
 )
 
 const sessionsLockType db.LockType = "sessions"
@@ -32,11 +36,13 @@ type SessionService interface {
 	OnDelete(ctx context.Context, id string) error
 }
 
-func NewSessionService(lockFactory db.LockFactory, sessionDao SessionDao, events services.EventService) SessionService {
+func NewSessionService(lockFactory db.LockFactory, sessionDao SessionDao, events services.EventService, modelSvc models.ModelService, usage sessionUsage.SessionUsageService) SessionService {
 	return &sqlSessionService{
 		lockFactory: lockFactory,
 		sessionDao:  sessionDao,
 		events:      events,
+		models:      modelSvc,
+		usage:       usage,
 	}
 }
 
@@ -46,6 +52,8 @@ type sqlSessionService struct {
 	lockFactory db.LockFactory
 	sessionDao  SessionDao
 	events      services.EventService
+	models      models.ModelService
+	usage       sessionUsage.SessionUsageService
 }
 
 func (s *sqlSessionService) OnUpsert(ctx context.Context, id string) error {
@@ -76,6 +84,15 @@ func (s *sqlSessionService) Get(ctx context.Context, id string) (*Session, *erro
 }
 
 func (s *sqlSessionService) Create(ctx context.Context, session *Session) (*Session, *errors.ServiceError) {
+	if svcErr := s.applyModel(ctx, session); svcErr != nil {
+		return nil, svcErr
+	}
+	if s.usage != nil {
+		if svcErr := s.usage.CheckBudget(ctx, util.NilToEmptyString(session.ProjectId)); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
 	session, err := s.sessionDao.Create(ctx, session)
 	if err != nil {
 		return nil, services.HandleCreateError("Session", err)
@@ -100,6 +117,10 @@ func (s *sqlSessionService) Replace(ctx context.Context, session *Session) (*Ses
 	}
 	defer s.lockFactory.Unlock(ctx, lockOwnerID)
 
+	if svcErr := s.checkModelChange(ctx, session); svcErr != nil {
+		return nil, svcErr
+	}
+
 	var replaceErr error
 	session, replaceErr = s.sessionDao.Replace(ctx, session)
 	if replaceErr != nil {
@@ -118,6 +139,48 @@ func (s *sqlSessionService) Replace(ctx context.Context, session *Session) (*Ses
 	return session, nil
 }
 
+// applyModel fills unset generation parameters from the model catalog and
+// rejects a model the session's project may not use.
+func (s *sqlSessionService) applyModel(ctx context.Context, session *Session) *errors.ServiceError {
+	if s.models == nil {
+		return nil
+	}
+	defaults := s.models.Defaults()
+	if session.LlmModel == nil || *session.LlmModel == "" {
+		session.LlmModel = &defaults.Model
+	} else if svcErr := s.models.Validate(ctx, util.NilToEmptyString(session.ProjectId), *session.LlmModel); svcErr != nil {
+		return svcErr
+	}
+	if session.LlmTemperature == nil {
+		session.LlmTemperature = &defaults.Temperature
+	}
+	if session.LlmMaxTokens == nil {
+		session.LlmMaxTokens = &defaults.MaxTokens
+	}
+	return nil
+}
+
+// checkModelChange validates the model of a session being replaced. A
+// session keeps a model that was disabled after it was chosen, so the
+// stored row is only consulted when validation fails.
+func (s *sqlSessionService) checkModelChange(ctx context.Context, session *Session) *errors.ServiceError {
+	if s.models == nil || session.LlmModel == nil || *session.LlmModel == "" {
+		return nil
+	}
+	svcErr := s.models.Validate(ctx, util.NilToEmptyString(session.ProjectId), *session.LlmModel)
+	if svcErr == nil {
+		return nil
+	}
+	existing, err := s.sessionDao.Get(ctx, session.ID)
+	if err != nil {
+		return services.HandleGetError("Session", "id", session.ID, err)
+	}
+	if existing.LlmModel != nil && *existing.LlmModel == *session.LlmModel {
+		return nil
+	}
+	return svcErr
+}
+
 func (s *sqlSessionService) Delete(ctx context.Context, id string) *errors.ServiceError {
 	if err := s.sessionDao.Delete(ctx, id); err != nil {
 		return services.HandleDeleteError("Session", errors.GeneralError("Unable to delete session: %s", err))
@@ -173,8 +236,8 @@ func (s *sqlSessionService) UpdateStatus(ctx context.Context, id string, patch *
 	if patch.Phase == nil && patch.StartTime == nil && patch.CompletionTime == nil &&
 		patch.SdkSessionId == nil && patch.SdkRestartCount == nil && patch.Conditions == nil &&
 		patch.ReconciledRepos == nil && patch.ReconciledWorkflow == nil &&
-		patch.KubeCrUid == nil && patch.KubeNamespace == nil {
-		return nil, errors.Validation("status patch body must set at least one field: phase, start_time, completion_time, sdk_session_id, sdk_restart_count, conditions, reconciled_repos, reconciled_workflow, kube_cr_uid, kube_namespace")
+		patch.KubeCrUid == nil && patch.KubeNamespace == nil && patch.StoppedReason == nil {
+		return nil, errors.Validation("status patch body must set at least one field: phase, start_time, completion_time, sdk_session_id, sdk_restart_count, conditions, reconciled_repos, reconciled_workflow, kube_cr_uid, kube_namespace, stopped_reason")
 	}
 
 	session, err := s.sessionDao.Get(ctx, id)
@@ -215,6 +278,9 @@ func (s *sqlSessionService) UpdateStatus(ctx context.Context, id string, patch *
 	if patch.KubeNamespace != nil {
 		session.KubeNamespace = patch.KubeNamespace
 	}
+	if patch.StoppedReason != nil {
+		session.StoppedReason = patch.StoppedReason
+	}
 
 	session, err = s.sessionDao.Replace(ctx, session)
 	if err != nil {
@@ -250,6 +316,7 @@ func (s *sqlSessionService) Start(ctx context.Context, id string) (*Session, *er
 
 	pending := "Pending"
 	session.Phase = &pending
+	session.StoppedReason = nil
 
 	session, err = s.sessionDao.Replace(ctx, session)
 	if err != nil {
@@ -0,0 +1,77 @@
+package sessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-online/rh-trex-ai/pkg/util"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessionUsage"
+)
+
+// usageWriteTimeout bounds how long a push waits on its ledger writes.
+const usageWriteTimeout = 5 * time.Second
+
+// UsageRecorder is told about every pushed session message.
+type UsageRecorder interface {
+	RecordMessage(ctx context.Context, msg *SessionMessage)
+}
+
+// NewUsageRecorder returns a UsageRecorder that writes the token usage in
+// runner events to the usage ledger, attributed to the session's project,
+// agent and creator.
+func NewUsageRecorder(sessionDao SessionDao, usage sessionUsage.SessionUsageService) UsageRecorder {
+	return &usageRecorder{sessionDao: sessionDao, usage: usage}
+}
+
+type usageRecorder struct {
+	sessionDao SessionDao
+	usage      sessionUsage.SessionUsageService
+}
+
+// RecordMessage never fails the push. Ledger entries are written outside the
+// push's transaction, so a failed insert, such as a duplicate on
+// idx_session_usage_message_model, cannot roll back the message; it is
+// logged and the usage is lost.
+func (r *usageRecorder) RecordMessage(ctx context.Context, msg *SessionMessage) {
+	if r.usage == nil {
+		return
+	}
+	samples := sessionUsage.Extract(msg.EventType, msg.Payload)
+	if len(samples) == 0 {
+		return
+	}
+	session, err := r.sessionDao.Get(ctx, msg.SessionID)
+	if err != nil {
+		glog.Warningf("Session usage: load session %s for seq %d: %v", msg.SessionID, msg.Seq, err)
+		return
+	}
+	writeCtx, cancel := context.WithTimeout(context.Background(), usageWriteTimeout)
+	defer cancel()
+	for _, sample := range samples {
+		model := sample.Model
+		if model == "" {
+			model = util.NilToEmptyString(session.LlmModel)
+		}
+		entry := &sessionUsage.SessionUsage{
+			SessionId:        session.ID,
+			ProjectId:        session.ProjectId,
+			AgentId:          session.AgentId,
+			UserId:           session.CreatedByUserId,
+			MessageSeq:       msg.Seq,
+			Model:            model,
+			InputTokens:      sample.InputTokens,
+			OutputTokens:     sample.OutputTokens,
+			CacheReadTokens:  sample.CacheReadTokens,
+			CacheWriteTokens: sample.CacheWriteTokens,
+			OccurredAt:       msg.CreatedAt,
+		}
+		if sample.RunId != "" {
+			entry.RunId = &sample.RunId
+		}
+		if _, svcErr := r.usage.Record(writeCtx, entry); svcErr != nil {
+			glog.Warningf("Session usage: record session %s seq %d model %s: %v", session.ID, msg.Seq, model, svcErr)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package sessions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-online/rh-trex-ai/pkg/db/db_context"
+	"github.com/openshift-online/rh-trex-ai/pkg/db/transaction"
+	"github.com/openshift-online/rh-trex-ai/pkg/errors"
+
+	"github.com/ambient-code/platform/components/ambient-api-server/plugins/sessionUsage"
+)
+
+// recordingUsageService fails every Record and remembers whether it was
+// called inside a request transaction.
+type recordingUsageService struct {
+	sessionUsage.SessionUsageService
+	calls       int
+	inRequestTx bool
+}
+
+func (s *recordingUsageService) Record(ctx context.Context, usage *sessionUsage.SessionUsage) (*sessionUsage.SessionUsage, *errors.ServiceError) {
+	s.calls++
+	if _, ok := db_context.Transaction(ctx); ok {
+		s.inRequestTx = true
+	}
+	return nil, errors.Conflict("duplicate usage entry")
+}
+
+func TestUsageRecorder_WritesOutsideRequestTransaction(t *testing.T) {
+	sessionDao := NewMockSessionDao()
+	_, _ = sessionDao.Create(context.Background(), &Session{Name: "s"})
+	session := sessionDao.sessions[0]
+	session.ID = "s1"
+	usage := &recordingUsageService{}
+	recorder := NewUsageRecorder(sessionDao, usage)
+
+	ctx := db_context.WithTransaction(context.Background(), transaction.Build(nil, 1, false))
+	recorder.RecordMessage(ctx, &SessionMessage{
+		SessionID: "s1",
+		Seq:       7,
+		EventType: "RUN_FINISHED",
+		Payload:   `{"type": "RUN_FINISHED", "result": {"model_usage": {"a": {"input_tokens": 1}, "b": {"input_tokens": 2}}}}`,
+	})
+
+	if usage.calls != 2 {
+		t.Fatalf("Record called %d times, want 2: one failed entry must not stop the rest", usage.calls)
+	}
+	if usage.inRequestTx {
+		t.Fatal("ledger entries were written inside the push's request transaction")
+	}
+}
@@ -68,9 +68,11 @@ type ProjectCountsMsg struct {
 	Err    error
 }
 
-// AgentCounts holds the session count for a single agent.
+// AgentCounts holds the session count and project-home status for a single
+// agent.
 type AgentCounts struct {
 	SessionCount int
+	Status       string
 }
 
 // AgentCountsMsg carries per-agent session counts keyed by agent ID.
@@ -294,8 +296,9 @@ func (tc *TUIClient) FetchProjectCounts(projects []string) tea.Cmd {
 
 // FetchAgentCounts returns a tea.Cmd that fans out per-agent session list
 // fetches and returns an AgentCountsMsg with the counts. Uses the
-// AgentAPI.Sessions() endpoint to count sessions per agent. Partial failures
-// are tolerated — failed agents get count -1.
+// AgentAPI.Sessions() endpoint to count sessions per agent, and a single
+// project home fetch for each agent's status. Partial failures are tolerated —
+// failed agents get count -1, and a failed home fetch leaves Status empty.
 func (tc *TUIClient) FetchAgentCounts(projectID string, agentIDs []string) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
@@ -312,6 +315,13 @@ func (tc *TUIClient) FetchAgentCounts(projectID string, agentIDs []string) tea.C
 			return AgentCountsMsg{Err: err}
 		}
 
+		statuses := make(map[string]string, len(agentIDs))
+		if home, err := client.Projects().Home(ctx, projectID); err == nil {
+			for _, a := range home.Agents {
+				statuses[a.AgentID] = a.AgentStatus
+			}
+		}
+
 		sem := make(chan struct{}, 10)
 		for _, agentID := range agentIDs {
 			wg.Add(1)
@@ -327,7 +337,7 @@ func (tc *TUIClient) FetchAgentCounts(projectID string, agentIDs []string) tea.C
 				}
 
 				mu.Lock()
-				counts[agentID] = AgentCounts{SessionCount: sc}
+				counts[agentID] = AgentCounts{SessionCount: sc, Status: statuses[agentID]}
 				mu.Unlock()
 			}()
 		}
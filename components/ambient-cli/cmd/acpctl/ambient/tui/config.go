@@ -4,7 +4,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"net/url"
 	"os"
 	"sort"
 	"strings"
@@ -116,6 +115,9 @@ type legacyConfig struct {
 //     A single context entry is created, auto-named from the server hostname, and set as current.
 //   - If the file does not exist, an empty TUIConfig is returned.
 //
+// AMBIENT_CONTEXT, set by the global --context flag, replaces the current
+// context when it names an existing one.
+//
 // Environment variable overrides (AMBIENT_API_URL, AMBIENT_TOKEN, AMBIENT_PROJECT)
 // are applied to the current context's values after loading.
 func LoadTUIConfig() (*TUIConfig, error) {
@@ -160,6 +162,13 @@ func LoadTUIConfig() (*TUIConfig, error) {
 		cfg = migrateFromLegacy(&legacy)
 	}
 
+	// acpctl --context selects a context for this invocation only.
+	if name := os.Getenv(config.ContextEnv); name != "" {
+		if _, ok := cfg.Contexts[name]; ok {
+			cfg.CurrentContext = name
+		}
+	}
+
 	applyEnvOverrides(cfg)
 
 	return cfg, nil
@@ -265,20 +274,5 @@ func (c *TUIConfig) SwitchContext(name string) error {
 //   - localhost (any port) → "local"
 //   - All other servers → hostname portion of the URL
 func ContextNameFromURL(serverURL string) string {
-	parsed, err := url.Parse(serverURL)
-	if err != nil {
-		return "default"
-	}
-
-	hostname := parsed.Hostname()
-	if hostname == "" {
-		return "default"
-	}
-
-	if hostname == "localhost" || hostname == "127.0.0.1" || hostname == "::1" {
-		return "local"
-	}
-
-	// Strip port via Hostname() already done; return the hostname.
-	return strings.TrimPrefix(hostname, "www.")
+	return config.ContextNameFromURL(serverURL)
 }
@@ -1288,7 +1288,7 @@ func (m *AppModel) handleAgentsMsg(msg AgentsMsg) (tea.Model, tea.Cmd) {
 	rows := make([]table.Row, 0, len(msg.Agents))
 	for _, a := range msg.Agents {
 		// Pass -1 for session count — placeholder until AgentCountsMsg arrives.
-		row := views.AgentRow(a, -1, now)
+		row := views.AgentRow(a, -1, "", now)
 		// Sanitize all cells except PHASE (index 3) which contains embedded ANSI color.
 		for i := range row {
 			if i != 3 {
@@ -1335,11 +1335,11 @@ func (m *AppModel) handleAgentCountsMsg(msg AgentCountsMsg) (tea.Model, tea.Cmd)
 	now := time.Now()
 	rows := make([]table.Row, 0, len(m.cachedAgents))
 	for _, a := range m.cachedAgents {
-		sc := -1
+		sc, status := -1, ""
 		if counts, ok := msg.Counts[a.ID]; ok {
-			sc = counts.SessionCount
+			sc, status = counts.SessionCount, counts.Status
 		}
-		row := views.AgentRow(a, sc, now)
+		row := views.AgentRow(a, sc, status, now)
 		// Sanitize all cells except PHASE (index 3) which contains embedded ANSI color.
 		for i := range row {
 			if i != 3 {
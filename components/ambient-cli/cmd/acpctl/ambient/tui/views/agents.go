@@ -23,13 +23,12 @@ func AgentColumns() []table.Column {
 
 // AgentRow converts an SDK Agent into a table row suitable for the agent list
 // view. The sessionCount parameter is the number of sessions for this agent
-// (-1 means not yet loaded, displayed as "-"). The now parameter is used to
+// (-1 means not yet loaded, displayed as "-"). The status parameter is the
+// agent status from the project home view (idle, starting, running, stopping
+// or failed); when empty the PHASE column falls back to "active" if the agent
+// has a current session ID and "idle" otherwise. The now parameter is used to
 // compute the relative AGE column.
-//
-// The PHASE column shows "active" (orange) when the agent has a current
-// session ID, and "idle" (dim) otherwise. Phase text is rendered with
-// embedded lipgloss color so it displays correctly in the bubbles/table.
-func AgentRow(a sdktypes.Agent, sessionCount int, now time.Time) table.Row {
+func AgentRow(a sdktypes.Agent, sessionCount int, status string, now time.Time) table.Row {
 	age := ""
 	if a.CreatedAt != nil {
 		age = FormatAge(now.Sub(*a.CreatedAt))
@@ -40,9 +39,12 @@ func AgentRow(a sdktypes.Agent, sessionCount int, now time.Time) table.Row {
 		sessions = fmt.Sprintf("%d", sessionCount)
 	}
 
-	phase := "idle"
-	if a.CurrentSessionID != "" {
-		phase = "active"
+	phase := status
+	if phase == "" {
+		phase = "idle"
+		if a.CurrentSessionID != "" {
+			phase = "active"
+		}
 	}
 
 	return table.Row{
@@ -8,28 +8,38 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/ambient-code/platform/components/ambient-cli/pkg/config"
 	"github.com/ambient-code/platform/components/ambient-cli/pkg/connection"
 	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
 	sdktypes "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v3"
 )
 
 var Cmd = &cobra.Command{
 	Use:   "apply",
-	Short: "Apply declarative Project, Agent, Credential, and RoleBinding manifests",
-	Long: `Apply Projects, Agents, Credentials, and RoleBindings from YAML files or a Kustomize directory.
+	Short: "Apply declarative manifests for projects, agents, credentials and more",
+	Long: `Apply Projects, Agents, Credentials, RoleBindings, ScheduledSessions,
+ProjectSettings and Applications from YAML files or a Kustomize directory.
 
 Mirrors kubectl apply semantics: resources are created if they do not exist,
 or patched if they do. Output reports created / configured / unchanged per resource.
+Run 'acpctl diff' with the same arguments to see the field-level changes first.
 
-Supported kinds: Project, Agent, Credential, RoleBinding
+Supported kinds: Project, Agent, Credential, RoleBinding, ScheduledSession,
+ProjectSettings, Application
+
+Agents, ScheduledSessions and ProjectSettings go to the current project, or
+to --project. Projects, Agents, Credentials and Applications are annotated
+with ambient.io/last-applied-configuration. With --prune -l <selector>,
+objects of those kinds that carry the annotation and match the selector but
+are no longer in the manifests are deleted. Prune stays within the target
+project: agents in it, applications deploying to it, and credentials last
+applied to it (recorded in ambient.io/applied-project). Projects are never
+pruned.
 
 File format (one or more documents separated by ---):
 
@@ -64,6 +74,7 @@ Examples:
   acpctl apply -f .ambient/teams/base/lead.yaml
   acpctl apply -k .ambient/teams/overlays/dev/
   acpctl apply -k .ambient/teams/overlays/prod/ --dry-run
+  acpctl apply -k .ambient/teams/overlays/prod/ --prune -l team=platform
   cat lead.yaml | acpctl apply -f -
 
 Credential example:
@@ -83,6 +94,38 @@ RoleBinding example:
   scope: credential
   scope_id: my-gitlab-pat
   user_id: lead
+
+ScheduledSession example:
+
+  kind: ScheduledSession
+  name: nightly-triage
+  agent: lead
+  schedule: "0 2 * * *"
+  timezone: Europe/Berlin
+  prompt: Triage the issues opened today.
+  enabled: true
+
+ProjectSettings example (structured fields may also be JSON strings):
+
+  kind: ProjectSettings
+  repositories:
+    - url: https://github.com/org/repo
+      branch: main
+  model_overrides:
+    claude-opus-4-6: true
+  monthly_budget_usd: 500
+
+Application example:
+
+  kind: Application
+  name: platform-team
+  source_repo_url: https://github.com/org/fleet
+  source_path: .ambient/teams/overlays/prod
+  destination_project: platform
+  credential: my-gitlab-pat
+  auto_sync: true
+  labels:
+    team: platform
 `,
 	RunE: run,
 }
@@ -93,38 +136,18 @@ var applyArgs struct {
 	dryRun       bool
 	outputFormat string
 	project      string
+	prune        bool
+	selector     string
 }
 
 func init() {
 	Cmd.Flags().StringVarP(&applyArgs.file, "filename", "f", "", "File, directory, or - for stdin")
 	Cmd.Flags().StringVarP(&applyArgs.kustomize, "kustomize", "k", "", "Kustomize directory")
-	Cmd.Flags().BoolVar(&applyArgs.dryRun, "dry-run", false, "Print what would be applied without making API calls")
+	Cmd.Flags().BoolVar(&applyArgs.dryRun, "dry-run", false, "Print what would be applied without making API calls (with --prune, also list what would be pruned)")
 	Cmd.Flags().StringVarP(&applyArgs.outputFormat, "output", "o", "", "Output format: json")
-	Cmd.Flags().StringVar(&applyArgs.project, "project", "", "Override project context for Agent resources")
-}
-
-// resource is a parsed YAML document from a manifest file.
-type resource struct {
-	Kind        string            `yaml:"kind"`
-	Name        string            `yaml:"name"`
-	Description string            `yaml:"description"`
-	Prompt      string            `yaml:"prompt"`
-	Labels      map[string]string `yaml:"labels"`
-	Annotations map[string]string `yaml:"annotations"`
-	Inbox       []inboxSeed       `yaml:"inbox"`
-	Provider    string            `yaml:"provider"`
-	Token       string            `yaml:"token"`
-	URL         string            `yaml:"url"`
-	Email       string            `yaml:"email"`
-	Role        string            `yaml:"role"`
-	Scope       string            `yaml:"scope"`
-	ScopeID     string            `yaml:"scope_id"`
-	UserID      string            `yaml:"user_id"`
-}
-
-type inboxSeed struct {
-	FromName string `yaml:"from_name"`
-	Body     string `yaml:"body"`
+	Cmd.Flags().StringVar(&applyArgs.project, "project", "", "Override project context for Agent, ScheduledSession and ProjectSettings resources")
+	Cmd.Flags().BoolVar(&applyArgs.prune, "prune", false, "Delete managed objects matching --selector that are not in the manifests")
+	Cmd.Flags().StringVarP(&applyArgs.selector, "selector", "l", "", "Label selector for --prune (e.g. team=platform,env!=dev)")
 }
 
 type applyResult struct {
@@ -134,80 +157,76 @@ type applyResult struct {
 }
 
 func run(cmd *cobra.Command, _ []string) error {
-	if applyArgs.file == "" && applyArgs.kustomize == "" {
-		return fmt.Errorf("one of -f or -k is required")
-	}
-	if applyArgs.file != "" && applyArgs.kustomize != "" {
-		return fmt.Errorf("-f and -k are mutually exclusive")
-	}
-
-	var docs []resource
-	var err error
-
-	if applyArgs.kustomize != "" {
-		docs, err = loadKustomize(applyArgs.kustomize)
-	} else {
-		docs, err = loadFile(applyArgs.file)
-	}
+	docs, err := loadDocs(applyArgs.file, applyArgs.kustomize)
 	if err != nil {
 		return err
 	}
 
-	if applyArgs.dryRun {
-		return printDryRun(cmd, docs)
+	var sel selector
+	if applyArgs.prune {
+		if applyArgs.selector == "" {
+			return fmt.Errorf("--prune requires a label selector (-l)")
+		}
+		if sel, err = parseSelector(applyArgs.selector); err != nil {
+			return err
+		}
 	}
 
-	factory, err := connection.NewClientFactory()
-	if err != nil {
-		return err
+	if applyArgs.dryRun && !applyArgs.prune {
+		return printDryRun(cmd, docs, nil)
 	}
 
-	cfg, err := config.Load()
+	t, cfg, err := newTarget(applyArgs.project)
 	if err != nil {
 		return err
 	}
 
-	projectName := applyArgs.project
-	if projectName == "" {
-		projectName = cfg.GetProject()
-	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.GetRequestTimeout())
 	defer cancel()
 
-	client, err := factory.ForProject(projectName)
-	if err != nil {
-		return err
+	if applyArgs.dryRun {
+		pruned, err := t.pruneCandidates(ctx, sel, appliedKeys(docs))
+		if err != nil {
+			return fmt.Errorf("prune: %w", err)
+		}
+		return printDryRun(cmd, docs, pruned)
 	}
 
 	var results []applyResult
-	for _, doc := range docs {
-		var result applyResult
-		switch strings.ToLower(doc.Kind) {
-		case "project":
-			result, err = applyProject(ctx, client, doc)
-		case "agent":
-			result, err = applyAgent(ctx, client, doc, projectName, factory)
-		case "credential":
-			result, err = applyCredential(ctx, client, doc)
-		case "rolebinding":
-			result, err = applyRoleBinding(ctx, client, doc)
-		default:
+	report := func(result applyResult) {
+		results = append(results, result)
+		if applyArgs.outputFormat != "json" {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s/%s %s\n",
+				strings.ToLower(result.Kind), result.Name, result.Status)
+		}
+	}
+
+	for _, doc := range sortDocs(docs) {
+		p, err := t.planFor(ctx, doc)
+		if errors.Is(err, errUnknownKind) {
 			fmt.Fprintf(cmd.ErrOrStderr(), "warning: unknown kind %q — skipping\n", doc.Kind)
 			continue
 		}
 		if err != nil {
-			return fmt.Errorf("apply %s/%s: %w", strings.ToLower(doc.Kind), docDisplayName(doc), err)
+			return fmt.Errorf("apply %s/%s: %w", strings.ToLower(doc.Kind), doc.DisplayName(), err)
 		}
-		results = append(results, result)
+		result, err := p.apply(ctx)
+		if err != nil {
+			return fmt.Errorf("apply %s/%s: %w", strings.ToLower(doc.Kind), doc.DisplayName(), err)
+		}
+		report(result)
+	}
 
-		if applyArgs.outputFormat != "json" {
-			displayName := result.Name
-			if displayName == "" {
-				displayName = result.Kind
+	if applyArgs.prune {
+		pruned, err := t.pruneCandidates(ctx, sel, appliedKeys(docs))
+		if err != nil {
+			return fmt.Errorf("prune: %w", err)
+		}
+		for _, item := range pruned {
+			if err := item.delete(ctx); err != nil {
+				return fmt.Errorf("prune %s/%s: %w", strings.ToLower(item.Kind), item.Name, err)
 			}
-			fmt.Fprintf(cmd.OutOrStdout(), "%s/%s %s\n",
-				strings.ToLower(result.Kind), displayName, result.Status)
+			report(applyResult{Kind: item.Kind, Name: item.Name, Status: statusPruned})
 		}
 	}
 
@@ -219,191 +238,29 @@ func run(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-func applyProject(ctx context.Context, client *sdkclient.Client, doc resource) (applyResult, error) {
-	existing, err := client.Projects().Get(ctx, doc.Name)
-	if err != nil {
-		builder := sdktypes.NewProjectBuilder().Name(doc.Name)
-		if doc.Description != "" {
-			builder = builder.Description(doc.Description)
-		}
-		if doc.Prompt != "" {
-			builder = builder.Prompt(doc.Prompt)
-		}
-		proj, buildErr := builder.Build()
-		if buildErr != nil {
-			return applyResult{}, buildErr
-		}
-		if _, createErr := client.Projects().Create(ctx, proj); createErr != nil {
-			return applyResult{}, createErr
-		}
-		if len(doc.Labels) > 0 || len(doc.Annotations) > 0 {
-			patch := map[string]any{}
-			if len(doc.Labels) > 0 {
-				patch["labels"] = marshalStringMap(doc.Labels)
-			}
-			if len(doc.Annotations) > 0 {
-				patch["annotations"] = marshalStringMap(doc.Annotations)
-			}
-			if _, patchErr := client.Projects().Update(ctx, doc.Name, patch); patchErr != nil {
-				return applyResult{}, patchErr
-			}
-		}
-		return applyResult{Kind: "Project", Name: doc.Name, Status: "created"}, nil
-	}
-
-	patch := buildProjectPatch(existing, doc)
-	if len(patch) == 0 {
-		return applyResult{Kind: "Project", Name: doc.Name, Status: "unchanged"}, nil
-	}
-	if _, err = client.Projects().Update(ctx, doc.Name, patch); err != nil {
-		return applyResult{}, err
-	}
-	return applyResult{Kind: "Project", Name: doc.Name, Status: "configured"}, nil
-}
-
-func applyCredential(ctx context.Context, client *sdkclient.Client, doc resource) (applyResult, error) {
-	existing, err := client.Credentials().Get(ctx, doc.Name)
+// newTarget connects to the server and resolves the project namespaced
+// kinds are applied to.
+func newTarget(projectOverride string) (*target, *config.Config, error) {
+	factory, err := connection.NewClientFactory()
 	if err != nil {
-		token := os.ExpandEnv(doc.Token)
-		builder := sdktypes.NewCredentialBuilder().
-			Name(doc.Name).
-			Provider(doc.Provider)
-		if token != "" {
-			builder = builder.Token(token)
-		}
-		if doc.Description != "" {
-			builder = builder.Description(doc.Description)
-		}
-		if doc.URL != "" {
-			builder = builder.URL(doc.URL)
-		}
-		if doc.Email != "" {
-			builder = builder.Email(doc.Email)
-		}
-		if len(doc.Labels) > 0 {
-			builder = builder.Labels(marshalStringMap(doc.Labels))
-		}
-		if len(doc.Annotations) > 0 {
-			builder = builder.Annotations(marshalStringMap(doc.Annotations))
-		}
-		cred, buildErr := builder.Build()
-		if buildErr != nil {
-			return applyResult{}, buildErr
-		}
-		if _, createErr := client.Credentials().Create(ctx, cred); createErr != nil {
-			return applyResult{}, createErr
-		}
-		return applyResult{Kind: "Credential", Name: doc.Name, Status: "created"}, nil
-	}
-
-	patch, changed := buildCredentialPatch(existing, doc)
-	if !changed {
-		return applyResult{Kind: "Credential", Name: doc.Name, Status: "unchanged"}, nil
-	}
-	if _, err = client.Credentials().Update(ctx, existing.ID, patch); err != nil {
-		return applyResult{}, err
-	}
-	return applyResult{Kind: "Credential", Name: doc.Name, Status: "configured"}, nil
-}
-
-func buildCredentialPatch(existing *sdktypes.Credential, doc resource) (map[string]any, bool) {
-	changed := false
-	patch := sdktypes.NewCredentialPatchBuilder()
-	if doc.Description != "" && doc.Description != existing.Description {
-		patch = patch.Description(doc.Description)
-		changed = true
-	}
-	if doc.URL != "" && doc.URL != existing.URL {
-		patch = patch.URL(doc.URL)
-		changed = true
-	}
-	if doc.Email != "" && doc.Email != existing.Email {
-		patch = patch.Email(doc.Email)
-		changed = true
-	}
-	token := os.ExpandEnv(doc.Token)
-	if token != "" && token != existing.Token {
-		patch = patch.Token(token)
-		changed = true
-	}
-	if len(doc.Labels) > 0 && marshalStringMap(doc.Labels) != existing.Labels {
-		patch = patch.Labels(marshalStringMap(doc.Labels))
-		changed = true
-	}
-	if len(doc.Annotations) > 0 && marshalStringMap(doc.Annotations) != existing.Annotations {
-		patch = patch.Annotations(marshalStringMap(doc.Annotations))
-		changed = true
-	}
-	return patch.Build(), changed
-}
-
-// ── RoleBinding ──────────────────────────────────────────────────────────────
-
-func applyRoleBinding(ctx context.Context, client *sdkclient.Client, doc resource) (applyResult, error) {
-	displayName := roleBindingDisplayName(doc)
-
-	if doc.Role == "" {
-		return applyResult{}, fmt.Errorf("role is required")
-	}
-	if doc.Scope == "" {
-		return applyResult{}, fmt.Errorf("scope is required")
-	}
-	if doc.ScopeID == "" {
-		return applyResult{}, fmt.Errorf("scope_id is required")
-	}
-	if doc.UserID == "" {
-		return applyResult{}, fmt.Errorf("user_id is required")
+		return nil, nil, err
 	}
 
-	roleID, err := resolveRoleID(ctx, client, doc.Role)
+	cfg, err := config.Load()
 	if err != nil {
-		return applyResult{}, err
+		return nil, nil, err
 	}
 
-	scopeFK, err := resolveScopeFK(ctx, client, doc.Scope, doc.ScopeID)
-	if err != nil {
-		return applyResult{}, err
+	projectName := projectOverride
+	if projectName == "" {
+		projectName = cfg.GetProject()
 	}
 
-	opts := sdktypes.NewListOptions().Size(100).Build()
-	existing, err := client.RoleBindings().List(ctx, opts)
+	client, err := factory.ForProject(projectName)
 	if err != nil {
-		return applyResult{}, fmt.Errorf("list role-bindings: %w", err)
-	}
-
-	for _, rb := range existing.Items {
-		if rb.RoleID == roleID &&
-			rb.Scope == doc.Scope &&
-			ptrEquals(rb.UserID, doc.UserID) &&
-			scopeFKMatches(rb, doc.Scope, scopeFK) {
-			return applyResult{Kind: "RoleBinding", Name: displayName, Status: "unchanged"}, nil
-		}
-	}
-
-	builder := sdktypes.NewRoleBindingBuilder().
-		RoleID(roleID).
-		Scope(doc.Scope).
-		UserID(doc.UserID)
-
-	switch doc.Scope {
-	case "credential":
-		builder = builder.CredentialID(scopeFK)
-	case "project":
-		builder = builder.ProjectID(scopeFK)
-	case "agent":
-		builder = builder.AgentID(scopeFK)
-	case "session":
-		builder = builder.SessionID(scopeFK)
+		return nil, nil, err
 	}
-
-	rb, buildErr := builder.Build()
-	if buildErr != nil {
-		return applyResult{}, buildErr
-	}
-	if _, createErr := client.RoleBindings().Create(ctx, rb); createErr != nil {
-		return applyResult{}, fmt.Errorf("create role-binding: %w", createErr)
-	}
-	return applyResult{Kind: "RoleBinding", Name: displayName, Status: "created"}, nil
+	return &target{client: client, projectName: projectName}, cfg, nil
 }
 
 func resolveRoleID(ctx context.Context, client *sdkclient.Client, roleName string) (string, error) {
@@ -538,20 +395,6 @@ func ptrEquals(p *string, v string) bool {
 	return p != nil && *p == v
 }
 
-func roleBindingDisplayName(doc resource) string {
-	return doc.UserID + "\u2192" + doc.ScopeID
-}
-
-func docDisplayName(d resource) string {
-	if d.Name != "" {
-		return d.Name
-	}
-	if strings.EqualFold(d.Kind, "RoleBinding") {
-		return roleBindingDisplayName(d)
-	}
-	return d.Kind
-}
-
 func marshalStringMap(m map[string]string) string {
 	if len(m) == 0 {
 		return ""
@@ -560,99 +403,7 @@ func marshalStringMap(m map[string]string) string {
 	return string(b)
 }
 
-func buildProjectPatch(existing *sdktypes.Project, doc resource) map[string]any {
-	patch := map[string]any{}
-	if doc.Description != "" && doc.Description != existing.Description {
-		patch["description"] = doc.Description
-	}
-	if doc.Prompt != "" && doc.Prompt != existing.Prompt {
-		patch["prompt"] = doc.Prompt
-	}
-	if len(doc.Labels) > 0 {
-		patch["labels"] = marshalStringMap(doc.Labels)
-	}
-	if len(doc.Annotations) > 0 {
-		patch["annotations"] = marshalStringMap(doc.Annotations)
-	}
-	return patch
-}
-
-func applyAgent(ctx context.Context, client *sdkclient.Client, doc resource, projectName string, factory *connection.ClientFactory) (applyResult, error) {
-	projClient := client
-	if factory != nil {
-		if pc, err := factory.ForProject(projectName); err == nil {
-			projClient = pc
-		}
-	}
-
-	project, err := projClient.Projects().Get(ctx, projectName)
-	if err != nil {
-		return applyResult{}, fmt.Errorf("project %q not found: %w", projectName, err)
-	}
-
-	existing, err := projClient.Agents().GetInProject(ctx, project.ID, doc.Name)
-	if err != nil {
-		builder := sdktypes.NewAgentBuilder().
-			ProjectID(project.ID).
-			Name(doc.Name)
-		if doc.Prompt != "" {
-			builder = builder.Prompt(doc.Prompt)
-		}
-		pa, buildErr := builder.Build()
-		if buildErr != nil {
-			return applyResult{}, buildErr
-		}
-		created, createErr := projClient.Agents().CreateInProject(ctx, project.ID, pa)
-		if createErr != nil {
-			return applyResult{}, createErr
-		}
-		if len(doc.Labels) > 0 || len(doc.Annotations) > 0 {
-			patch := map[string]any{}
-			if len(doc.Labels) > 0 {
-				patch["labels"] = marshalStringMap(doc.Labels)
-			}
-			if len(doc.Annotations) > 0 {
-				patch["annotations"] = marshalStringMap(doc.Annotations)
-			}
-			if _, patchErr := projClient.Agents().UpdateInProject(ctx, project.ID, created.ID, patch); patchErr != nil {
-				return applyResult{}, patchErr
-			}
-		}
-		if seedErr := seedInbox(ctx, projClient, project.ID, created.ID, doc.Inbox); seedErr != nil {
-			return applyResult{}, seedErr
-		}
-		return applyResult{Kind: "Agent", Name: doc.Name, Status: "created"}, nil
-	}
-
-	patch := buildAgentPatch(existing, doc)
-	status := "unchanged"
-	if len(patch) > 0 {
-		if _, err = projClient.Agents().UpdateInProject(ctx, project.ID, existing.ID, patch); err != nil {
-			return applyResult{}, err
-		}
-		status = "configured"
-	}
-	if seedErr := seedInbox(ctx, projClient, project.ID, existing.ID, doc.Inbox); seedErr != nil {
-		return applyResult{}, seedErr
-	}
-	return applyResult{Kind: "Agent", Name: doc.Name, Status: status}, nil
-}
-
-func buildAgentPatch(existing *sdktypes.Agent, doc resource) map[string]any {
-	patch := map[string]any{}
-	if doc.Prompt != "" && doc.Prompt != existing.Prompt {
-		patch["prompt"] = doc.Prompt
-	}
-	if len(doc.Labels) > 0 {
-		patch["labels"] = marshalStringMap(doc.Labels)
-	}
-	if len(doc.Annotations) > 0 {
-		patch["annotations"] = marshalStringMap(doc.Annotations)
-	}
-	return patch
-}
-
-func seedInbox(ctx context.Context, client *sdkclient.Client, projectID, agentID string, seeds []inboxSeed) error {
+func seedInbox(ctx context.Context, client *sdkclient.Client, projectID, agentID string, seeds []manifest.InboxSeed) error {
 	if len(seeds) == 0 {
 		return nil
 	}
@@ -678,245 +429,36 @@ func seedInbox(ctx context.Context, client *sdkclient.Client, projectID, agentID
 
 // ── YAML loading ──────────────────────────────────────────────────────────────
 
-func loadFile(path string) ([]resource, error) {
-	if path == "-" {
-		return parseManifests(os.Stdin)
-	}
-	info, err := os.Stat(path)
-	if err != nil {
-		return nil, err
-	}
-	if info.IsDir() {
-		return loadDir(path)
-	}
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
-	return parseManifests(f)
-}
-
-func loadDir(dir string) ([]resource, error) {
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		return nil, err
-	}
-	var all []resource
-	for _, e := range entries {
-		if e.IsDir() {
-			continue
-		}
-		name := e.Name()
-		if !strings.HasSuffix(name, ".yaml") && !strings.HasSuffix(name, ".yml") {
-			continue
-		}
-		if name == "kustomization.yaml" || name == "kustomization.yml" {
-			continue
-		}
-		docs, err := loadFile(filepath.Join(dir, name))
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", name, err)
-		}
-		all = append(all, docs...)
-	}
-	return all, nil
-}
-
-func parseManifests(r io.Reader) ([]resource, error) {
-	data, err := io.ReadAll(r)
-	if err != nil {
-		return nil, err
-	}
-	var docs []resource
-	dec := yaml.NewDecoder(bytes.NewReader(data))
-	for {
-		var doc resource
-		if err := dec.Decode(&doc); err != nil {
-			if err == io.EOF {
-				break
-			}
-			return nil, fmt.Errorf("parse YAML: %w", err)
-		}
-		if doc.Kind == "" {
-			continue
-		}
-		docs = append(docs, doc)
-	}
-	return docs, nil
-}
-
-// ── Kustomize ─────────────────────────────────────────────────────────────────
-
-type kustomization struct {
-	Kind      string      `yaml:"kind"`
-	Resources []string    `yaml:"resources"`
-	Bases     []string    `yaml:"bases"`
-	Patches   []kustPatch `yaml:"patches"`
-}
-
-type kustPatch struct {
-	Path   string     `yaml:"path"`
-	Target kustTarget `yaml:"target"`
-}
-
-type kustTarget struct {
-	Kind string `yaml:"kind"`
-	Name string `yaml:"name"`
-}
-
-func loadKustomize(dir string) ([]resource, error) {
-	kustFile := ""
-	for _, name := range []string{"kustomization.yaml", "kustomization.yml"} {
-		p := filepath.Join(dir, name)
-		if _, err := os.Stat(p); err == nil {
-			kustFile = p
-			break
-		}
-	}
-	if kustFile == "" {
-		return nil, fmt.Errorf("no kustomization.yaml found in %s", dir)
-	}
-
-	data, err := os.ReadFile(kustFile)
-	if err != nil {
-		return nil, err
-	}
-	var kust kustomization
-	if err := yaml.Unmarshal(data, &kust); err != nil {
-		return nil, fmt.Errorf("parse kustomization: %w", err)
-	}
-
-	var docs []resource
-
-	for _, base := range kust.Bases {
-		basePath := filepath.Join(dir, base)
-		baseDocs, err := loadKustomize(basePath)
-		if err != nil {
-			return nil, fmt.Errorf("base %s: %w", base, err)
-		}
-		docs = append(docs, baseDocs...)
-	}
-
-	for _, res := range kust.Resources {
-		resPath := filepath.Join(dir, res)
-		info, err := os.Stat(resPath)
-		if err != nil {
-			return nil, fmt.Errorf("resource %s: %w", res, err)
-		}
-		var resDocs []resource
-		if info.IsDir() {
-			resDocs, err = loadKustomize(resPath)
-		} else {
-			resDocs, err = loadFile(resPath)
-		}
-		if err != nil {
-			return nil, fmt.Errorf("resource %s: %w", res, err)
-		}
-		docs = mergeResources(docs, resDocs)
-	}
-
-	for _, patch := range kust.Patches {
-		patchDocs, err := loadFile(filepath.Join(dir, patch.Path))
-		if err != nil {
-			return nil, fmt.Errorf("patch %s: %w", patch.Path, err)
-		}
-		for _, p := range patchDocs {
-			docs = applyPatch(docs, p, patch.Target)
-		}
-	}
-
-	return docs, nil
-}
-
-// mergeResources adds resDocs into docs, deduplicating by kind+name (later wins).
-func mergeResources(docs, incoming []resource) []resource {
-	idx := make(map[string]int, len(docs))
-	for i, d := range docs {
-		idx[resourceKey(d)] = i
-	}
-	for _, inc := range incoming {
-		key := resourceKey(inc)
-		if i, exists := idx[key]; exists {
-			docs[i] = inc
-		} else {
-			idx[key] = len(docs)
-			docs = append(docs, inc)
-		}
+func loadDocs(file, kustomize string) ([]manifest.Resource, error) {
+	if file == "" && kustomize == "" {
+		return nil, fmt.Errorf("one of -f or -k is required")
 	}
-	return docs
-}
-
-func resourceKey(r resource) string {
-	return strings.ToLower(r.Kind) + "/" + r.Name
-}
-
-// applyPatch merges patch into all matching resources (strategic merge).
-func applyPatch(docs []resource, patch resource, target kustTarget) []resource {
-	for i := range docs {
-		if !matchesTarget(docs[i], target) {
-			continue
-		}
-		docs[i] = strategicMerge(docs[i], patch)
-	}
-	return docs
-}
-
-func matchesTarget(doc resource, target kustTarget) bool {
-	if target.Kind != "" && !strings.EqualFold(doc.Kind, target.Kind) {
-		return false
+	if file != "" && kustomize != "" {
+		return nil, fmt.Errorf("-f and -k are mutually exclusive")
 	}
-	if target.Name != "" && doc.Name != target.Name {
-		return false
+	if kustomize != "" {
+		return manifest.LoadKustomize(kustomize)
 	}
-	return true
+	return loadFile(file)
 }
 
-// strategicMerge applies patch onto base: scalars overwrite, maps merge.
-func strategicMerge(base, patch resource) resource {
-	if patch.Name != "" {
-		base.Name = patch.Name
-	}
-	if patch.Description != "" {
-		base.Description = patch.Description
-	}
-	if patch.Prompt != "" {
-		base.Prompt = patch.Prompt
-	}
-	if patch.Provider != "" {
-		base.Provider = patch.Provider
-	}
-	if patch.Token != "" {
-		base.Token = patch.Token
-	}
-	if patch.URL != "" {
-		base.URL = patch.URL
-	}
-	if patch.Email != "" {
-		base.Email = patch.Email
-	}
-	for k, v := range patch.Labels {
-		if base.Labels == nil {
-			base.Labels = make(map[string]string)
-		}
-		base.Labels[k] = v
-	}
-	for k, v := range patch.Annotations {
-		if base.Annotations == nil {
-			base.Annotations = make(map[string]string)
-		}
-		base.Annotations[k] = v
+func loadFile(path string) ([]manifest.Resource, error) {
+	if path == "-" {
+		return manifest.Parse(os.Stdin)
 	}
-	return base
+	return manifest.LoadFile(path)
 }
 
 // ── Dry-run ───────────────────────────────────────────────────────────────────
 
-func printDryRun(cmd *cobra.Command, docs []resource) error {
+func printDryRun(cmd *cobra.Command, docs []manifest.Resource, pruned []pruneItem) error {
 	if applyArgs.outputFormat == "json" {
-		results := make([]applyResult, 0, len(docs))
+		results := make([]applyResult, 0, len(docs)+len(pruned))
 		for _, d := range docs {
-			results = append(results, applyResult{Kind: d.Kind, Name: docDisplayName(d), Status: "dry-run"})
+			results = append(results, applyResult{Kind: d.Kind, Name: d.DisplayName(), Status: "dry-run"})
+		}
+		for _, item := range pruned {
+			results = append(results, applyResult{Kind: item.Kind, Name: item.Name, Status: statusPruned + " (dry run)"})
 		}
 		enc := json.NewEncoder(cmd.OutOrStdout())
 		enc.SetIndent("", "  ")
@@ -925,7 +467,13 @@ func printDryRun(cmd *cobra.Command, docs []resource) error {
 	w := cmd.OutOrStdout()
 	fmt.Fprintln(w, "dry-run: would apply:")
 	for _, d := range docs {
-		fmt.Fprintf(w, "  %s/%s\n", strings.ToLower(d.Kind), docDisplayName(d))
+		fmt.Fprintf(w, "  %s/%s\n", strings.ToLower(d.Kind), d.DisplayName())
+	}
+	if len(pruned) > 0 {
+		fmt.Fprintln(w, "dry-run: would prune:")
+		for _, item := range pruned {
+			fmt.Fprintf(w, "  %s/%s\n", strings.ToLower(item.Kind), item.Name)
+		}
 	}
 	return nil
 }
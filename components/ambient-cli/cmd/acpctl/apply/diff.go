@@ -0,0 +1,234 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// DiffCmd shows what apply would change without changing anything.
+var DiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the field-level changes apply would make",
+	Long: `Compare manifests against the live objects and print the fields apply would change.
+
+Takes the same -f / -k arguments as 'acpctl apply'. Objects that do not exist
+yet are marked with +, changed objects with ~, and with --prune -l <selector>
+the managed objects apply would delete are marked with -. Credential tokens
+are never printed.
+
+Examples:
+
+  acpctl diff -f .ambient/teams/base/
+  acpctl diff -k .ambient/teams/overlays/prod/ --prune -l team=platform
+`,
+	Args: cobra.NoArgs,
+	RunE: runDiff,
+}
+
+var diffArgs struct {
+	file      string
+	kustomize string
+	project   string
+	prune     bool
+	selector  string
+}
+
+func init() {
+	DiffCmd.Flags().StringVarP(&diffArgs.file, "filename", "f", "", "File or directory to diff (use - for stdin)")
+	DiffCmd.Flags().StringVarP(&diffArgs.kustomize, "kustomize", "k", "", "Kustomize directory to build and diff")
+	DiffCmd.Flags().StringVar(&diffArgs.project, "project", "", "Override project context for Agent, ScheduledSession and ProjectSettings resources")
+	DiffCmd.Flags().BoolVar(&diffArgs.prune, "prune", false, "Also show managed objects matching --selector that apply --prune would delete")
+	DiffCmd.Flags().StringVarP(&diffArgs.selector, "selector", "l", "", "Label selector for --prune (e.g. team=platform,env!=dev)")
+}
+
+func runDiff(cmd *cobra.Command, _ []string) error {
+	docs, err := loadDocs(diffArgs.file, diffArgs.kustomize)
+	if err != nil {
+		return err
+	}
+
+	var sel selector
+	if diffArgs.prune {
+		if diffArgs.selector == "" {
+			return fmt.Errorf("--prune requires a label selector (-l)")
+		}
+		if sel, err = parseSelector(diffArgs.selector); err != nil {
+			return err
+		}
+	}
+
+	t, cfg, err := newTarget(diffArgs.project)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.GetRequestTimeout())
+	defer cancel()
+
+	w := cmd.OutOrStdout()
+	var created, changed, pruned int
+	for _, doc := range sortDocs(docs) {
+		p, err := t.planFor(ctx, doc)
+		if errors.Is(err, errUnknownKind) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: unknown kind %q — skipping\n", doc.Kind)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("diff %s/%s: %w", strings.ToLower(doc.Kind), doc.DisplayName(), err)
+		}
+		switch p.status() {
+		case statusCreated:
+			created++
+			fmt.Fprintf(w, "+ %s/%s\n", strings.ToLower(p.Kind), p.Name)
+			printChanges(w, p.Changes)
+		case statusConfigured:
+			changed++
+			fmt.Fprintf(w, "~ %s/%s\n", strings.ToLower(p.Kind), p.Name)
+			printChanges(w, p.Changes)
+		}
+	}
+
+	if diffArgs.prune {
+		items, err := t.pruneCandidates(ctx, sel, appliedKeys(docs))
+		if err != nil {
+			return fmt.Errorf("prune: %w", err)
+		}
+		for _, item := range items {
+			pruned++
+			fmt.Fprintf(w, "- %s/%s (%s)\n", strings.ToLower(item.Kind), item.Name, statusPruned)
+		}
+	}
+
+	if created+changed+pruned == 0 {
+		fmt.Fprintln(w, "No differences.")
+		return nil
+	}
+	fmt.Fprintf(w, "\n%d to create, %d to change, %d to prune\n", created, changed, pruned)
+	return nil
+}
+
+// printChanges writes one line per changed field. JSON document fields are
+// broken down by key and multi-line strings are shown line by line.
+func printChanges(w io.Writer, changes []fieldChange) {
+	for _, c := range changes {
+		switch {
+		case c.Field == "token":
+			fmt.Fprintf(w, "    token: %s\n", redact(c.Live))
+		case jsonFields[c.Field]:
+			printJSONChange(w, c)
+		case isMultiline(c.Live) || isMultiline(c.Desired):
+			fmt.Fprintf(w, "    %s:\n", c.Field)
+			for _, line := range splitLines(c.Live) {
+				fmt.Fprintf(w, "      - %s\n", line)
+			}
+			for _, line := range splitLines(c.Desired) {
+				fmt.Fprintf(w, "      + %s\n", line)
+			}
+		default:
+			fmt.Fprintf(w, "    %s: %s → %s\n", c.Field, formatValue(c.Live), formatValue(c.Desired))
+		}
+	}
+}
+
+func printJSONChange(w io.Writer, c fieldChange) {
+	live, desired := parseJSONField(c.Live), parseJSONField(c.Desired)
+	if c.Field == "annotations" {
+		live, desired = withoutLastApplied(live, desired)
+	}
+	l, lok := asMap(live)
+	d, dok := asMap(desired)
+	if !lok || !dok {
+		fmt.Fprintf(w, "    %s: %s → %s\n", c.Field, formatJSON(live), formatJSON(desired))
+		return
+	}
+
+	keys := make([]string, 0, len(l)+len(d))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	for k := range d {
+		if _, ok := l[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		lv, inLive := l[k]
+		dv, inDesired := d[k]
+		switch {
+		case k == lastAppliedAnnotation:
+			// Its content is the manifest itself; only say that it changes.
+			if inLive {
+				fmt.Fprintf(w, "    %s.%s: (updated)\n", c.Field, k)
+			} else {
+				fmt.Fprintf(w, "    %s.%s: (added)\n", c.Field, k)
+			}
+		case !inLive:
+			fmt.Fprintf(w, "    %s.%s: (unset) → %s\n", c.Field, k, formatJSON(dv))
+		case !inDesired:
+			fmt.Fprintf(w, "    %s.%s: %s → (removed)\n", c.Field, k, formatJSON(lv))
+		case formatJSON(lv) != formatJSON(dv):
+			fmt.Fprintf(w, "    %s.%s: %s → %s\n", c.Field, k, formatJSON(lv), formatJSON(dv))
+		}
+	}
+}
+
+// asMap treats an unset JSON field as an empty object so added keys show up
+// one by one.
+func asMap(v any) (map[string]any, bool) {
+	if v == nil {
+		return map[string]any{}, true
+	}
+	m, ok := v.(map[string]any)
+	return m, ok
+}
+
+func redact(live any) string {
+	if live == nil || live == "" {
+		return "(unset) → (redacted)"
+	}
+	return "(redacted) → (redacted)"
+}
+
+func formatValue(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return "(unset)"
+	case string:
+		return fmt.Sprintf("%q", t)
+	default:
+		return formatJSON(t)
+	}
+}
+
+func formatJSON(v any) string {
+	if v == nil {
+		return "(unset)"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func isMultiline(v any) bool {
+	s, ok := v.(string)
+	return ok && strings.Contains(strings.TrimRight(s, "\n"), "\n")
+}
+
+func splitLines(v any) []string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(s, "\n"), "\n")
+}
@@ -0,0 +1,300 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+)
+
+// lastAppliedAnnotation records the manifest an object was last applied
+// from, like kubectl's last-applied-configuration. Its presence marks the
+// object as managed by apply, which is what --prune deletes.
+const lastAppliedAnnotation = "ambient.io/last-applied-configuration"
+
+// appliedProjectAnnotation records the project apply targeted when it last
+// wrote an object. Credentials are not project-scoped, so --prune uses it to
+// keep one project's manifest set from pruning another's.
+const appliedProjectAnnotation = "ambient.io/applied-project"
+
+// jsonFields are API fields that hold a JSON document in a string. They are
+// compared by value, not by their text.
+var jsonFields = map[string]bool{
+	"labels":          true,
+	"annotations":     true,
+	"group_access":    true,
+	"repositories":    true,
+	"model_overrides": true,
+	"resource_limits": true,
+	"sync_options":    true,
+}
+
+// fieldChange is a managed field whose live value differs from the manifest.
+// Live is nil when the object does not exist yet.
+type fieldChange struct {
+	Field   string
+	Live    any
+	Desired any
+}
+
+// withLastApplied returns doc with the last-applied annotation set to the
+// document itself, and the applied-project annotation set to project when
+// there is one. Credential tokens are left out.
+func withLastApplied(doc manifest.Resource, project string) manifest.Resource {
+	recorded := doc
+	recorded.Token = ""
+	recorded.Annotations = nil
+	for k, v := range doc.Annotations {
+		if k == lastAppliedAnnotation || k == appliedProjectAnnotation {
+			continue
+		}
+		if recorded.Annotations == nil {
+			recorded.Annotations = map[string]string{}
+		}
+		recorded.Annotations[k] = v
+	}
+	data, err := json.Marshal(recorded)
+	if err != nil {
+		return doc
+	}
+	annotations := make(map[string]string, len(recorded.Annotations)+1)
+	for k, v := range recorded.Annotations {
+		annotations[k] = v
+	}
+	annotations[lastAppliedAnnotation] = string(data)
+	if project != "" {
+		annotations[appliedProjectAnnotation] = project
+	}
+	doc.Annotations = annotations
+	return doc
+}
+
+// liveFields flattens an SDK object into a map keyed by API field name.
+func liveFields(obj any) map[string]any {
+	fields := map[string]any{}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// normalize round-trips desired values through JSON so numbers compare with
+// the float64s decoded from live objects.
+func normalize(desired map[string]any) map[string]any {
+	return liveFields(desired)
+}
+
+// compareFields returns the desired fields whose live value differs, sorted
+// by field name. Fields the manifest does not set are not managed.
+func compareFields(live, desired map[string]any) []fieldChange {
+	desired = normalize(desired)
+	fields := make([]string, 0, len(desired))
+	for field := range desired {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var changes []fieldChange
+	for _, field := range fields {
+		if sameValue(field, live[field], desired[field]) {
+			continue
+		}
+		changes = append(changes, fieldChange{Field: field, Live: live[field], Desired: desired[field]})
+	}
+	return changes
+}
+
+func sameValue(field string, live, desired any) bool {
+	if jsonFields[field] {
+		l, d := parseJSONField(live), parseJSONField(desired)
+		if field == "annotations" {
+			l, d = withoutLastApplied(l, d)
+		}
+		return reflect.DeepEqual(l, d)
+	}
+	// The API omits zero values, so an absent live field equals a zero one.
+	if live == nil {
+		return desired == nil || reflect.ValueOf(desired).IsZero()
+	}
+	return reflect.DeepEqual(live, desired)
+}
+
+// parseJSONField decodes a JSON-in-a-string field. Empty means unset.
+func parseJSONField(v any) any {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal([]byte(s), &out); err != nil {
+		return s
+	}
+	if m, ok := out.(map[string]any); ok && len(m) == 0 {
+		return nil
+	}
+	return out
+}
+
+// withoutLastApplied drops the last-applied annotation from both sides when
+// both have one: its content changes with fields that are compared
+// separately. An object without one still differs, so applying adds it.
+func withoutLastApplied(live, desired any) (any, any) {
+	l, lok := live.(map[string]any)
+	d, dok := desired.(map[string]any)
+	if !lok || !dok {
+		return live, desired
+	}
+	if _, ok := l[lastAppliedAnnotation]; !ok {
+		return live, desired
+	}
+	if _, ok := d[lastAppliedAnnotation]; !ok {
+		return live, desired
+	}
+	return without(l, lastAppliedAnnotation), without(d, lastAppliedAnnotation)
+}
+
+func without(m map[string]any, key string) any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func patchFrom(changes []fieldChange) map[string]any {
+	patch := make(map[string]any, len(changes))
+	for _, c := range changes {
+		patch[c.Field] = c.Desired
+	}
+	return patch
+}
+
+// ── desired fields ────────────────────────────────────────────────────────────
+
+func setString(fields map[string]any, field, v string) {
+	if v != "" {
+		fields[field] = v
+	}
+}
+
+func setStringMap(fields map[string]any, field string, m map[string]string) {
+	if len(m) > 0 {
+		fields[field] = marshalStringMap(m)
+	}
+}
+
+func setPtr[T any](fields map[string]any, field string, v *T) {
+	if v != nil {
+		fields[field] = *v
+	}
+}
+
+// setJSON stores a structured manifest value as the JSON string the API
+// expects. A string is taken to be JSON already.
+func setJSON(fields map[string]any, field string, v any) error {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		if !json.Valid([]byte(t)) {
+			return fmt.Errorf("%s must be valid JSON or a YAML structure", field)
+		}
+		fields[field] = t
+	default:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+		fields[field] = string(data)
+	}
+	return nil
+}
+
+func projectFields(doc manifest.Resource) map[string]any {
+	fields := map[string]any{"name": doc.Name}
+	setString(fields, "description", doc.Description)
+	setString(fields, "prompt", doc.Prompt)
+	setStringMap(fields, "labels", doc.Labels)
+	setStringMap(fields, "annotations", doc.Annotations)
+	return fields
+}
+
+func agentFields(doc manifest.Resource) map[string]any {
+	fields := map[string]any{"name": doc.Name}
+	setString(fields, "prompt", doc.Prompt)
+	setStringMap(fields, "labels", doc.Labels)
+	setStringMap(fields, "annotations", doc.Annotations)
+	return fields
+}
+
+func credentialFields(doc manifest.Resource, token string) map[string]any {
+	fields := map[string]any{"name": doc.Name, "provider": doc.Provider}
+	setString(fields, "description", doc.Description)
+	setString(fields, "url", doc.URL)
+	setString(fields, "email", doc.Email)
+	setString(fields, "token", token)
+	setStringMap(fields, "labels", doc.Labels)
+	setStringMap(fields, "annotations", doc.Annotations)
+	return fields
+}
+
+func scheduledSessionFields(doc manifest.Resource, agentID string) map[string]any {
+	fields := map[string]any{"name": doc.Name}
+	setString(fields, "description", doc.Description)
+	setString(fields, "session_prompt", doc.Prompt)
+	setString(fields, "schedule", doc.Schedule)
+	setString(fields, "timezone", doc.Timezone)
+	setString(fields, "agent_id", agentID)
+	setString(fields, "runner_type", doc.RunnerType)
+	setPtr(fields, "enabled", doc.Enabled)
+	setPtr(fields, "timeout", doc.Timeout)
+	setPtr(fields, "inactivity_timeout", doc.InactivityTimeout)
+	setPtr(fields, "stop_on_run_finished", doc.StopOnRunFinished)
+	return fields
+}
+
+func projectSettingsFields(doc manifest.Resource) (map[string]any, error) {
+	fields := map[string]any{}
+	for field, v := range map[string]any{
+		"group_access":    doc.GroupAccess,
+		"repositories":    doc.Repositories,
+		"model_overrides": doc.ModelOverrides,
+		"resource_limits": doc.ResourceLimits,
+	} {
+		if err := setJSON(fields, field, v); err != nil {
+			return nil, err
+		}
+	}
+	setPtr(fields, "inactivity_timeout_seconds", doc.InactivityTimeoutSeconds)
+	setPtr(fields, "monthly_budget_usd", doc.MonthlyBudgetUsd)
+	return fields, nil
+}
+
+func applicationFields(doc manifest.Resource, credentialID string) (map[string]any, error) {
+	fields := map[string]any{"name": doc.Name}
+	setString(fields, "source_repo_url", doc.SourceRepoURL)
+	setString(fields, "source_path", doc.SourcePath)
+	setString(fields, "source_target_revision", doc.SourceTargetRevision)
+	setString(fields, "destination_project", doc.DestinationProject)
+	setString(fields, "destination_ambient_url", doc.DestinationAmbientURL)
+	setString(fields, "credential_id", credentialID)
+	setPtr(fields, "auto_sync", doc.AutoSync)
+	setPtr(fields, "auto_prune", doc.AutoPrune)
+	setPtr(fields, "self_heal", doc.SelfHeal)
+	setPtr(fields, "retry_limit", doc.RetryLimit)
+	setStringMap(fields, "labels", doc.Labels)
+	setStringMap(fields, "annotations", doc.Annotations)
+	if err := setJSON(fields, "sync_options", doc.SyncOptions); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
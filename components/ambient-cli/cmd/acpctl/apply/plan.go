@@ -0,0 +1,522 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+	sdktypes "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+const (
+	statusCreated    = "created"
+	statusConfigured = "configured"
+	statusUnchanged  = "unchanged"
+	statusPruned     = "pruned"
+)
+
+// plan is what applying one document would do: create the object when ID is
+// empty, otherwise patch the fields in Changes. apply and diff both work
+// from plans, so a diff shows exactly what an apply would change.
+type plan struct {
+	Kind    string
+	Name    string
+	ID      string
+	Desired map[string]any
+	Changes []fieldChange
+
+	create func(ctx context.Context) (any, error)
+	update func(ctx context.Context, id string, patch map[string]any) error
+	// after runs once the object exists, whether or not it changed.
+	after func(ctx context.Context, id string) error
+}
+
+func (p *plan) status() string {
+	switch {
+	case p.ID == "":
+		return statusCreated
+	case len(p.Changes) > 0:
+		return statusConfigured
+	default:
+		return statusUnchanged
+	}
+}
+
+func (p *plan) apply(ctx context.Context) (applyResult, error) {
+	status := p.status()
+	switch status {
+	case statusCreated:
+		created, err := p.create(ctx)
+		if err != nil {
+			return applyResult{}, err
+		}
+		live := liveFields(created)
+		p.ID, _ = live["id"].(string)
+		// Some fields cannot be set on create; patch whatever did not stick.
+		if rest := compareFields(live, p.Desired); len(rest) > 0 && p.update != nil && p.ID != "" {
+			if err := p.update(ctx, p.ID, patchFrom(rest)); err != nil {
+				return applyResult{}, err
+			}
+		}
+	case statusConfigured:
+		if err := p.update(ctx, p.ID, patchFrom(p.Changes)); err != nil {
+			return applyResult{}, err
+		}
+	}
+	if p.after != nil {
+		if err := p.after(ctx, p.ID); err != nil {
+			return applyResult{}, err
+		}
+	}
+	return applyResult{Kind: p.Kind, Name: p.Name, Status: status}, nil
+}
+
+// target resolves documents against the server. Namespaced kinds go to
+// projectName, resolved on first use so a Project created earlier in the
+// same apply can be used.
+type target struct {
+	client      *sdkclient.Client
+	projectName string
+	projectID   string
+}
+
+func (t *target) project(ctx context.Context) (string, error) {
+	if t.projectID != "" {
+		return t.projectID, nil
+	}
+	if t.projectName == "" {
+		return "", fmt.Errorf("no project set; pass --project or run 'acpctl project <name>'")
+	}
+	project, err := t.client.Projects().Get(ctx, t.projectName)
+	if err != nil {
+		return "", fmt.Errorf("project %q not found: %w", t.projectName, err)
+	}
+	t.projectID = project.ID
+	return t.projectID, nil
+}
+
+// kindOrder applies documents so that references resolve: projects and
+// their settings first, then credentials and agents, then the scheduled
+// sessions and bindings that point at them.
+var kindOrder = map[string]int{
+	"project":          0,
+	"projectsettings":  1,
+	"credential":       2,
+	"agent":            3,
+	"scheduledsession": 4,
+	"rolebinding":      5,
+	"application":      6,
+}
+
+func sortDocs(docs []manifest.Resource) []manifest.Resource {
+	sorted := append([]manifest.Resource(nil), docs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return kindRank(sorted[i].Kind) < kindRank(sorted[j].Kind)
+	})
+	return sorted
+}
+
+func kindRank(kind string) int {
+	if r, ok := kindOrder[strings.ToLower(kind)]; ok {
+		return r
+	}
+	return len(kindOrder)
+}
+
+// hasAnnotations reports whether a kind carries labels and annotations, and
+// so can record the last-applied configuration and be pruned.
+func hasAnnotations(kind string) bool {
+	switch strings.ToLower(kind) {
+	case "project", "agent", "credential", "application":
+		return true
+	}
+	return false
+}
+
+var errUnknownKind = errors.New("unknown kind")
+
+// planFor looks up the live object for doc and works out what applying it
+// would change.
+func (t *target) planFor(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	if hasAnnotations(doc.Kind) {
+		doc = withLastApplied(doc, t.projectName)
+	}
+	switch strings.ToLower(doc.Kind) {
+	case "project":
+		return t.planProject(ctx, doc)
+	case "agent":
+		return t.planAgent(ctx, doc)
+	case "credential":
+		return t.planCredential(ctx, doc)
+	case "rolebinding":
+		return t.planRoleBinding(ctx, doc)
+	case "scheduledsession":
+		return t.planScheduledSession(ctx, doc)
+	case "projectsettings":
+		return t.planProjectSettings(ctx, doc)
+	case "application":
+		return t.planApplication(ctx, doc)
+	default:
+		return nil, errUnknownKind
+	}
+}
+
+func newPlan(kind, name, id string, live, desired map[string]any) *plan {
+	p := &plan{Kind: kind, Name: name, ID: id, Desired: desired}
+	if id == "" {
+		p.Changes = compareFields(map[string]any{}, desired)
+	} else {
+		p.Changes = compareFields(live, desired)
+	}
+	return p
+}
+
+func (t *target) planProject(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	desired := projectFields(doc)
+	var live map[string]any
+	id := ""
+	existing, err := t.client.Projects().Get(ctx, doc.Name)
+	switch {
+	case err == nil:
+		id, live = existing.ID, liveFields(existing)
+	case !isNotFound(err):
+		return nil, err
+	}
+
+	p := newPlan(manifest.KindProject, doc.Name, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var proj sdktypes.Project
+		if err := decodeInto(desired, &proj); err != nil {
+			return nil, err
+		}
+		return t.client.Projects().Create(ctx, &proj)
+	}
+	// Projects are addressed by name.
+	p.update = func(ctx context.Context, _ string, patch map[string]any) error {
+		_, err := t.client.Projects().Update(ctx, doc.Name, patch)
+		return err
+	}
+	return p, nil
+}
+
+func (t *target) planAgent(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	projectID, err := t.project(ctx)
+	if err != nil {
+		return nil, err
+	}
+	desired := agentFields(doc)
+	existing, err := findByName(doc.Name, func(opts *sdktypes.ListOptions) ([]sdktypes.Agent, error) {
+		list, err := t.client.Agents().ListByProject(ctx, projectID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(a sdktypes.Agent) string { return a.Name })
+	if err != nil {
+		return nil, fmt.Errorf("look up agent: %w", err)
+	}
+
+	var live map[string]any
+	id := ""
+	if existing != nil {
+		id, live = existing.ID, liveFields(existing)
+	}
+	p := newPlan(manifest.KindAgent, doc.Name, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var agent sdktypes.Agent
+		if err := decodeInto(desired, &agent); err != nil {
+			return nil, err
+		}
+		agent.ProjectID = projectID
+		return t.client.Agents().CreateInProject(ctx, projectID, &agent)
+	}
+	p.update = func(ctx context.Context, id string, patch map[string]any) error {
+		_, err := t.client.Agents().UpdateInProject(ctx, projectID, id, patch)
+		return err
+	}
+	p.after = func(ctx context.Context, id string) error {
+		return seedInbox(ctx, t.client, projectID, id, doc.Inbox)
+	}
+	return p, nil
+}
+
+func (t *target) planCredential(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	desired := credentialFields(doc, os.ExpandEnv(doc.Token))
+	existing, err := findByName(doc.Name, func(opts *sdktypes.ListOptions) ([]sdktypes.Credential, error) {
+		list, err := t.client.Credentials().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(c sdktypes.Credential) string { return c.Name })
+	if err != nil {
+		return nil, fmt.Errorf("look up credential: %w", err)
+	}
+
+	var live map[string]any
+	id := ""
+	if existing != nil {
+		id, live = existing.ID, liveFields(existing)
+	}
+	p := newPlan(manifest.KindCredential, doc.Name, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var cred sdktypes.Credential
+		if err := decodeInto(desired, &cred); err != nil {
+			return nil, err
+		}
+		return t.client.Credentials().Create(ctx, &cred)
+	}
+	p.update = func(ctx context.Context, id string, patch map[string]any) error {
+		_, err := t.client.Credentials().Update(ctx, id, patch)
+		return err
+	}
+	return p, nil
+}
+
+// planRoleBinding plans a binding by identity: it either exists or is
+// created. Bindings have no fields to patch.
+func (t *target) planRoleBinding(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	displayName := manifest.RoleBindingDisplayName(doc)
+
+	if doc.Role == "" {
+		return nil, fmt.Errorf("role is required")
+	}
+	if doc.Scope == "" {
+		return nil, fmt.Errorf("scope is required")
+	}
+	if doc.ScopeID == "" {
+		return nil, fmt.Errorf("scope_id is required")
+	}
+	if doc.UserID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	roleID, err := resolveRoleID(ctx, t.client, doc.Role)
+	if err != nil {
+		return nil, err
+	}
+	scopeFK, err := resolveScopeFK(ctx, t.client, doc.Scope, doc.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := sdktypes.NewListOptions().Size(100).Build()
+	existing, err := t.client.RoleBindings().List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("list role-bindings: %w", err)
+	}
+
+	desired := map[string]any{"role_id": roleID, "scope": doc.Scope, "user_id": doc.UserID, doc.Scope + "_id": scopeFK}
+	for _, rb := range existing.Items {
+		if rb.RoleID == roleID &&
+			rb.Scope == doc.Scope &&
+			ptrEquals(rb.UserID, doc.UserID) &&
+			scopeFKMatches(rb, doc.Scope, scopeFK) {
+			return &plan{Kind: manifest.KindRoleBinding, Name: displayName, ID: rb.ID, Desired: desired}, nil
+		}
+	}
+
+	p := newPlan(manifest.KindRoleBinding, displayName, "", nil, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		builder := sdktypes.NewRoleBindingBuilder().
+			RoleID(roleID).
+			Scope(doc.Scope).
+			UserID(doc.UserID)
+
+		switch doc.Scope {
+		case "credential":
+			builder = builder.CredentialID(scopeFK)
+		case "project":
+			builder = builder.ProjectID(scopeFK)
+		case "agent":
+			builder = builder.AgentID(scopeFK)
+		case "session":
+			builder = builder.SessionID(scopeFK)
+		}
+
+		rb, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		created, err := t.client.RoleBindings().Create(ctx, rb)
+		if err != nil {
+			return nil, fmt.Errorf("create role-binding: %w", err)
+		}
+		return created, nil
+	}
+	return p, nil
+}
+
+func (t *target) planScheduledSession(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	if doc.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	projectID, err := t.project(ctx)
+	if err != nil {
+		return nil, err
+	}
+	agentID := ""
+	if doc.Agent != "" {
+		agent, err := t.client.Agents().GetInProject(ctx, projectID, doc.Agent)
+		if err != nil {
+			return nil, fmt.Errorf("resolve agent %q: %w", doc.Agent, err)
+		}
+		agentID = agent.ID
+	}
+	desired := scheduledSessionFields(doc, agentID)
+	existing, err := findByName(doc.Name, func(opts *sdktypes.ListOptions) ([]sdktypes.ScheduledSession, error) {
+		list, err := t.client.ScheduledSessions().ListByProject(ctx, projectID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(s sdktypes.ScheduledSession) string { return s.Name })
+	if err != nil {
+		return nil, fmt.Errorf("look up scheduled session: %w", err)
+	}
+
+	var live map[string]any
+	id := ""
+	if existing != nil {
+		id, live = existing.ID, liveFields(existing)
+	}
+	p := newPlan(manifest.KindScheduledSession, doc.Name, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var ss sdktypes.ScheduledSession
+		if err := decodeInto(desired, &ss); err != nil {
+			return nil, err
+		}
+		ss.ProjectID = projectID
+		return t.client.ScheduledSessions().CreateInProject(ctx, projectID, &ss)
+	}
+	p.update = func(ctx context.Context, id string, patch map[string]any) error {
+		_, err := t.client.ScheduledSessions().UpdateInProject(ctx, projectID, id, patch)
+		return err
+	}
+	return p, nil
+}
+
+// planProjectSettings plans the settings of the target project. A project
+// has at most one settings object, so the document needs no name.
+func (t *target) planProjectSettings(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	projectID, err := t.project(ctx)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := projectSettingsFields(doc)
+	if err != nil {
+		return nil, err
+	}
+	opts := sdktypes.NewListOptions().Size(1).Search(fmt.Sprintf("project_id = '%s'", projectID)).Build()
+	list, err := t.client.ProjectSettings().List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("look up project settings: %w", err)
+	}
+
+	var live map[string]any
+	id := ""
+	if len(list.Items) > 0 {
+		id, live = list.Items[0].ID, liveFields(list.Items[0])
+	}
+	p := newPlan(manifest.KindProjectSettings, t.projectName, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var settings sdktypes.ProjectSettings
+		if err := decodeInto(desired, &settings); err != nil {
+			return nil, err
+		}
+		settings.ProjectID = projectID
+		return t.client.ProjectSettings().Create(ctx, &settings)
+	}
+	p.update = func(ctx context.Context, id string, patch map[string]any) error {
+		_, err := t.client.ProjectSettings().Update(ctx, id, patch)
+		return err
+	}
+	return p, nil
+}
+
+func (t *target) planApplication(ctx context.Context, doc manifest.Resource) (*plan, error) {
+	if doc.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	credentialID := ""
+	if doc.Credential != "" {
+		id, err := resolveCredentialID(ctx, t.client, doc.Credential)
+		if err != nil {
+			return nil, err
+		}
+		credentialID = id
+	}
+	desired, err := applicationFields(doc, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := findByName(doc.Name, func(opts *sdktypes.ListOptions) ([]sdktypes.Application, error) {
+		list, err := t.client.Applications().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(a sdktypes.Application) string { return a.Name })
+	if err != nil {
+		return nil, fmt.Errorf("look up application: %w", err)
+	}
+
+	var live map[string]any
+	id := ""
+	if existing != nil {
+		id, live = existing.ID, liveFields(existing)
+	}
+	p := newPlan(manifest.KindApplication, doc.Name, id, live, desired)
+	p.create = func(ctx context.Context) (any, error) {
+		var app sdktypes.Application
+		if err := decodeInto(desired, &app); err != nil {
+			return nil, err
+		}
+		return t.client.Applications().Create(ctx, &app)
+	}
+	p.update = func(ctx context.Context, id string, patch map[string]any) error {
+		_, err := t.client.Applications().Update(ctx, id, patch)
+		return err
+	}
+	return p, nil
+}
+
+// findByName searches for an object by name and returns the exact match,
+// or nil when there is none. More than one match is an error.
+func findByName[T any](name string, list func(*sdktypes.ListOptions) ([]T, error), nameOf func(T) string) (*T, error) {
+	items, err := list(sdktypes.NewListOptions().Size(100).Search(fmt.Sprintf("name = '%s'", name)).Build())
+	if err != nil {
+		return nil, err
+	}
+	var found *T
+	for i := range items {
+		if nameOf(items[i]) != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one object named %q", name)
+		}
+		found = &items[i]
+	}
+	return found, nil
+}
+
+// decodeInto fills an SDK create request from desired API fields.
+func decodeInto(fields map[string]any, out any) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func isNotFound(err error) bool {
+	var apiErr *sdktypes.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 404
+}
@@ -0,0 +1,201 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+	sdktypes "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+// selector is a label selector of comma-separated terms: key=value (or
+// key==value), key!=value, key (label present) and !key (label absent).
+type selector []selectorTerm
+
+type selectorTerm struct {
+	key   string
+	value string
+	op    string // "=", "!=", "exists" or "!exists"
+}
+
+func parseSelector(s string) (selector, error) {
+	var sel selector
+	for _, raw := range strings.Split(s, ",") {
+		term := strings.TrimSpace(raw)
+		if term == "" {
+			continue
+		}
+		var t selectorTerm
+		switch {
+		case strings.Contains(term, "!="):
+			k, v, _ := strings.Cut(term, "!=")
+			t = selectorTerm{key: strings.TrimSpace(k), value: strings.TrimSpace(v), op: "!="}
+		case strings.Contains(term, "=="):
+			k, v, _ := strings.Cut(term, "==")
+			t = selectorTerm{key: strings.TrimSpace(k), value: strings.TrimSpace(v), op: "="}
+		case strings.Contains(term, "="):
+			k, v, _ := strings.Cut(term, "=")
+			t = selectorTerm{key: strings.TrimSpace(k), value: strings.TrimSpace(v), op: "="}
+		case strings.HasPrefix(term, "!"):
+			t = selectorTerm{key: strings.TrimSpace(term[1:]), op: "!exists"}
+		default:
+			t = selectorTerm{key: term, op: "exists"}
+		}
+		if t.key == "" {
+			return nil, fmt.Errorf("invalid selector term %q", term)
+		}
+		sel = append(sel, t)
+	}
+	if len(sel) == 0 {
+		return nil, fmt.Errorf("selector is empty")
+	}
+	return sel, nil
+}
+
+func (s selector) matches(labels map[string]string) bool {
+	for _, t := range s {
+		v, ok := labels[t.key]
+		switch t.op {
+		case "=":
+			if !ok || v != t.value {
+				return false
+			}
+		case "!=":
+			if ok && v == t.value {
+				return false
+			}
+		case "exists":
+			if !ok {
+				return false
+			}
+		case "!exists":
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// pruneItem is a managed object that is no longer in the manifests.
+type pruneItem struct {
+	Kind   string
+	Name   string
+	delete func(ctx context.Context) error
+}
+
+// managed reports whether an object was created or updated by apply, and
+// whether its labels match sel.
+func managed(labels, annotations string, sel selector) bool {
+	ann := parseAnnotations(annotations)
+	if _, ok := ann[lastAppliedAnnotation]; !ok {
+		return false
+	}
+	var lbl map[string]string
+	if labels != "" {
+		if err := json.Unmarshal([]byte(labels), &lbl); err != nil {
+			return false
+		}
+	}
+	return sel.matches(lbl)
+}
+
+// appliedTo reports whether apply last wrote the object while targeting
+// project. Objects applied before the annotation existed do not match.
+func appliedTo(annotations, project string) bool {
+	v, ok := parseAnnotations(annotations)[appliedProjectAnnotation].(string)
+	return ok && project != "" && v == project
+}
+
+func parseAnnotations(raw string) map[string]any {
+	var ann map[string]any
+	if err := json.Unmarshal([]byte(raw), &ann); err != nil {
+		return nil
+	}
+	return ann
+}
+
+// pruneCandidates finds the objects apply manages that match sel but are
+// not among applied (keys from manifest.Resource.Key). Every candidate
+// belongs to the target project: agents are listed in it, applications must
+// deploy to it, and credentials, which have no project, must have been
+// applied while targeting it. Projects are never pruned, and kinds without
+// labels (ScheduledSession, ProjectSettings, RoleBinding) cannot be selected.
+func (t *target) pruneCandidates(ctx context.Context, sel selector, applied map[string]bool) ([]pruneItem, error) {
+	var items []pruneItem
+	add := func(kind, name string, del func(ctx context.Context) error) {
+		if applied[strings.ToLower(kind)+"/"+name] {
+			return
+		}
+		items = append(items, pruneItem{Kind: kind, Name: name, delete: del})
+	}
+
+	projectID, err := t.project(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for page := 1; ; page++ {
+		list, err := t.client.Agents().ListByProject(ctx, projectID, sdktypes.NewListOptions().Page(page).Size(100).Build())
+		if err != nil {
+			return nil, fmt.Errorf("list agents: %w", err)
+		}
+		for _, a := range list.Items {
+			if !managed(a.Labels, a.Annotations, sel) {
+				continue
+			}
+			id := a.ID
+			add(manifest.KindAgent, a.Name, func(ctx context.Context) error {
+				return t.client.Agents().DeleteInProject(ctx, projectID, id)
+			})
+		}
+		if len(list.Items) == 0 || page*100 >= list.Total {
+			break
+		}
+	}
+
+	creds := t.client.Credentials().ListAll(ctx, sdktypes.NewListOptions().Size(100).Build())
+	for creds.Next() {
+		c := creds.Item()
+		if !managed(c.Labels, c.Annotations, sel) || !appliedTo(c.Annotations, t.projectName) {
+			continue
+		}
+		id := c.ID
+		add(manifest.KindCredential, c.Name, func(ctx context.Context) error {
+			return t.client.Credentials().Delete(ctx, id)
+		})
+	}
+	if err := creds.Err(); err != nil {
+		return nil, fmt.Errorf("list credentials: %w", err)
+	}
+
+	apps := t.client.Applications().ListAll(ctx, sdktypes.NewListOptions().Size(100).Build())
+	for apps.Next() {
+		a := apps.Item()
+		if a.DestinationProject != t.projectName || !managed(a.Labels, a.Annotations, sel) {
+			continue
+		}
+		id := a.ID
+		add(manifest.KindApplication, a.Name, func(ctx context.Context) error {
+			return t.client.Applications().Delete(ctx, id)
+		})
+	}
+	if err := apps.Err(); err != nil {
+		return nil, fmt.Errorf("list applications: %w", err)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return kindRank(items[i].Kind) < kindRank(items[j].Kind)
+	})
+	return items, nil
+}
+
+func appliedKeys(docs []manifest.Resource) map[string]bool {
+	keys := make(map[string]bool, len(docs))
+	for _, d := range docs {
+		keys[d.Key()] = true
+	}
+	return keys
+}
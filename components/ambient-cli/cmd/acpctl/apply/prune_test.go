@@ -0,0 +1,237 @@
+package apply
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ambient-code/platform/components/ambient-cli/internal/testhelper"
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+	sdktypes "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    selector
+		wantErr bool
+	}{
+		{in: "team=platform", want: selector{{key: "team", value: "platform", op: "="}}},
+		{in: "team==platform", want: selector{{key: "team", value: "platform", op: "="}}},
+		{in: "env!=dev", want: selector{{key: "env", value: "dev", op: "!="}}},
+		{in: "team", want: selector{{key: "team", op: "exists"}}},
+		{in: "!legacy", want: selector{{key: "legacy", op: "!exists"}}},
+		{in: " team = platform , env!=dev ,", want: selector{
+			{key: "team", value: "platform", op: "="},
+			{key: "env", value: "dev", op: "!="},
+		}},
+		{in: "", wantErr: true},
+		{in: " , ", wantErr: true},
+		{in: "=platform", wantErr: true},
+		{in: "!", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSelector(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("term %d: got %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	tests := []struct {
+		selector string
+		labels   map[string]string
+		want     bool
+	}{
+		{"team=platform", map[string]string{"team": "platform"}, true},
+		{"team=platform", map[string]string{"team": "data"}, false},
+		{"team=platform", nil, false},
+		{"env!=dev", map[string]string{"env": "prod"}, true},
+		{"env!=dev", nil, true},
+		{"env!=dev", map[string]string{"env": "dev"}, false},
+		{"team", map[string]string{"team": ""}, true},
+		{"team", nil, false},
+		{"!legacy", nil, true},
+		{"!legacy", map[string]string{"legacy": "true"}, false},
+		{"team=platform,env!=dev", map[string]string{"team": "platform", "env": "prod"}, true},
+		{"team=platform,env!=dev", map[string]string{"team": "platform", "env": "dev"}, false},
+	}
+	for _, tt := range tests {
+		sel, err := parseSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("parseSelector(%q): %v", tt.selector, err)
+		}
+		if got := sel.matches(tt.labels); got != tt.want {
+			t.Errorf("%q matches %v = %v, want %v", tt.selector, tt.labels, got, tt.want)
+		}
+	}
+}
+
+func TestManaged(t *testing.T) {
+	sel, err := parseSelector("team=platform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const applied = `{"ambient.io/last-applied-configuration":"{}"}`
+	tests := []struct {
+		name        string
+		labels      string
+		annotations string
+		want        bool
+	}{
+		{"applied and matching", `{"team":"platform"}`, applied, true},
+		{"selector mismatch", `{"team":"data"}`, applied, false},
+		{"no labels", "", applied, false},
+		{"invalid labels", "not json", applied, false},
+		{"not applied", `{"team":"platform"}`, `{"owner":"someone"}`, false},
+		{"no annotations", `{"team":"platform"}`, "", false},
+		{"invalid annotations", `{"team":"platform"}`, "not json", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := managed(tt.labels, tt.annotations, sel); got != tt.want {
+				t.Errorf("managed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithLastApplied_RecordsProject(t *testing.T) {
+	doc := withLastApplied(manifest.Resource{
+		Kind:        manifest.KindCredential,
+		Name:        "gh",
+		Token:       "secret",
+		Annotations: map[string]string{appliedProjectAnnotation: "stale", "owner": "me"},
+	}, "alpha")
+
+	if got := doc.Annotations[appliedProjectAnnotation]; got != "alpha" {
+		t.Errorf("applied project = %q, want alpha", got)
+	}
+	recorded := doc.Annotations[lastAppliedAnnotation]
+	for _, leaked := range []string{"secret", "stale", appliedProjectAnnotation} {
+		if strings.Contains(recorded, leaked) {
+			t.Errorf("last-applied %s should not contain %q", recorded, leaked)
+		}
+	}
+}
+
+func TestPruneCandidates_StaysInTargetProject(t *testing.T) {
+	srv := testhelper.NewServer(t)
+
+	const (
+		lastApplied = `"ambient.io/last-applied-configuration":"{}"`
+		platform    = `{"team":"platform"}`
+		inAlpha     = `{` + lastApplied + `,"ambient.io/applied-project":"alpha"}`
+		inBeta      = `{` + lastApplied + `,"ambient.io/applied-project":"beta"}`
+		unscoped    = `{` + lastApplied + `}`
+		notByApply  = `{"owner":"someone"}`
+		alphaID     = "proj-alpha"
+	)
+	page := func(r *http.Request) bool {
+		p := r.URL.Query().Get("page")
+		return p == "" || p == "1"
+	}
+
+	srv.Handle("/api/ambient/v1/projects/alpha", func(w http.ResponseWriter, r *http.Request) {
+		srv.RespondJSON(t, w, http.StatusOK, sdktypes.Project{ObjectReference: sdktypes.ObjectReference{ID: alphaID}, Name: "alpha"})
+	})
+	srv.Handle("/api/ambient/v1/projects/"+alphaID+"/agents", func(w http.ResponseWriter, r *http.Request) {
+		items := []sdktypes.Agent{
+			{ObjectReference: sdktypes.ObjectReference{ID: "a1"}, Name: "kept", Labels: platform, Annotations: unscoped},
+			{ObjectReference: sdktypes.ObjectReference{ID: "a2"}, Name: "stale-agent", Labels: platform, Annotations: unscoped},
+			{ObjectReference: sdktypes.ObjectReference{ID: "a3"}, Name: "other-team", Labels: `{"team":"data"}`, Annotations: unscoped},
+			{ObjectReference: sdktypes.ObjectReference{ID: "a4"}, Name: "hand-made", Labels: platform, Annotations: notByApply},
+		}
+		srv.RespondJSON(t, w, http.StatusOK, sdktypes.AgentList{ListMeta: sdktypes.ListMeta{Total: len(items)}, Items: items})
+	})
+	srv.Handle("/api/ambient/v1/credentials", func(w http.ResponseWriter, r *http.Request) {
+		var items []sdktypes.Credential
+		if page(r) {
+			items = []sdktypes.Credential{
+				{ObjectReference: sdktypes.ObjectReference{ID: "c1"}, Name: "stale-cred", Labels: platform, Annotations: inAlpha},
+				{ObjectReference: sdktypes.ObjectReference{ID: "c2"}, Name: "beta-cred", Labels: platform, Annotations: inBeta},
+				{ObjectReference: sdktypes.ObjectReference{ID: "c3"}, Name: "legacy-cred", Labels: platform, Annotations: unscoped},
+			}
+		}
+		srv.RespondJSON(t, w, http.StatusOK, sdktypes.CredentialList{ListMeta: sdktypes.ListMeta{Total: 3}, Items: items})
+	})
+	srv.Handle("/api/ambient/v1/applications", func(w http.ResponseWriter, r *http.Request) {
+		var items []sdktypes.Application
+		if page(r) {
+			items = []sdktypes.Application{
+				{ObjectReference: sdktypes.ObjectReference{ID: "p1"}, Name: "stale-app", DestinationProject: "alpha", Labels: platform, Annotations: unscoped},
+				{ObjectReference: sdktypes.ObjectReference{ID: "p2"}, Name: "beta-app", DestinationProject: "beta", Labels: platform, Annotations: unscoped},
+			}
+		}
+		srv.RespondJSON(t, w, http.StatusOK, sdktypes.ApplicationList{ListMeta: sdktypes.ListMeta{Total: 2}, Items: items})
+	})
+
+	client, err := sdkclient.NewClient(srv.URL, testhelper.TestToken, "alpha")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	tgt := &target{client: client, projectName: "alpha"}
+	sel, err := parseSelector("team=platform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied := map[string]bool{
+		manifest.Resource{Kind: manifest.KindAgent, Name: "kept"}.Key(): true,
+	}
+
+	items, err := tgt.pruneCandidates(context.Background(), sel, applied)
+	if err != nil {
+		t.Fatalf("pruneCandidates: %v", err)
+	}
+	var got []string
+	for _, item := range items {
+		got = append(got, item.Kind+"/"+item.Name)
+	}
+	sort.Strings(got)
+	want := []string{
+		manifest.KindAgent + "/stale-agent",
+		manifest.KindApplication + "/stale-app",
+		manifest.KindCredential + "/stale-cred",
+	}
+	sort.Strings(want)
+	if len(got) != len(want) {
+		t.Fatalf("candidates = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("candidates = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPruneCandidates_RequiresProject(t *testing.T) {
+	srv := testhelper.NewServer(t)
+	client, err := sdkclient.NewClient(srv.URL, testhelper.TestToken, "alpha")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	sel, _ := parseSelector("team=platform")
+	if _, err := (&target{client: client}).pruneCandidates(context.Background(), sel, nil); err == nil {
+		t.Fatal("expected an error without a target project")
+	}
+}
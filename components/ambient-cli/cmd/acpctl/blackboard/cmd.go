@@ -0,0 +1,454 @@
+// Package blackboard implements the blackboard subcommand for reading and
+// writing a project's shared key/value entries.
+package blackboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-cli/pkg/config"
+	"github.com/ambient-code/platform/components/ambient-cli/pkg/connection"
+	"github.com/ambient-code/platform/components/ambient-cli/pkg/output"
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	sdktypes "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "blackboard",
+	Short: "Read and write a project's blackboard",
+	Long: `Read and write a project's blackboard, a shared key/value store agents use
+to coordinate. Every write bumps the entry's version; pass --expected-version
+to make a write or delete conditional on it.
+
+Subcommands:
+  list    List entries, optionally filtered by key prefix
+  get     Get one entry
+  set     Create or replace an entry
+  delete  Delete an entry
+  watch   Stream changes as they happen`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(getCmd)
+	Cmd.AddCommand(setCmd)
+	Cmd.AddCommand(deleteCmd)
+	Cmd.AddCommand(watchCmd)
+
+	listCmd.Flags().StringVar(&listArgs.projectID, "project-id", "", "Project ID (defaults to current project)")
+	listCmd.Flags().StringVar(&listArgs.prefix, "prefix", "", "Only list keys with this prefix")
+	listCmd.Flags().StringVarP(&listArgs.outputFormat, "output", "o", "", "Output format: json|wide")
+
+	getCmd.Flags().StringVar(&getArgs.projectID, "project-id", "", "Project ID (defaults to current project)")
+	getCmd.Flags().StringVarP(&getArgs.outputFormat, "output", "o", "", "Output format: json|wide")
+
+	setCmd.Flags().StringVar(&setArgs.projectID, "project-id", "", "Project ID (defaults to current project)")
+	setCmd.Flags().IntVar(&setArgs.expectedVersion, "expected-version", -1, "Only write if the entry is at this version; 0 requires the key to be absent")
+	setCmd.Flags().DurationVar(&setArgs.ttl, "ttl", 0, "Expire the entry after this duration (e.g. 10m)")
+	setCmd.Flags().StringVarP(&setArgs.outputFormat, "output", "o", "", "Output format: json")
+
+	deleteCmd.Flags().StringVar(&deleteArgs.projectID, "project-id", "", "Project ID (defaults to current project)")
+	deleteCmd.Flags().IntVar(&deleteArgs.expectedVersion, "expected-version", -1, "Only delete if the entry is at this version")
+
+	watchCmd.Flags().StringVar(&watchArgs.projectID, "project-id", "", "Project ID (defaults to current project)")
+	watchCmd.Flags().StringVar(&watchArgs.prefix, "prefix", "", "Only watch keys with this prefix")
+	watchCmd.Flags().BoolVar(&watchArgs.replay, "replay", false, "Print current entries before streaming changes")
+	watchCmd.Flags().StringVarP(&watchArgs.outputFormat, "output", "o", "", "Output format: json")
+}
+
+func resolveProject(projectID string) (string, error) {
+	if projectID != "" {
+		return projectID, nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return "", err
+	}
+	p := cfg.GetProject()
+	if p == "" {
+		return "", fmt.Errorf("no project set; use --project-id or run 'acpctl config set project <name>'")
+	}
+	return p, nil
+}
+
+func requestContext() (context.Context, context.CancelFunc, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.GetRequestTimeout())
+	return ctx, cancel, nil
+}
+
+// ---------------------------------------------------------------------------
+// list
+// ---------------------------------------------------------------------------
+
+var listArgs struct {
+	projectID    string
+	prefix       string
+	outputFormat string
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List blackboard entries",
+	Example: `  acpctl blackboard list
+  acpctl blackboard list --prefix plan. -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, err := resolveProject(listArgs.projectID)
+		if err != nil {
+			return err
+		}
+
+		client, err := connection.NewClientFromConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel, err := requestContext()
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		list, err := client.BlackboardEntries().ListByProject(ctx, projectID, listArgs.prefix)
+		if err != nil {
+			return fmt.Errorf("list blackboard entries: %w", err)
+		}
+
+		format, err := output.ParseFormat(listArgs.outputFormat)
+		if err != nil {
+			return err
+		}
+		printer := output.NewPrinter(format, cmd.OutOrStdout())
+
+		if printer.Format() == output.FormatJSON {
+			return printer.PrintJSON(list)
+		}
+		return printTable(printer, list.Items)
+	},
+}
+
+// ---------------------------------------------------------------------------
+// get
+// ---------------------------------------------------------------------------
+
+var getArgs struct {
+	projectID    string
+	outputFormat string
+}
+
+var getCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Get a blackboard entry",
+	Args:  cobra.ExactArgs(1),
+	Example: `  acpctl blackboard get plan.owner
+  acpctl blackboard get plan.owner -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, err := resolveProject(getArgs.projectID)
+		if err != nil {
+			return err
+		}
+
+		client, err := connection.NewClientFromConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel, err := requestContext()
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		entry, err := client.BlackboardEntries().GetByProject(ctx, projectID, args[0])
+		if err != nil {
+			return fmt.Errorf("get blackboard entry %q: %w", args[0], err)
+		}
+
+		format, err := output.ParseFormat(getArgs.outputFormat)
+		if err != nil {
+			return err
+		}
+		printer := output.NewPrinter(format, cmd.OutOrStdout())
+
+		switch printer.Format() {
+		case output.FormatJSON:
+			return printer.PrintJSON(entry)
+		case output.FormatWide:
+			return printTable(printer, []sdktypes.BlackboardEntry{*entry})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), entry.Value)
+		return nil
+	},
+}
+
+// ---------------------------------------------------------------------------
+// set
+// ---------------------------------------------------------------------------
+
+var setArgs struct {
+	projectID       string
+	expectedVersion int
+	ttl             time.Duration
+	outputFormat    string
+}
+
+var setCmd = &cobra.Command{
+	Use:   "set <key> <value|->",
+	Short: "Create or replace a blackboard entry",
+	Long: `Create or replace a blackboard entry. Pass "-" as the value to read it from
+stdin.`,
+	Args: cobra.ExactArgs(2),
+	Example: `  acpctl blackboard set plan.owner agent-alpha
+  acpctl blackboard set lock:deploy agent-alpha --expected-version 0 --ttl 10m
+  acpctl blackboard set plan.owner agent-beta --expected-version 3
+  cat plan.json | acpctl blackboard set plan.doc -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, err := resolveProject(setArgs.projectID)
+		if err != nil {
+			return err
+		}
+		if setArgs.ttl < 0 {
+			return fmt.Errorf("--ttl must not be negative")
+		}
+
+		value := args[1]
+		if value == "-" {
+			data, err := io.ReadAll(cmd.InOrStdin())
+			if err != nil {
+				return fmt.Errorf("read value from stdin: %w", err)
+			}
+			value = string(data)
+		}
+
+		opts := &sdktypes.BlackboardWriteOptions{
+			TTLSeconds: int(setArgs.ttl.Round(time.Second) / time.Second),
+		}
+		if setArgs.ttl > 0 && opts.TTLSeconds == 0 {
+			opts.TTLSeconds = 1
+		}
+		if cmd.Flags().Changed("expected-version") {
+			if setArgs.expectedVersion < 0 {
+				return fmt.Errorf("--expected-version must not be negative")
+			}
+			opts.ExpectedVersion = &setArgs.expectedVersion
+		}
+
+		client, err := connection.NewClientFromConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel, err := requestContext()
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		entry, err := client.BlackboardEntries().Put(ctx, projectID, args[0], value, opts)
+		if err != nil {
+			return fmt.Errorf("set blackboard entry %q: %w", args[0], err)
+		}
+
+		format, err := output.ParseFormat(setArgs.outputFormat)
+		if err != nil {
+			return err
+		}
+		printer := output.NewPrinter(format, cmd.OutOrStdout())
+
+		if printer.Format() == output.FormatJSON {
+			return printer.PrintJSON(entry)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "blackboard/%s set (version %d)\n", entry.Key, entry.Version)
+		return nil
+	},
+}
+
+// ---------------------------------------------------------------------------
+// delete
+// ---------------------------------------------------------------------------
+
+var deleteArgs struct {
+	projectID       string
+	expectedVersion int
+}
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <key>",
+	Short: "Delete a blackboard entry",
+	Args:  cobra.ExactArgs(1),
+	Example: `  acpctl blackboard delete plan.owner
+  acpctl blackboard delete lock:deploy --expected-version 4`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, err := resolveProject(deleteArgs.projectID)
+		if err != nil {
+			return err
+		}
+
+		var expected *int
+		if cmd.Flags().Changed("expected-version") {
+			if deleteArgs.expectedVersion < 1 {
+				return fmt.Errorf("--expected-version must be at least 1")
+			}
+			expected = &deleteArgs.expectedVersion
+		}
+
+		client, err := connection.NewClientFromConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel, err := requestContext()
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		if err := client.BlackboardEntries().DeleteInProject(ctx, projectID, args[0], expected); err != nil {
+			return fmt.Errorf("delete blackboard entry %q: %w", args[0], err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "blackboard/%s deleted\n", args[0])
+		return nil
+	},
+}
+
+// ---------------------------------------------------------------------------
+// watch
+// ---------------------------------------------------------------------------
+
+var watchArgs struct {
+	projectID    string
+	prefix       string
+	replay       bool
+	outputFormat string
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream blackboard changes",
+	Example: `  acpctl blackboard watch
+  acpctl blackboard watch --prefix lock: --replay -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID, err := resolveProject(watchArgs.projectID)
+		if err != nil {
+			return err
+		}
+
+		format, err := output.ParseFormat(watchArgs.outputFormat)
+		if err != nil {
+			return err
+		}
+		printer := output.NewPrinter(format, cmd.OutOrStdout())
+
+		client, err := connection.NewClientFromConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer cancel()
+
+		watcher, err := client.BlackboardEntries().Watch(ctx, projectID, &sdkclient.BlackboardWatchOptions{
+			KeyPrefix: watchArgs.prefix,
+			Replay:    watchArgs.replay,
+		})
+		if err != nil {
+			return fmt.Errorf("watch blackboard: %w", err)
+		}
+		defer watcher.Stop()
+
+		var table *output.Table
+		if printer.Format() != output.FormatJSON {
+			table = output.NewTable(printer.Writer(), []output.Column{
+				{Name: "EVENT", Width: 8},
+				{Name: "KEY", Width: 32},
+				{Name: "VERSION", Width: 8},
+				{Name: "VALUE", Width: 50},
+			})
+			table.WriteHeaders()
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-watcher.Done():
+				return nil
+			case err := <-watcher.Errors():
+				if err != nil {
+					return err
+				}
+			case event := <-watcher.Events():
+				if event == nil {
+					continue
+				}
+				if table == nil {
+					if err := printer.PrintJSON(event); err != nil {
+						return err
+					}
+					continue
+				}
+				version, value := "", ""
+				if event.Entry != nil {
+					version = fmt.Sprintf("%d", event.Entry.Version)
+					value = truncate(event.Entry.Value, 48)
+				}
+				table.WriteRow(event.Type, event.Key, version, value)
+			}
+		}
+	},
+}
+
+func printTable(printer *output.Printer, entries []sdktypes.BlackboardEntry) error {
+	wide := printer.Format() == output.FormatWide
+	columns := []output.Column{
+		{Name: "KEY", Width: 32},
+		{Name: "VERSION", Width: 8},
+		{Name: "VALUE", Width: 50},
+		{Name: "AGE", Width: 10},
+	}
+	if wide {
+		columns = append(columns,
+			output.Column{Name: "UPDATED BY", Width: 24},
+			output.Column{Name: "EXPIRES", Width: 10},
+		)
+	}
+
+	table := output.NewTable(printer.Writer(), columns)
+	table.WriteHeaders()
+
+	for _, e := range entries {
+		age := ""
+		if e.UpdatedAt != nil {
+			age = output.FormatAge(time.Since(*e.UpdatedAt))
+		}
+		row := []string{e.Key, fmt.Sprintf("%d", e.Version), truncate(e.Value, 48), age}
+		if wide {
+			expires := "never"
+			if e.ExpiresAt != nil {
+				expires = output.FormatAge(time.Until(*e.ExpiresAt))
+			}
+			row = append(row, e.UpdatedBy, expires)
+		}
+		table.WriteRow(row...)
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n-3] + "..."
+	}
+	return s
+}
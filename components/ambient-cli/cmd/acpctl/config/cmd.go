@@ -1,7 +1,8 @@
-// Package config implements the config get and config set subcommands.
+// Package config implements the config subcommands for values and contexts.
 package config
 
 import (
+	configcontexts "github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/config/contexts"
 	configget "github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/config/get"
 	configset "github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/config/set"
 	"github.com/spf13/cobra"
@@ -10,10 +11,17 @@ import (
 var Cmd = &cobra.Command{
 	Use:   "config",
 	Short: "Manage CLI configuration",
-	Long:  "Get and set configuration values for the Ambient CLI.",
+	Long: `Get and set configuration values for the Ambient CLI, and manage named
+contexts. A context holds a server, its credentials and a default project;
+server, project and token values apply to the current context, or to the
+context named by --context.`,
 }
 
 func init() {
 	Cmd.AddCommand(configget.Cmd)
 	Cmd.AddCommand(configset.Cmd)
+	Cmd.AddCommand(configcontexts.GetContextsCmd)
+	Cmd.AddCommand(configcontexts.UseContextCmd)
+	Cmd.AddCommand(configcontexts.SetContextCmd)
+	Cmd.AddCommand(configcontexts.DeleteContextCmd)
 }
@@ -0,0 +1,158 @@
+// Package contexts implements the config subcommands that manage named contexts.
+package contexts
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/ambient-code/platform/components/ambient-cli/pkg/config"
+	"github.com/ambient-code/platform/components/ambient-cli/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+var GetContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List config contexts",
+	Long:  "List the contexts in the configuration file. The current context is marked with '*'.",
+	Args:  cobra.NoArgs,
+	RunE:  getContexts,
+}
+
+var UseContextCmd = &cobra.Command{
+	Use:   "use-context <name>",
+	Short: "Set the current context",
+	Args:  cobra.ExactArgs(1),
+	RunE:  useContext,
+}
+
+var setArgs struct {
+	server  string
+	project string
+}
+
+var SetContextCmd = &cobra.Command{
+	Use:   "set-context <name>",
+	Short: "Create or modify a context",
+	Long: `Create a context, or modify the server or project of an existing one.
+Credentials are not set here; run 'acpctl --context <name> login' afterwards.`,
+	Args: cobra.ExactArgs(1),
+	RunE: setContext,
+}
+
+var DeleteContextCmd = &cobra.Command{
+	Use:   "delete-context <name>",
+	Short: "Delete a context and its saved credentials",
+	Args:  cobra.ExactArgs(1),
+	RunE:  deleteContext,
+}
+
+func init() {
+	SetContextCmd.Flags().StringVar(&setArgs.server, "server", "", "API server URL")
+	SetContextCmd.Flags().StringVar(&setArgs.project, "project", "", "Default project name")
+}
+
+func getContexts(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	names := cfg.ContextNames()
+	if len(names) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No contexts found. Run 'acpctl login' to create one.")
+		return nil
+	}
+
+	table := output.NewTable(cmd.OutOrStdout(), []output.Column{
+		{Name: "CURRENT", Width: 8},
+		{Name: "NAME", Width: 24},
+		{Name: "SERVER", Width: 45},
+		{Name: "PROJECT", Width: 24},
+	})
+	table.WriteHeaders()
+	for _, name := range names {
+		ctx := cfg.Contexts[name]
+		current := ""
+		if name == cfg.CurrentContext {
+			current = "*"
+		}
+		table.WriteRow(current, name, ctx.Server, ctx.Project)
+	}
+	return nil
+}
+
+func useContext(cmd *cobra.Command, cmdArgs []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	name := cmdArgs[0]
+	if err := cfg.UseContext(name); err != nil {
+		return err
+	}
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q.\n", name)
+	return nil
+}
+
+func setContext(cmd *cobra.Command, cmdArgs []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	name := cmdArgs[0]
+	existed := cfg.HasContext(name)
+	if !existed && setArgs.server == "" {
+		return fmt.Errorf("--server is required to create context %q", name)
+	}
+
+	cfg.Select(name)
+	if setArgs.server != "" {
+		parsed, err := url.Parse(setArgs.server)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("invalid URL %q: must include scheme and host (e.g. https://api.example.com)", setArgs.server)
+		}
+		cfg.APIUrl = setArgs.server
+	}
+	if setArgs.project != "" {
+		cfg.Project = setArgs.project
+	}
+
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	if existed {
+		fmt.Fprintf(cmd.OutOrStdout(), "Context %q modified.\n", name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Context %q created.\n", name)
+	}
+	return nil
+}
+
+func deleteContext(cmd *cobra.Command, cmdArgs []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	name := cmdArgs[0]
+	wasCurrent := name == cfg.CurrentContext
+	if err := cfg.DeleteContext(name); err != nil {
+		return err
+	}
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Deleted context %q.\n", name)
+	if wasCurrent {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the current context was deleted; run 'acpctl config use-context <name>' to select another.")
+	}
+	return nil
+}
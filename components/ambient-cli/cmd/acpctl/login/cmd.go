@@ -4,6 +4,7 @@ package login
 import (
 	"fmt"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/ambient-code/platform/components/ambient-cli/pkg/config"
@@ -28,6 +29,10 @@ var Cmd = &cobra.Command{
 	Long: `Log in to the Ambient API server by providing an access token or using
 the browser-based OAuth2 authorization code flow against Red Hat SSO.
 
+Credentials are saved to a context named after the server's host, or to
+the context named by the global --context flag, and that context becomes
+current. Logging in to another server leaves existing contexts untouched.
+
 To log in with a static token:
   acpctl login --token <token> --url https://api.example.com
 
@@ -85,6 +90,14 @@ func run(cmd *cobra.Command, positional []string) error {
 		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
 			return fmt.Errorf("invalid URL %q: must be a valid URL with scheme and host (e.g. https://api.example.com)", serverURL)
 		}
+	}
+
+	name := loginContextName(cfg, serverURL)
+	if name != cfg.ContextName() {
+		cfg.Select(name)
+	}
+	cfg.CurrentContext = name
+	if serverURL != "" {
 		cfg.APIUrl = serverURL
 	}
 
@@ -132,9 +145,9 @@ func run(cmd *cobra.Command, positional []string) error {
 
 	location, err := config.Location()
 	if err != nil {
-		fmt.Fprintln(cmd.OutOrStdout(), "Login successful. Configuration saved.")
+		fmt.Fprintf(cmd.OutOrStdout(), "Login successful. Context %q saved.\n", name)
 	} else {
-		fmt.Fprintf(cmd.OutOrStdout(), "Login successful. Configuration saved to %s\n", location)
+		fmt.Fprintf(cmd.OutOrStdout(), "Login successful. Context %q saved to %s\n", name, location)
 	}
 
 	if args.insecureSkipVerify {
@@ -150,3 +163,22 @@ func run(cmd *cobra.Command, positional []string) error {
 	}
 	return nil
 }
+
+// loginContextName picks the context a login writes to. --context names it
+// explicitly. Otherwise logging in to the selected context's server reuses
+// that context, and any other server gets a context named after its host,
+// so logging in to one cluster does not replace the credentials for another.
+func loginContextName(cfg *config.Config, serverURL string) string {
+	if name := os.Getenv(config.ContextEnv); name != "" {
+		return name
+	}
+	if serverURL == "" || serverURL == cfg.APIUrl {
+		if name := cfg.ContextName(); name != "" {
+			return name
+		}
+	}
+	if serverURL == "" {
+		serverURL = cfg.GetAPIUrl()
+	}
+	return config.ContextNameFromURL(serverURL)
+}
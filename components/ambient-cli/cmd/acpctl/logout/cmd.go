@@ -11,7 +11,7 @@ import (
 var Cmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Log out from the Ambient API server",
-	Long:  "Remove saved credentials from the configuration file.",
+	Long:  "Remove the saved access token of the current context, or of the context named by --context.",
 	Args:  cobra.NoArgs,
 	RunE:  run,
 }
@@ -28,6 +28,10 @@ func run(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("save config: %w", err)
 	}
 
+	if name := cfg.ContextName(); name != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "Logged out of context %q.\n", name)
+		return nil
+	}
 	fmt.Fprintln(cmd.OutOrStdout(), "Logged out successfully.")
 	return nil
 }
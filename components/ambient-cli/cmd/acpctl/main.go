@@ -8,6 +8,7 @@ import (
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/ambient"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/application"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/apply"
+	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/blackboard"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/completion"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/config"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/create"
@@ -25,6 +26,7 @@ import (
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/stop"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/version"
 	"github.com/ambient-code/platform/components/ambient-cli/cmd/acpctl/whoami"
+	pkgconfig "github.com/ambient-code/platform/components/ambient-cli/pkg/config"
 	"github.com/ambient-code/platform/components/ambient-cli/pkg/connection"
 	"github.com/ambient-code/platform/components/ambient-cli/pkg/info"
 	"github.com/spf13/cobra"
@@ -33,6 +35,7 @@ import (
 var (
 	insecureSkipTLSVerify bool
 	apiURLOverride        string
+	contextOverride       string
 )
 
 var root = &cobra.Command{
@@ -49,6 +52,9 @@ var root = &cobra.Command{
 		if apiURLOverride != "" {
 			os.Setenv("AMBIENT_API_URL", apiURLOverride)
 		}
+		if contextOverride != "" {
+			os.Setenv(pkgconfig.ContextEnv, contextOverride)
+		}
 		return nil
 	},
 }
@@ -56,6 +62,7 @@ var root = &cobra.Command{
 func init() {
 	root.PersistentFlags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Skip TLS certificate verification (insecure)")
 	root.PersistentFlags().StringVar(&apiURLOverride, "api-url", "", "Override the API server URL for this invocation")
+	root.PersistentFlags().StringVar(&contextOverride, "context", "", "Use the named config context for this invocation")
 	root.AddCommand(login.Cmd)
 	root.AddCommand(logout.Cmd)
 	root.AddCommand(version.Cmd)
@@ -67,6 +74,7 @@ func init() {
 	root.AddCommand(scheduledsession.Cmd)
 	root.AddCommand(credential.Cmd)
 	root.AddCommand(inbox.Cmd)
+	root.AddCommand(blackboard.Cmd)
 	root.AddCommand(get.Cmd)
 	root.AddCommand(create.Cmd)
 	root.AddCommand(delete.Cmd)
@@ -77,6 +85,7 @@ func init() {
 	root.AddCommand(ambient.Cmd)
 	root.AddCommand(application.Cmd)
 	root.AddCommand(apply.Cmd)
+	root.AddCommand(apply.DiffCmd)
 }
 
 func main() {
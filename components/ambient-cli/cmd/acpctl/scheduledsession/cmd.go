@@ -565,7 +565,7 @@ var runsArgs struct {
 
 var runsCmd = &cobra.Command{
 	Use:   "runs <name-or-id>",
-	Short: "List session runs for a scheduled session",
+	Short: "List the firings of a scheduled session, including skipped and failed ones",
 	Args:  cobra.ExactArgs(1),
 	Example: `  acpctl scheduled-session runs my-schedule
   acpctl scheduled-session runs <id> --project-id <id> -o json`,
@@ -695,23 +695,30 @@ func printTable(printer *output.Printer, items []sdktypes.ScheduledSession) erro
 	return nil
 }
 
-func printRunsTable(printer *output.Printer, sessions []sdktypes.Session) error {
+func printRunsTable(printer *output.Printer, runs []sdktypes.ScheduledSessionRun) error {
 	columns := []output.Column{
 		{Name: "ID", Width: 27},
-		{Name: "NAME", Width: 32},
-		{Name: "PHASE", Width: 12},
+		{Name: "TRIGGER", Width: 9},
+		{Name: "STATUS", Width: 8},
+		{Name: "SCHEDULED FOR", Width: 20},
+		{Name: "SESSION", Width: 27},
 		{Name: "AGE", Width: 10},
+		{Name: "ERROR", Width: 40},
 	}
 
 	table := output.NewTable(printer.Writer(), columns)
 	table.WriteHeaders()
 
-	for _, s := range sessions {
+	for _, run := range runs {
+		scheduledFor := ""
+		if run.ScheduledFor != nil {
+			scheduledFor = run.ScheduledFor.Format(time.RFC3339)
+		}
 		age := ""
-		if s.CreatedAt != nil {
-			age = output.FormatAge(time.Since(*s.CreatedAt))
+		if run.FiredAt != nil {
+			age = output.FormatAge(time.Since(*run.FiredAt))
 		}
-		table.WriteRow(s.ID, s.Name, s.Phase, age)
+		table.WriteRow(run.ID, run.Trigger, run.Status, scheduledFor, run.SessionID, age, run.Error)
 	}
 	return nil
 }
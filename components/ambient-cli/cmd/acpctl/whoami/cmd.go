@@ -3,6 +3,7 @@ package whoami
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -44,6 +45,7 @@ func run(cmd *cobra.Command, _ []string) error {
 
 	if strings.HasPrefix(token, "sha256~") {
 		fmt.Fprintf(out, "Token type: OpenShift service account\n")
+		printContext(out, cfg)
 		fmt.Fprintf(out, "API URL:    %s\n", cfg.GetAPIUrl())
 		fmt.Fprintf(out, "Project:    %s\n", cfg.GetProject())
 		return nil
@@ -56,6 +58,7 @@ func run(cmd *cobra.Command, _ []string) error {
 	_, _, err = parser.ParseUnverified(token, claims)
 	if err != nil {
 		fmt.Fprintf(out, "Token type: opaque\n")
+		printContext(out, cfg)
 		fmt.Fprintf(out, "API URL:    %s\n", cfg.GetAPIUrl())
 		fmt.Fprintf(out, "Project:    %s\n", cfg.GetProject())
 		return nil
@@ -71,8 +74,15 @@ func run(cmd *cobra.Command, _ []string) error {
 		expTime := time.Unix(int64(exp), 0)
 		fmt.Fprintf(out, "Expires:    %s\n", expTime.Format(time.RFC3339))
 	}
+	printContext(out, cfg)
 	fmt.Fprintf(out, "API URL:    %s\n", cfg.GetAPIUrl())
 	fmt.Fprintf(out, "Project:    %s\n", cfg.GetProject())
 
 	return nil
 }
+
+func printContext(out io.Writer, cfg *config.Config) {
+	if name := cfg.ContextName(); name != "" {
+		fmt.Fprintf(out, "Context:    %s\n", name)
+	}
+}
@@ -4,12 +4,28 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// ContextEnv names the environment variable that selects a context for a
+// single invocation. The global --context flag sets it.
+const ContextEnv = "AMBIENT_CONTEXT"
+
+const defaultAPIUrl = "http://localhost:8000"
+
+// Config is the CLI configuration. The file holds any number of named
+// contexts; Load selects one and exposes its connection settings through
+// the flat fields, and Save writes them back to that context, so commands
+// read and write the selected context without knowing about the others.
+//
+// The flat fields keep their JSON tags so a legacy single-context file can
+// still be read; it is migrated into a context named after its server.
 type Config struct {
 	APIUrl            string `json:"api_url,omitempty"`
 	AccessToken       string `json:"access_token,omitempty"`
@@ -21,6 +37,33 @@ type Config struct {
 	RequestTimeout    int    `json:"request_timeout,omitempty"`  // Request timeout in seconds
 	PollingInterval   int    `json:"polling_interval,omitempty"` // Watch polling interval in seconds
 	InsecureTLSVerify bool   `json:"insecure_tls_verify,omitempty"`
+
+	CurrentContext string              `json:"current_context,omitempty"`
+	Contexts       map[string]*Context `json:"contexts,omitempty"`
+
+	// context is the name of the context the flat fields belong to.
+	context string
+}
+
+// Context is a server connection with its credentials and default project.
+// The JSON layout is shared with the TUI's multi-context config.
+type Context struct {
+	Server            string `json:"server"`
+	AccessToken       string `json:"access_token,omitempty"`
+	RefreshToken      string `json:"refresh_token,omitempty"`
+	IssuerURL         string `json:"issuer_url,omitempty"`
+	ClientID          string `json:"client_id,omitempty"`
+	Project           string `json:"project,omitempty"`
+	InsecureTLSVerify bool   `json:"insecure_tls_verify,omitempty"`
+}
+
+// fileConfig is the on-disk format written by Save.
+type fileConfig struct {
+	CurrentContext  string              `json:"current_context,omitempty"`
+	Contexts        map[string]*Context `json:"contexts,omitempty"`
+	Pager           string              `json:"pager,omitempty"`
+	RequestTimeout  int                 `json:"request_timeout,omitempty"`
+	PollingInterval int                 `json:"polling_interval,omitempty"`
 }
 
 func Location() (string, error) {
@@ -36,26 +79,56 @@ func Location() (string, error) {
 	return filepath.Join(configDir, "ambient", "config.json"), nil
 }
 
+// Load reads the config file and selects the context named by AMBIENT_CONTEXT,
+// or the current context. A legacy flat file is migrated and rewritten.
 func Load() (*Config, error) {
 	location, err := Location()
 	if err != nil {
 		return nil, err
 	}
 
+	cfg, migrated, err := read(location)
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		if err := write(location, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to migrate config file to contexts: %v\n", err)
+		}
+	}
+
+	name := os.Getenv(ContextEnv)
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name != "" {
+		cfg.Select(name)
+	}
+
+	return cfg, nil
+}
+
+func read(location string) (*Config, bool, error) {
 	data, err := os.ReadFile(location)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &Config{}, nil
+			return &Config{}, false, nil
 		}
-		return nil, fmt.Errorf("read config file %q: %w", location, err)
+		return nil, false, fmt.Errorf("read config file %q: %w", location, err)
 	}
 
 	cfg := &Config{}
 	if err := json.Unmarshal(data, cfg); err != nil {
-		return nil, fmt.Errorf("parse config file %q: %w", location, err)
+		return nil, false, fmt.Errorf("parse config file %q: %w", location, err)
 	}
 
-	return cfg, nil
+	if len(cfg.Contexts) > 0 || !cfg.hasConnection() {
+		return cfg, false, nil
+	}
+	// Legacy flat file: move the connection into a context of its own.
+	cfg.sync()
+	cfg.context = ""
+	return cfg, true, nil
 }
 
 func Save(cfg *Config) error {
@@ -63,13 +136,23 @@ func Save(cfg *Config) error {
 	if err != nil {
 		return err
 	}
+	cfg.sync()
+	return write(location, cfg)
+}
 
+func write(location string, cfg *Config) error {
 	dir := filepath.Dir(location)
 	if err := os.MkdirAll(dir, 0700); err != nil {
 		return fmt.Errorf("create config directory %q: %w", dir, err)
 	}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	data, err := json.MarshalIndent(&fileConfig{
+		CurrentContext:  cfg.CurrentContext,
+		Contexts:        cfg.Contexts,
+		Pager:           cfg.Pager,
+		RequestTimeout:  cfg.RequestTimeout,
+		PollingInterval: cfg.PollingInterval,
+	}, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshal config: %w", err)
 	}
@@ -81,6 +164,134 @@ func Save(cfg *Config) error {
 	return nil
 }
 
+// ContextName returns the name of the selected context, or "" when none is.
+func (c *Config) ContextName() string {
+	return c.context
+}
+
+// HasContext reports whether the file defines the named context.
+func (c *Config) HasContext(name string) bool {
+	_, ok := c.Contexts[name]
+	return ok
+}
+
+// ContextNames returns the names of all contexts, sorted.
+func (c *Config) ContextNames() []string {
+	names := make([]string, 0, len(c.Contexts))
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Select points the connection fields at the named context. A context that
+// does not exist yet starts empty and is created by the next Save. Select
+// does not change the current context.
+func (c *Config) Select(name string) {
+	c.sync()
+	c.context = name
+	ctx, ok := c.Contexts[name]
+	if !ok {
+		ctx = &Context{}
+	}
+	c.APIUrl = ctx.Server
+	c.AccessToken = ctx.AccessToken
+	c.RefreshToken = ctx.RefreshToken
+	c.IssuerURL = ctx.IssuerURL
+	c.ClientID = ctx.ClientID
+	c.Project = ctx.Project
+	c.InsecureTLSVerify = ctx.InsecureTLSVerify
+}
+
+// UseContext makes the named context current and selects it.
+func (c *Config) UseContext(name string) error {
+	if !c.HasContext(name) {
+		return fmt.Errorf("context %q not found", name)
+	}
+	c.Select(name)
+	c.CurrentContext = name
+	return nil
+}
+
+// DeleteContext removes the named context. Deleting the current context
+// leaves no context current.
+func (c *Config) DeleteContext(name string) error {
+	if !c.HasContext(name) {
+		return fmt.Errorf("context %q not found", name)
+	}
+	if c.context == name {
+		c.Select("")
+	}
+	delete(c.Contexts, name)
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return nil
+}
+
+// sync copies the connection fields into the selected context. With no
+// context selected, one named after the server is created and made current
+// if nothing else is.
+func (c *Config) sync() {
+	if c.context == "" {
+		if !c.hasConnection() {
+			return
+		}
+		server := c.APIUrl
+		if server == "" {
+			server = defaultAPIUrl
+		}
+		c.context = ContextNameFromURL(server)
+	}
+	ctx, ok := c.Contexts[c.context]
+	if !ok {
+		if !c.hasConnection() {
+			return
+		}
+		if c.Contexts == nil {
+			c.Contexts = make(map[string]*Context)
+		}
+		ctx = &Context{}
+		c.Contexts[c.context] = ctx
+	}
+	if c.CurrentContext == "" {
+		c.CurrentContext = c.context
+	}
+	ctx.Server = c.APIUrl
+	ctx.AccessToken = c.AccessToken
+	ctx.RefreshToken = c.RefreshToken
+	ctx.IssuerURL = c.IssuerURL
+	ctx.ClientID = c.ClientID
+	ctx.Project = c.Project
+	ctx.InsecureTLSVerify = c.InsecureTLSVerify
+}
+
+// ContextNameFromURL derives a context name from a server URL: "local" for
+// localhost on any port, otherwise the hostname.
+func ContextNameFromURL(serverURL string) string {
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return "default"
+	}
+
+	hostname := parsed.Hostname()
+	if hostname == "" {
+		return "default"
+	}
+
+	if hostname == "localhost" || hostname == "127.0.0.1" || hostname == "::1" {
+		return "local"
+	}
+
+	return strings.TrimPrefix(hostname, "www.")
+}
+
+func (c *Config) hasConnection() bool {
+	return c.APIUrl != "" || c.AccessToken != "" || c.RefreshToken != "" ||
+		c.IssuerURL != "" || c.ClientID != "" || c.Project != "" || c.InsecureTLSVerify
+}
+
 func (c *Config) ClearToken() {
 	c.AccessToken = ""
 }
@@ -92,7 +303,7 @@ func (c *Config) GetAPIUrl() string {
 	if c.APIUrl != "" {
 		return c.APIUrl
 	}
-	return "http://localhost:8000"
+	return defaultAPIUrl
 }
 
 func (c *Config) GetProject() string {
@@ -140,13 +351,37 @@ func (c *Config) GetTokenWithRefresh() (string, error) {
 		c.RefreshToken = newRefresh
 	}
 
-	if saveErr := Save(c); saveErr != nil {
+	if saveErr := c.saveTokens(); saveErr != nil {
 		fmt.Fprintf(os.Stderr, "warning: failed to persist refreshed token: %v\n", saveErr)
 	}
 
 	return c.AccessToken, nil
 }
 
+// saveTokens persists refreshed tokens into the selected context only. The
+// file is re-read first so a long-running command does not overwrite
+// changes made to other contexts since it started.
+func (c *Config) saveTokens() error {
+	if c.context == "" {
+		return Save(c)
+	}
+	location, err := Location()
+	if err != nil {
+		return err
+	}
+	onDisk, _, err := read(location)
+	if err != nil {
+		return err
+	}
+	ctx, ok := onDisk.Contexts[c.context]
+	if !ok {
+		return Save(c)
+	}
+	ctx.AccessToken = c.AccessToken
+	ctx.RefreshToken = c.RefreshToken
+	return write(location, onDisk)
+}
+
 // GetRequestTimeout returns the request timeout duration with fallback to default
 func (c *Config) GetRequestTimeout() time.Duration {
 	if env := os.Getenv("AMBIENT_REQUEST_TIMEOUT"); env != "" {
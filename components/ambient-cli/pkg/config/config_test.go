@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -154,3 +155,133 @@ func TestLoadInvalidJSON(t *testing.T) {
 		t.Fatal("expected error for invalid JSON")
 	}
 }
+
+func TestLoadMigratesLegacyFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	t.Setenv("AMBIENT_CONFIG", path)
+	t.Setenv(ContextEnv, "")
+
+	legacy := `{"api_url":"https://api.stage.example.com","access_token":"tok","project":"p1","pager":"less"}`
+	if err := os.WriteFile(path, []byte(legacy), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if cfg.ContextName() != "api.stage.example.com" || cfg.CurrentContext != "api.stage.example.com" {
+		t.Errorf("expected context named after the server, got %q (current %q)", cfg.ContextName(), cfg.CurrentContext)
+	}
+	if cfg.APIUrl != "https://api.stage.example.com" || cfg.AccessToken != "tok" || cfg.Project != "p1" {
+		t.Errorf("connection fields not carried over: %+v", cfg)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var onDisk map[string]json.RawMessage
+	if err := json.Unmarshal(data, &onDisk); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := onDisk["contexts"]; !ok {
+		t.Errorf("legacy file was not rewritten with contexts: %s", data)
+	}
+	if _, ok := onDisk["api_url"]; ok {
+		t.Errorf("rewritten file still has flat fields: %s", data)
+	}
+	if _, ok := onDisk["pager"]; !ok {
+		t.Errorf("global settings lost in migration: %s", data)
+	}
+}
+
+func TestSaveWritesOnlySelectedContext(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AMBIENT_CONFIG", filepath.Join(dir, "config.json"))
+	t.Setenv(ContextEnv, "")
+
+	cfg := &Config{
+		CurrentContext: "dev",
+		Contexts: map[string]*Context{
+			"dev":  {Server: "https://dev.example.com", AccessToken: "dev-token"},
+			"prod": {Server: "https://prod.example.com", AccessToken: "prod-token"},
+		},
+	}
+	if err := Save(cfg); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	t.Setenv(ContextEnv, "prod")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if cfg.ContextName() != "prod" || cfg.AccessToken != "prod-token" {
+		t.Fatalf("override did not select prod: %q %q", cfg.ContextName(), cfg.AccessToken)
+	}
+	cfg.Project = "prod-proj"
+	cfg.ClearToken()
+	if err := Save(cfg); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	t.Setenv(ContextEnv, "")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if cfg.CurrentContext != "dev" || cfg.AccessToken != "dev-token" || cfg.Project != "" {
+		t.Errorf("dev context changed: current=%q %+v", cfg.CurrentContext, cfg.Contexts["dev"])
+	}
+	if prod := cfg.Contexts["prod"]; prod.Project != "prod-proj" || prod.AccessToken != "" {
+		t.Errorf("prod context not updated: %+v", prod)
+	}
+}
+
+func TestUseAndDeleteContext(t *testing.T) {
+	cfg := &Config{
+		CurrentContext: "dev",
+		Contexts: map[string]*Context{
+			"dev":   {Server: "https://dev.example.com"},
+			"stage": {Server: "https://stage.example.com", Project: "s"},
+		},
+	}
+	cfg.Select("dev")
+
+	if err := cfg.UseContext("missing"); err == nil {
+		t.Error("expected error switching to an unknown context")
+	}
+	if err := cfg.UseContext("stage"); err != nil {
+		t.Fatalf("use-context failed: %v", err)
+	}
+	if cfg.CurrentContext != "stage" || cfg.Project != "s" {
+		t.Errorf("stage not selected: current=%q project=%q", cfg.CurrentContext, cfg.Project)
+	}
+
+	if err := cfg.DeleteContext("stage"); err != nil {
+		t.Fatalf("delete-context failed: %v", err)
+	}
+	if cfg.CurrentContext != "" || cfg.ContextName() != "" || cfg.APIUrl != "" {
+		t.Errorf("deleting the current context should leave none selected: %q %q", cfg.CurrentContext, cfg.APIUrl)
+	}
+	if got := cfg.ContextNames(); len(got) != 1 || got[0] != "dev" {
+		t.Errorf("expected only dev to remain, got %v", got)
+	}
+}
+
+func TestContextNameFromURL(t *testing.T) {
+	tests := map[string]string{
+		"http://localhost:8000":          "local",
+		"http://127.0.0.1:9000":          "local",
+		"https://api.prod.example.com":   "api.prod.example.com",
+		"https://www.example.com:8443/x": "example.com",
+		"not a url":                      "default",
+	}
+	for in, want := range tests {
+		if got := ContextNameFromURL(in); got != want {
+			t.Errorf("ContextNameFromURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
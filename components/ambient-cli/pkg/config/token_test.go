@@ -240,3 +240,61 @@ func TestGetTokenWithRefresh_EnvOverride(t *testing.T) {
 		t.Errorf("expected env token, got %q", token)
 	}
 }
+
+func TestGetTokenWithRefresh_PersistsToSelectedContext(t *testing.T) {
+	t.Setenv("AMBIENT_TOKEN", "")
+	t.Setenv(ContextEnv, "")
+	expiredToken := makeJWT(jwt.MapClaims{"exp": float64(time.Now().Add(-1 * time.Hour).Unix())})
+	newAccessToken := makeJWT(jwt.MapClaims{"exp": float64(time.Now().Add(1 * time.Hour).Unix())})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"rotated-refresh"}`, newAccessToken)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	t.Setenv("AMBIENT_CONFIG", dir+"/config.json")
+	if err := Save(&Config{
+		CurrentContext: "dev",
+		Contexts: map[string]*Context{
+			"dev":  {Server: "https://dev.example.com", AccessToken: "dev-token"},
+			"prod": {Server: "https://prod.example.com", AccessToken: expiredToken, RefreshToken: "old-refresh", IssuerURL: srv.URL, ClientID: "test-client"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(ContextEnv, "prod")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another command logs in to dev while this one is running.
+	other, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.Select("dev")
+	other.AccessToken = "new-dev-token"
+	if err := Save(other); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cfg.GetTokenWithRefresh(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Setenv(ContextEnv, "")
+	reloaded, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prod := reloaded.Contexts["prod"]; prod.AccessToken != newAccessToken || prod.RefreshToken != "rotated-refresh" {
+		t.Errorf("refreshed tokens not saved to prod: %+v", prod)
+	}
+	if dev := reloaded.Contexts["dev"]; dev.AccessToken != "new-dev-token" {
+		t.Errorf("refresh overwrote the dev context: %+v", dev)
+	}
+}
@@ -70,6 +70,10 @@ func NewClientFactory() (*ClientFactory, error) {
 		return nil, fmt.Errorf("load config: %w", err)
 	}
 
+	if name := cfg.ContextName(); name != "" && !cfg.HasContext(name) {
+		return nil, fmt.Errorf("context %q not found; run 'acpctl config get-contexts' to list contexts", name)
+	}
+
 	// Verify we have a token at startup.
 	token, err := cfg.GetTokenWithRefresh()
 	if err != nil {
@@ -14,13 +14,16 @@ import (
 
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/auth"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/config"
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/gitsource"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/keypair"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/kubeclient"
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/leader"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/reconciler"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/tokenserver"
 	"github.com/ambient-code/platform/components/ambient-control-plane/internal/watcher"
 	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
@@ -187,25 +190,80 @@ func runKubeMode(ctx context.Context, cfg *config.ControlPlaneConfig) error {
 	}
 
 	inf := informer.New(sdk, watchManager, log.Logger)
+	inf.SetProjectClientFunc(factory.ForProject)
+
+	elector, err := buildElector(cfg)
+	if err != nil {
+		return err
+	}
+	leaderOnly := func(h informer.EventHandler) informer.EventHandler { return h }
+	ownedSessions := func(h informer.EventHandler) informer.EventHandler { return h }
+	var ownsProject reconciler.ProjectFilter
+	if elector != nil {
+		leaderOnly = elector.LeaderOnly
+		ownedSessions = elector.OwnedSessions
+		ownsProject = elector.OwnsProject
+	}
 
 	projectReconciler := reconciler.NewProjectReconciler(factory, kube, projectKube, provisioner, cfg.CPRuntimeNamespace, log.Logger)
 	projectSettingsReconciler := reconciler.NewProjectSettingsReconciler(factory, kube, log.Logger)
 
-	inf.RegisterHandler("projects", projectReconciler.Reconcile)
-	inf.RegisterHandler("project_settings", projectSettingsReconciler.Reconcile)
+	inf.RegisterHandler("projects", leaderOnly(projectReconciler.Reconcile))
+	inf.RegisterHandler("project_settings", leaderOnly(projectSettingsReconciler.Reconcile))
 
 	sessionReconcilers := createSessionReconcilers(cfg.Reconcilers, factory, kube, projectKube, provisioner, kubeReconcilerCfg, log.Logger)
 	for _, sessionRec := range sessionReconcilers {
-		inf.RegisterHandler("sessions", sessionRec.Reconcile)
+		inf.RegisterHandler("sessions", ownedSessions(sessionRec.Reconcile))
 	}
+	inf.RegisterHandler("agents", requeueAgentSessions(inf))
 
 	podSyncer := reconciler.NewPodStatusSyncer(factory, provisionerKube, cfg.PlatformMode, cfg.MPPConfigNamespace, log.Logger)
+	podSyncer.SetProjectFilter(ownsProject)
 
+	var timeoutManager *reconciler.SessionTimeoutManager
+	for _, sessionRec := range sessionReconcilers {
+		if kubeRec, ok := sessionRec.(*reconciler.SimpleKubeReconciler); ok {
+			timeoutManager = reconciler.NewSessionTimeoutManager(factory, kubeRec, cfg.DefaultInactivityTimeout, log.Logger)
+			timeoutManager.SetProjectFilter(ownsProject)
+			inf.RegisterHandler("sessions", timeoutManager.HandleSession)
+			inf.RegisterHandler("project_settings", timeoutManager.HandleProjectSettings)
+			inf.RegisterHandler("credentials", leaderOnly(kubeRec.HandleCredential))
+			break
+		}
+	}
+	if timeoutManager == nil {
+		log.Info().Msg("session timeout manager disabled: kube reconciler not configured")
+	}
+
+	// Followers serve the token server too: runner pods of every session
+	// fetch CP tokens through the Service, whichever replica answers.
+	var readiness http.Handler
+	if elector != nil {
+		readiness = elector.ReadinessHandler()
+	}
 	tsErrCh := make(chan error, 1)
 	go func() {
-		tsErrCh <- startTokenServer(ctx, cfg, tokenProvider, kp)
+		tsErrCh <- startTokenServer(ctx, cfg, tokenProvider, kp, readiness)
 	}()
 
+	electErrCh := make(chan error, 1)
+	if elector != nil {
+		// A replica that takes over replays the informer cache, since its
+		// handlers ignored events while it was a follower.
+		elector.OnStartedLeading(func(leadCtx context.Context) {
+			inf.Resync(leadCtx, "projects", "project_settings")
+			if !elector.Sharded() {
+				inf.Resync(leadCtx, "sessions")
+			}
+		})
+		elector.OnAcquiredShard(func(shardCtx context.Context) {
+			inf.Resync(shardCtx, "sessions")
+		})
+		go func() {
+			electErrCh <- elector.Run(ctx)
+		}()
+	}
+
 	infErrCh := make(chan error, 1)
 	go func() {
 		infErrCh <- inf.Run(ctx)
@@ -216,6 +274,31 @@ func runKubeMode(ctx context.Context, cfg *config.ControlPlaneConfig) error {
 		podSyncErrCh <- podSyncer.Run(ctx)
 	}()
 
+	timeoutErrCh := make(chan error, 1)
+	if timeoutManager != nil {
+		go func() {
+			timeoutErrCh <- timeoutManager.Run(ctx)
+		}()
+	}
+
+	appSyncErrCh := make(chan error, 1)
+	if cfg.ApplicationSync {
+		appSyncer := reconciler.NewApplicationSyncer(factory, gitsource.NewGitFetcher(), cfg.ApplicationResync, log.Logger)
+		if elector != nil {
+			elector.OnStartedLeading(func(leadCtx context.Context) {
+				if err := appSyncer.Run(leadCtx); err != nil && leadCtx.Err() == nil {
+					log.Error().Err(err).Msg("application syncer stopped")
+				}
+			})
+		} else {
+			go func() {
+				appSyncErrCh <- appSyncer.Run(ctx)
+			}()
+		}
+	} else {
+		log.Info().Msg("application syncer disabled")
+	}
+
 	select {
 	case tsErr := <-tsErrCh:
 		if tsErr != nil {
@@ -226,10 +309,41 @@ func runKubeMode(ctx context.Context, cfg *config.ControlPlaneConfig) error {
 		return infErr
 	case podSyncErr := <-podSyncErrCh:
 		return fmt.Errorf("pod status syncer: %w", podSyncErr)
+	case timeoutErr := <-timeoutErrCh:
+		return fmt.Errorf("session timeout manager: %w", timeoutErr)
+	case appSyncErr := <-appSyncErrCh:
+		return fmt.Errorf("application syncer: %w", appSyncErr)
+	case electErr := <-electErrCh:
+		return fmt.Errorf("leader election: %w", electErr)
+	}
+}
+
+func buildElector(cfg *config.ControlPlaneConfig) (*leader.Elector, error) {
+	if !cfg.LeaderElection {
+		log.Info().Msg("leader election disabled: this replica reconciles everything")
+		return nil, nil
+	}
+	clientset, err := kubeclient.NewClientset(cfg.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("creating leader election client: %w", err)
 	}
+	elector, err := leader.New(clientset, leader.Config{
+		Namespace:     cfg.CPRuntimeNamespace,
+		LeaseName:     cfg.LeaderElectionLease,
+		Identity:      cfg.LeaderElectionIdentity,
+		LeaseDuration: cfg.LeaseDuration,
+		RenewDeadline: cfg.RenewDeadline,
+		RetryPeriod:   cfg.RetryPeriod,
+		ShardCount:    cfg.SessionShardCount,
+		ShardIndex:    cfg.SessionShardIndex,
+	}, log.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return elector, nil
 }
 
-func startTokenServer(ctx context.Context, cfg *config.ControlPlaneConfig, tokenProvider auth.TokenProvider, kp *keypair.KeyPair) error {
+func startTokenServer(ctx context.Context, cfg *config.ControlPlaneConfig, tokenProvider auth.TokenProvider, kp *keypair.KeyPair, readiness http.Handler) error {
 	privKey, err := keypair.ParsePrivateKey(kp.PrivateKeyPEM)
 	if err != nil {
 		return fmt.Errorf("parsing CP token private key: %w", err)
@@ -238,9 +352,29 @@ func startTokenServer(ctx context.Context, cfg *config.ControlPlaneConfig, token
 	if err != nil {
 		return fmt.Errorf("creating token server: %w", err)
 	}
+	if readiness != nil {
+		ts.SetReadiness(readiness)
+	}
 	return ts.Start(ctx)
 }
 
+// requeueAgentSessions reconciles an edited agent's sessions again, so ones
+// not yet provisioned start from the agent as it is now. The requeued events
+// pass through the session handlers' own ownership filters.
+func requeueAgentSessions(inf *informer.Informer) informer.EventHandler {
+	return func(ctx context.Context, event informer.ResourceEvent) error {
+		agent := event.Object.Agent
+		if event.Type != informer.EventModified || agent == nil {
+			return nil
+		}
+		go func() {
+			n := inf.RequeueSessions(ctx, func(s types.Session) bool { return s.AgentID == agent.ID })
+			log.Debug().Str("agent_id", agent.ID).Int("sessions", n).Msg("requeued sessions of modified agent")
+		}()
+		return nil
+	}
+}
+
 func createSessionReconcilers(reconcilerTypes []string, factory *reconciler.SDKClientFactory, kube *kubeclient.KubeClient, projectKube *kubeclient.KubeClient, provisioner kubeclient.NamespaceProvisioner, cfg reconciler.KubeReconcilerConfig, logger zerolog.Logger) []reconciler.Reconciler {
 	var reconcilers []reconciler.Reconciler
 
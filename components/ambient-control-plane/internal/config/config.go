@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type ControlPlaneConfig struct {
@@ -48,6 +50,23 @@ type ControlPlaneConfig struct {
 	NoProxy               string
 	ImagePullSecret       string
 	ServiceIdentity       string
+	ApplicationSync       bool
+	ApplicationResync     time.Duration
+	// DefaultInactivityTimeout applies to projects whose ProjectSettings do
+	// not set inactivity_timeout_seconds. Zero disables the inactivity stop.
+	DefaultInactivityTimeout time.Duration
+	// LeaderElection runs reconciliation only on the replica holding the
+	// control plane Lease; other replicas keep serving CP tokens.
+	LeaderElection         bool
+	LeaderElectionLease    string
+	LeaderElectionIdentity string
+	LeaseDuration          time.Duration
+	RenewDeadline          time.Duration
+	RetryPeriod            time.Duration
+	// SessionShardCount > 0 splits session reconciliation across replicas
+	// by project ID hash; this replica owns SessionShardIndex.
+	SessionShardCount int
+	SessionShardIndex int
 }
 
 func Load() (*ControlPlaneConfig, error) {
@@ -93,6 +112,25 @@ func Load() (*ControlPlaneConfig, error) {
 		NoProxy:               os.Getenv("NO_PROXY"),
 		ImagePullSecret:       os.Getenv("IMAGE_PULL_SECRET"),
 		ServiceIdentity:       strings.TrimSpace(os.Getenv("GRPC_SERVICE_ACCOUNT")),
+		ApplicationSync:       os.Getenv("APPLICATION_SYNC_ENABLED") != "false",
+		LeaderElection:        os.Getenv("LEADER_ELECTION_ENABLED") == "true",
+		LeaderElectionLease:   envOrDefault("LEADER_ELECTION_LEASE_NAME", "ambient-control-plane"),
+	}
+
+	resync, err := time.ParseDuration(envOrDefault("APPLICATION_RESYNC_INTERVAL", "3m"))
+	if err != nil || resync <= 0 {
+		return nil, fmt.Errorf("invalid APPLICATION_RESYNC_INTERVAL %q: must be a positive duration", os.Getenv("APPLICATION_RESYNC_INTERVAL"))
+	}
+	cfg.ApplicationResync = resync
+
+	inactivity, err := strconv.Atoi(envOrDefault("DEFAULT_INACTIVITY_TIMEOUT", "86400"))
+	if err != nil || inactivity < 0 {
+		return nil, fmt.Errorf("invalid DEFAULT_INACTIVITY_TIMEOUT %q: must be a non-negative number of seconds", os.Getenv("DEFAULT_INACTIVITY_TIMEOUT"))
+	}
+	cfg.DefaultInactivityTimeout = time.Duration(inactivity) * time.Second
+
+	if err := loadLeaderElection(cfg); err != nil {
+		return nil, err
 	}
 
 	if cfg.MCPAPIServerURL == "" {
@@ -118,6 +156,61 @@ func Load() (*ControlPlaneConfig, error) {
 	return cfg, nil
 }
 
+func loadLeaderElection(cfg *ControlPlaneConfig) error {
+	cfg.LeaderElectionIdentity = os.Getenv("POD_NAME")
+	if cfg.LeaderElectionIdentity == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("resolving leader election identity: set POD_NAME: %w", err)
+		}
+		cfg.LeaderElectionIdentity = host
+	}
+
+	durations := []struct {
+		env      string
+		fallback string
+		dst      *time.Duration
+	}{
+		{"LEADER_ELECTION_LEASE_DURATION", "15s", &cfg.LeaseDuration},
+		{"LEADER_ELECTION_RENEW_DEADLINE", "10s", &cfg.RenewDeadline},
+		{"LEADER_ELECTION_RETRY_PERIOD", "2s", &cfg.RetryPeriod},
+	}
+	for _, d := range durations {
+		v, err := time.ParseDuration(envOrDefault(d.env, d.fallback))
+		if err != nil || v <= 0 {
+			return fmt.Errorf("invalid %s %q: must be a positive duration", d.env, os.Getenv(d.env))
+		}
+		*d.dst = v
+	}
+
+	shards, err := strconv.Atoi(envOrDefault("SESSION_SHARD_COUNT", "0"))
+	if err != nil || shards < 0 {
+		return fmt.Errorf("invalid SESSION_SHARD_COUNT %q: must be a non-negative integer", os.Getenv("SESSION_SHARD_COUNT"))
+	}
+	cfg.SessionShardCount = shards
+	if shards == 0 {
+		return nil
+	}
+	if !cfg.LeaderElection {
+		return fmt.Errorf("SESSION_SHARD_COUNT requires LEADER_ELECTION_ENABLED=true")
+	}
+
+	// Without SESSION_SHARD_INDEX the shard is the StatefulSet ordinal
+	// suffix of the identity, e.g. ambient-control-plane-2.
+	indexStr := os.Getenv("SESSION_SHARD_INDEX")
+	if indexStr == "" {
+		if i := strings.LastIndex(cfg.LeaderElectionIdentity, "-"); i >= 0 {
+			indexStr = cfg.LeaderElectionIdentity[i+1:]
+		}
+	}
+	index, err := strconv.Atoi(indexStr)
+	if err != nil || index < 0 || index >= shards {
+		return fmt.Errorf("invalid session shard index %q for %d shards: set SESSION_SHARD_INDEX or run as a StatefulSet", indexStr, shards)
+	}
+	cfg.SessionShardIndex = index
+	return nil
+}
+
 func envOrDefault(key, fallback string) string {
 	if v := os.Getenv(key); v != "" {
 		return v
@@ -0,0 +1,122 @@
+// Package gitsource checks out Application source repositories with the git CLI.
+package gitsource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Checkout is a working tree of a source repository pinned to one commit.
+type Checkout struct {
+	Dir      string
+	Revision string
+}
+
+// Path resolves a repository-relative path inside the checkout. Leading
+// ".." segments are dropped so the result never escapes the working tree.
+func (c *Checkout) Path(rel string) string {
+	return filepath.Join(c.Dir, filepath.Clean("/"+rel))
+}
+
+// Cleanup removes the working tree.
+func (c *Checkout) Cleanup() {
+	_ = os.RemoveAll(c.Dir)
+}
+
+// Fetcher clones a repository at a revision. Implementations must return a
+// Checkout whose Revision is the resolved commit SHA.
+type Fetcher interface {
+	Fetch(ctx context.Context, repoURL, revision string) (*Checkout, error)
+}
+
+// GitFetcher shells out to the git binary.
+type GitFetcher struct {
+	// Binary is the git executable; defaults to "git" on PATH.
+	Binary string
+}
+
+func NewGitFetcher() *GitFetcher {
+	return &GitFetcher{Binary: "git"}
+}
+
+// Fetch clones repoURL into a temporary directory and checks out revision,
+// which may be a branch, tag or commit SHA. An empty revision or "HEAD"
+// selects the remote's default branch. Authentication is whatever the
+// process's git configuration provides (SSH keys, credential helpers).
+func (f *GitFetcher) Fetch(ctx context.Context, repoURL, revision string) (*Checkout, error) {
+	if repoURL == "" {
+		return nil, fmt.Errorf("source repository URL is empty")
+	}
+	dir, err := os.MkdirTemp("", "ambient-app-")
+	if err != nil {
+		return nil, fmt.Errorf("creating checkout directory: %w", err)
+	}
+	co := &Checkout{Dir: dir}
+
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if _, err := f.run(ctx, "", env, "clone", "--quiet", "--no-checkout", "--", repoURL, dir); err != nil {
+		co.Cleanup()
+		return nil, fmt.Errorf("cloning %s: %w", redactURL(repoURL), err)
+	}
+
+	sha, err := f.resolve(ctx, dir, env, revision)
+	if err != nil {
+		co.Cleanup()
+		return nil, err
+	}
+	if _, err := f.run(ctx, dir, env, "checkout", "--quiet", "--detach", sha); err != nil {
+		co.Cleanup()
+		return nil, fmt.Errorf("checking out %s: %w", sha, err)
+	}
+	co.Revision = sha
+	return co, nil
+}
+
+func (f *GitFetcher) resolve(ctx context.Context, dir string, env []string, revision string) (string, error) {
+	candidates := []string{"HEAD"}
+	if revision != "" && revision != "HEAD" {
+		candidates = []string{"origin/" + revision, "refs/tags/" + revision, revision}
+	}
+	for _, c := range candidates {
+		out, err := f.run(ctx, dir, env, "rev-parse", "--verify", "--quiet", c+"^{commit}")
+		if err == nil {
+			return strings.TrimSpace(out), nil
+		}
+	}
+	return "", fmt.Errorf("revision %q not found", revision)
+}
+
+func (f *GitFetcher) run(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	bin := f.Binary
+	if bin == "" {
+		bin = "git"
+	}
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: %s", err, msg)
+	}
+	return stdout.String(), nil
+}
+
+func redactURL(raw string) string {
+	if i := strings.Index(raw, "@"); i >= 0 {
+		if j := strings.Index(raw, "://"); j >= 0 && j < i {
+			return raw[:j+3] + "***" + raw[i:]
+		}
+	}
+	return raw
+}
@@ -0,0 +1,109 @@
+package gitsource
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "init.defaultBranch=main"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// newBareRepo returns a bare repository URL and a working clone to commit in.
+func newBareRepo(t *testing.T) (string, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	bare := filepath.Join(root, "source.git")
+	work := filepath.Join(root, "work")
+	git(t, root, "init", "--quiet", "--bare", bare)
+	git(t, root, "clone", "--quiet", bare, work)
+	return bare, work
+}
+
+func commitFile(t *testing.T, work, name, content string) string {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(work, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "update "+name)
+	return git(t, work, "rev-parse", "HEAD")
+}
+
+func TestFetch_ResolvesBranchTagAndSHA(t *testing.T) {
+	bare, work := newBareRepo(t)
+	first := commitFile(t, work, "a.txt", "one")
+	git(t, work, "tag", "v1")
+	git(t, work, "push", "--quiet", "origin", "HEAD:main", "v1")
+	git(t, work, "checkout", "--quiet", "-b", "feature")
+	second := commitFile(t, work, "a.txt", "two")
+	git(t, work, "push", "--quiet", "origin", "feature")
+
+	cases := map[string]string{
+		"":        first,
+		"HEAD":    first,
+		"main":    first,
+		"v1":      first,
+		"feature": second,
+		second:    second,
+	}
+	for rev, want := range cases {
+		co, err := NewGitFetcher().Fetch(context.Background(), bare, rev)
+		if err != nil {
+			t.Fatalf("Fetch(%q): %v", rev, err)
+		}
+		if co.Revision != want {
+			t.Errorf("Fetch(%q) revision = %s, want %s", rev, co.Revision, want)
+		}
+		data, err := os.ReadFile(co.Path("a.txt"))
+		if err != nil {
+			t.Fatalf("Fetch(%q): %v", rev, err)
+		}
+		if wantContent := map[string]string{first: "one", second: "two"}[want]; string(data) != wantContent {
+			t.Errorf("Fetch(%q) content = %q, want %q", rev, data, wantContent)
+		}
+		co.Cleanup()
+		if _, err := os.Stat(co.Dir); !os.IsNotExist(err) {
+			t.Errorf("Cleanup left %s behind", co.Dir)
+		}
+	}
+}
+
+func TestFetch_UnknownRevision(t *testing.T) {
+	bare, work := newBareRepo(t)
+	commitFile(t, work, "a.txt", "one")
+	git(t, work, "push", "--quiet", "origin", "HEAD:main")
+
+	if _, err := NewGitFetcher().Fetch(context.Background(), bare, "does-not-exist"); err == nil {
+		t.Fatal("expected error for unknown revision")
+	}
+}
+
+func TestCheckoutPath_StaysInsideWorkingTree(t *testing.T) {
+	co := &Checkout{Dir: "/tmp/checkout"}
+	for rel, want := range map[string]string{
+		"":                  "/tmp/checkout",
+		".":                 "/tmp/checkout",
+		"manifests/dev":     "/tmp/checkout/manifests/dev",
+		"../../etc/passwd":  "/tmp/checkout/etc/passwd",
+		"/abs/../manifests": "/tmp/checkout/manifests",
+	} {
+		if got := co.Path(rel); got != want {
+			t.Errorf("Path(%q) = %q, want %q", rel, got, want)
+		}
+	}
+}
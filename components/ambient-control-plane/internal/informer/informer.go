@@ -23,9 +23,14 @@ const (
 )
 
 type ResourceObject struct {
-	Session         *types.Session
-	Project         *types.Project
-	ProjectSettings *types.ProjectSettings
+	Session          *types.Session
+	Project          *types.Project
+	ProjectSettings  *types.ProjectSettings
+	Agent            *types.Agent
+	Credential       *types.Credential
+	RoleBinding      *types.RoleBinding
+	Application      *types.Application
+	ScheduledSession *types.ScheduledSession
 }
 
 func (r ResourceObject) GetID() string {
@@ -36,6 +41,16 @@ func (r ResourceObject) GetID() string {
 		return r.Project.ID
 	case r.ProjectSettings != nil:
 		return r.ProjectSettings.ID
+	case r.Agent != nil:
+		return r.Agent.ID
+	case r.Credential != nil:
+		return r.Credential.ID
+	case r.RoleBinding != nil:
+		return r.RoleBinding.ID
+	case r.Application != nil:
+		return r.Application.ID
+	case r.ScheduledSession != nil:
+		return r.ScheduledSession.ID
 	default:
 		return ""
 	}
@@ -49,13 +64,24 @@ func (r ResourceObject) GetResourceType() string {
 		return "projects"
 	case r.ProjectSettings != nil:
 		return "project_settings"
+	case r.Agent != nil:
+		return "agents"
+	case r.Credential != nil:
+		return "credentials"
+	case r.RoleBinding != nil:
+		return "role_bindings"
+	case r.Application != nil:
+		return "applications"
+	case r.ScheduledSession != nil:
+		return "scheduled_sessions"
 	default:
 		return ""
 	}
 }
 
 func (r ResourceObject) IsEmpty() bool {
-	return r.Session == nil && r.Project == nil && r.ProjectSettings == nil
+	return r.Session == nil && r.Project == nil && r.ProjectSettings == nil &&
+		r.Agent == nil && r.Credential == nil && r.RoleBinding == nil && r.Application == nil && r.ScheduledSession == nil
 }
 
 func NewSessionObject(s types.Session) ResourceObject {
@@ -70,6 +96,26 @@ func NewProjectSettingsObject(ps types.ProjectSettings) ResourceObject {
 	return ResourceObject{ProjectSettings: &ps}
 }
 
+func NewAgentObject(o types.Agent) ResourceObject {
+	return ResourceObject{Agent: &o}
+}
+
+func NewCredentialObject(o types.Credential) ResourceObject {
+	return ResourceObject{Credential: &o}
+}
+
+func NewRoleBindingObject(o types.RoleBinding) ResourceObject {
+	return ResourceObject{RoleBinding: &o}
+}
+
+func NewApplicationObject(o types.Application) ResourceObject {
+	return ResourceObject{Application: &o}
+}
+
+func NewScheduledSessionObject(o types.ScheduledSession) ResourceObject {
+	return ResourceObject{ScheduledSession: &o}
+}
+
 type ResourceEvent struct {
 	Type      EventType
 	Resource  string
@@ -92,31 +138,47 @@ type retryEvent struct {
 	fireAt       time.Time
 }
 
-type Informer struct {
-	sdk          *sdkclient.Client
-	watchManager *watcher.WatchManager
-	handlers     map[string][]EventHandler
-	mu           sync.RWMutex
-	logger       zerolog.Logger
-	eventCh      chan ResourceEvent
-	retryCh      chan retryEvent
+// ProjectClientFunc returns an SDK client scoped to a project. It is used to
+// list project-scoped resources (agents, scheduled sessions) during the
+// initial sync, since the informer's own client is bound to one project.
+type ProjectClientFunc func(ctx context.Context, project string) (*sdkclient.Client, error)
 
-	sessionCache         map[string]types.Session
-	projectCache         map[string]types.Project
-	projectSettingsCache map[string]types.ProjectSettings
+type Informer struct {
+	sdk           *sdkclient.Client
+	projectClient ProjectClientFunc
+	watchManager  *watcher.WatchManager
+	handlers      map[string][]EventHandler
+	mu            sync.RWMutex
+	logger        zerolog.Logger
+	eventCh       chan ResourceEvent
+	retryCh       chan retryEvent
+
+	sessionCache          map[string]types.Session
+	projectCache          map[string]types.Project
+	projectSettingsCache  map[string]types.ProjectSettings
+	agentCache            map[string]types.Agent
+	credentialCache       map[string]types.Credential
+	roleBindingCache      map[string]types.RoleBinding
+	applicationCache      map[string]types.Application
+	scheduledSessionCache map[string]types.ScheduledSession
 }
 
 func New(sdk *sdkclient.Client, watchManager *watcher.WatchManager, logger zerolog.Logger) *Informer {
 	return &Informer{
-		sdk:                  sdk,
-		watchManager:         watchManager,
-		handlers:             make(map[string][]EventHandler),
-		logger:               logger.With().Str("component", "informer").Logger(),
-		eventCh:              make(chan ResourceEvent, 256),
-		retryCh:              make(chan retryEvent, 256),
-		sessionCache:         make(map[string]types.Session),
-		projectCache:         make(map[string]types.Project),
-		projectSettingsCache: make(map[string]types.ProjectSettings),
+		sdk:                   sdk,
+		watchManager:          watchManager,
+		handlers:              make(map[string][]EventHandler),
+		logger:                logger.With().Str("component", "informer").Logger(),
+		eventCh:               make(chan ResourceEvent, 256),
+		retryCh:               make(chan retryEvent, 256),
+		sessionCache:          make(map[string]types.Session),
+		projectCache:          make(map[string]types.Project),
+		projectSettingsCache:  make(map[string]types.ProjectSettings),
+		agentCache:            make(map[string]types.Agent),
+		credentialCache:       make(map[string]types.Credential),
+		roleBindingCache:      make(map[string]types.RoleBinding),
+		applicationCache:      make(map[string]types.Application),
+		scheduledSessionCache: make(map[string]types.ScheduledSession),
 	}
 }
 
@@ -126,6 +188,24 @@ func (inf *Informer) RegisterHandler(resource string, handler EventHandler) {
 	inf.handlers[resource] = append(inf.handlers[resource], handler)
 }
 
+// SetProjectClientFunc enables the initial sync of project-scoped resources.
+// Without it, agents and scheduled sessions are only observed through watch
+// events.
+func (inf *Informer) SetProjectClientFunc(fn ProjectClientFunc) {
+	inf.mu.Lock()
+	defer inf.mu.Unlock()
+	inf.projectClient = fn
+}
+
+// watching reports whether any handler is registered for resource. Sessions,
+// projects and project settings are always synced and watched; the other
+// resources are only listed and watched when something consumes them.
+func (inf *Informer) watching(resource string) bool {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	return len(inf.handlers[resource]) > 0
+}
+
 func (inf *Informer) Run(ctx context.Context) error {
 	inf.logger.Info().Msg("performing initial list sync")
 
@@ -245,6 +325,36 @@ func (inf *Informer) initialSync(ctx context.Context) error {
 		inf.logger.Error().Err(err).Msg("initial session sync failed")
 		errs = append(errs, err.Error())
 	}
+	if inf.watching("agents") {
+		if err := inf.syncAgents(ctx); err != nil {
+			inf.logger.Error().Err(err).Msg("initial agents sync failed")
+			errs = append(errs, err.Error())
+		}
+	}
+	if inf.watching("credentials") {
+		if err := inf.syncCredentials(ctx); err != nil {
+			inf.logger.Error().Err(err).Msg("initial credentials sync failed")
+			errs = append(errs, err.Error())
+		}
+	}
+	if inf.watching("role_bindings") {
+		if err := inf.syncRoleBindings(ctx); err != nil {
+			inf.logger.Error().Err(err).Msg("initial role_bindings sync failed")
+			errs = append(errs, err.Error())
+		}
+	}
+	if inf.watching("applications") {
+		if err := inf.syncApplications(ctx); err != nil {
+			inf.logger.Error().Err(err).Msg("initial applications sync failed")
+			errs = append(errs, err.Error())
+		}
+	}
+	if inf.watching("scheduled_sessions") {
+		if err := inf.syncScheduledSessions(ctx); err != nil {
+			inf.logger.Error().Err(err).Msg("initial scheduled_sessions sync failed")
+			errs = append(errs, err.Error())
+		}
+	}
 	if len(errs) > 0 {
 		return fmt.Errorf("initial sync failures: %s", strings.Join(errs, "; "))
 	}
@@ -356,6 +466,245 @@ func (inf *Informer) syncProjectSettings(ctx context.Context) error {
 	return nil
 }
 
+func (inf *Informer) syncAgents(ctx context.Context) error {
+	var all []types.Agent
+	err := inf.forEachProject(ctx, "agents", func(sdk *sdkclient.Client) error {
+		opts := &types.ListOptions{Size: 100, Page: 1}
+		var items []types.Agent
+		for {
+			list, err := sdk.Agents().List(ctx, opts)
+			if err != nil {
+				return fmt.Errorf("list agents in project %s page %d: %w", sdk.Project(), opts.Page, err)
+			}
+			items = append(items, list.Items...)
+			if len(items) >= list.Total || len(list.Items) == 0 {
+				break
+			}
+			opts.Page++
+		}
+		all = append(all, items...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	inf.storeAgents(ctx, all)
+	return nil
+}
+
+func (inf *Informer) storeAgents(ctx context.Context, items []types.Agent) {
+	func() {
+		inf.mu.Lock()
+		defer inf.mu.Unlock()
+		for _, item := range items {
+			inf.agentCache[item.ID] = item
+		}
+	}()
+
+	for _, item := range items {
+		inf.dispatchBlocking(ctx, ResourceEvent{
+			Type:     EventAdded,
+			Resource: "agents",
+			Object:   NewAgentObject(item),
+		})
+	}
+
+	inf.logger.Info().Int("count", len(items)).Msg("initial agents sync complete")
+}
+
+func (inf *Informer) syncCredentials(ctx context.Context) error {
+	opts := &types.ListOptions{Size: 100, Page: 1}
+	var all []types.Credential
+	for {
+		list, err := inf.sdk.Credentials().List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("list credentials page %d: %w", opts.Page, err)
+		}
+		all = append(all, list.Items...)
+		if len(all) >= list.Total || len(list.Items) == 0 {
+			break
+		}
+		opts.Page++
+	}
+
+	inf.storeCredentials(ctx, all)
+	return nil
+}
+
+func (inf *Informer) storeCredentials(ctx context.Context, items []types.Credential) {
+	func() {
+		inf.mu.Lock()
+		defer inf.mu.Unlock()
+		for _, item := range items {
+			inf.credentialCache[item.ID] = item
+		}
+	}()
+
+	for _, item := range items {
+		inf.dispatchBlocking(ctx, ResourceEvent{
+			Type:     EventAdded,
+			Resource: "credentials",
+			Object:   NewCredentialObject(item),
+		})
+	}
+
+	inf.logger.Info().Int("count", len(items)).Msg("initial credentials sync complete")
+}
+
+func (inf *Informer) syncRoleBindings(ctx context.Context) error {
+	opts := &types.ListOptions{Size: 100, Page: 1}
+	var all []types.RoleBinding
+	for {
+		list, err := inf.sdk.RoleBindings().List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("list role_bindings page %d: %w", opts.Page, err)
+		}
+		all = append(all, list.Items...)
+		if len(all) >= list.Total || len(list.Items) == 0 {
+			break
+		}
+		opts.Page++
+	}
+
+	inf.storeRoleBindings(ctx, all)
+	return nil
+}
+
+func (inf *Informer) storeRoleBindings(ctx context.Context, items []types.RoleBinding) {
+	func() {
+		inf.mu.Lock()
+		defer inf.mu.Unlock()
+		for _, item := range items {
+			inf.roleBindingCache[item.ID] = item
+		}
+	}()
+
+	for _, item := range items {
+		inf.dispatchBlocking(ctx, ResourceEvent{
+			Type:     EventAdded,
+			Resource: "role_bindings",
+			Object:   NewRoleBindingObject(item),
+		})
+	}
+
+	inf.logger.Info().Int("count", len(items)).Msg("initial role_bindings sync complete")
+}
+
+func (inf *Informer) syncApplications(ctx context.Context) error {
+	opts := &types.ListOptions{Size: 100, Page: 1}
+	var all []types.Application
+	for {
+		list, err := inf.sdk.Applications().List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("list applications page %d: %w", opts.Page, err)
+		}
+		all = append(all, list.Items...)
+		if len(all) >= list.Total || len(list.Items) == 0 {
+			break
+		}
+		opts.Page++
+	}
+
+	inf.storeApplications(ctx, all)
+	return nil
+}
+
+func (inf *Informer) storeApplications(ctx context.Context, items []types.Application) {
+	func() {
+		inf.mu.Lock()
+		defer inf.mu.Unlock()
+		for _, item := range items {
+			inf.applicationCache[item.ID] = item
+		}
+	}()
+
+	for _, item := range items {
+		inf.dispatchBlocking(ctx, ResourceEvent{
+			Type:     EventAdded,
+			Resource: "applications",
+			Object:   NewApplicationObject(item),
+		})
+	}
+
+	inf.logger.Info().Int("count", len(items)).Msg("initial applications sync complete")
+}
+
+func (inf *Informer) syncScheduledSessions(ctx context.Context) error {
+	var all []types.ScheduledSession
+	err := inf.forEachProject(ctx, "scheduled_sessions", func(sdk *sdkclient.Client) error {
+		opts := &types.ListOptions{Size: 100, Page: 1}
+		var items []types.ScheduledSession
+		for {
+			list, err := sdk.ScheduledSessions().List(ctx, opts)
+			if err != nil {
+				return fmt.Errorf("list scheduled_sessions in project %s page %d: %w", sdk.Project(), opts.Page, err)
+			}
+			items = append(items, list.Items...)
+			if len(items) >= list.Total || len(list.Items) == 0 {
+				break
+			}
+			opts.Page++
+		}
+		all = append(all, items...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	inf.storeScheduledSessions(ctx, all)
+	return nil
+}
+
+func (inf *Informer) storeScheduledSessions(ctx context.Context, items []types.ScheduledSession) {
+	func() {
+		inf.mu.Lock()
+		defer inf.mu.Unlock()
+		for _, item := range items {
+			inf.scheduledSessionCache[item.ID] = item
+		}
+	}()
+
+	for _, item := range items {
+		inf.dispatchBlocking(ctx, ResourceEvent{
+			Type:     EventAdded,
+			Resource: "scheduled_sessions",
+			Object:   NewScheduledSessionObject(item),
+		})
+	}
+
+	inf.logger.Info().Int("count", len(items)).Msg("initial scheduled_sessions sync complete")
+}
+
+// forEachProject calls fn with a client for every project in the project
+// cache, which syncProjects fills before any project-scoped resource is synced.
+func (inf *Informer) forEachProject(ctx context.Context, resource string, fn func(sdk *sdkclient.Client) error) error {
+	inf.mu.RLock()
+	projectClient := inf.projectClient
+	projects := make([]string, 0, len(inf.projectCache))
+	for _, p := range inf.projectCache {
+		projects = append(projects, p.Name)
+	}
+	inf.mu.RUnlock()
+
+	if projectClient == nil {
+		inf.logger.Info().Str("resource", resource).Msg("no project client configured; skipping initial list")
+		return nil
+	}
+
+	for _, project := range projects {
+		sdk, err := projectClient(ctx, project)
+		if err != nil {
+			return fmt.Errorf("client for project %s: %w", project, err)
+		}
+		if err := fn(sdk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (inf *Informer) wireWatchHandlers() {
 	inf.watchManager.RegisterSessionHandler(func(ctx context.Context, we watcher.SessionWatchEvent) error {
 		return inf.handleSessionWatch(ctx, we)
@@ -366,6 +715,31 @@ func (inf *Informer) wireWatchHandlers() {
 	inf.watchManager.RegisterProjectSettingsHandler(func(ctx context.Context, we watcher.ProjectSettingsWatchEvent) error {
 		return inf.handleProjectSettingsWatch(ctx, we)
 	})
+	if inf.watching("agents") {
+		inf.watchManager.RegisterAgentHandler(func(ctx context.Context, we watcher.AgentWatchEvent) error {
+			return inf.handleAgentWatch(ctx, we)
+		})
+	}
+	if inf.watching("credentials") {
+		inf.watchManager.RegisterCredentialHandler(func(ctx context.Context, we watcher.CredentialWatchEvent) error {
+			return inf.handleCredentialWatch(ctx, we)
+		})
+	}
+	if inf.watching("role_bindings") {
+		inf.watchManager.RegisterRoleBindingHandler(func(ctx context.Context, we watcher.RoleBindingWatchEvent) error {
+			return inf.handleRoleBindingWatch(ctx, we)
+		})
+	}
+	if inf.watching("applications") {
+		inf.watchManager.RegisterApplicationHandler(func(ctx context.Context, we watcher.ApplicationWatchEvent) error {
+			return inf.handleApplicationWatch(ctx, we)
+		})
+	}
+	if inf.watching("scheduled_sessions") {
+		inf.watchManager.RegisterScheduledSessionHandler(func(ctx context.Context, we watcher.ScheduledSessionWatchEvent) error {
+			return inf.handleScheduledSessionWatch(ctx, we)
+		})
+	}
 }
 
 func (inf *Informer) handleSessionWatch(ctx context.Context, we watcher.SessionWatchEvent) error {
@@ -473,6 +847,234 @@ func (inf *Informer) handleProjectSettingsWatch(ctx context.Context, we watcher.
 	return nil
 }
 
+func (inf *Informer) handleAgentWatch(ctx context.Context, we watcher.AgentWatchEvent) error {
+	var event ResourceEvent
+
+	inf.mu.Lock()
+	switch we.Type {
+	case watcher.EventCreated:
+		obj := protoAgentToSDK(we.Agent)
+		inf.agentCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventAdded, Resource: "agents", Object: NewAgentObject(obj)}
+
+	case watcher.EventUpdated:
+		obj := protoAgentToSDK(we.Agent)
+		old := inf.agentCache[obj.ID]
+		inf.agentCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventModified, Resource: "agents", Object: NewAgentObject(obj), OldObject: NewAgentObject(old)}
+
+	case watcher.EventDeleted:
+		if old, found := inf.agentCache[we.ResourceID]; found {
+			delete(inf.agentCache, we.ResourceID)
+			event = ResourceEvent{Type: EventDeleted, Resource: "agents", Object: NewAgentObject(old)}
+		} else {
+			inf.logger.Warn().Str("resource_id", we.ResourceID).Msg("agents DELETE event for unknown resource; dispatching tombstone")
+			tombstone := types.Agent{}
+			tombstone.ID = we.ResourceID
+			event = ResourceEvent{Type: EventDeleted, Resource: "agents", Object: NewAgentObject(tombstone)}
+		}
+	}
+	inf.mu.Unlock()
+
+	if event.Resource != "" {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return nil
+}
+
+func (inf *Informer) handleCredentialWatch(ctx context.Context, we watcher.CredentialWatchEvent) error {
+	var event ResourceEvent
+
+	inf.mu.Lock()
+	switch we.Type {
+	case watcher.EventCreated:
+		obj := protoCredentialToSDK(we.Credential)
+		inf.credentialCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventAdded, Resource: "credentials", Object: NewCredentialObject(obj)}
+
+	case watcher.EventUpdated:
+		obj := protoCredentialToSDK(we.Credential)
+		old := inf.credentialCache[obj.ID]
+		inf.credentialCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventModified, Resource: "credentials", Object: NewCredentialObject(obj), OldObject: NewCredentialObject(old)}
+
+	case watcher.EventDeleted:
+		if old, found := inf.credentialCache[we.ResourceID]; found {
+			delete(inf.credentialCache, we.ResourceID)
+			event = ResourceEvent{Type: EventDeleted, Resource: "credentials", Object: NewCredentialObject(old)}
+		} else {
+			inf.logger.Warn().Str("resource_id", we.ResourceID).Msg("credentials DELETE event for unknown resource; dispatching tombstone")
+			tombstone := types.Credential{}
+			tombstone.ID = we.ResourceID
+			event = ResourceEvent{Type: EventDeleted, Resource: "credentials", Object: NewCredentialObject(tombstone)}
+		}
+	}
+	inf.mu.Unlock()
+
+	if event.Resource != "" {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return nil
+}
+
+func (inf *Informer) handleRoleBindingWatch(ctx context.Context, we watcher.RoleBindingWatchEvent) error {
+	var event ResourceEvent
+
+	inf.mu.Lock()
+	switch we.Type {
+	case watcher.EventCreated:
+		obj := protoRoleBindingToSDK(we.RoleBinding)
+		inf.roleBindingCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventAdded, Resource: "role_bindings", Object: NewRoleBindingObject(obj)}
+
+	case watcher.EventUpdated:
+		obj := protoRoleBindingToSDK(we.RoleBinding)
+		old := inf.roleBindingCache[obj.ID]
+		inf.roleBindingCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventModified, Resource: "role_bindings", Object: NewRoleBindingObject(obj), OldObject: NewRoleBindingObject(old)}
+
+	case watcher.EventDeleted:
+		if old, found := inf.roleBindingCache[we.ResourceID]; found {
+			delete(inf.roleBindingCache, we.ResourceID)
+			event = ResourceEvent{Type: EventDeleted, Resource: "role_bindings", Object: NewRoleBindingObject(old)}
+		} else {
+			inf.logger.Warn().Str("resource_id", we.ResourceID).Msg("role_bindings DELETE event for unknown resource; dispatching tombstone")
+			tombstone := types.RoleBinding{}
+			tombstone.ID = we.ResourceID
+			event = ResourceEvent{Type: EventDeleted, Resource: "role_bindings", Object: NewRoleBindingObject(tombstone)}
+		}
+	}
+	inf.mu.Unlock()
+
+	if event.Resource != "" {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return nil
+}
+
+func (inf *Informer) handleApplicationWatch(ctx context.Context, we watcher.ApplicationWatchEvent) error {
+	var event ResourceEvent
+
+	inf.mu.Lock()
+	switch we.Type {
+	case watcher.EventCreated:
+		obj := protoApplicationToSDK(we.Application)
+		inf.applicationCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventAdded, Resource: "applications", Object: NewApplicationObject(obj)}
+
+	case watcher.EventUpdated:
+		obj := protoApplicationToSDK(we.Application)
+		old := inf.applicationCache[obj.ID]
+		inf.applicationCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventModified, Resource: "applications", Object: NewApplicationObject(obj), OldObject: NewApplicationObject(old)}
+
+	case watcher.EventDeleted:
+		if old, found := inf.applicationCache[we.ResourceID]; found {
+			delete(inf.applicationCache, we.ResourceID)
+			event = ResourceEvent{Type: EventDeleted, Resource: "applications", Object: NewApplicationObject(old)}
+		} else {
+			inf.logger.Warn().Str("resource_id", we.ResourceID).Msg("applications DELETE event for unknown resource; dispatching tombstone")
+			tombstone := types.Application{}
+			tombstone.ID = we.ResourceID
+			event = ResourceEvent{Type: EventDeleted, Resource: "applications", Object: NewApplicationObject(tombstone)}
+		}
+	}
+	inf.mu.Unlock()
+
+	if event.Resource != "" {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return nil
+}
+
+func (inf *Informer) handleScheduledSessionWatch(ctx context.Context, we watcher.ScheduledSessionWatchEvent) error {
+	var event ResourceEvent
+
+	inf.mu.Lock()
+	switch we.Type {
+	case watcher.EventCreated:
+		obj := protoScheduledSessionToSDK(we.ScheduledSession)
+		inf.scheduledSessionCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventAdded, Resource: "scheduled_sessions", Object: NewScheduledSessionObject(obj)}
+
+	case watcher.EventUpdated:
+		obj := protoScheduledSessionToSDK(we.ScheduledSession)
+		old := inf.scheduledSessionCache[obj.ID]
+		inf.scheduledSessionCache[obj.ID] = obj
+		event = ResourceEvent{Type: EventModified, Resource: "scheduled_sessions", Object: NewScheduledSessionObject(obj), OldObject: NewScheduledSessionObject(old)}
+
+	case watcher.EventDeleted:
+		if old, found := inf.scheduledSessionCache[we.ResourceID]; found {
+			delete(inf.scheduledSessionCache, we.ResourceID)
+			event = ResourceEvent{Type: EventDeleted, Resource: "scheduled_sessions", Object: NewScheduledSessionObject(old)}
+		} else {
+			inf.logger.Warn().Str("resource_id", we.ResourceID).Msg("scheduled_sessions DELETE event for unknown resource; dispatching tombstone")
+			tombstone := types.ScheduledSession{}
+			tombstone.ID = we.ResourceID
+			event = ResourceEvent{Type: EventDeleted, Resource: "scheduled_sessions", Object: NewScheduledSessionObject(tombstone)}
+		}
+	}
+	inf.mu.Unlock()
+
+	if event.Resource != "" {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return nil
+}
+
+// Resync replays the cached sessions, projects or project settings to their
+// handlers as EventAdded, as the initial sync does. It is used when a
+// replica takes over reconciliation and must catch up on events its handlers
+// ignored while it was a follower. It blocks until every event is queued.
+func (inf *Informer) Resync(ctx context.Context, resources ...string) {
+	for _, resource := range resources {
+		var events []ResourceEvent
+		inf.mu.RLock()
+		switch resource {
+		case "sessions":
+			for _, s := range inf.sessionCache {
+				events = append(events, ResourceEvent{Type: EventAdded, Resource: resource, Object: NewSessionObject(s)})
+			}
+		case "projects":
+			for _, p := range inf.projectCache {
+				events = append(events, ResourceEvent{Type: EventAdded, Resource: resource, Object: NewProjectObject(p)})
+			}
+		case "project_settings":
+			for _, ps := range inf.projectSettingsCache {
+				events = append(events, ResourceEvent{Type: EventAdded, Resource: resource, Object: NewProjectSettingsObject(ps)})
+			}
+		default:
+			inf.logger.Warn().Str("resource", resource).Msg("resync not supported for resource")
+		}
+		inf.mu.RUnlock()
+
+		for _, event := range events {
+			inf.dispatchBlocking(ctx, event)
+		}
+		inf.logger.Info().Str("resource", resource).Int("count", len(events)).Msg("resync queued")
+	}
+}
+
+// RequeueSessions replays the cached sessions that match to the session
+// handlers as EventModified, so they are reconciled again after a resource
+// they depend on changed. It blocks until every event is queued, so handlers
+// must call it from their own goroutine. It returns the number requeued.
+func (inf *Informer) RequeueSessions(ctx context.Context, match func(types.Session) bool) int {
+	var events []ResourceEvent
+	inf.mu.RLock()
+	for _, s := range inf.sessionCache {
+		if match(s) {
+			events = append(events, ResourceEvent{Type: EventModified, Resource: "sessions", Object: NewSessionObject(s), OldObject: NewSessionObject(s)})
+		}
+	}
+	inf.mu.RUnlock()
+
+	for _, event := range events {
+		inf.dispatchBlocking(ctx, event)
+	}
+	return len(events)
+}
+
 func (inf *Informer) dispatchBlocking(ctx context.Context, event ResourceEvent) {
 	select {
 	case inf.eventCh <- event:
@@ -513,6 +1115,7 @@ func protoSessionToSDK(s *pb.Session) types.Session {
 		Conditions:           s.GetConditions(),
 		ReconciledRepos:      s.GetReconciledRepos(),
 		ReconciledWorkflow:   s.GetReconciledWorkflow(),
+		StoppedReason:        s.GetStoppedReason(),
 	}
 	if m := s.GetMetadata(); m != nil {
 		session.ID = m.GetId()
@@ -566,9 +1169,11 @@ func protoProjectSettingsToSDK(ps *pb.ProjectSettings) types.ProjectSettings {
 		return types.ProjectSettings{}
 	}
 	settings := types.ProjectSettings{
-		ProjectID:    ps.GetProjectId(),
-		GroupAccess:  ps.GetGroupAccess(),
-		Repositories: ps.GetRepositories(),
+		ProjectID:                ps.GetProjectId(),
+		GroupAccess:              ps.GetGroupAccess(),
+		Repositories:             ps.GetRepositories(),
+		ResourceLimits:           ps.GetResourceLimits(),
+		InactivityTimeoutSeconds: ps.GetInactivityTimeoutSeconds(),
 	}
 	if m := ps.GetMetadata(); m != nil {
 		settings.ID = m.GetId()
@@ -583,3 +1188,154 @@ func protoProjectSettingsToSDK(ps *pb.ProjectSettings) types.ProjectSettings {
 	}
 	return settings
 }
+
+func objectReferenceToSDK(m *pb.ObjectReference) types.ObjectReference {
+	var ref types.ObjectReference
+	if m == nil {
+		return ref
+	}
+	ref.ID = m.GetId()
+	if m.GetCreatedAt() != nil {
+		t := m.GetCreatedAt().AsTime()
+		ref.CreatedAt = &t
+	}
+	if m.GetUpdatedAt() != nil {
+		t := m.GetUpdatedAt().AsTime()
+		ref.UpdatedAt = &t
+	}
+	return ref
+}
+
+func optionalString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := *s
+	return &v
+}
+
+func protoAgentToSDK(a *pb.Agent) types.Agent {
+	if a == nil {
+		return types.Agent{}
+	}
+	return types.Agent{
+		ObjectReference:      objectReferenceToSDK(a.GetMetadata()),
+		ProjectID:            a.GetProjectId(),
+		ParentAgentID:        a.GetParentAgentId(),
+		OwnerUserID:          a.GetOwnerUserId(),
+		Name:                 a.GetName(),
+		DisplayName:          a.GetDisplayName(),
+		Description:          a.GetDescription(),
+		Prompt:               a.GetPrompt(),
+		RepoURL:              a.GetRepoUrl(),
+		WorkflowID:           a.GetWorkflowId(),
+		LlmModel:             a.GetLlmModel(),
+		LlmTemperature:       a.GetLlmTemperature(),
+		LlmMaxTokens:         a.GetLlmMaxTokens(),
+		BotAccountName:       a.GetBotAccountName(),
+		ResourceOverrides:    a.GetResourceOverrides(),
+		EnvironmentVariables: a.GetEnvironmentVariables(),
+		Labels:               a.GetLabels(),
+		Annotations:          a.GetAnnotations(),
+		CurrentSessionID:     a.GetCurrentSessionId(),
+	}
+}
+
+// protoCredentialToSDK never carries a token: the watch stream only sends
+// credential metadata.
+func protoCredentialToSDK(c *pb.Credential) types.Credential {
+	if c == nil {
+		return types.Credential{}
+	}
+	return types.Credential{
+		ObjectReference: objectReferenceToSDK(c.GetMetadata()),
+		Name:            c.GetName(),
+		Description:     c.GetDescription(),
+		Provider:        c.GetProvider(),
+		URL:             c.GetUrl(),
+		Email:           c.GetEmail(),
+		Labels:          c.GetLabels(),
+		Annotations:     c.GetAnnotations(),
+	}
+}
+
+func protoRoleBindingToSDK(rb *pb.RoleBinding) types.RoleBinding {
+	if rb == nil {
+		return types.RoleBinding{}
+	}
+	return types.RoleBinding{
+		ObjectReference: objectReferenceToSDK(rb.GetMetadata()),
+		RoleID:          rb.GetRoleId(),
+		Scope:           rb.GetScope(),
+		UserID:          optionalString(rb.UserId),
+		ProjectID:       optionalString(rb.ProjectId),
+		AgentID:         optionalString(rb.AgentId),
+		SessionID:       optionalString(rb.SessionId),
+		CredentialID:    optionalString(rb.CredentialId),
+	}
+}
+
+func protoApplicationToSDK(a *pb.Application) types.Application {
+	if a == nil {
+		return types.Application{}
+	}
+	app := types.Application{
+		ObjectReference:       objectReferenceToSDK(a.GetMetadata()),
+		Name:                  a.GetName(),
+		SourceRepoURL:         a.GetSourceRepoUrl(),
+		SourceTargetRevision:  a.GetSourceTargetRevision(),
+		SourcePath:            a.GetSourcePath(),
+		DestinationAmbientURL: a.GetDestinationAmbientUrl(),
+		DestinationProject:    a.GetDestinationProject(),
+		CredentialID:          a.GetCredentialId(),
+		AutoSync:              a.GetAutoSync(),
+		AutoPrune:             a.GetAutoPrune(),
+		SelfHeal:              a.GetSelfHeal(),
+		SyncOptions:           a.GetSyncOptions(),
+		RetryLimit:            a.GetRetryLimit(),
+		SyncStatus:            a.GetSyncStatus(),
+		HealthStatus:          a.GetHealthStatus(),
+		SyncRevision:          a.GetSyncRevision(),
+		OperationPhase:        a.GetOperationPhase(),
+		OperationMessage:      a.GetOperationMessage(),
+		ResourceStatus:        a.GetResourceStatus(),
+		Conditions:            a.GetConditions(),
+		Labels:                a.GetLabels(),
+		Annotations:           a.GetAnnotations(),
+	}
+	if a.GetLastSyncedAt() != nil {
+		t := a.GetLastSyncedAt().AsTime()
+		app.LastSyncedAt = &t
+	}
+	return app
+}
+
+func protoScheduledSessionToSDK(ss *pb.ScheduledSession) types.ScheduledSession {
+	if ss == nil {
+		return types.ScheduledSession{}
+	}
+	sched := types.ScheduledSession{
+		ObjectReference:   objectReferenceToSDK(ss.GetMetadata()),
+		Name:              ss.GetName(),
+		Description:       ss.GetDescription(),
+		ProjectID:         ss.GetProjectId(),
+		AgentID:           ss.GetAgentId(),
+		Schedule:          ss.GetSchedule(),
+		Timezone:          ss.GetTimezone(),
+		Enabled:           ss.GetEnabled(),
+		SessionPrompt:     ss.GetSessionPrompt(),
+		Timeout:           ss.GetTimeout(),
+		InactivityTimeout: ss.GetInactivityTimeout(),
+		StopOnRunFinished: ss.GetStopOnRunFinished(),
+		RunnerType:        ss.GetRunnerType(),
+	}
+	if ss.GetLastRunAt() != nil {
+		t := ss.GetLastRunAt().AsTime()
+		sched.LastRunAt = &t
+	}
+	if ss.GetNextRunAt() != nil {
+		t := ss.GetNextRunAt().AsTime()
+		sched.NextRunAt = &t
+	}
+	return sched
+}
@@ -1,10 +1,14 @@
 package informer
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	pb "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/watcher"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -141,3 +145,166 @@ func TestProtoSessionToSDK_AllFieldsMapped(t *testing.T) {
 		t.Errorf("CompletionTime: got %v, want %v", s.CompletionTime, later)
 	}
 }
+
+func TestProtoRoleBindingToSDK_OptionalIDs(t *testing.T) {
+	rb := protoRoleBindingToSDK(&pb.RoleBinding{
+		Metadata:     &pb.ObjectReference{Id: "rb-1"},
+		RoleId:       "role-1",
+		Scope:        "credential",
+		ProjectId:    strPtr("my-project"),
+		CredentialId: strPtr("cred-1"),
+	})
+
+	if rb.ID != "rb-1" || rb.RoleID != "role-1" || rb.Scope != "credential" {
+		t.Errorf("unexpected binding: %+v", rb)
+	}
+	if rb.ProjectID == nil || *rb.ProjectID != "my-project" {
+		t.Errorf("ProjectID: got %v, want my-project", rb.ProjectID)
+	}
+	if rb.CredentialID == nil || *rb.CredentialID != "cred-1" {
+		t.Errorf("CredentialID: got %v, want cred-1", rb.CredentialID)
+	}
+	if rb.UserID != nil || rb.AgentID != nil || rb.SessionID != nil {
+		t.Error("unset optional IDs should stay nil")
+	}
+}
+
+func TestProtoScheduledSessionToSDK_Timestamps(t *testing.T) {
+	last := time.Now().Add(-time.Hour).Truncate(time.Second)
+	next := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	ss := protoScheduledSessionToSDK(&pb.ScheduledSession{
+		Metadata:  &pb.ObjectReference{Id: "sched-1"},
+		Name:      "nightly",
+		ProjectId: "my-project",
+		Schedule:  "0 2 * * *",
+		Timezone:  "UTC",
+		Enabled:   true,
+		LastRunAt: timestamppb.New(last),
+		NextRunAt: timestamppb.New(next),
+		Timeout:   int32Ptr(600),
+	})
+
+	if ss.ID != "sched-1" || ss.Schedule != "0 2 * * *" || !ss.Enabled || ss.Timeout != 600 {
+		t.Errorf("unexpected scheduled session: %+v", ss)
+	}
+	if ss.LastRunAt == nil || !ss.LastRunAt.Equal(last) {
+		t.Errorf("LastRunAt: got %v, want %v", ss.LastRunAt, last)
+	}
+	if ss.NextRunAt == nil || !ss.NextRunAt.Equal(next) {
+		t.Errorf("NextRunAt: got %v, want %v", ss.NextRunAt, next)
+	}
+}
+
+func TestHandleCredentialWatch_UpdateCarriesOldObject(t *testing.T) {
+	inf := New(nil, nil, zerolog.Nop())
+	ctx := context.Background()
+
+	cred := &pb.Credential{Metadata: &pb.ObjectReference{Id: "cred-1"}, Name: "github", Provider: "github"}
+	if err := inf.handleCredentialWatch(ctx, watcher.CredentialWatchEvent{Type: watcher.EventCreated, ResourceID: "cred-1", Credential: cred}); err != nil {
+		t.Fatal(err)
+	}
+	added := <-inf.eventCh
+	if added.Type != EventAdded || added.Resource != "credentials" || added.Object.GetID() != "cred-1" {
+		t.Fatalf("unexpected ADDED event: %+v", added)
+	}
+
+	rotated := &pb.Credential{Metadata: &pb.ObjectReference{Id: "cred-1"}, Name: "github", Provider: "github", Description: strPtr("rotated")}
+	if err := inf.handleCredentialWatch(ctx, watcher.CredentialWatchEvent{Type: watcher.EventUpdated, ResourceID: "cred-1", Credential: rotated}); err != nil {
+		t.Fatal(err)
+	}
+	modified := <-inf.eventCh
+	if modified.Type != EventModified {
+		t.Fatalf("got %s, want MODIFIED", modified.Type)
+	}
+	if modified.Object.Credential.Description != "rotated" || modified.OldObject.Credential.Description != "" {
+		t.Errorf("MODIFIED event did not carry old and new objects: %+v", modified)
+	}
+
+	if err := inf.handleCredentialWatch(ctx, watcher.CredentialWatchEvent{Type: watcher.EventDeleted, ResourceID: "cred-1"}); err != nil {
+		t.Fatal(err)
+	}
+	deleted := <-inf.eventCh
+	if deleted.Type != EventDeleted || deleted.Object.Credential.Name != "github" {
+		t.Errorf("DELETED event should carry the cached object: %+v", deleted)
+	}
+	if _, ok := inf.credentialCache["cred-1"]; ok {
+		t.Error("credential should be evicted from cache after DELETE")
+	}
+}
+
+func TestWatching_OnlyRegisteredResources(t *testing.T) {
+	inf := New(nil, nil, zerolog.Nop())
+	inf.RegisterHandler("agents", func(context.Context, ResourceEvent) error { return nil })
+
+	if !inf.watching("agents") {
+		t.Error("agents has a handler and should be watched")
+	}
+	if inf.watching("credentials") {
+		t.Error("credentials has no handler and should not be watched")
+	}
+}
+
+func TestResync_ReplaysCachedObjects(t *testing.T) {
+	inf := New(nil, nil, zerolog.Nop())
+	inf.sessionCache["s1"] = types.Session{ObjectReference: types.ObjectReference{ID: "s1"}, ProjectID: "p1"}
+	inf.sessionCache["s2"] = types.Session{ObjectReference: types.ObjectReference{ID: "s2"}, ProjectID: "p2"}
+	inf.projectCache["p1"] = types.Project{ObjectReference: types.ObjectReference{ID: "p1"}}
+
+	inf.Resync(context.Background(), "sessions", "projects")
+
+	got := map[string]int{}
+	for len(inf.eventCh) > 0 {
+		event := <-inf.eventCh
+		if event.Type != EventAdded {
+			t.Errorf("resync event type: got %s, want ADDED", event.Type)
+		}
+		got[event.Resource]++
+	}
+	if got["sessions"] != 2 || got["projects"] != 1 {
+		t.Errorf("unexpected resync events: %v", got)
+	}
+}
+
+func TestCredentialWatchEvent_ReachesRegisteredHandler(t *testing.T) {
+	inf := New(nil, watcher.NewWatchManager(nil, nil, zerolog.Nop()), zerolog.Nop())
+	got := make(chan ResourceEvent, 1)
+	inf.RegisterHandler("credentials", func(_ context.Context, event ResourceEvent) error {
+		got <- event
+		return nil
+	})
+	inf.wireWatchHandlers()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inf.dispatchLoop(ctx)
+
+	cred := &pb.Credential{Metadata: &pb.ObjectReference{Id: "cred-1"}, Name: "github", Provider: "github"}
+	if err := inf.handleCredentialWatch(ctx, watcher.CredentialWatchEvent{Type: watcher.EventCreated, ResourceID: "cred-1", Credential: cred}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-got:
+		if event.Type != EventAdded || event.Object.Credential == nil || event.Object.Credential.ID != "cred-1" {
+			t.Errorf("unexpected event at handler: %+v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("credential event did not reach the registered handler")
+	}
+}
+
+func TestRequeueSessions_ReplaysMatchingSessions(t *testing.T) {
+	inf := New(nil, nil, zerolog.Nop())
+	inf.sessionCache["s1"] = types.Session{ObjectReference: types.ObjectReference{ID: "s1"}, AgentID: "agent-1"}
+	inf.sessionCache["s2"] = types.Session{ObjectReference: types.ObjectReference{ID: "s2"}, AgentID: "agent-2"}
+
+	n := inf.RequeueSessions(context.Background(), func(s types.Session) bool { return s.AgentID == "agent-1" })
+	if n != 1 || len(inf.eventCh) != 1 {
+		t.Fatalf("requeued %d sessions (%d queued), want 1", n, len(inf.eventCh))
+	}
+	event := <-inf.eventCh
+	if event.Type != EventModified || event.Resource != "sessions" || event.Object.GetID() != "s1" {
+		t.Errorf("unexpected requeue event: %+v", event)
+	}
+}
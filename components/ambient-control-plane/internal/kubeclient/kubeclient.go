@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -93,6 +94,21 @@ func New(kubeconfig string, logger zerolog.Logger) (*KubeClient, error) {
 	return kc, nil
 }
 
+// NewClientset returns a typed clientset built from the same kubeconfig
+// resolution as New. It is used for APIs the dynamic client is awkward for,
+// such as coordination.k8s.io Leases.
+func NewClientset(kubeconfig string) (kubernetes.Interface, error) {
+	cfg, err := buildRestConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("building kubeconfig: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating clientset: %w", err)
+	}
+	return clientset, nil
+}
+
 func buildRestConfig(kubeconfig string) (*rest.Config, error) {
 	if kubeconfig != "" {
 		return clientcmd.BuildConfigFromFlags("", kubeconfig)
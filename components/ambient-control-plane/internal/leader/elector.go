@@ -0,0 +1,256 @@
+// Package leader coordinates control plane replicas through Kubernetes
+// Leases.
+//
+// Every replica campaigns for a primary lease. The holder owns
+// cluster-wide work (project and project-settings reconciliation, the
+// application syncer) and, unless sharding is enabled, every session.
+//
+// With sharding enabled, sessions are partitioned by hashing their project
+// ID into ShardCount shards. A replica is statically assigned ShardIndex
+// (usually its StatefulSet ordinal) and campaigns for that shard's lease
+// too, so at most one process reconciles a shard's sessions at a time and a
+// duplicate replica stands by as a hot spare.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	DefaultLeaseName     = "ambient-control-plane"
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewDeadline = 10 * time.Second
+	DefaultRetryPeriod   = 2 * time.Second
+)
+
+type Config struct {
+	Namespace     string
+	LeaseName     string
+	Identity      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+	// ShardCount > 0 enables sharded session reconciliation; this replica
+	// reconciles the sessions of projects that hash to ShardIndex.
+	ShardCount int
+	ShardIndex int
+}
+
+func (c Config) validate() error {
+	if c.Namespace == "" {
+		return fmt.Errorf("lease namespace is required")
+	}
+	if c.LeaseName == "" {
+		return fmt.Errorf("lease name is required")
+	}
+	if c.Identity == "" {
+		return fmt.Errorf("identity is required")
+	}
+	if c.LeaseDuration <= c.RenewDeadline {
+		return fmt.Errorf("lease duration %s must be greater than renew deadline %s", c.LeaseDuration, c.RenewDeadline)
+	}
+	if c.RenewDeadline <= c.RetryPeriod {
+		return fmt.Errorf("renew deadline %s must be greater than retry period %s", c.RenewDeadline, c.RetryPeriod)
+	}
+	if c.ShardCount < 0 {
+		return fmt.Errorf("shard count must not be negative, got %d", c.ShardCount)
+	}
+	if c.ShardCount > 0 && (c.ShardIndex < 0 || c.ShardIndex >= c.ShardCount) {
+		return fmt.Errorf("shard index %d out of range for %d shards", c.ShardIndex, c.ShardCount)
+	}
+	return nil
+}
+
+// Elector tracks this replica's primary and shard leases.
+type Elector struct {
+	client kubernetes.Interface
+	cfg    Config
+	logger zerolog.Logger
+
+	leading     atomic.Bool
+	holdsShard  atomic.Bool
+	mu          sync.Mutex
+	onLeading   []func(context.Context)
+	onShardHeld []func(context.Context)
+}
+
+func New(client kubernetes.Interface, cfg Config, logger zerolog.Logger) (*Elector, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid leader election config: %w", err)
+	}
+	return &Elector{
+		client: client,
+		cfg:    cfg,
+		logger: logger.With().Str("component", "leader-elector").Str("identity", cfg.Identity).Logger(),
+	}, nil
+}
+
+// OnStartedLeading registers fn to run, in its own goroutine, each time the
+// primary lease is acquired. Its context is cancelled when the lease is lost.
+func (e *Elector) OnStartedLeading(fn func(ctx context.Context)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onLeading = append(e.onLeading, fn)
+}
+
+// OnAcquiredShard registers fn to run each time this replica's shard lease
+// is acquired. It is never called when sharding is disabled.
+func (e *Elector) OnAcquiredShard(fn func(ctx context.Context)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onShardHeld = append(e.onShardHeld, fn)
+}
+
+// Run campaigns for the primary lease, and the shard lease when sharding is
+// enabled, until ctx is cancelled. A lost lease is campaigned for again.
+// Leases are released on shutdown so a standby takes over promptly.
+func (e *Elector) Run(ctx context.Context) error {
+	e.logger.Info().
+		Str("namespace", e.cfg.Namespace).
+		Str("lease", e.cfg.LeaseName).
+		Int("shard_count", e.cfg.ShardCount).
+		Int("shard_index", e.cfg.ShardIndex).
+		Msg("leader election started")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		e.campaign(ctx, e.cfg.LeaseName, &e.leading, func() []func(context.Context) {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			return append([]func(context.Context){}, e.onLeading...)
+		})
+	}()
+
+	if e.Sharded() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.campaign(ctx, ShardLeaseName(e.cfg.LeaseName, e.cfg.ShardIndex), &e.holdsShard, func() []func(context.Context) {
+				e.mu.Lock()
+				defer e.mu.Unlock()
+				return append([]func(context.Context){}, e.onShardHeld...)
+			})
+		}()
+	}
+
+	wg.Wait()
+	e.logger.Info().Msg("leader election stopped")
+	return ctx.Err()
+}
+
+func (e *Elector) campaign(ctx context.Context, leaseName string, held *atomic.Bool, callbacks func() []func(context.Context)) {
+	log := e.logger.With().Str("lease", leaseName).Logger()
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Namespace: e.cfg.Namespace, Name: leaseName},
+		Client:     e.client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: e.cfg.Identity},
+	}
+
+	for ctx.Err() == nil {
+		le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			Name:            leaseName,
+			LeaseDuration:   e.cfg.LeaseDuration,
+			RenewDeadline:   e.cfg.RenewDeadline,
+			RetryPeriod:     e.cfg.RetryPeriod,
+			ReleaseOnCancel: true,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(leadCtx context.Context) {
+					held.Store(true)
+					log.Info().Msg("acquired lease")
+					for _, fn := range callbacks() {
+						go fn(leadCtx)
+					}
+				},
+				OnStoppedLeading: func() {
+					if held.Swap(false) {
+						log.Warn().Msg("lost lease")
+					}
+				},
+				OnNewLeader: func(identity string) {
+					if identity != e.cfg.Identity {
+						log.Info().Str("holder", identity).Msg("lease held by another replica")
+					}
+				},
+			},
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("creating leader elector")
+			return
+		}
+		le.Run(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(e.cfg.RetryPeriod):
+		}
+	}
+}
+
+// IsLeader reports whether this replica holds the primary lease.
+func (e *Elector) IsLeader() bool {
+	return e.leading.Load()
+}
+
+// Sharded reports whether session reconciliation is split across replicas.
+func (e *Elector) Sharded() bool {
+	return e.cfg.ShardCount > 0
+}
+
+// OwnsProject reports whether this replica currently reconciles the sessions
+// of projectID: the primary lease holder when sharding is disabled, else the
+// holder of the project's shard lease.
+func (e *Elector) OwnsProject(projectID string) bool {
+	if !e.Sharded() {
+		return e.IsLeader()
+	}
+	return e.holdsShard.Load() && ShardFor(projectID, e.cfg.ShardCount) == e.cfg.ShardIndex
+}
+
+// Status is the leadership state reported by the readiness endpoint.
+type Status struct {
+	Identity   string `json:"identity"`
+	Leader     bool   `json:"leader"`
+	ShardCount int    `json:"shard_count,omitempty"`
+	ShardIndex *int   `json:"shard_index,omitempty"`
+	ShardHeld  bool   `json:"shard_held,omitempty"`
+}
+
+func (e *Elector) Status() Status {
+	st := Status{Identity: e.cfg.Identity, Leader: e.IsLeader()}
+	if e.Sharded() {
+		idx := e.cfg.ShardIndex
+		st.ShardCount = e.cfg.ShardCount
+		st.ShardIndex = &idx
+		st.ShardHeld = e.holdsShard.Load()
+	}
+	return st
+}
+
+// ShardFor maps a project ID onto one of shards partitions.
+func ShardFor(projectID string, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(projectID))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// ShardLeaseName is the Lease guarding shard index of the named election.
+func ShardLeaseName(leaseName string, index int) string {
+	return fmt.Sprintf("%s-shard-%d", leaseName, index)
+}
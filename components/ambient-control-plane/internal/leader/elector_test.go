@@ -0,0 +1,242 @@
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testConfig(identity string) Config {
+	return Config{
+		Namespace:     "ambient-code",
+		LeaseName:     DefaultLeaseName,
+		Identity:      identity,
+		LeaseDuration: 2 * time.Second,
+		RenewDeadline: time.Second,
+		RetryPeriod:   100 * time.Millisecond,
+	}
+}
+
+func newTestElector(t *testing.T, client kubernetes.Interface, cfg Config) *Elector {
+	t.Helper()
+	e, err := New(client, cfg, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return e
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func TestElector_SingleLeader(t *testing.T) {
+	client := fake.NewClientset()
+	a := newTestElector(t, client, testConfig("cp-a"))
+	b := newTestElector(t, client, testConfig("cp-b"))
+
+	started := make(chan struct{}, 1)
+	a.OnStartedLeading(func(context.Context) { started <- struct{}{} })
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	doneA := make(chan struct{})
+	go func() { _ = a.Run(ctxA); close(doneA) }()
+	waitFor(t, "cp-a to lead", a.IsLeader)
+	go func() { _ = b.Run(ctxB) }()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStartedLeading callback not run")
+	}
+
+	lease, err := client.CoordinationV1().Leases("ambient-code").Get(context.Background(), DefaultLeaseName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get lease: %v", err)
+	}
+	if got := *lease.Spec.HolderIdentity; got != "cp-a" {
+		t.Errorf("lease holder: got %q, want cp-a", got)
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	if b.IsLeader() {
+		t.Fatal("expected cp-b to follow while cp-a holds the lease")
+	}
+	if b.OwnsProject("any") {
+		t.Error("a follower must not own sessions")
+	}
+
+	// Shutting down releases the lease, so the follower takes over.
+	cancelA()
+	<-doneA
+	if a.IsLeader() {
+		t.Error("expected cp-a to stop leading after shutdown")
+	}
+	waitFor(t, "cp-b to take over", b.IsLeader)
+	if !b.OwnsProject("any") {
+		t.Error("unsharded leader should own every project")
+	}
+}
+
+func TestElector_Sharded(t *testing.T) {
+	client := fake.NewClientset()
+	cfg0 := testConfig("cp-0")
+	cfg0.ShardCount, cfg0.ShardIndex = 2, 0
+	cfg1 := testConfig("cp-1")
+	cfg1.ShardCount, cfg1.ShardIndex = 2, 1
+	e0 := newTestElector(t, client, cfg0)
+	e1 := newTestElector(t, client, cfg1)
+
+	acquired := make(chan struct{}, 1)
+	e1.OnAcquiredShard(func(context.Context) { acquired <- struct{}{} })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = e0.Run(ctx) }()
+	waitFor(t, "cp-0 to lead", e0.IsLeader)
+	go func() { _ = e1.Run(ctx) }()
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnAcquiredShard callback not run")
+	}
+	waitFor(t, "cp-0 to hold its shard", func() bool { return e0.Status().ShardHeld })
+
+	// Find one project per shard.
+	projects := map[int]string{}
+	for i := 0; len(projects) < 2; i++ {
+		p := "project-" + string(rune('a'+i))
+		projects[ShardFor(p, 2)] = p
+	}
+
+	if !e0.OwnsProject(projects[0]) || e0.OwnsProject(projects[1]) {
+		t.Errorf("cp-0 should own only shard 0 projects")
+	}
+	if !e1.OwnsProject(projects[1]) || e1.OwnsProject(projects[0]) {
+		t.Errorf("cp-1 should own only shard 1 projects")
+	}
+	if e1.IsLeader() {
+		t.Error("expected cp-1 to follow on the primary lease")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CoordinationV1().Leases("ambient-code").Get(context.Background(), ShardLeaseName(DefaultLeaseName, i), metav1.GetOptions{}); err != nil {
+			t.Errorf("expected shard %d lease: %v", i, err)
+		}
+	}
+}
+
+func TestShardFor_Stable(t *testing.T) {
+	for _, p := range []string{"alpha", "beta", "gamma"} {
+		got := ShardFor(p, 4)
+		if got < 0 || got >= 4 {
+			t.Fatalf("ShardFor(%q, 4) = %d out of range", p, got)
+		}
+		if again := ShardFor(p, 4); again != got {
+			t.Errorf("ShardFor(%q) not stable: %d then %d", p, got, again)
+		}
+	}
+	if got := ShardFor("alpha", 0); got != 0 {
+		t.Errorf("ShardFor with no shards: got %d, want 0", got)
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	cases := map[string]func(*Config){
+		"missing identity":       func(c *Config) { c.Identity = "" },
+		"renew exceeds duration": func(c *Config) { c.RenewDeadline = 3 * time.Second },
+		"shard index too large":  func(c *Config) { c.ShardCount, c.ShardIndex = 2, 2 },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := testConfig("cp-a")
+			mutate(&cfg)
+			if _, err := New(fake.NewClientset(), cfg, zerolog.Nop()); err == nil {
+				t.Error("expected config error")
+			}
+		})
+	}
+}
+
+func TestHandlers_GateOnOwnership(t *testing.T) {
+	cfg := testConfig("cp-0")
+	cfg.ShardCount, cfg.ShardIndex = 2, 0
+	e := newTestElector(t, fake.NewClientset(), cfg)
+
+	var calls int
+	count := func(context.Context, informer.ResourceEvent) error { calls++; return nil }
+
+	var owned, other string
+	for i := 0; owned == "" || other == ""; i++ {
+		p := "project-" + string(rune('a'+i))
+		if ShardFor(p, 2) == 0 {
+			owned = p
+		} else {
+			other = p
+		}
+	}
+	sessionEvent := func(projectID string) informer.ResourceEvent {
+		return informer.ResourceEvent{Resource: "sessions", Object: informer.ResourceObject{Session: &types.Session{ProjectID: projectID}}}
+	}
+
+	sessions := e.OwnedSessions(count)
+	leaderOnly := e.LeaderOnly(count)
+	ctx := context.Background()
+
+	_ = sessions(ctx, sessionEvent(owned))
+	_ = leaderOnly(ctx, informer.ResourceEvent{Resource: "projects"})
+	if calls != 0 {
+		t.Fatalf("expected no handling before any lease is held, got %d calls", calls)
+	}
+
+	e.holdsShard.Store(true)
+	e.leading.Store(true)
+	_ = sessions(ctx, sessionEvent(owned))
+	_ = sessions(ctx, sessionEvent(other))
+	_ = leaderOnly(ctx, informer.ResourceEvent{Resource: "projects"})
+	if calls != 2 {
+		t.Errorf("expected owned session and leader-only event handled, got %d calls", calls)
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	cfg := testConfig("cp-1")
+	cfg.ShardCount, cfg.ShardIndex = 3, 1
+	e := newTestElector(t, fake.NewClientset(), cfg)
+	e.holdsShard.Store(true)
+
+	rec := httptest.NewRecorder()
+	e.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200 for a follower", rec.Code)
+	}
+	var st Status
+	if err := json.Unmarshal(rec.Body.Bytes(), &st); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if st.Identity != "cp-1" || st.Leader || st.ShardCount != 3 || st.ShardIndex == nil || *st.ShardIndex != 1 || !st.ShardHeld {
+		t.Errorf("unexpected status: %+v", st)
+	}
+}
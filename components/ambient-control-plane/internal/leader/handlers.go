@@ -0,0 +1,45 @@
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
+)
+
+// LeaderOnly wraps an informer handler so events are only handled while this
+// replica holds the primary lease. Events dropped meanwhile are replayed by
+// the informer's Resync when the lease is acquired.
+func (e *Elector) LeaderOnly(handler informer.EventHandler) informer.EventHandler {
+	return func(ctx context.Context, event informer.ResourceEvent) error {
+		if !e.IsLeader() {
+			return nil
+		}
+		return handler(ctx, event)
+	}
+}
+
+// OwnedSessions wraps a session handler so only sessions of projects this
+// replica owns are handled.
+func (e *Elector) OwnedSessions(handler informer.EventHandler) informer.EventHandler {
+	return func(ctx context.Context, event informer.ResourceEvent) error {
+		session := event.Object.Session
+		if session == nil {
+			session = event.OldObject.Session
+		}
+		if session == nil || !e.OwnsProject(session.ProjectID) {
+			return nil
+		}
+		return handler(ctx, event)
+	}
+}
+
+// ReadinessHandler reports the replica's leadership state as JSON. It always
+// answers 200: followers stay ready because they keep serving CP tokens.
+func (e *Elector) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(e.Status())
+	})
+}
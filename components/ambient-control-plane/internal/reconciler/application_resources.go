@@ -0,0 +1,668 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+const (
+	ResourceSynced    = "Synced"
+	ResourceOutOfSync = "OutOfSync"
+	ResourceSkipped   = "Skipped"
+
+	ResourceHealthy  = "Healthy"
+	ResourceMissing  = "Missing"
+	ResourceDegraded = "Degraded"
+	ResourceUnknown  = "Unknown"
+
+	ResourceCreated    = "created"
+	ResourceConfigured = "configured"
+	ResourceUnchanged  = "unchanged"
+
+	// LabelApplicationID marks agents and credentials created or adopted by
+	// an application. Pruning only deletes resources carrying the label with
+	// the application's ID, so a tampered resource_status cannot point the
+	// control plane at someone else's resource.
+	LabelApplicationID = "ambient-code.io/application-id"
+)
+
+// errNotOwned reports a recorded resource that does not belong to the
+// application being pruned.
+var errNotOwned = errors.New("not owned by this application")
+
+// ApplicationResourceStatus is one entry of Application.ResourceStatus. The
+// list written after each sync is also the record of which resources the
+// application manages, so it drives pruning on the next sync; prune still
+// checks ownership on the live resource before deleting anything.
+type ApplicationResourceStatus struct {
+	Kind            string `json:"kind"`
+	Name            string `json:"name"`
+	ID              string `json:"id,omitempty"`
+	Status          string `json:"status"`
+	Health          string `json:"health"`
+	Message         string `json:"message,omitempty"`
+	RequiresPruning bool   `json:"requires_pruning,omitempty"`
+}
+
+func (r ApplicationResourceStatus) key() string {
+	return strings.ToLower(r.Kind) + "/" + r.Name
+}
+
+func parseResourceStatus(raw string) []ApplicationResourceStatus {
+	if raw == "" {
+		return nil
+	}
+	var out []ApplicationResourceStatus
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// kindOrder applies resources so that references resolve: the project first,
+// then credentials and agents, then the bindings that point at them.
+var kindOrder = map[string]int{
+	"project":     0,
+	"credential":  1,
+	"agent":       2,
+	"rolebinding": 3,
+}
+
+func sortManifests(docs []manifest.Resource) []manifest.Resource {
+	sorted := append([]manifest.Resource(nil), docs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return kindRank(sorted[i].Kind) < kindRank(sorted[j].Kind)
+	})
+	return sorted
+}
+
+func kindRank(kind string) int {
+	if r, ok := kindOrder[strings.ToLower(kind)]; ok {
+		return r
+	}
+	return len(kindOrder)
+}
+
+// applicationTarget resolves and mutates resources in an application's
+// destination project. With apply unset it only reports drift.
+type applicationTarget struct {
+	client        *sdkclient.Client
+	owner         string
+	project       string
+	projectID     string
+	createProject bool
+	apply         bool
+}
+
+// sync reconciles one document. Kinds that acpctl apply understands but an
+// Application does not sync are reported as Skipped rather than failing.
+func (t *applicationTarget) sync(ctx context.Context, doc manifest.Resource) (ApplicationResourceStatus, error) {
+	switch strings.ToLower(doc.Kind) {
+	case "project":
+		return t.syncProject(ctx, doc)
+	case "credential":
+		return t.syncCredential(ctx, doc)
+	case "agent":
+		return t.syncAgent(ctx, doc)
+	case "rolebinding":
+		return t.syncRoleBinding(ctx, doc)
+	default:
+		return ApplicationResourceStatus{
+			Kind:    doc.Kind,
+			Name:    doc.DisplayName(),
+			Status:  ResourceSkipped,
+			Health:  ResourceUnknown,
+			Message: "kind is not synced by Application",
+		}, nil
+	}
+}
+
+// result reports a resource that exists (id set) or is absent (id empty).
+// changed means live state differs from the document; when applying, the
+// difference has just been reconciled by op.
+func (t *applicationTarget) result(doc manifest.Resource, id string, changed bool, op string) ApplicationResourceStatus {
+	res := ApplicationResourceStatus{Kind: doc.Kind, Name: doc.DisplayName(), ID: id, Status: ResourceSynced, Health: ResourceHealthy}
+	switch {
+	case !changed:
+		res.Message = ResourceUnchanged
+	case t.apply:
+		res.Message = op
+	case id == "":
+		res.Status = ResourceOutOfSync
+		res.Health = ResourceMissing
+		res.Message = "missing"
+	default:
+		res.Status = ResourceOutOfSync
+		res.Health = ResourceDegraded
+		res.Message = "differs from source"
+	}
+	return res
+}
+
+func (t *applicationTarget) syncProject(ctx context.Context, doc manifest.Resource) (ApplicationResourceStatus, error) {
+	if doc.Name != t.project {
+		return ApplicationResourceStatus{}, fmt.Errorf("project %q is outside the destination project %q", doc.Name, t.project)
+	}
+	existing, err := t.client.Projects().Get(ctx, doc.Name)
+	if err != nil {
+		if !isNotFound(err) {
+			return ApplicationResourceStatus{}, fmt.Errorf("get project: %w", err)
+		}
+		if !t.apply {
+			return t.result(doc, "", true, ResourceCreated), nil
+		}
+		id, err := t.createDestination(ctx, doc)
+		if err != nil {
+			return ApplicationResourceStatus{}, err
+		}
+		return t.result(doc, id, true, ResourceCreated), nil
+	}
+
+	t.projectID = existing.ID
+	patch := metadataPatch(doc, existing.Labels, existing.Annotations)
+	if doc.Description != "" && doc.Description != existing.Description {
+		patch["description"] = doc.Description
+	}
+	if doc.Prompt != "" && doc.Prompt != existing.Prompt {
+		patch["prompt"] = doc.Prompt
+	}
+	if len(patch) > 0 && t.apply {
+		if _, err := t.client.Projects().Update(ctx, existing.ID, patch); err != nil {
+			return ApplicationResourceStatus{}, fmt.Errorf("update project: %w", err)
+		}
+	}
+	return t.result(doc, existing.ID, len(patch) > 0, ResourceConfigured), nil
+}
+
+// createDestination creates the destination project, which is only allowed
+// with the CreateProject=true sync option.
+func (t *applicationTarget) createDestination(ctx context.Context, doc manifest.Resource) (string, error) {
+	if !t.createProject {
+		return "", fmt.Errorf("destination project %q does not exist; set CreateProject=true in sync_options to create it", t.project)
+	}
+	builder := types.NewProjectBuilder().Name(t.project)
+	if doc.Description != "" {
+		builder = builder.Description(doc.Description)
+	}
+	if doc.Prompt != "" {
+		builder = builder.Prompt(doc.Prompt)
+	}
+	proj, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+	created, err := t.client.Projects().Create(ctx, proj)
+	if err != nil {
+		return "", fmt.Errorf("create project: %w", err)
+	}
+	if patch := metadataPatch(doc, "", ""); len(patch) > 0 {
+		if _, err := t.client.Projects().Update(ctx, created.ID, patch); err != nil {
+			return "", fmt.Errorf("label project: %w", err)
+		}
+	}
+	t.projectID = created.ID
+	return created.ID, nil
+}
+
+// resolveProject looks up the destination project once. During a dry-run
+// comparison it may legitimately be missing; when applying it is created
+// on demand if the sync options allow it.
+func (t *applicationTarget) resolveProject(ctx context.Context) (string, error) {
+	if t.projectID != "" {
+		return t.projectID, nil
+	}
+	proj, err := t.client.Projects().Get(ctx, t.project)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", fmt.Errorf("get project %q: %w", t.project, err)
+		}
+		if !t.apply {
+			return "", nil
+		}
+		return t.createDestination(ctx, manifest.Resource{Kind: manifest.KindProject, Name: t.project})
+	}
+	t.projectID = proj.ID
+	return proj.ID, nil
+}
+
+func (t *applicationTarget) syncAgent(ctx context.Context, doc manifest.Resource) (ApplicationResourceStatus, error) {
+	projectID, err := t.resolveProject(ctx)
+	if err != nil {
+		return ApplicationResourceStatus{}, err
+	}
+	if projectID == "" {
+		return t.result(doc, "", true, ResourceCreated), nil
+	}
+
+	existing, err := t.findAgent(ctx, projectID, doc.Name)
+	if err != nil {
+		return ApplicationResourceStatus{}, err
+	}
+	if existing == nil {
+		if !t.apply {
+			return t.result(doc, "", true, ResourceCreated), nil
+		}
+		doc = t.withOwner(doc, "")
+		builder := types.NewAgentBuilder().ProjectID(projectID).Name(doc.Name)
+		if doc.Prompt != "" {
+			builder = builder.Prompt(doc.Prompt)
+		}
+		if len(doc.Labels) > 0 {
+			builder = builder.Labels(marshalStringMap(doc.Labels))
+		}
+		if len(doc.Annotations) > 0 {
+			builder = builder.Annotations(marshalStringMap(doc.Annotations))
+		}
+		agent, err := builder.Build()
+		if err != nil {
+			return ApplicationResourceStatus{}, err
+		}
+		created, err := t.client.Agents().CreateInProject(ctx, projectID, agent)
+		if err != nil {
+			return ApplicationResourceStatus{}, fmt.Errorf("create agent: %w", err)
+		}
+		if err := t.seedInbox(ctx, projectID, created.ID, doc.Inbox); err != nil {
+			return ApplicationResourceStatus{}, err
+		}
+		return t.result(doc, created.ID, true, ResourceCreated), nil
+	}
+
+	patch := metadataPatch(t.withOwner(doc, existing.Labels), existing.Labels, existing.Annotations)
+	if doc.Prompt != "" && doc.Prompt != existing.Prompt {
+		patch["prompt"] = doc.Prompt
+	}
+	if len(patch) > 0 && t.apply {
+		if _, err := t.client.Agents().UpdateInProject(ctx, projectID, existing.ID, patch); err != nil {
+			return ApplicationResourceStatus{}, fmt.Errorf("update agent: %w", err)
+		}
+	}
+	if t.apply {
+		if err := t.seedInbox(ctx, projectID, existing.ID, doc.Inbox); err != nil {
+			return ApplicationResourceStatus{}, err
+		}
+	}
+	return t.result(doc, existing.ID, len(patch) > 0, ResourceConfigured), nil
+}
+
+func (t *applicationTarget) findAgent(ctx context.Context, projectID, name string) (*types.Agent, error) {
+	list, err := t.client.Agents().ListByProject(ctx, projectID, &types.ListOptions{Size: 100, Search: fmt.Sprintf("name = '%s'", name)})
+	if err != nil {
+		return nil, fmt.Errorf("list agents: %w", err)
+	}
+	for i := range list.Items {
+		if list.Items[i].Name == name {
+			return &list.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (t *applicationTarget) seedInbox(ctx context.Context, projectID, agentID string, seeds []manifest.InboxSeed) error {
+	if len(seeds) == 0 {
+		return nil
+	}
+	existing, err := t.client.Agents().ListInboxInProject(ctx, projectID, agentID)
+	if err != nil {
+		return fmt.Errorf("list inbox: %w", err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, msg := range existing {
+		seen[msg.FromName+"\x00"+msg.Body] = true
+	}
+	for _, seed := range seeds {
+		if seen[seed.FromName+"\x00"+seed.Body] {
+			continue
+		}
+		if err := t.client.Agents().SendInboxInProject(ctx, projectID, agentID, seed.FromName, seed.Body); err != nil {
+			return fmt.Errorf("seed inbox: %w", err)
+		}
+	}
+	return nil
+}
+
+// syncCredential never expands $VARS in token: the control plane's own
+// environment must not leak into tenant credentials. Tokens that reference a
+// variable are left for the owner to set out of band.
+func (t *applicationTarget) syncCredential(ctx context.Context, doc manifest.Resource) (ApplicationResourceStatus, error) {
+	existing, err := t.findCredential(ctx, doc.Name)
+	if err != nil {
+		return ApplicationResourceStatus{}, err
+	}
+	token := doc.Token
+	if strings.Contains(token, "$") {
+		token = ""
+	}
+
+	if existing == nil {
+		if !t.apply {
+			return t.result(doc, "", true, ResourceCreated), nil
+		}
+		doc = t.withOwner(doc, "")
+		builder := types.NewCredentialBuilder().Name(doc.Name).Provider(doc.Provider)
+		if token != "" {
+			builder = builder.Token(token)
+		}
+		if doc.Description != "" {
+			builder = builder.Description(doc.Description)
+		}
+		if doc.URL != "" {
+			builder = builder.URL(doc.URL)
+		}
+		if doc.Email != "" {
+			builder = builder.Email(doc.Email)
+		}
+		if len(doc.Labels) > 0 {
+			builder = builder.Labels(marshalStringMap(doc.Labels))
+		}
+		if len(doc.Annotations) > 0 {
+			builder = builder.Annotations(marshalStringMap(doc.Annotations))
+		}
+		cred, err := builder.Build()
+		if err != nil {
+			return ApplicationResourceStatus{}, err
+		}
+		created, err := t.client.Credentials().Create(ctx, cred)
+		if err != nil {
+			return ApplicationResourceStatus{}, fmt.Errorf("create credential: %w", err)
+		}
+		return t.result(doc, created.ID, true, ResourceCreated), nil
+	}
+
+	patch := metadataPatch(t.withOwner(doc, existing.Labels), existing.Labels, existing.Annotations)
+	if doc.Description != "" && doc.Description != existing.Description {
+		patch["description"] = doc.Description
+	}
+	if doc.URL != "" && doc.URL != existing.URL {
+		patch["url"] = doc.URL
+	}
+	if doc.Email != "" && doc.Email != existing.Email {
+		patch["email"] = doc.Email
+	}
+	if len(patch) > 0 && t.apply {
+		if _, err := t.client.Credentials().Update(ctx, existing.ID, patch); err != nil {
+			return ApplicationResourceStatus{}, fmt.Errorf("update credential: %w", err)
+		}
+	}
+	return t.result(doc, existing.ID, len(patch) > 0, ResourceConfigured), nil
+}
+
+func (t *applicationTarget) findCredential(ctx context.Context, name string) (*types.Credential, error) {
+	list, err := t.client.Credentials().List(ctx, &types.ListOptions{Size: 100, Search: fmt.Sprintf("name = '%s'", name)})
+	if err != nil {
+		return nil, fmt.Errorf("list credentials: %w", err)
+	}
+	var match *types.Credential
+	for i := range list.Items {
+		if list.Items[i].Name != name {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("multiple credentials named %q", name)
+		}
+		match = &list.Items[i]
+	}
+	return match, nil
+}
+
+func (t *applicationTarget) syncRoleBinding(ctx context.Context, doc manifest.Resource) (ApplicationResourceStatus, error) {
+	if doc.Role == "" || doc.Scope == "" || doc.ScopeID == "" || doc.UserID == "" {
+		return ApplicationResourceStatus{}, fmt.Errorf("role, scope, scope_id and user_id are required")
+	}
+	roles, err := t.client.Roles().List(ctx, &types.ListOptions{Size: 1, Search: fmt.Sprintf("name = '%s'", doc.Role)})
+	if err != nil {
+		return ApplicationResourceStatus{}, fmt.Errorf("search roles for %q: %w", doc.Role, err)
+	}
+	if len(roles.Items) == 0 {
+		return ApplicationResourceStatus{}, fmt.Errorf("role %q not found", doc.Role)
+	}
+	roleID := roles.Items[0].ID
+
+	scopeFK, err := t.resolveScope(ctx, doc.Scope, doc.ScopeID)
+	if err != nil {
+		return ApplicationResourceStatus{}, err
+	}
+	if scopeFK == "" {
+		// The scope target is created by this same sync; nothing to compare yet.
+		if t.apply {
+			return ApplicationResourceStatus{}, fmt.Errorf("%s %q not found", doc.Scope, doc.ScopeID)
+		}
+		return t.result(doc, "", true, ResourceCreated), nil
+	}
+
+	search := fmt.Sprintf("role_id = '%s' and user_id = '%s'", roleID, doc.UserID)
+	it := t.client.RoleBindings().ListAll(ctx, &types.ListOptions{Size: 100, Search: search})
+	for it.Next() {
+		rb := it.Item()
+		if rb.Scope == doc.Scope && scopeFKMatches(rb, doc.Scope, scopeFK) {
+			return t.result(doc, rb.ID, false, ""), nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return ApplicationResourceStatus{}, fmt.Errorf("list role bindings: %w", err)
+	}
+
+	if !t.apply {
+		return t.result(doc, "", true, ResourceCreated), nil
+	}
+	builder := types.NewRoleBindingBuilder().RoleID(roleID).Scope(doc.Scope).UserID(doc.UserID)
+	switch doc.Scope {
+	case "credential":
+		builder = builder.CredentialID(scopeFK)
+	case "project":
+		builder = builder.ProjectID(scopeFK)
+	case "agent":
+		builder = builder.ProjectID(t.projectID).AgentID(scopeFK)
+	}
+	rb, err := builder.Build()
+	if err != nil {
+		return ApplicationResourceStatus{}, err
+	}
+	created, err := t.client.RoleBindings().Create(ctx, rb)
+	if err != nil {
+		return ApplicationResourceStatus{}, fmt.Errorf("create role binding: %w", err)
+	}
+	return t.result(doc, created.ID, true, ResourceCreated), nil
+}
+
+// resolveScope returns the ID of the binding's scope target, or "" when it
+// does not exist yet.
+func (t *applicationTarget) resolveScope(ctx context.Context, scope, scopeID string) (string, error) {
+	switch scope {
+	case "project":
+		if scopeID != t.project {
+			return "", fmt.Errorf("project scope %q is outside the destination project %q", scopeID, t.project)
+		}
+		return t.resolveProject(ctx)
+	case "credential":
+		cred, err := t.findCredential(ctx, scopeID)
+		if err != nil || cred == nil {
+			return "", err
+		}
+		return cred.ID, nil
+	case "agent":
+		projectID, err := t.resolveProject(ctx)
+		if err != nil || projectID == "" {
+			return "", err
+		}
+		agent, err := t.findAgent(ctx, projectID, scopeID)
+		if err != nil || agent == nil {
+			return "", err
+		}
+		return agent.ID, nil
+	default:
+		return "", fmt.Errorf("unsupported scope %q", scope)
+	}
+}
+
+// withOwner returns doc with the application's ownership label added. When
+// the document declares no labels the live ones are kept, so adopting a
+// resource does not wipe labels the source never managed.
+func (t *applicationTarget) withOwner(doc manifest.Resource, liveLabels string) manifest.Resource {
+	if t.owner == "" {
+		return doc
+	}
+	base := doc.Labels
+	if len(base) == 0 && liveLabels != "" {
+		_ = json.Unmarshal([]byte(liveLabels), &base)
+	}
+	labels := make(map[string]string, len(base)+1)
+	for k, v := range base {
+		labels[k] = v
+	}
+	labels[LabelApplicationID] = t.owner
+	doc.Labels = labels
+	return doc
+}
+
+func (t *applicationTarget) owns(labels string) bool {
+	var parsed map[string]string
+	if labels == "" || json.Unmarshal([]byte(labels), &parsed) != nil {
+		return false
+	}
+	return t.owner != "" && parsed[LabelApplicationID] == t.owner
+}
+
+// prune deletes a resource recorded by a previous sync. Projects are never
+// pruned: the destination project outlives the application. The record is
+// only a hint: the live resource must be in the destination project and
+// carry this application's ownership label, otherwise errNotOwned is
+// returned and nothing is deleted.
+func (t *applicationTarget) prune(ctx context.Context, res ApplicationResourceStatus) error {
+	if res.ID == "" {
+		return nil
+	}
+	var err error
+	switch strings.ToLower(res.Kind) {
+	case "agent":
+		projectID, resolveErr := t.resolveProject(ctx)
+		if resolveErr != nil || projectID == "" {
+			return resolveErr
+		}
+		agent, getErr := t.client.Agents().GetByProject(ctx, projectID, res.ID)
+		if getErr != nil {
+			err = getErr
+			break
+		}
+		if agent.ProjectID != projectID || !t.owns(agent.Labels) {
+			return fmt.Errorf("prune agent/%s: %w", res.Name, errNotOwned)
+		}
+		err = t.client.Agents().DeleteInProject(ctx, projectID, res.ID)
+	case "credential":
+		owned, checkErr := t.ownsCredential(ctx, res.ID)
+		if checkErr != nil {
+			err = checkErr
+			break
+		}
+		if !owned {
+			return fmt.Errorf("prune credential/%s: %w", res.Name, errNotOwned)
+		}
+		err = t.client.Credentials().Delete(ctx, res.ID)
+	case "rolebinding":
+		owned, checkErr := t.ownsRoleBinding(ctx, res.ID)
+		if checkErr != nil {
+			err = checkErr
+			break
+		}
+		if !owned {
+			return fmt.Errorf("prune rolebinding/%s: %w", res.Name, errNotOwned)
+		}
+		err = t.client.RoleBindings().Delete(ctx, res.ID)
+	default:
+		return nil
+	}
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("prune %s/%s: %w", strings.ToLower(res.Kind), res.Name, err)
+	}
+	return nil
+}
+
+func (t *applicationTarget) ownsCredential(ctx context.Context, id string) (bool, error) {
+	cred, err := t.client.Credentials().Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return t.owns(cred.Labels), nil
+}
+
+// ownsRoleBinding reports whether a binding is scoped to something the
+// application manages: the destination project, an agent in it, or a
+// credential carrying the ownership label. Bindings have no labels of their
+// own, so the scope target stands in for them.
+func (t *applicationTarget) ownsRoleBinding(ctx context.Context, id string) (bool, error) {
+	rb, err := t.client.RoleBindings().Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	switch rb.Scope {
+	case "project", "agent":
+		projectID, err := t.resolveProject(ctx)
+		if err != nil {
+			return false, err
+		}
+		return projectID != "" && rb.ProjectID != nil && *rb.ProjectID == projectID, nil
+	case "credential":
+		if rb.CredentialID == nil {
+			return false, nil
+		}
+		owned, err := t.ownsCredential(ctx, *rb.CredentialID)
+		if isNotFound(err) {
+			return false, nil
+		}
+		return owned, err
+	default:
+		return false, nil
+	}
+}
+
+func scopeFKMatches(rb types.RoleBinding, scope, fk string) bool {
+	var got *string
+	switch scope {
+	case "credential":
+		got = rb.CredentialID
+	case "project":
+		got = rb.ProjectID
+	case "agent":
+		got = rb.AgentID
+	}
+	return got != nil && *got == fk
+}
+
+// metadataPatch returns label/annotation updates for doc relative to the
+// stored JSON strings. Like acpctl apply, omitted maps are left untouched.
+func metadataPatch(doc manifest.Resource, labels, annotations string) map[string]any {
+	patch := map[string]any{}
+	if len(doc.Labels) > 0 && !stringMapEquals(labels, doc.Labels) {
+		patch["labels"] = marshalStringMap(doc.Labels)
+	}
+	if len(doc.Annotations) > 0 && !stringMapEquals(annotations, doc.Annotations) {
+		patch["annotations"] = marshalStringMap(doc.Annotations)
+	}
+	return patch
+}
+
+func stringMapEquals(raw string, want map[string]string) bool {
+	var got map[string]string
+	if raw == "" || json.Unmarshal([]byte(raw), &got) != nil {
+		return false
+	}
+	return reflect.DeepEqual(got, want)
+}
+
+func marshalStringMap(m map[string]string) string {
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+func isNotFound(err error) bool {
+	var apiErr *types.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 404
+}
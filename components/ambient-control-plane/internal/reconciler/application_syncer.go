@@ -0,0 +1,560 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/gitsource"
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/manifest"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	applicationPollInterval        = 15 * time.Second
+	defaultApplicationResync       = 3 * time.Minute
+	defaultApplicationRetryBackoff = 5 * time.Second
+	applicationListProject         = "default"
+	syncOptionCreateProject        = "CreateProject=true"
+)
+
+const (
+	AppSyncStatusSynced    = "Synced"
+	AppSyncStatusOutOfSync = "OutOfSync"
+	AppSyncStatusUnknown   = "Unknown"
+
+	AppHealthHealthy     = "Healthy"
+	AppHealthDegraded    = "Degraded"
+	AppHealthProgressing = "Progressing"
+
+	AppOperationRunning   = "Running"
+	AppOperationSucceeded = "Succeeded"
+	AppOperationFailed    = "Failed"
+
+	AppConditionComparisonError = "ComparisonError"
+	AppConditionSyncError       = "SyncError"
+	AppConditionOrphaned        = "OrphanedResources"
+)
+
+// ApplicationCondition is one entry of Application.Conditions. Only active
+// error conditions are recorded.
+type ApplicationCondition struct {
+	Type               string    `json:"type"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// ApplicationSyncer reconciles GitOps Applications: it checks out the source
+// repository, compares the rendered manifests against the destination project
+// and, when a sync is requested or auto-sync applies, creates, patches and
+// prunes resources to match.
+//
+// Applications are polled every applicationPollInterval so that `acpctl app
+// sync` (OperationPhase=Running) and `acpctl app refresh` (SyncStatus=Unknown)
+// are picked up promptly; otherwise each application is compared once per
+// resync interval.
+type ApplicationSyncer struct {
+	factory      *SDKClientFactory
+	fetcher      gitsource.Fetcher
+	resync       time.Duration
+	retryBackoff time.Duration
+	tracked      map[string]applicationTracking
+	now          func() time.Time
+	logger       zerolog.Logger
+}
+
+// applicationTracking is per-application memory between polls.
+type applicationTracking struct {
+	comparedAt time.Time
+	// updatedAt is the application's UpdatedAt after our last write, so a
+	// refresh request (SyncStatus set to Unknown by a user) can be told
+	// apart from the Unknown we write after a comparison error.
+	updatedAt time.Time
+	// failedRevision is the last revision whose sync exhausted its retries;
+	// auto-sync does not retry it until a new commit or a manual sync.
+	failedRevision string
+}
+
+func NewApplicationSyncer(factory *SDKClientFactory, fetcher gitsource.Fetcher, resync time.Duration, logger zerolog.Logger) *ApplicationSyncer {
+	if resync <= 0 {
+		resync = defaultApplicationResync
+	}
+	return &ApplicationSyncer{
+		factory:      factory,
+		fetcher:      fetcher,
+		resync:       resync,
+		retryBackoff: defaultApplicationRetryBackoff,
+		tracked:      make(map[string]applicationTracking),
+		now:          time.Now,
+		logger:       logger.With().Str("component", "application-syncer").Logger(),
+	}
+}
+
+func (s *ApplicationSyncer) Run(ctx context.Context) error {
+	s.logger.Info().Dur("poll_interval", applicationPollInterval).Dur("resync", s.resync).Msg("application syncer started")
+	ticker := time.NewTicker(applicationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info().Msg("application syncer stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			s.syncOnce(ctx)
+		}
+	}
+}
+
+func (s *ApplicationSyncer) syncOnce(ctx context.Context) {
+	apps, err := s.listApplications(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to list applications")
+		return
+	}
+
+	seen := make(map[string]bool, len(apps))
+	for _, app := range apps {
+		seen[app.ID] = true
+		if s.due(app) {
+			s.SyncApplication(ctx, app)
+		}
+	}
+	for id := range s.tracked {
+		if !seen[id] {
+			delete(s.tracked, id)
+		}
+	}
+}
+
+func (s *ApplicationSyncer) listApplications(ctx context.Context) ([]types.Application, error) {
+	sdk, err := s.factory.ForProject(ctx, applicationListProject)
+	if err != nil {
+		return nil, err
+	}
+	opts := &types.ListOptions{Size: 100, Page: 1}
+	var all []types.Application
+	for {
+		list, err := sdk.Applications().List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("list applications page %d: %w", opts.Page, err)
+		}
+		all = append(all, list.Items...)
+		if len(all) >= list.Total || len(list.Items) == 0 {
+			break
+		}
+		opts.Page++
+	}
+	return all, nil
+}
+
+func (s *ApplicationSyncer) due(app types.Application) bool {
+	if app.OperationPhase == AppOperationRunning {
+		return true
+	}
+	t, ok := s.tracked[app.ID]
+	if !ok {
+		return true
+	}
+	if app.SyncStatus == AppSyncStatusUnknown && app.UpdatedAt != nil && !app.UpdatedAt.Equal(t.updatedAt) {
+		return true
+	}
+	return s.now().Sub(t.comparedAt) >= s.resync
+}
+
+// applicationState accumulates the status fields written back after one pass.
+type applicationState struct {
+	syncStatus   string
+	health       string
+	revision     string
+	phase        string
+	message      string
+	resources    []ApplicationResourceStatus
+	conditions   map[string]string
+	lastSyncedAt *time.Time
+}
+
+// SyncApplication runs one refresh → diff → (sync) → status pass for an
+// application. Status is always written back, even on failure.
+func (s *ApplicationSyncer) SyncApplication(ctx context.Context, app types.Application) {
+	logger := s.logger.With().Str("application_id", app.ID).Str("application", app.Name).Logger()
+	tracking := s.tracked[app.ID]
+	tracking.comparedAt = s.now()
+	s.tracked[app.ID] = tracking
+
+	manual := app.OperationPhase == AppOperationRunning
+	state := applicationState{
+		syncStatus: app.SyncStatus,
+		health:     app.HealthStatus,
+		revision:   app.SyncRevision,
+		phase:      app.OperationPhase,
+		message:    app.OperationMessage,
+		resources:  parseResourceStatus(app.ResourceStatus),
+		conditions: map[string]string{},
+	}
+
+	fail := func(err error) {
+		logger.Warn().Err(err).Msg("application comparison failed")
+		state.syncStatus = AppSyncStatusUnknown
+		state.conditions[AppConditionComparisonError] = err.Error()
+		if manual {
+			state.phase = AppOperationFailed
+			state.message = err.Error()
+		}
+		s.writeStatus(ctx, app, state)
+	}
+
+	sdk, err := s.destinationClient(ctx, app)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	checkout, docs, err := s.loadSource(ctx, app)
+	if err != nil {
+		fail(err)
+		return
+	}
+	defer checkout.Cleanup()
+
+	managed := state.resources
+	diff, _, err := s.reconcile(ctx, sdk, app, docs, managed, false)
+	if err != nil {
+		fail(err)
+		return
+	}
+	state.resources = diff
+	state.syncStatus = aggregateSyncStatus(diff)
+
+	if s.shouldSync(app, checkout.Revision, state.syncStatus) {
+		logger.Info().Str("revision", checkout.Revision).Bool("manual", manual).Msg("syncing application")
+		app = s.writeStatus(ctx, app, applicationState{
+			syncStatus: state.syncStatus,
+			health:     AppHealthProgressing,
+			revision:   app.SyncRevision,
+			phase:      AppOperationRunning,
+			message:    "syncing to " + shortRevision(checkout.Revision),
+			resources:  state.resources,
+			conditions: map[string]string{},
+		})
+
+		applied, pruned, attempts, err := s.applyWithRetry(ctx, sdk, app, docs, managed)
+		tracking = s.tracked[app.ID]
+		if err != nil {
+			logger.Warn().Err(err).Int("attempts", attempts).Msg("application sync failed")
+			tracking.failedRevision = checkout.Revision
+			state.phase = AppOperationFailed
+			state.message = fmt.Sprintf("sync to %s failed after %d attempt(s): %v", shortRevision(checkout.Revision), attempts, err)
+			state.conditions[AppConditionSyncError] = err.Error()
+		} else {
+			now := s.now().UTC()
+			tracking.failedRevision = ""
+			state.resources = applied
+			state.syncStatus = aggregateSyncStatus(applied)
+			state.revision = checkout.Revision
+			state.phase = AppOperationSucceeded
+			state.message = operationSummary(applied, pruned)
+			state.lastSyncedAt = &now
+		}
+		s.tracked[app.ID] = tracking
+	}
+
+	var orphaned []string
+	for _, r := range state.resources {
+		if r.RequiresPruning {
+			orphaned = append(orphaned, strings.ToLower(r.Kind)+"/"+r.Name)
+		}
+	}
+	if len(orphaned) > 0 {
+		state.conditions[AppConditionOrphaned] = "not in source and auto_prune is disabled: " + strings.Join(orphaned, ", ")
+	}
+
+	state.health = aggregateHealth(state)
+	s.writeStatus(ctx, app, state)
+}
+
+// destinationClient returns a client for the destination project. A remote
+// destination_ambient_url is reached with the token of the application's
+// credential, resolved fresh on every pass.
+func (s *ApplicationSyncer) destinationClient(ctx context.Context, app types.Application) (*sdkclient.Client, error) {
+	local, err := s.factory.ForProject(ctx, app.DestinationProject)
+	if err != nil {
+		return nil, err
+	}
+	if app.DestinationAmbientURL == "" || strings.TrimRight(app.DestinationAmbientURL, "/") == strings.TrimRight(s.factory.baseURL, "/") {
+		return local, nil
+	}
+	if app.CredentialID == "" {
+		return nil, fmt.Errorf("credential_id is required when destination_ambient_url is set")
+	}
+	tok, err := local.Credentials().GetToken(ctx, app.CredentialID)
+	if err != nil {
+		return nil, fmt.Errorf("resolving destination credential %s: %w", app.CredentialID, err)
+	}
+	remote, err := sdkclient.NewClient(app.DestinationAmbientURL, tok.Token, app.DestinationProject, sdkclient.WithTimeout(sdkClientTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("creating client for %s: %w", app.DestinationAmbientURL, err)
+	}
+	return remote, nil
+}
+
+func (s *ApplicationSyncer) loadSource(ctx context.Context, app types.Application) (*gitsource.Checkout, []manifest.Resource, error) {
+	checkout, err := s.fetcher.Fetch(ctx, app.SourceRepoURL, app.SourceTargetRevision)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := checkout.Path(app.SourcePath)
+	if _, err := os.Stat(path); err != nil {
+		checkout.Cleanup()
+		return nil, nil, fmt.Errorf("source path %q not found at %s", app.SourcePath, shortRevision(checkout.Revision))
+	}
+	docs, err := manifest.Load(path)
+	if err != nil {
+		checkout.Cleanup()
+		return nil, nil, fmt.Errorf("rendering %q: %w", app.SourcePath, err)
+	}
+	return checkout, docs, nil
+}
+
+// shouldSync follows the usual GitOps rules: an explicit sync always runs;
+// auto-sync runs on a new revision, and with self-heal also when the
+// destination drifted. A revision whose sync exhausted its retries is not
+// retried automatically.
+func (s *ApplicationSyncer) shouldSync(app types.Application, revision, syncStatus string) bool {
+	if app.OperationPhase == AppOperationRunning {
+		return true
+	}
+	if !app.AutoSync || revision == s.tracked[app.ID].failedRevision {
+		return false
+	}
+	if revision != app.SyncRevision {
+		return true
+	}
+	return app.SelfHeal && syncStatus == AppSyncStatusOutOfSync
+}
+
+func (s *ApplicationSyncer) applyWithRetry(ctx context.Context, sdk *sdkclient.Client, app types.Application, docs []manifest.Resource, managed []ApplicationResourceStatus) ([]ApplicationResourceStatus, int, int, error) {
+	attempts := 1
+	if app.RetryLimit > 0 {
+		attempts += int(app.RetryLimit)
+	}
+	var lastErr error
+	for i := 1; i <= attempts; i++ {
+		applied, pruned, err := s.reconcile(ctx, sdk, app, docs, managed, true)
+		if err == nil {
+			return applied, pruned, i, nil
+		}
+		lastErr = err
+		if i == attempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, 0, i, ctx.Err()
+		case <-time.After(s.retryBackoff * time.Duration(i)):
+		}
+	}
+	return nil, 0, attempts, lastErr
+}
+
+// reconcile walks the desired resources in dependency order, then handles
+// resources recorded by the previous sync that are no longer in the source.
+// It returns the new resource status list and the number of resources pruned.
+func (s *ApplicationSyncer) reconcile(ctx context.Context, sdk *sdkclient.Client, app types.Application, docs []manifest.Resource, managed []ApplicationResourceStatus, apply bool) ([]ApplicationResourceStatus, int, error) {
+	target := &applicationTarget{
+		client:        sdk,
+		owner:         app.ID,
+		project:       app.DestinationProject,
+		createProject: hasSyncOption(app.SyncOptions, syncOptionCreateProject),
+		apply:         apply,
+	}
+
+	desired := make(map[string]bool, len(docs))
+	var results []ApplicationResourceStatus
+	for _, doc := range sortManifests(docs) {
+		res, err := target.sync(ctx, doc)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s/%s: %w", strings.ToLower(doc.Kind), doc.DisplayName(), err)
+		}
+		desired[res.key()] = true
+		results = append(results, res)
+	}
+
+	pruned := 0
+	for _, prev := range managed {
+		if desired[prev.key()] || prev.ID == "" || prev.Status == ResourceSkipped || strings.EqualFold(prev.Kind, manifest.KindProject) {
+			continue
+		}
+		if app.AutoPrune && apply {
+			if err := target.prune(ctx, prev); err != nil {
+				if errors.Is(err, errNotOwned) {
+					// Drop the record: the resource is not ours to delete or track.
+					s.logger.Warn().Err(err).Str("application_id", app.ID).Str("kind", prev.Kind).Str("id", prev.ID).Msg("refusing to prune resource")
+					continue
+				}
+				return nil, 0, err
+			}
+			pruned++
+			s.logger.Info().Str("application_id", app.ID).Str("kind", prev.Kind).Str("name", prev.Name).Msg("pruned resource")
+			continue
+		}
+		prev.Status = ResourceOutOfSync
+		prev.Health = ResourceHealthy
+		prev.Message = "not in source"
+		prev.RequiresPruning = !app.AutoPrune
+		results = append(results, prev)
+	}
+	return results, pruned, nil
+}
+
+func hasSyncOption(options, want string) bool {
+	for _, opt := range strings.Split(options, ",") {
+		if strings.EqualFold(strings.TrimSpace(opt), want) {
+			return true
+		}
+	}
+	return false
+}
+
+func aggregateSyncStatus(resources []ApplicationResourceStatus) string {
+	for _, r := range resources {
+		if r.Status == ResourceOutOfSync {
+			return AppSyncStatusOutOfSync
+		}
+	}
+	return AppSyncStatusSynced
+}
+
+// aggregateHealth is Degraded when the last sync failed or any synced
+// resource is missing or drifted; orphans left behind without auto_prune do
+// not count against health.
+func aggregateHealth(state applicationState) string {
+	if _, failed := state.conditions[AppConditionSyncError]; failed {
+		return AppHealthDegraded
+	}
+	for _, r := range state.resources {
+		if r.Health == ResourceMissing || r.Health == ResourceDegraded {
+			return AppHealthDegraded
+		}
+	}
+	return AppHealthHealthy
+}
+
+func operationSummary(resources []ApplicationResourceStatus, pruned int) string {
+	created, configured := 0, 0
+	for _, r := range resources {
+		switch r.Message {
+		case ResourceCreated:
+			created++
+		case ResourceConfigured:
+			configured++
+		}
+	}
+	return fmt.Sprintf("%d created, %d configured, %d pruned", created, configured, pruned)
+}
+
+func shortRevision(rev string) string {
+	if len(rev) > 12 {
+		return rev[:12]
+	}
+	return rev
+}
+
+// writeStatus patches only the fields that changed so that steady-state
+// comparisons do not churn the application's UpdatedAt. It returns the
+// application as last seen, which later writes in the same pass diff against.
+func (s *ApplicationSyncer) writeStatus(ctx context.Context, app types.Application, state applicationState) types.Application {
+	patch := types.NewApplicationPatchBuilder()
+	changed := false
+
+	set := func(current, next string, apply func(string) *types.ApplicationPatchBuilder) {
+		if current != next {
+			apply(next)
+			changed = true
+		}
+	}
+	set(app.SyncStatus, state.syncStatus, patch.SyncStatus)
+	set(app.HealthStatus, state.health, patch.HealthStatus)
+	set(app.SyncRevision, state.revision, patch.SyncRevision)
+	set(app.OperationPhase, state.phase, patch.OperationPhase)
+	set(app.OperationMessage, state.message, patch.OperationMessage)
+	set(app.ResourceStatus, marshalResources(state.resources), patch.ResourceStatus)
+	set(app.Conditions, marshalJSON(s.buildConditions(app.Conditions, state.conditions)), patch.Conditions)
+	if state.lastSyncedAt != nil {
+		patch.LastSyncedAt(state.lastSyncedAt)
+		changed = true
+	}
+	if !changed {
+		s.observe(app.ID, app.UpdatedAt)
+		return app
+	}
+
+	sdk, err := s.factory.ForProject(ctx, applicationListProject)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("application_id", app.ID).Msg("failed to get SDK client for status update")
+		return app
+	}
+	updated, err := sdk.Applications().Update(ctx, app.ID, patch.Build())
+	if err != nil {
+		s.logger.Warn().Err(err).Str("application_id", app.ID).Msg("failed to update application status")
+		return app
+	}
+	s.observe(app.ID, updated.UpdatedAt)
+	return *updated
+}
+
+func (s *ApplicationSyncer) observe(id string, updatedAt *time.Time) {
+	t := s.tracked[id]
+	if updatedAt != nil {
+		t.updatedAt = *updatedAt
+	}
+	s.tracked[id] = t
+}
+
+// buildConditions keeps LastTransitionTime stable for conditions that were
+// already active so an unchanged error does not rewrite the record.
+func (s *ApplicationSyncer) buildConditions(previous string, active map[string]string) []ApplicationCondition {
+	var prev []ApplicationCondition
+	if previous != "" {
+		_ = json.Unmarshal([]byte(previous), &prev)
+	}
+	since := make(map[string]time.Time, len(prev))
+	for _, c := range prev {
+		since[c.Type] = c.LastTransitionTime
+	}
+
+	conditions := []ApplicationCondition{}
+	for _, t := range []string{AppConditionComparisonError, AppConditionSyncError, AppConditionOrphaned} {
+		msg, ok := active[t]
+		if !ok {
+			continue
+		}
+		ts, ok := since[t]
+		if !ok {
+			ts = s.now().UTC().Truncate(time.Second)
+		}
+		conditions = append(conditions, ApplicationCondition{Type: t, Message: msg, LastTransitionTime: ts})
+	}
+	return conditions
+}
+
+func marshalResources(resources []ApplicationResourceStatus) string {
+	if resources == nil {
+		resources = []ApplicationResourceStatus{}
+	}
+	return marshalJSON(resources)
+}
+
+func marshalJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
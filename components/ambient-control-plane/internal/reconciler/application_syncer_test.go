@@ -0,0 +1,532 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/auth"
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/gitsource"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+// fakeAmbientAPI serves the subset of the API the application syncer uses.
+type fakeAmbientAPI struct {
+	mu            sync.Mutex
+	apps          map[string]*types.Application
+	projects      map[string]*types.Project
+	agents        map[string]*types.Agent
+	inbox         map[string][]types.InboxMessage
+	nextID        int
+	failAgentPOST int
+	agentPOSTs    int
+}
+
+func newFakeAmbientAPI() *fakeAmbientAPI {
+	return &fakeAmbientAPI{
+		apps:     map[string]*types.Application{},
+		projects: map[string]*types.Project{},
+		agents:   map[string]*types.Agent{},
+		inbox:    map[string][]types.InboxMessage{},
+	}
+}
+
+func (f *fakeAmbientAPI) id(prefix string) string {
+	f.nextID++
+	return fmt.Sprintf("%s-%d", prefix, f.nextID)
+}
+
+func (f *fakeAmbientAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/ambient/v1")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	body, _ := io.ReadAll(r.Body)
+
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(types.APIError{Code: "not_found", Reason: "not found"})
+	}
+	write := func(status int, v any) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	switch {
+	case parts[0] == "applications" && len(parts) == 1 && r.Method == http.MethodGet:
+		list := types.ApplicationList{}
+		for _, a := range f.apps {
+			list.Items = append(list.Items, *a)
+		}
+		list.Total = len(list.Items)
+		write(http.StatusOK, list)
+
+	case parts[0] == "applications" && len(parts) == 2 && r.Method == http.MethodPatch:
+		app, ok := f.apps[parts[1]]
+		if !ok {
+			notFound()
+			return
+		}
+		if err := json.Unmarshal(body, app); err != nil {
+			write(http.StatusBadRequest, types.APIError{Code: "bad_request", Reason: err.Error()})
+			return
+		}
+		now := time.Now()
+		app.UpdatedAt = &now
+		write(http.StatusOK, app)
+
+	case parts[0] == "projects" && len(parts) == 1 && r.Method == http.MethodPost:
+		var p types.Project
+		_ = json.Unmarshal(body, &p)
+		p.ID = p.Name
+		f.projects[p.Name] = &p
+		write(http.StatusCreated, p)
+
+	case parts[0] == "projects" && len(parts) == 2:
+		p, ok := f.projects[parts[1]]
+		if !ok {
+			notFound()
+			return
+		}
+		if r.Method == http.MethodPatch {
+			_ = json.Unmarshal(body, p)
+		}
+		write(http.StatusOK, p)
+
+	case parts[0] == "projects" && len(parts) == 3 && parts[2] == "agents" && r.Method == http.MethodGet:
+		list := types.AgentList{}
+		for _, a := range f.agents {
+			if a.ProjectID == parts[1] {
+				list.Items = append(list.Items, *a)
+			}
+		}
+		list.Total = len(list.Items)
+		write(http.StatusOK, list)
+
+	case parts[0] == "projects" && len(parts) == 3 && parts[2] == "agents" && r.Method == http.MethodPost:
+		f.agentPOSTs++
+		if f.failAgentPOST > 0 {
+			f.failAgentPOST--
+			write(http.StatusServiceUnavailable, types.APIError{Code: "unavailable", Reason: "try again"})
+			return
+		}
+		var a types.Agent
+		_ = json.Unmarshal(body, &a)
+		a.ID = f.id("agent")
+		f.agents[a.ID] = &a
+		write(http.StatusCreated, a)
+
+	case parts[0] == "projects" && len(parts) == 4 && parts[2] == "agents":
+		a, ok := f.agents[parts[3]]
+		if !ok {
+			notFound()
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			_ = json.Unmarshal(body, a)
+			write(http.StatusOK, a)
+		case http.MethodDelete:
+			delete(f.agents, a.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			write(http.StatusOK, a)
+		}
+
+	case parts[0] == "projects" && len(parts) == 5 && parts[4] == "inbox":
+		if r.Method == http.MethodPost {
+			var msg types.InboxMessage
+			_ = json.Unmarshal(body, &msg)
+			f.inbox[parts[3]] = append(f.inbox[parts[3]], msg)
+			write(http.StatusCreated, msg)
+			return
+		}
+		write(http.StatusOK, types.InboxMessageList{Items: f.inbox[parts[3]]})
+
+	default:
+		notFound()
+	}
+}
+
+func (f *fakeAmbientAPI) app(id string) types.Application {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return *f.apps[id]
+}
+
+func (f *fakeAmbientAPI) agentNames() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var names []string
+	for _, a := range f.agents {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "init.defaultBranch=main"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// sourceRepo is a local bare repository standing in for the application's
+// upstream, plus a working clone used to push new revisions to it.
+type sourceRepo struct {
+	t    *testing.T
+	bare string
+	work string
+}
+
+func newSourceRepo(t *testing.T) *sourceRepo {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	r := &sourceRepo{t: t, bare: filepath.Join(root, "fleet.git"), work: filepath.Join(root, "work")}
+	runGit(t, root, "init", "--quiet", "--bare", r.bare)
+	runGit(t, root, "clone", "--quiet", r.bare, r.work)
+	return r
+}
+
+// push replaces the repository's manifests/ directory with files and returns the new SHA.
+func (r *sourceRepo) push(files map[string]string) string {
+	r.t.Helper()
+	dir := filepath.Join(r.work, "manifests")
+	_ = os.RemoveAll(dir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		r.t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			r.t.Fatal(err)
+		}
+	}
+	runGit(r.t, r.work, "add", "-A")
+	runGit(r.t, r.work, "commit", "--quiet", "--allow-empty", "-m", "update manifests")
+	runGit(r.t, r.work, "push", "--quiet", "origin", "HEAD:main")
+	return runGit(r.t, r.work, "rev-parse", "HEAD")
+}
+
+func newTestApplicationSyncer(t *testing.T, api *fakeAmbientAPI) *ApplicationSyncer {
+	t.Helper()
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	factory := NewSDKClientFactory(srv.URL, auth.NewStaticTokenProvider("test-token-must-be-at-least-20-chars-long"), zerolog.Nop())
+	s := NewApplicationSyncer(factory, gitsource.NewGitFetcher(), time.Minute, zerolog.Nop())
+	s.retryBackoff = time.Millisecond
+	return s
+}
+
+const (
+	projectManifest = "kind: Project\nname: fleet\ndescription: fleet project\n"
+	leadManifest    = "kind: Agent\nname: lead\nprompt: lead the team\ninbox:\n  - from_name: bootstrap\n    body: hello\n"
+	devManifest     = "kind: Agent\nname: dev\nprompt: write code\nlabels:\n  tier: dev\n"
+)
+
+func addApp(api *fakeAmbientAPI, repo string, mutate func(*types.Application)) string {
+	app := &types.Application{
+		Name:                 "fleet",
+		SourceRepoURL:        repo,
+		SourcePath:           "manifests",
+		SourceTargetRevision: "main",
+		DestinationProject:   "fleet",
+		SyncOptions:          "CreateProject=true",
+	}
+	app.ID = "app-1"
+	if mutate != nil {
+		mutate(app)
+	}
+	api.apps[app.ID] = app
+	return app.ID
+}
+
+func decodeResources(t *testing.T, raw string) map[string]ApplicationResourceStatus {
+	t.Helper()
+	var list []ApplicationResourceStatus
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		t.Fatalf("resource_status %q: %v", raw, err)
+	}
+	out := map[string]ApplicationResourceStatus{}
+	for _, r := range list {
+		out[r.key()] = r
+	}
+	return out
+}
+
+func TestApplicationSyncer_AutoSyncCreatesThenPrunes(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	rev1 := repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest, "dev.yaml": devManifest})
+	id := addApp(api, repo.bare, func(a *types.Application) { a.AutoSync = true; a.AutoPrune = true })
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.SyncStatus != AppSyncStatusSynced || app.HealthStatus != AppHealthHealthy {
+		t.Fatalf("after first sync: sync=%s health=%s msg=%s", app.SyncStatus, app.HealthStatus, app.OperationMessage)
+	}
+	if app.SyncRevision != rev1 || app.OperationPhase != AppOperationSucceeded || app.LastSyncedAt == nil {
+		t.Fatalf("after first sync: revision=%s phase=%s last_synced=%v", app.SyncRevision, app.OperationPhase, app.LastSyncedAt)
+	}
+	if app.OperationMessage != "3 created, 0 configured, 0 pruned" {
+		t.Errorf("operation message = %q", app.OperationMessage)
+	}
+	if len(api.agentNames()) != 2 {
+		t.Fatalf("expected 2 agents, got %v", api.agentNames())
+	}
+	res := decodeResources(t, app.ResourceStatus)
+	if res["agent/lead"].Message != ResourceCreated || res["agent/lead"].ID == "" {
+		t.Errorf("lead status = %+v", res["agent/lead"])
+	}
+	if got := len(api.inbox[res["agent/lead"].ID]); got != 1 {
+		t.Errorf("expected 1 seeded inbox message, got %d", got)
+	}
+
+	rev2 := repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app = api.app(id)
+	if app.SyncRevision != rev2 || app.SyncStatus != AppSyncStatusSynced {
+		t.Fatalf("after second sync: revision=%s sync=%s msg=%s res=%s", app.SyncRevision, app.SyncStatus, app.OperationMessage, app.ResourceStatus)
+	}
+	if names := api.agentNames(); len(names) != 1 || names[0] != "lead" {
+		t.Fatalf("dev should have been pruned, agents = %v", names)
+	}
+	if _, ok := decodeResources(t, app.ResourceStatus)["agent/dev"]; ok {
+		t.Error("pruned agent still recorded in resource_status")
+	}
+	if app.OperationMessage != "0 created, 0 configured, 1 pruned" {
+		t.Errorf("operation message = %q", app.OperationMessage)
+	}
+}
+
+func TestApplicationSyncer_PruneSkipsResourcesItDoesNotOwn(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+	id := addApp(api, repo.bare, func(a *types.Application) { a.AutoSync = true; a.AutoPrune = true })
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+	if names := api.agentNames(); len(names) != 1 {
+		t.Fatalf("expected only the lead agent, got %v", names)
+	}
+	lead := decodeResources(t, api.app(id).ResourceStatus)["agent/lead"]
+
+	// A user creates an agent by hand and then plants it in resource_status,
+	// hoping the next sync prunes it as the application's own.
+	api.mu.Lock()
+	victim := &types.Agent{Name: "victim", ProjectID: "fleet"}
+	victim.ID = api.id("agent")
+	api.agents[victim.ID] = victim
+	tampered := []ApplicationResourceStatus{lead, {Kind: "Agent", Name: "victim", ID: victim.ID, Status: ResourceSynced, Health: ResourceHealthy}}
+	raw, _ := json.Marshal(tampered)
+	api.apps[id].ResourceStatus = string(raw)
+	api.mu.Unlock()
+
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest, "dev.yaml": devManifest})
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.OperationPhase != AppOperationSucceeded {
+		t.Fatalf("sync phase = %s: %s", app.OperationPhase, app.OperationMessage)
+	}
+	api.mu.Lock()
+	_, survived := api.agents[victim.ID]
+	api.mu.Unlock()
+	if !survived {
+		t.Fatal("agent without the application's ownership label was pruned")
+	}
+	if _, ok := decodeResources(t, app.ResourceStatus)["agent/victim"]; ok {
+		t.Error("unowned agent still recorded in resource_status")
+	}
+	if app.OperationMessage != "1 created, 0 configured, 0 pruned" {
+		t.Errorf("operation message = %q", app.OperationMessage)
+	}
+}
+
+func TestApplicationSyncer_WithoutAutoSyncOnlyReportsDrift(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+	id := addApp(api, repo.bare, nil)
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.SyncStatus != AppSyncStatusOutOfSync || app.HealthStatus != AppHealthDegraded {
+		t.Fatalf("sync=%s health=%s", app.SyncStatus, app.HealthStatus)
+	}
+	if app.SyncRevision != "" || app.OperationPhase != "" {
+		t.Fatalf("refresh must not record a sync: revision=%s phase=%s", app.SyncRevision, app.OperationPhase)
+	}
+	if len(api.projects) != 0 || len(api.agents) != 0 {
+		t.Fatalf("refresh must not create resources: projects=%d agents=%d", len(api.projects), len(api.agents))
+	}
+}
+
+func TestApplicationSyncer_ManualSyncWithoutPruneReportsOrphans(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest, "dev.yaml": devManifest})
+	id := addApp(api, repo.bare, func(a *types.Application) { a.OperationPhase = AppOperationRunning })
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+	if app := api.app(id); app.OperationPhase != AppOperationSucceeded {
+		t.Fatalf("phase=%s msg=%s", app.OperationPhase, app.OperationMessage)
+	}
+
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+	api.apps[id].OperationPhase = AppOperationRunning
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if len(api.agentNames()) != 2 {
+		t.Fatalf("agents must not be pruned without auto_prune: %v", api.agentNames())
+	}
+	if app.SyncStatus != AppSyncStatusOutOfSync {
+		t.Errorf("sync=%s, want OutOfSync while orphans remain", app.SyncStatus)
+	}
+	if !decodeResources(t, app.ResourceStatus)["agent/dev"].RequiresPruning {
+		t.Error("dev should be marked requires_pruning")
+	}
+	if !strings.Contains(app.Conditions, AppConditionOrphaned) {
+		t.Errorf("conditions = %s", app.Conditions)
+	}
+}
+
+func TestApplicationSyncer_RetriesUpToRetryLimit(t *testing.T) {
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+
+	t.Run("succeeds within limit", func(t *testing.T) {
+		api := newFakeAmbientAPI()
+		api.failAgentPOST = 2
+		id := addApp(api, repo.bare, func(a *types.Application) { a.AutoSync = true; a.RetryLimit = 2 })
+		s := newTestApplicationSyncer(t, api)
+
+		s.SyncApplication(context.Background(), api.app(id))
+
+		if app := api.app(id); app.OperationPhase != AppOperationSucceeded {
+			t.Fatalf("phase=%s msg=%s", app.OperationPhase, app.OperationMessage)
+		}
+		if api.agentPOSTs != 3 {
+			t.Errorf("agent create attempts = %d, want 3", api.agentPOSTs)
+		}
+	})
+
+	t.Run("fails after limit and does not auto-retry the same revision", func(t *testing.T) {
+		api := newFakeAmbientAPI()
+		api.failAgentPOST = 10
+		id := addApp(api, repo.bare, func(a *types.Application) { a.AutoSync = true; a.RetryLimit = 1 })
+		s := newTestApplicationSyncer(t, api)
+
+		s.SyncApplication(context.Background(), api.app(id))
+
+		app := api.app(id)
+		if app.OperationPhase != AppOperationFailed || app.HealthStatus != AppHealthDegraded {
+			t.Fatalf("phase=%s health=%s", app.OperationPhase, app.HealthStatus)
+		}
+		if !strings.Contains(app.OperationMessage, "2 attempt(s)") || !strings.Contains(app.Conditions, AppConditionSyncError) {
+			t.Errorf("msg=%s conditions=%s", app.OperationMessage, app.Conditions)
+		}
+		if api.agentPOSTs != 2 {
+			t.Errorf("agent create attempts = %d, want 2", api.agentPOSTs)
+		}
+
+		s.SyncApplication(context.Background(), api.app(id))
+		if api.agentPOSTs != 2 {
+			t.Errorf("failed revision was retried automatically: %d attempts", api.agentPOSTs)
+		}
+	})
+}
+
+func TestApplicationSyncer_ComparisonErrorIsReported(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"lead.yaml": leadManifest})
+	id := addApp(api, repo.bare, func(a *types.Application) {
+		a.OperationPhase = AppOperationRunning
+		a.SourceTargetRevision = "no-such-branch"
+	})
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.SyncStatus != AppSyncStatusUnknown || app.OperationPhase != AppOperationFailed {
+		t.Fatalf("sync=%s phase=%s", app.SyncStatus, app.OperationPhase)
+	}
+	if !strings.Contains(app.Conditions, AppConditionComparisonError) {
+		t.Errorf("conditions = %s", app.Conditions)
+	}
+	if s.due(app) {
+		t.Error("our own Unknown after a comparison error must not look like a refresh request")
+	}
+}
+
+func TestApplicationSyncer_RequiresCreateProjectOption(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{"project.yaml": projectManifest, "lead.yaml": leadManifest})
+	id := addApp(api, repo.bare, func(a *types.Application) {
+		a.OperationPhase = AppOperationRunning
+		a.SyncOptions = ""
+	})
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.OperationPhase != AppOperationFailed || !strings.Contains(app.OperationMessage, "CreateProject") {
+		t.Fatalf("phase=%s msg=%s", app.OperationPhase, app.OperationMessage)
+	}
+	if len(api.projects) != 0 {
+		t.Errorf("project created without CreateProject=true")
+	}
+}
+
+func TestApplicationSyncer_UnsupportedKindIsSkipped(t *testing.T) {
+	api := newFakeAmbientAPI()
+	repo := newSourceRepo(t)
+	repo.push(map[string]string{
+		"project.yaml": projectManifest,
+		"lead.yaml":    leadManifest,
+		"widget.yaml":  "kind: Widget\nname: spinner\n",
+	})
+	id := addApp(api, repo.bare, func(a *types.Application) { a.AutoSync = true })
+	s := newTestApplicationSyncer(t, api)
+
+	s.SyncApplication(context.Background(), api.app(id))
+
+	app := api.app(id)
+	if app.SyncStatus != AppSyncStatusSynced || app.HealthStatus != AppHealthHealthy {
+		t.Fatalf("sync=%s health=%s msg=%s", app.SyncStatus, app.HealthStatus, app.OperationMessage)
+	}
+	if got := decodeResources(t, app.ResourceStatus)["widget/spinner"]; got.Status != ResourceSkipped || got.Health != ResourceUnknown {
+		t.Errorf("widget status = %+v", got)
+	}
+}
@@ -0,0 +1,79 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+// credentialGrantProject is the project the SDK client is scoped to when
+// listing token-reader bindings by credential; credentials and their
+// bindings are not project-scoped.
+const credentialGrantProject = "default"
+
+// HandleCredential keeps the credential:token-reader grants of sessions in
+// step with the credentials they were granted. A session's pod keys its
+// credentials by provider when it starts, so a grant is revoked as soon as
+// its credential is deleted or moves to another provider rather than left to
+// the session's cleanup. Rotations need no action here: credential sidecars
+// re-read the token on every refresh and restart their server when it changed.
+func (r *SimpleKubeReconciler) HandleCredential(ctx context.Context, event informer.ResourceEvent) error {
+	cred := event.Object.Credential
+	if cred == nil {
+		r.logger.Warn().Msg("expected credential object in credential event")
+		return nil
+	}
+
+	switch event.Type {
+	case informer.EventDeleted:
+	case informer.EventModified:
+		old := event.OldObject.Credential
+		if old == nil || old.Provider == "" || old.Provider == cred.Provider {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	sdk, err := r.factory.ForProject(ctx, credentialGrantProject)
+	if err != nil {
+		return fmt.Errorf("credential %s: creating SDK client: %w", cred.ID, err)
+	}
+	return r.revokeCredentialGrants(ctx, sdk, cred.ID)
+}
+
+// revokeCredentialGrants deletes every session-scoped binding on the
+// credential, i.e. the token-reader grants made by grantTokenReaderBindings.
+func (r *SimpleKubeReconciler) revokeCredentialGrants(ctx context.Context, sdk *sdkclient.Client, credentialID string) error {
+	if err := validateTSLValue(credentialID); err != nil {
+		return fmt.Errorf("invalid credential_id: %w", err)
+	}
+	search := fmt.Sprintf("scope = 'credential' and credential_id = '%s'", credentialID)
+	it := sdk.RoleBindings().ListAll(ctx, &types.ListOptions{Size: 100, Search: search})
+	var grants []types.RoleBinding
+	for it.Next() {
+		if b := it.Item(); b.SessionID != nil && *b.SessionID != "" {
+			grants = append(grants, b)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("listing token-reader bindings for credential %s: %w", credentialID, err)
+	}
+
+	var errs int
+	for _, b := range grants {
+		if err := sdk.RoleBindings().Delete(ctx, b.ID); err != nil {
+			r.logger.Warn().Err(err).Str("binding_id", b.ID).Msg("failed to delete token-reader binding")
+			errs++
+			continue
+		}
+		r.logger.Info().Str("binding_id", b.ID).Str("credential_id", credentialID).Str("session_id", *b.SessionID).Msg("revoked credential:token-reader binding for changed credential")
+	}
+	if errs > 0 {
+		return fmt.Errorf("failed to revoke %d token-reader binding(s) for credential %s", errs, credentialID)
+	}
+	return nil
+}
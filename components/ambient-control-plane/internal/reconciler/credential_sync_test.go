@@ -0,0 +1,59 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+func TestRevokeCredentialGrants_DeletesOnlySessionBindings(t *testing.T) {
+	credID := "cred-a"
+	sessionID := "sess-1"
+	bindings := []types.RoleBinding{
+		{ObjectReference: types.ObjectReference{ID: "rb-owner"}, Scope: "credential", CredentialID: &credID},
+		{ObjectReference: types.ObjectReference{ID: "rb-grant"}, Scope: "credential", CredentialID: &credID, SessionID: &sessionID},
+	}
+
+	var mu sync.Mutex
+	var deleted []string
+	var search string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ambient/v1/role_bindings":
+			search = r.URL.Query().Get("search")
+			items := bindings
+			if page := r.URL.Query().Get("page"); page != "" && page != "1" {
+				items = nil
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"kind": "RoleBindingList", "page": 1, "size": len(items), "total": len(bindings), "items": items})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/ambient/v1/role_bindings/"):
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/ambient/v1/role_bindings/"))
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := newTestReconciler(zerolog.New(zerolog.NewTestWriter(t)))
+	if err := r.revokeCredentialGrants(context.Background(), newSDKClient(t, server.URL), credID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(search, "credential_id = 'cred-a'") {
+		t.Errorf("bindings were not listed by credential: search %q", search)
+	}
+	if len(deleted) != 1 || deleted[0] != "rb-grant" {
+		t.Errorf("deleted %v, want only the session grant rb-grant", deleted)
+	}
+}
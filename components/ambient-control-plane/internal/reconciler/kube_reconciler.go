@@ -247,6 +247,19 @@ func (r *SimpleKubeReconciler) deprovisionSession(ctx context.Context, session t
 	return nil
 }
 
+// StopSession records why the control plane is stopping a session, then
+// deprovisions it exactly as a user-requested stop would.
+func (r *SimpleKubeReconciler) StopSession(ctx context.Context, session types.Session, reason string) error {
+	sdk, err := r.factory.ForProject(ctx, session.ProjectID)
+	if err != nil {
+		return fmt.Errorf("session %s: creating SDK client for project %s: %w", session.ID, session.ProjectID, err)
+	}
+	if _, err := sdk.Sessions().UpdateStatus(ctx, session.ID, map[string]interface{}{"stopped_reason": reason}); err != nil {
+		return fmt.Errorf("session %s: recording stopped_reason: %w", session.ID, err)
+	}
+	return r.deprovisionSession(ctx, session, PhaseStopped)
+}
+
 func (r *SimpleKubeReconciler) cleanupSession(ctx context.Context, session types.Session) error {
 	namespace := r.namespaceForSession(session)
 	selector := sessionLabelSelector(session.ID)
@@ -545,7 +558,7 @@ func (r *SimpleKubeReconciler) ensurePod(ctx context.Context, namespace string,
 
 	containers := []interface{}{
 		map[string]interface{}{
-			"name":            "ambient-code-runner",
+			"name":            runnerContainerName,
 			"image":           runnerImage,
 			"imagePullPolicy": imagePullPolicy,
 			"ports": []interface{}{
@@ -557,16 +570,7 @@ func (r *SimpleKubeReconciler) ensurePod(ctx context.Context, namespace string,
 			},
 			"volumeMounts": r.buildVolumeMounts(),
 			"env":          r.buildEnv(ctx, session, sdk, useMCPSidecar, credentialIDs),
-			"resources": map[string]interface{}{
-				"requests": map[string]interface{}{
-					"cpu":    "500m",
-					"memory": "1Gi",
-				},
-				"limits": map[string]interface{}{
-					"cpu":    "2000m",
-					"memory": "4Gi",
-				},
-			},
+			"resources":    defaultRunnerResources().toUnstructured(),
 			"securityContext": map[string]interface{}{
 				"allowPrivilegeEscalation": false,
 				"capabilities": map[string]interface{}{
@@ -640,10 +644,26 @@ func (r *SimpleKubeReconciler) ensurePod(ctx context.Context, namespace string,
 		}
 	}
 
+	var overridesCondition *SessionCondition
+	if session.ResourceOverrides != "" {
+		overrides, err := r.resolveResourceOverrides(ctx, sdk, session)
+		if err != nil {
+			r.logger.Warn().Err(err).Str("session_id", session.ID).Msg("resource overrides rejected; using default resources")
+			overridesCondition = &SessionCondition{Type: ConditionResourceOverridesApplied, Status: "False", Reason: "OverridesRejected", Message: err.Error()}
+		} else if overrides != nil {
+			applyResourceOverrides(pod.Object["spec"].(map[string]interface{}), overrides)
+			overridesCondition = &SessionCondition{Type: ConditionResourceOverridesApplied, Status: "True", Reason: "OverridesApplied", Message: "resource overrides applied to session pod"}
+		}
+	}
+
 	if _, err := r.nsKube().CreatePod(ctx, pod); err != nil && !k8serrors.IsAlreadyExists(err) {
 		return fmt.Errorf("creating pod %s: %w", name, err)
 	}
 
+	if overridesCondition != nil {
+		r.setSessionCondition(ctx, sdk, session, *overridesCondition)
+	}
+
 	r.logger.Info().Str("pod", name).Str("namespace", namespace).Str("image", runnerImage).Msg("runner pod created")
 	return nil
 }
@@ -1288,16 +1308,7 @@ func (r *SimpleKubeReconciler) buildCredentialSidecars(sessionID string, namespa
 					"readOnly":  true,
 				},
 			},
-			"resources": map[string]interface{}{
-				"requests": map[string]interface{}{
-					"cpu":    "100m",
-					"memory": "256Mi",
-				},
-				"limits": map[string]interface{}{
-					"cpu":    "500m",
-					"memory": "512Mi",
-				},
-			},
+			"resources": defaultCredentialSidecarResources().toUnstructured(),
 			"securityContext": map[string]interface{}{
 				"allowPrivilegeEscalation": false,
 				"runAsNonRoot":             true,
@@ -1373,16 +1384,7 @@ func (r *SimpleKubeReconciler) buildMCPSidecar(sessionID string) interface{} {
 				"readOnly":  true,
 			},
 		},
-		"resources": map[string]interface{}{
-			"requests": map[string]interface{}{
-				"cpu":    "100m",
-				"memory": "128Mi",
-			},
-			"limits": map[string]interface{}{
-				"cpu":    "500m",
-				"memory": "256Mi",
-			},
-		},
+		"resources": defaultMCPSidecarResources().toUnstructured(),
 		"securityContext": map[string]interface{}{
 			"allowPrivilegeEscalation": false,
 			"capabilities": map[string]interface{}{
@@ -22,6 +22,7 @@ type PodStatusSyncer struct {
 	kube               *kubeclient.KubeClient
 	platformMode       string
 	mppConfigNamespace string
+	projectFilter      ProjectFilter
 	logger             zerolog.Logger
 }
 
@@ -35,6 +36,12 @@ func NewPodStatusSyncer(factory *SDKClientFactory, kube *kubeclient.KubeClient,
 	}
 }
 
+// SetProjectFilter limits syncing to pods of projects the filter owns, so
+// sharded replicas only update their own sessions' phases.
+func (s *PodStatusSyncer) SetProjectFilter(filter ProjectFilter) {
+	s.projectFilter = filter
+}
+
 func (s *PodStatusSyncer) Run(ctx context.Context) error {
 	s.logger.Info().Dur("interval", podSyncInterval).Msg("pod status syncer started")
 	ticker := time.NewTicker(podSyncInterval)
@@ -108,7 +115,7 @@ func (s *PodStatusSyncer) syncPod(ctx context.Context, namespace string, pod *un
 	labels := pod.GetLabels()
 	sessionID := labels["ambient-code.io/session-id"]
 	projectID := labels[LabelProjectID]
-	if sessionID == "" || projectID == "" {
+	if sessionID == "" || projectID == "" || !s.projectFilter.owns(projectID) {
 		return
 	}
 
@@ -0,0 +1,413 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	sdkclient "github.com/ambient-code/platform/components/ambient-sdk/go-sdk/client"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	runnerContainerName = "ambient-code-runner"
+
+	// ConditionResourceOverridesApplied records whether a session's
+	// resource_overrides were accepted. It is only written for sessions
+	// that request overrides.
+	ConditionResourceOverridesApplied = "ResourceOverridesApplied"
+)
+
+// overridableResources are the only container resources a session may set.
+// GPUs and other extended resources are not schedulable from sessions.
+var overridableResources = []string{"cpu", "memory", "ephemeral-storage"}
+
+// ContainerResources is a requests/limits pair keyed by resource name.
+type ContainerResources struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+// Toleration mirrors the subset of corev1.Toleration a session may request.
+type Toleration struct {
+	Key               string `json:"key"`
+	Operator          string `json:"operator,omitempty"`
+	Value             string `json:"value,omitempty"`
+	Effect            string `json:"effect,omitempty"`
+	TolerationSeconds *int64 `json:"toleration_seconds,omitempty"`
+}
+
+// ResourceOverrides is the document carried in Session.ResourceOverrides.
+// Top-level requests/limits apply to the runner container; Sidecars applies
+// to every other container in the pod.
+type ResourceOverrides struct {
+	ContainerResources
+	Sidecars      *ContainerResources `json:"sidecars,omitempty"`
+	NodeSelector  map[string]string   `json:"node_selector,omitempty"`
+	Tolerations   []Toleration        `json:"tolerations,omitempty"`
+	PriorityClass string              `json:"priority_class,omitempty"`
+}
+
+// ResourceLimits is the document stored in ProjectSettings.ResourceLimits:
+// the per-project ceilings that resource overrides are validated against.
+type ResourceLimits struct {
+	Max              map[string]string `json:"max,omitempty"`
+	PriorityClasses  []string          `json:"priority_classes,omitempty"`
+	NodeSelectorKeys []string          `json:"node_selector_keys,omitempty"`
+	TolerationKeys   []string          `json:"toleration_keys,omitempty"`
+}
+
+// SessionCondition is one entry of Session.Conditions.
+type SessionCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+func decodeStrict(raw string, v any) error {
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// parseResourceOverrides returns nil for an empty document.
+func parseResourceOverrides(raw string) (*ResourceOverrides, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "{}" || raw == "null" {
+		return nil, nil
+	}
+	var o ResourceOverrides
+	if err := decodeStrict(raw, &o); err != nil {
+		return nil, fmt.Errorf("resource_overrides is not valid: %w", err)
+	}
+	return &o, nil
+}
+
+// parseResourceLimits returns nil when the project has no ceilings configured.
+func parseResourceLimits(raw string) (*ResourceLimits, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "null" {
+		return nil, nil
+	}
+	var l ResourceLimits
+	if err := decodeStrict(raw, &l); err != nil {
+		return nil, fmt.Errorf("project resource_limits is not valid: %w", err)
+	}
+	return &l, nil
+}
+
+// validate checks the overrides against the project's ceilings after merging
+// them over the container defaults, so a request that exceeds a default
+// limit is caught here rather than by the Kubernetes API.
+func (o *ResourceOverrides) validate(limits *ResourceLimits) error {
+	if limits == nil {
+		return errors.New("project has no resource_limits configured in its ProjectSettings; resource overrides are not permitted")
+	}
+
+	var errs []error
+	errs = append(errs, validateContainerResources("runner", o.ContainerResources, limits, defaultRunnerResources())...)
+	if o.Sidecars != nil {
+		// The sidecar override is applied to every non-runner container, so it
+		// must be consistent with each of their defaults.
+		errs = append(errs, validateContainerResources("sidecars", *o.Sidecars, limits, defaultMCPSidecarResources(), defaultCredentialSidecarResources())...)
+	}
+
+	for _, key := range sortedKeys(o.NodeSelector) {
+		if !slices.Contains(limits.NodeSelectorKeys, key) {
+			errs = append(errs, fmt.Errorf("node_selector key %q is not allowed in this project", key))
+		}
+	}
+
+	for i, t := range o.Tolerations {
+		switch {
+		case t.Key == "":
+			errs = append(errs, fmt.Errorf("tolerations[%d]: key is required", i))
+		case !slices.Contains(limits.TolerationKeys, t.Key):
+			errs = append(errs, fmt.Errorf("tolerations[%d]: key %q is not allowed in this project", i, t.Key))
+		}
+		if t.Operator != "" && t.Operator != "Equal" && t.Operator != "Exists" {
+			errs = append(errs, fmt.Errorf("tolerations[%d]: operator must be Equal or Exists", i))
+		}
+		if t.Effect != "" && t.Effect != "NoSchedule" && t.Effect != "PreferNoSchedule" && t.Effect != "NoExecute" {
+			errs = append(errs, fmt.Errorf("tolerations[%d]: effect must be NoSchedule, PreferNoSchedule or NoExecute", i))
+		}
+	}
+
+	if o.PriorityClass != "" && !slices.Contains(limits.PriorityClasses, o.PriorityClass) {
+		errs = append(errs, fmt.Errorf("priority_class %q is not allowed in this project", o.PriorityClass))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateContainerResources(target string, cr ContainerResources, limits *ResourceLimits, defaults ...ContainerResources) []error {
+	var errs []error
+	for kind, values := range map[string]map[string]string{"requests": cr.Requests, "limits": cr.Limits} {
+		for _, name := range sortedKeys(values) {
+			if !slices.Contains(overridableResources, name) {
+				errs = append(errs, fmt.Errorf("%s.%s: resource %q cannot be overridden (allowed: %s)", target, kind, name, strings.Join(overridableResources, ", ")))
+				continue
+			}
+			q, err := resource.ParseQuantity(values[name])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s.%s: %w", target, kind, name, err))
+				continue
+			}
+			ceiling, ok := limits.Max[name]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s.%s.%s: project has no ceiling for %s", target, kind, name, name))
+				continue
+			}
+			max, err := resource.ParseQuantity(ceiling)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("project resource_limits.max.%s: %w", name, err))
+				continue
+			}
+			if q.Cmp(max) > 0 {
+				errs = append(errs, fmt.Errorf("%s.%s.%s %s exceeds the project ceiling %s", target, kind, name, q.String(), max.String()))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for _, d := range defaults {
+		merged := mergeContainerResources(d, cr)
+		for _, name := range sortedKeys(merged.Requests) {
+			limit, ok := merged.Limits[name]
+			if !ok {
+				continue
+			}
+			req, reqErr := resource.ParseQuantity(merged.Requests[name])
+			lim, limErr := resource.ParseQuantity(limit)
+			if reqErr != nil || limErr != nil || req.Cmp(lim) <= 0 {
+				continue
+			}
+			err := fmt.Errorf("%s: requests.%s %s exceeds limits.%s %s", target, name, req.String(), name, lim.String())
+			if !slices.ContainsFunc(errs, func(e error) bool { return e.Error() == err.Error() }) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+func defaultRunnerResources() ContainerResources {
+	return ContainerResources{
+		Requests: map[string]string{"cpu": "500m", "memory": "1Gi"},
+		Limits:   map[string]string{"cpu": "2000m", "memory": "4Gi"},
+	}
+}
+
+func defaultMCPSidecarResources() ContainerResources {
+	return ContainerResources{
+		Requests: map[string]string{"cpu": "100m", "memory": "128Mi"},
+		Limits:   map[string]string{"cpu": "500m", "memory": "256Mi"},
+	}
+}
+
+func defaultCredentialSidecarResources() ContainerResources {
+	return ContainerResources{
+		Requests: map[string]string{"cpu": "100m", "memory": "256Mi"},
+		Limits:   map[string]string{"cpu": "500m", "memory": "512Mi"},
+	}
+}
+
+func mergeContainerResources(base, override ContainerResources) ContainerResources {
+	merged := ContainerResources{Requests: map[string]string{}, Limits: map[string]string{}}
+	for k, v := range base.Requests {
+		merged.Requests[k] = v
+	}
+	for k, v := range base.Limits {
+		merged.Limits[k] = v
+	}
+	for k, v := range override.Requests {
+		merged.Requests[k] = v
+	}
+	for k, v := range override.Limits {
+		merged.Limits[k] = v
+	}
+	return merged
+}
+
+// toUnstructured renders resources in the shape the pod builder uses.
+func (cr ContainerResources) toUnstructured() map[string]interface{} {
+	out := map[string]interface{}{}
+	if len(cr.Requests) > 0 {
+		requests := map[string]interface{}{}
+		for k, v := range cr.Requests {
+			requests[k] = v
+		}
+		out["requests"] = requests
+	}
+	if len(cr.Limits) > 0 {
+		limits := map[string]interface{}{}
+		for k, v := range cr.Limits {
+			limits[k] = v
+		}
+		out["limits"] = limits
+	}
+	return out
+}
+
+// containerResourcesFrom reads back the resources the pod builder set.
+func containerResourcesFrom(container map[string]interface{}) ContainerResources {
+	cr := ContainerResources{Requests: map[string]string{}, Limits: map[string]string{}}
+	res, _ := container["resources"].(map[string]interface{})
+	for kind, dst := range map[string]map[string]string{"requests": cr.Requests, "limits": cr.Limits} {
+		values, _ := res[kind].(map[string]interface{})
+		for k, v := range values {
+			if s, ok := v.(string); ok {
+				dst[k] = s
+			}
+		}
+	}
+	return cr
+}
+
+// applyResourceOverrides writes validated overrides into the pod spec: the
+// runner container gets the top-level requests/limits, every other container
+// gets Sidecars, and the scheduling fields are set on the pod.
+func applyResourceOverrides(spec map[string]interface{}, o *ResourceOverrides) {
+	if o == nil {
+		return
+	}
+
+	containers, _ := spec["containers"].([]interface{})
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		override := o.ContainerResources
+		if container["name"] != runnerContainerName {
+			if o.Sidecars == nil {
+				continue
+			}
+			override = *o.Sidecars
+		}
+		container["resources"] = mergeContainerResources(containerResourcesFrom(container), override).toUnstructured()
+	}
+
+	if len(o.NodeSelector) > 0 {
+		selector := map[string]interface{}{}
+		for k, v := range o.NodeSelector {
+			selector[k] = v
+		}
+		spec["nodeSelector"] = selector
+	}
+
+	if len(o.Tolerations) > 0 {
+		tolerations := make([]interface{}, 0, len(o.Tolerations))
+		for _, t := range o.Tolerations {
+			tol := map[string]interface{}{"key": t.Key}
+			if t.Operator != "" {
+				tol["operator"] = t.Operator
+			}
+			if t.Value != "" {
+				tol["value"] = t.Value
+			}
+			if t.Effect != "" {
+				tol["effect"] = t.Effect
+			}
+			if t.TolerationSeconds != nil {
+				tol["tolerationSeconds"] = *t.TolerationSeconds
+			}
+			tolerations = append(tolerations, tol)
+		}
+		spec["tolerations"] = tolerations
+	}
+
+	if o.PriorityClass != "" {
+		spec["priorityClassName"] = o.PriorityClass
+	}
+}
+
+// resolveResourceOverrides parses the session's overrides and validates them
+// against its project's ceilings. It returns (nil, nil) when the session
+// requests no overrides.
+func (r *SimpleKubeReconciler) resolveResourceOverrides(ctx context.Context, sdk *sdkclient.Client, session types.Session) (*ResourceOverrides, error) {
+	overrides, err := parseResourceOverrides(session.ResourceOverrides)
+	if err != nil || overrides == nil {
+		return nil, err
+	}
+
+	limits, err := r.projectResourceLimits(ctx, sdk, session.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := overrides.validate(limits); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+func (r *SimpleKubeReconciler) projectResourceLimits(ctx context.Context, sdk *sdkclient.Client, projectID string) (*ResourceLimits, error) {
+	if err := validateTSLValue(projectID); err != nil {
+		return nil, fmt.Errorf("invalid project_id: %w", err)
+	}
+	list, err := sdk.ProjectSettings().List(ctx, &types.ListOptions{Size: 1, Search: fmt.Sprintf("project_id = '%s'", projectID)})
+	if err != nil {
+		return nil, fmt.Errorf("looking up project settings: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil, nil
+	}
+	return parseResourceLimits(list.Items[0].ResourceLimits)
+}
+
+// setSessionCondition upserts one condition in the session's Conditions
+// list. LastTransitionTime only moves when the status changes.
+func (r *SimpleKubeReconciler) setSessionCondition(ctx context.Context, sdk *sdkclient.Client, session types.Session, cond SessionCondition) {
+	var conditions []SessionCondition
+	if session.Conditions != "" {
+		if err := json.Unmarshal([]byte(session.Conditions), &conditions); err != nil {
+			r.logger.Warn().Err(err).Str("session_id", session.ID).Msg("replacing unparseable session conditions")
+			conditions = nil
+		}
+	}
+
+	cond.LastTransitionTime = time.Now().UTC().Format(time.RFC3339)
+	replaced := false
+	for i, existing := range conditions {
+		if existing.Type != cond.Type {
+			continue
+		}
+		if existing.Status == cond.Status {
+			cond.LastTransitionTime = existing.LastTransitionTime
+		}
+		conditions[i] = cond
+		replaced = true
+	}
+	if !replaced {
+		conditions = append(conditions, cond)
+	}
+
+	raw, err := json.Marshal(conditions)
+	if err != nil {
+		r.logger.Warn().Err(err).Str("session_id", session.ID).Msg("failed to marshal session conditions")
+		return
+	}
+	if _, err := sdk.Sessions().UpdateStatus(ctx, session.ID, map[string]interface{}{"conditions": string(raw)}); err != nil {
+		r.logger.Warn().Err(err).Str("session_id", session.ID).Str("condition", cond.Type).Msg("failed to update session condition")
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
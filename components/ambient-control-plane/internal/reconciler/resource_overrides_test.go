@@ -0,0 +1,162 @@
+package reconciler
+
+import (
+	"strings"
+	"testing"
+)
+
+func testResourceLimits() *ResourceLimits {
+	return &ResourceLimits{
+		Max:              map[string]string{"cpu": "8", "memory": "32Gi", "ephemeral-storage": "50Gi"},
+		PriorityClasses:  []string{"ambient-high"},
+		NodeSelectorKeys: []string{"node.ambient-code.io/pool"},
+		TolerationKeys:   []string{"dedicated"},
+	}
+}
+
+func TestParseResourceOverrides_Empty(t *testing.T) {
+	for _, raw := range []string{"", " ", "{}", "null"} {
+		o, err := parseResourceOverrides(raw)
+		if err != nil || o != nil {
+			t.Errorf("parseResourceOverrides(%q) = %v, %v; want nil, nil", raw, o, err)
+		}
+	}
+}
+
+func TestParseResourceOverrides_UnknownField(t *testing.T) {
+	if _, err := parseResourceOverrides(`{"gpus":"1"}`); err == nil {
+		t.Fatal("expected unknown field to be rejected")
+	}
+}
+
+func TestResourceOverridesValidate_WithinCeilings(t *testing.T) {
+	o, err := parseResourceOverrides(`{
+		"requests": {"cpu": "2", "memory": "8Gi"},
+		"limits": {"cpu": "4", "memory": "16Gi", "ephemeral-storage": "20Gi"},
+		"sidecars": {"limits": {"memory": "1Gi"}},
+		"node_selector": {"node.ambient-code.io/pool": "large"},
+		"tolerations": [{"key": "dedicated", "operator": "Equal", "value": "ambient", "effect": "NoSchedule"}],
+		"priority_class": "ambient-high"
+	}`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if err := o.validate(testResourceLimits()); err != nil {
+		t.Fatalf("expected overrides to validate, got %v", err)
+	}
+}
+
+func TestResourceOverridesValidate_Rejections(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"exceeds ceiling", `{"limits":{"memory":"64Gi"}}`, "exceeds the project ceiling"},
+		{"gpu", `{"limits":{"nvidia.com/gpu":"1"}}`, "cannot be overridden"},
+		{"bad quantity", `{"requests":{"cpu":"lots"}}`, "runner.requests.cpu"},
+		{"request above default limit", `{"requests":{"memory":"8Gi"}}`, "exceeds limits.memory"},
+		{"sidecar limit below credential sidecar request", `{"sidecars":{"limits":{"memory":"200Mi"}}}`, "sidecars: requests.memory 256Mi exceeds limits.memory 200Mi"},
+		{"sidecar request above mcp sidecar limit", `{"sidecars":{"requests":{"memory":"300Mi"}}}`, "sidecars: requests.memory 300Mi exceeds limits.memory 256Mi"},
+		{"node selector key", `{"node_selector":{"kubernetes.io/hostname":"n1"}}`, "node_selector key"},
+		{"toleration key", `{"tolerations":[{"key":"other"}]}`, "not allowed"},
+		{"toleration without key", `{"tolerations":[{"operator":"Exists"}]}`, "key is required"},
+		{"toleration operator", `{"tolerations":[{"key":"dedicated","operator":"Gt"}]}`, "operator must be"},
+		{"priority class", `{"priority_class":"system-cluster-critical"}`, "priority_class"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := parseResourceOverrides(tc.raw)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			err = o.validate(testResourceLimits())
+			if err == nil {
+				t.Fatal("expected validation error")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestResourceOverridesValidate_NoProjectLimits(t *testing.T) {
+	o := &ResourceOverrides{ContainerResources: ContainerResources{Limits: map[string]string{"cpu": "1"}}}
+	if err := o.validate(nil); err == nil {
+		t.Fatal("expected overrides to be rejected when the project has no resource_limits")
+	}
+}
+
+func TestResourceOverridesValidate_MissingCeiling(t *testing.T) {
+	limits := testResourceLimits()
+	delete(limits.Max, "ephemeral-storage")
+	o := &ResourceOverrides{ContainerResources: ContainerResources{Limits: map[string]string{"ephemeral-storage": "1Gi"}}}
+	err := o.validate(limits)
+	if err == nil || !strings.Contains(err.Error(), "no ceiling") {
+		t.Fatalf("expected missing-ceiling error, got %v", err)
+	}
+}
+
+func TestApplyResourceOverrides(t *testing.T) {
+	spec := map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"name": runnerContainerName, "resources": defaultRunnerResources().toUnstructured()},
+			map[string]interface{}{"name": "ambient-mcp", "resources": defaultMCPSidecarResources().toUnstructured()},
+		},
+	}
+	seconds := int64(300)
+	applyResourceOverrides(spec, &ResourceOverrides{
+		ContainerResources: ContainerResources{Limits: map[string]string{"memory": "16Gi"}},
+		Sidecars:           &ContainerResources{Limits: map[string]string{"memory": "1Gi"}},
+		NodeSelector:       map[string]string{"node.ambient-code.io/pool": "large"},
+		Tolerations:        []Toleration{{Key: "dedicated", Operator: "Exists", Effect: "NoSchedule", TolerationSeconds: &seconds}},
+		PriorityClass:      "ambient-high",
+	})
+
+	containers := spec["containers"].([]interface{})
+	runner := containerResourcesFrom(containers[0].(map[string]interface{}))
+	if runner.Limits["memory"] != "16Gi" || runner.Limits["cpu"] != "2000m" || runner.Requests["memory"] != "1Gi" {
+		t.Errorf("unexpected runner resources: %+v", runner)
+	}
+	sidecar := containerResourcesFrom(containers[1].(map[string]interface{}))
+	if sidecar.Limits["memory"] != "1Gi" || sidecar.Requests["cpu"] != "100m" {
+		t.Errorf("unexpected sidecar resources: %+v", sidecar)
+	}
+
+	if got := spec["nodeSelector"].(map[string]interface{})["node.ambient-code.io/pool"]; got != "large" {
+		t.Errorf("expected nodeSelector pool=large, got %v", got)
+	}
+	tolerations := spec["tolerations"].([]interface{})
+	if len(tolerations) != 1 {
+		t.Fatalf("expected 1 toleration, got %d", len(tolerations))
+	}
+	tol := tolerations[0].(map[string]interface{})
+	if tol["key"] != "dedicated" || tol["operator"] != "Exists" || tol["tolerationSeconds"] != int64(300) {
+		t.Errorf("unexpected toleration: %v", tol)
+	}
+	if spec["priorityClassName"] != "ambient-high" {
+		t.Errorf("expected priorityClassName ambient-high, got %v", spec["priorityClassName"])
+	}
+}
+
+func TestApplyResourceOverrides_SidecarsUntouchedWithoutOverride(t *testing.T) {
+	spec := map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"name": runnerContainerName, "resources": defaultRunnerResources().toUnstructured()},
+			map[string]interface{}{"name": "ambient-mcp", "resources": defaultMCPSidecarResources().toUnstructured()},
+		},
+	}
+	applyResourceOverrides(spec, &ResourceOverrides{ContainerResources: ContainerResources{Requests: map[string]string{"cpu": "1"}}})
+
+	sidecar := containerResourcesFrom(spec["containers"].([]interface{})[1].(map[string]interface{}))
+	if sidecar.Requests["cpu"] != "100m" || sidecar.Limits["memory"] != "256Mi" {
+		t.Errorf("expected sidecar defaults, got %+v", sidecar)
+	}
+	if _, ok := spec["nodeSelector"]; ok {
+		t.Error("expected no nodeSelector")
+	}
+	if _, ok := spec["priorityClassName"]; ok {
+		t.Error("expected no priorityClassName")
+	}
+}
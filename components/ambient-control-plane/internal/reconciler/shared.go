@@ -49,6 +49,14 @@ type Reconciler interface {
 	Reconcile(ctx context.Context, event informer.ResourceEvent) error
 }
 
+// ProjectFilter reports whether this replica reconciles a project's
+// sessions. A nil filter owns every project.
+type ProjectFilter func(projectID string) bool
+
+func (f ProjectFilter) owns(projectID string) bool {
+	return f == nil || f(projectID)
+}
+
 type SDKClientFactory struct {
 	baseURL  string
 	provider auth.TokenProvider
@@ -0,0 +1,263 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	timeoutCheckInterval = 30 * time.Second
+
+	StoppedReasonTimeout    = "timeout"
+	StoppedReasonInactivity = "inactivity"
+)
+
+// SessionStopper stops a running session on the control plane's initiative.
+type SessionStopper interface {
+	StopSession(ctx context.Context, session types.Session, reason string) error
+}
+
+// messageLister returns a session's messages after afterSeq.
+type messageLister func(ctx context.Context, projectID, sessionID string, afterSeq int) ([]types.SessionMessage, error)
+
+// SessionTimeoutManager stops Running sessions that overrun their wall-clock
+// Timeout or go quiet for longer than their project's inactivity budget.
+//
+// Running sessions and project inactivity settings are tracked from informer
+// events (register HandleSession and HandleProjectSettings). Activity is the
+// newest session message, polled incrementally every timeoutCheckInterval;
+// a session with no messages is measured from its start time.
+//
+// ProjectSettings.InactivityTimeoutSeconds overrides the default budget; 0
+// (unset) keeps the default and -1 disables the inactivity stop for the
+// project.
+type SessionTimeoutManager struct {
+	factory           *SDKClientFactory
+	stopper           SessionStopper
+	defaultInactivity time.Duration
+	listMessages      messageLister
+	projectFilter     ProjectFilter
+	now               func() time.Time
+	logger            zerolog.Logger
+
+	mu                sync.Mutex
+	sessions          map[string]*sessionActivity
+	projectInactivity map[string]int32
+}
+
+// sessionActivity is per-session memory between checks.
+type sessionActivity struct {
+	session      types.Session
+	lastSeq      int
+	lastActivity time.Time
+}
+
+func NewSessionTimeoutManager(factory *SDKClientFactory, stopper SessionStopper, defaultInactivity time.Duration, logger zerolog.Logger) *SessionTimeoutManager {
+	m := &SessionTimeoutManager{
+		factory:           factory,
+		stopper:           stopper,
+		defaultInactivity: defaultInactivity,
+		now:               time.Now,
+		logger:            logger.With().Str("component", "session-timeout-manager").Logger(),
+		sessions:          make(map[string]*sessionActivity),
+		projectInactivity: make(map[string]int32),
+	}
+	m.listMessages = m.listMessagesFromAPI
+	return m
+}
+
+// SetProjectFilter limits stops to sessions of projects the filter owns.
+// Sessions of other projects stay tracked so a replica that takes over a
+// project's shard can stop them without waiting for new events.
+func (m *SessionTimeoutManager) SetProjectFilter(filter ProjectFilter) {
+	m.projectFilter = filter
+}
+
+// HandleSession tracks sessions while they are Running.
+func (m *SessionTimeoutManager) HandleSession(_ context.Context, event informer.ResourceEvent) error {
+	if event.Object.Session == nil {
+		return nil
+	}
+	session := *event.Object.Session
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// A Running session with a stopped_reason is already being stopped.
+	if event.Type == informer.EventDeleted || session.Phase != PhaseRunning || session.StoppedReason != "" {
+		delete(m.sessions, session.ID)
+		return nil
+	}
+	if tracked, ok := m.sessions[session.ID]; ok {
+		tracked.session = session
+		return nil
+	}
+	m.sessions[session.ID] = &sessionActivity{session: session}
+	return nil
+}
+
+// HandleProjectSettings caches each project's inactivity budget.
+func (m *SessionTimeoutManager) HandleProjectSettings(_ context.Context, event informer.ResourceEvent) error {
+	if event.Object.ProjectSettings == nil {
+		return nil
+	}
+	ps := *event.Object.ProjectSettings
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if event.Type == informer.EventDeleted || ps.InactivityTimeoutSeconds == 0 {
+		delete(m.projectInactivity, ps.ProjectID)
+		return nil
+	}
+	m.projectInactivity[ps.ProjectID] = ps.InactivityTimeoutSeconds
+	return nil
+}
+
+func (m *SessionTimeoutManager) Run(ctx context.Context) error {
+	m.logger.Info().Dur("interval", timeoutCheckInterval).Dur("default_inactivity", m.defaultInactivity).Msg("session timeout manager started")
+	ticker := time.NewTicker(timeoutCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info().Msg("session timeout manager stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			m.checkOnce(ctx)
+		}
+	}
+}
+
+func (m *SessionTimeoutManager) checkOnce(ctx context.Context) {
+	m.mu.Lock()
+	tracked := make([]sessionActivity, 0, len(m.sessions))
+	for _, a := range m.sessions {
+		if m.projectFilter.owns(a.session.ProjectID) {
+			tracked = append(tracked, *a)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, a := range tracked {
+		m.checkSession(ctx, a)
+	}
+}
+
+func (m *SessionTimeoutManager) checkSession(ctx context.Context, a sessionActivity) {
+	session := a.session
+
+	msgs, err := m.listMessages(ctx, session.ProjectID, session.ID, a.lastSeq)
+	if err != nil {
+		m.logger.Warn().Err(err).Str("session_id", session.ID).Msg("failed to list session messages; skipping inactivity check")
+	}
+	for _, msg := range msgs {
+		if msg.Seq > a.lastSeq {
+			a.lastSeq = msg.Seq
+		}
+		at := m.now()
+		if msg.CreatedAt != nil {
+			at = *msg.CreatedAt
+		}
+		if at.After(a.lastActivity) {
+			a.lastActivity = at
+		}
+	}
+
+	m.mu.Lock()
+	if current, ok := m.sessions[session.ID]; ok {
+		current.lastSeq = a.lastSeq
+		current.lastActivity = a.lastActivity
+	}
+	m.mu.Unlock()
+
+	reason, detail := m.expired(a, err == nil)
+	if reason == "" {
+		return
+	}
+
+	m.logger.Info().
+		Str("session_id", session.ID).
+		Str("project_id", session.ProjectID).
+		Str("reason", reason).
+		Msg(detail)
+
+	if err := m.stopper.StopSession(ctx, session, reason); err != nil {
+		m.logger.Warn().Err(err).Str("session_id", session.ID).Str("reason", reason).Msg("failed to stop session")
+		return
+	}
+
+	m.mu.Lock()
+	delete(m.sessions, session.ID)
+	m.mu.Unlock()
+}
+
+// expired returns the stop reason for a session, or "" if it may keep
+// running. Inactivity is only judged when activity is known to be current.
+func (m *SessionTimeoutManager) expired(a sessionActivity, activityKnown bool) (string, string) {
+	now := m.now()
+	session := a.session
+
+	started := session.StartTime
+	if started == nil {
+		started = session.CreatedAt
+	}
+
+	if session.Timeout > 0 && started != nil {
+		limit := time.Duration(session.Timeout) * time.Second
+		if now.Sub(*started) > limit {
+			return StoppedReasonTimeout, fmt.Sprintf("session exceeded its %s timeout", limit)
+		}
+	}
+
+	if !activityKnown {
+		return "", ""
+	}
+	budget := m.inactivityBudget(session.ProjectID)
+	if budget <= 0 {
+		return "", ""
+	}
+	// Messages from before the current run (a restarted session) must not
+	// count against it, so activity is never earlier than the start time.
+	last := a.lastActivity
+	if started != nil && started.After(last) {
+		last = *started
+	}
+	if last.IsZero() {
+		return "", ""
+	}
+	if now.Sub(last) > budget {
+		return StoppedReasonInactivity, fmt.Sprintf("session idle for longer than %s", budget)
+	}
+	return "", ""
+}
+
+func (m *SessionTimeoutManager) inactivityBudget(projectID string) time.Duration {
+	m.mu.Lock()
+	seconds, ok := m.projectInactivity[projectID]
+	m.mu.Unlock()
+
+	switch {
+	case !ok:
+		return m.defaultInactivity
+	case seconds < 0:
+		return 0
+	default:
+		return time.Duration(seconds) * time.Second
+	}
+}
+
+func (m *SessionTimeoutManager) listMessagesFromAPI(ctx context.Context, projectID, sessionID string, afterSeq int) ([]types.SessionMessage, error) {
+	sdk, err := m.factory.ForProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Sessions().ListMessages(ctx, sessionID, afterSeq)
+}
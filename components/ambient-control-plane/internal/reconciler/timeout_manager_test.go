@@ -0,0 +1,199 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ambient-code/platform/components/ambient-control-plane/internal/informer"
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+type recordingStopper struct {
+	stopped map[string]string
+}
+
+func (s *recordingStopper) StopSession(_ context.Context, session types.Session, reason string) error {
+	s.stopped[session.ID] = reason
+	return nil
+}
+
+func newTestTimeoutManager(now time.Time, msgs map[string][]types.SessionMessage) (*SessionTimeoutManager, *recordingStopper) {
+	stopper := &recordingStopper{stopped: map[string]string{}}
+	m := NewSessionTimeoutManager(nil, stopper, time.Hour, zerolog.Nop())
+	m.now = func() time.Time { return now }
+	m.listMessages = func(_ context.Context, _, sessionID string, afterSeq int) ([]types.SessionMessage, error) {
+		var out []types.SessionMessage
+		for _, msg := range msgs[sessionID] {
+			if msg.Seq > afterSeq {
+				out = append(out, msg)
+			}
+		}
+		return out, nil
+	}
+	return m, stopper
+}
+
+func runningSession(id string, started time.Time, timeout int) *types.Session {
+	return &types.Session{
+		ObjectReference: types.ObjectReference{ID: id},
+		ProjectID:       "proj",
+		Phase:           PhaseRunning,
+		StartTime:       timePtr(started),
+		Timeout:         timeout,
+	}
+}
+
+func message(seq int, at time.Time) types.SessionMessage {
+	return types.SessionMessage{ObjectReference: types.ObjectReference{CreatedAt: timePtr(at)}, Seq: seq}
+}
+
+func TestSessionTimeoutManager_WallClockTimeout(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	m, stopper := newTestTimeoutManager(now, map[string][]types.SessionMessage{
+		"s1": {message(1, now.Add(-time.Minute))},
+	})
+	_ = m.HandleSession(context.Background(), informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-11*time.Minute), 600)}})
+
+	m.checkOnce(context.Background())
+
+	if stopper.stopped["s1"] != StoppedReasonTimeout {
+		t.Fatalf("expected s1 stopped for timeout, got %q", stopper.stopped["s1"])
+	}
+	if len(m.sessions) != 0 {
+		t.Errorf("expected stopped session to be untracked, got %d tracked", len(m.sessions))
+	}
+}
+
+func TestSessionTimeoutManager_Inactivity(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	m, stopper := newTestTimeoutManager(now, map[string][]types.SessionMessage{
+		"idle":   {message(1, now.Add(-2*time.Hour))},
+		"active": {message(1, now.Add(-2*time.Hour)), message(2, now.Add(-5*time.Minute))},
+	})
+	ctx := context.Background()
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("idle", now.Add(-3*time.Hour), 0)}})
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("active", now.Add(-3*time.Hour), 0)}})
+
+	m.checkOnce(ctx)
+
+	if stopper.stopped["idle"] != StoppedReasonInactivity {
+		t.Errorf("expected idle session stopped for inactivity, got %q", stopper.stopped["idle"])
+	}
+	if _, ok := stopper.stopped["active"]; ok {
+		t.Error("expected active session to keep running")
+	}
+	if got := m.sessions["active"].lastSeq; got != 2 {
+		t.Errorf("expected lastSeq 2 for active session, got %d", got)
+	}
+}
+
+func TestSessionTimeoutManager_MessagesBeforeRestartIgnored(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	m, stopper := newTestTimeoutManager(now, map[string][]types.SessionMessage{
+		"s1": {message(1, now.Add(-48*time.Hour))},
+	})
+	_ = m.HandleSession(context.Background(), informer.ResourceEvent{Type: informer.EventModified, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-10*time.Minute), 0)}})
+
+	m.checkOnce(context.Background())
+
+	if _, ok := stopper.stopped["s1"]; ok {
+		t.Error("expected restarted session to be measured from its start time")
+	}
+}
+
+func TestSessionTimeoutManager_ProjectSettings(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	t.Run("project budget overrides default", func(t *testing.T) {
+		m, stopper := newTestTimeoutManager(now, nil)
+		_ = m.HandleProjectSettings(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{ProjectSettings: &types.ProjectSettings{ProjectID: "proj", InactivityTimeoutSeconds: 300}}})
+		_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-10*time.Minute), 0)}})
+
+		m.checkOnce(ctx)
+
+		if stopper.stopped["s1"] != StoppedReasonInactivity {
+			t.Errorf("expected s1 stopped after the 5m project budget, got %q", stopper.stopped["s1"])
+		}
+	})
+
+	t.Run("negative disables", func(t *testing.T) {
+		m, stopper := newTestTimeoutManager(now, nil)
+		_ = m.HandleProjectSettings(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{ProjectSettings: &types.ProjectSettings{ProjectID: "proj", InactivityTimeoutSeconds: -1}}})
+		_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-48*time.Hour), 0)}})
+
+		m.checkOnce(ctx)
+
+		if _, ok := stopper.stopped["s1"]; ok {
+			t.Error("expected inactivity stop to be disabled for the project")
+		}
+	})
+}
+
+func TestSessionTimeoutManager_ListErrorSkipsInactivity(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	m, stopper := newTestTimeoutManager(now, nil)
+	m.listMessages = func(context.Context, string, string, int) ([]types.SessionMessage, error) {
+		return nil, errors.New("api unavailable")
+	}
+	_ = m.HandleSession(context.Background(), informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-48*time.Hour), 0)}})
+
+	m.checkOnce(context.Background())
+
+	if _, ok := stopper.stopped["s1"]; ok {
+		t.Error("expected no inactivity stop while activity is unknown")
+	}
+}
+
+func TestSessionTimeoutManager_HandleSessionTracksRunningOnly(t *testing.T) {
+	now := time.Now()
+	m, _ := newTestTimeoutManager(now, nil)
+	ctx := context.Background()
+
+	s := runningSession("s1", now, 0)
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: s}})
+	if _, ok := m.sessions["s1"]; !ok {
+		t.Fatal("expected Running session to be tracked")
+	}
+
+	stopping := *s
+	stopping.StoppedReason = StoppedReasonInactivity
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventModified, Object: informer.ResourceObject{Session: &stopping}})
+	if _, ok := m.sessions["s1"]; ok {
+		t.Error("expected session with a stopped_reason to be untracked")
+	}
+
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventModified, Object: informer.ResourceObject{Session: s}})
+	stopped := *s
+	stopped.Phase = PhaseStopped
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventModified, Object: informer.ResourceObject{Session: &stopped}})
+	if _, ok := m.sessions["s1"]; ok {
+		t.Error("expected Stopped session to be untracked")
+	}
+}
+
+func TestSessionTimeoutManager_ProjectFilter(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	m, stopper := newTestTimeoutManager(now, nil)
+	owned := false
+	m.SetProjectFilter(func(string) bool { return owned })
+	ctx := context.Background()
+	_ = m.HandleSession(ctx, informer.ResourceEvent{Type: informer.EventAdded, Object: informer.ResourceObject{Session: runningSession("s1", now.Add(-11*time.Minute), 600)}})
+
+	m.checkOnce(ctx)
+	if _, ok := stopper.stopped["s1"]; ok {
+		t.Fatal("expected no stop for a project owned by another replica")
+	}
+	if _, ok := m.sessions["s1"]; !ok {
+		t.Fatal("expected unowned session to stay tracked")
+	}
+
+	owned = true
+	m.checkOnce(ctx)
+	if stopper.stopped["s1"] != StoppedReasonTimeout {
+		t.Errorf("expected s1 stopped once its project is owned, got %q", stopper.stopped["s1"])
+	}
+}
@@ -160,3 +160,25 @@ func TestDecryptSessionID_RoundTrip(t *testing.T) {
 		t.Errorf("decryptSessionID() = %q, want %q", got, want)
 	}
 }
+
+func TestHandleReadyz(t *testing.T) {
+	s, err := New(DefaultListenAddr, &staticTokenProvider{token: "t"}, nil, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("default readyz: got %d %q", rec.Code, rec.Body.String())
+	}
+
+	s.SetReadiness(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"leader":false}`))
+	}))
+	rec = httptest.NewRecorder()
+	s.srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Body.String() != `{"leader":false}` {
+		t.Errorf("custom readyz: got %q", rec.Body.String())
+	}
+}
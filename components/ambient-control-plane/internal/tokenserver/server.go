@@ -20,8 +20,9 @@ const (
 )
 
 type Server struct {
-	srv    *http.Server
-	logger zerolog.Logger
+	srv       *http.Server
+	readiness http.Handler
+	logger    zerolog.Logger
 }
 
 func New(
@@ -36,20 +37,38 @@ func New(
 		logger:        logger.With().Str("component", "tokenserver").Logger(),
 	}
 
+	s := &Server{
+		logger: logger.With().Str("component", "tokenserver").Logger(),
+	}
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/token", h.handleToken)
 	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
 
-	return &Server{
-		srv: &http.Server{
-			Addr:         listenAddr,
-			Handler:      mux,
-			ReadTimeout:  readTimeout,
-			WriteTimeout: writeTimeout,
-			IdleTimeout:  idleTimeout,
-		},
-		logger: logger.With().Str("component", "tokenserver").Logger(),
-	}, nil
+	s.srv = &http.Server{
+		Addr:         listenAddr,
+		Handler:      mux,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+		IdleTimeout:  idleTimeout,
+	}
+	return s, nil
+}
+
+// SetReadiness replaces the default /readyz response, which is a plain "ok",
+// with h. It is used to report leader election state and must be called
+// before Start.
+func (s *Server) SetReadiness(h http.Handler) {
+	s.readiness = h
+}
+
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if s.readiness != nil {
+		s.readiness.ServeHTTP(w, r)
+		return
+	}
+	handleHealthz(w, r)
 }
 
 func (s *Server) Start(ctx context.Context) error {
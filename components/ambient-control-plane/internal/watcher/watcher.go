@@ -18,6 +18,19 @@ import (
 
 type EventType string
 
+// watchedResources is every resource the API server exposes a gRPC watch
+// stream for, in the order streams are opened.
+var watchedResources = []string{
+	"sessions",
+	"projects",
+	"project_settings",
+	"agents",
+	"credentials",
+	"role_bindings",
+	"applications",
+	"scheduled_sessions",
+}
+
 const (
 	EventCreated EventType = "CREATED"
 	EventUpdated EventType = "UPDATED"
@@ -42,18 +55,58 @@ type ProjectSettingsWatchEvent struct {
 	ProjectSettings *pb.ProjectSettings
 }
 
+type AgentWatchEvent struct {
+	Type       EventType
+	ResourceID string
+	Agent      *pb.Agent
+}
+
+type CredentialWatchEvent struct {
+	Type       EventType
+	ResourceID string
+	Credential *pb.Credential
+}
+
+type RoleBindingWatchEvent struct {
+	Type        EventType
+	ResourceID  string
+	RoleBinding *pb.RoleBinding
+}
+
+type ApplicationWatchEvent struct {
+	Type        EventType
+	ResourceID  string
+	Application *pb.Application
+}
+
+type ScheduledSessionWatchEvent struct {
+	Type             EventType
+	ResourceID       string
+	ScheduledSession *pb.ScheduledSession
+}
+
 type SessionEventHandler func(ctx context.Context, event SessionWatchEvent) error
 type ProjectEventHandler func(ctx context.Context, event ProjectWatchEvent) error
 type ProjectSettingsEventHandler func(ctx context.Context, event ProjectSettingsWatchEvent) error
+type AgentEventHandler func(ctx context.Context, event AgentWatchEvent) error
+type CredentialEventHandler func(ctx context.Context, event CredentialWatchEvent) error
+type RoleBindingEventHandler func(ctx context.Context, event RoleBindingWatchEvent) error
+type ApplicationEventHandler func(ctx context.Context, event ApplicationWatchEvent) error
+type ScheduledSessionEventHandler func(ctx context.Context, event ScheduledSessionWatchEvent) error
 
 type WatchManager struct {
-	conn                    *grpc.ClientConn
-	provider                auth.TokenProvider
-	sessionHandlers         []SessionEventHandler
-	projectHandlers         []ProjectEventHandler
-	projectSettingsHandlers []ProjectSettingsEventHandler
-	mu                      sync.RWMutex
-	logger                  zerolog.Logger
+	conn                     *grpc.ClientConn
+	provider                 auth.TokenProvider
+	sessionHandlers          []SessionEventHandler
+	projectHandlers          []ProjectEventHandler
+	projectSettingsHandlers  []ProjectSettingsEventHandler
+	agentHandlers            []AgentEventHandler
+	credentialHandlers       []CredentialEventHandler
+	roleBindingHandlers      []RoleBindingEventHandler
+	applicationHandlers      []ApplicationEventHandler
+	scheduledSessionHandlers []ScheduledSessionEventHandler
+	mu                       sync.RWMutex
+	logger                   zerolog.Logger
 }
 
 func NewWatchManager(conn *grpc.ClientConn, provider auth.TokenProvider, logger zerolog.Logger) *WatchManager {
@@ -93,34 +146,60 @@ func (wm *WatchManager) RegisterProjectSettingsHandler(handler ProjectSettingsEv
 	wm.projectSettingsHandlers = append(wm.projectSettingsHandlers, handler)
 }
 
+func (wm *WatchManager) RegisterAgentHandler(handler AgentEventHandler) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.agentHandlers = append(wm.agentHandlers, handler)
+}
+
+func (wm *WatchManager) RegisterCredentialHandler(handler CredentialEventHandler) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.credentialHandlers = append(wm.credentialHandlers, handler)
+}
+
+func (wm *WatchManager) RegisterRoleBindingHandler(handler RoleBindingEventHandler) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.roleBindingHandlers = append(wm.roleBindingHandlers, handler)
+}
+
+func (wm *WatchManager) RegisterApplicationHandler(handler ApplicationEventHandler) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.applicationHandlers = append(wm.applicationHandlers, handler)
+}
+
+func (wm *WatchManager) RegisterScheduledSessionHandler(handler ScheduledSessionEventHandler) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.scheduledSessionHandlers = append(wm.scheduledSessionHandlers, handler)
+}
+
 func (wm *WatchManager) Run(ctx context.Context) {
 	wm.mu.RLock()
-	hasSessions := len(wm.sessionHandlers) > 0
-	hasProjects := len(wm.projectHandlers) > 0
-	hasProjectSettings := len(wm.projectSettingsHandlers) > 0
+	active := map[string]bool{
+		"sessions":           len(wm.sessionHandlers) > 0,
+		"projects":           len(wm.projectHandlers) > 0,
+		"project_settings":   len(wm.projectSettingsHandlers) > 0,
+		"agents":             len(wm.agentHandlers) > 0,
+		"credentials":        len(wm.credentialHandlers) > 0,
+		"role_bindings":      len(wm.roleBindingHandlers) > 0,
+		"applications":       len(wm.applicationHandlers) > 0,
+		"scheduled_sessions": len(wm.scheduledSessionHandlers) > 0,
+	}
 	wm.mu.RUnlock()
 
 	var wg sync.WaitGroup
-	if hasSessions {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			wm.watchLoop(ctx, "sessions")
-		}()
-	}
-	if hasProjects {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			wm.watchLoop(ctx, "projects")
-		}()
-	}
-	if hasProjectSettings {
+	for _, resource := range watchedResources {
+		if !active[resource] {
+			continue
+		}
 		wg.Add(1)
-		go func() {
+		go func(resource string) {
 			defer wg.Done()
-			wm.watchLoop(ctx, "project_settings")
-		}()
+			wm.watchLoop(ctx, resource)
+		}(resource)
 	}
 	wg.Wait()
 }
@@ -163,6 +242,16 @@ func (wm *WatchManager) watchOnce(ctx context.Context, resource string) error {
 		return wm.watchProjects(ctx)
 	case "project_settings":
 		return wm.watchProjectSettings(ctx)
+	case "agents":
+		return wm.watchAgents(ctx)
+	case "credentials":
+		return wm.watchCredentials(ctx)
+	case "role_bindings":
+		return wm.watchRoleBindings(ctx)
+	case "applications":
+		return wm.watchApplications(ctx)
+	case "scheduled_sessions":
+		return wm.watchScheduledSessions(ctx)
 	default:
 		wm.logger.Warn().Str("resource", resource).Msg("no gRPC watch available for resource")
 		<-ctx.Done()
@@ -260,6 +349,156 @@ func (wm *WatchManager) watchProjectSettings(ctx context.Context) error {
 	}
 }
 
+func (wm *WatchManager) watchAgents(ctx context.Context) error {
+	authCtx, err := wm.authContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewAgentServiceClient(wm.conn)
+	stream, err := client.WatchAgents(authCtx, &pb.WatchAgentsRequest{})
+	if err != nil {
+		return err
+	}
+
+	wm.logger.Info().Msg("agents watch stream established")
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		wm.dispatchAgent(ctx, AgentWatchEvent{
+			Type:       protoEventType(event.Type),
+			ResourceID: event.ResourceId,
+			Agent:      event.Agent,
+		})
+	}
+}
+
+func (wm *WatchManager) watchCredentials(ctx context.Context) error {
+	authCtx, err := wm.authContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewCredentialServiceClient(wm.conn)
+	stream, err := client.WatchCredentials(authCtx, &pb.WatchCredentialsRequest{})
+	if err != nil {
+		return err
+	}
+
+	wm.logger.Info().Msg("credentials watch stream established")
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		wm.dispatchCredential(ctx, CredentialWatchEvent{
+			Type:       protoEventType(event.Type),
+			ResourceID: event.ResourceId,
+			Credential: event.Credential,
+		})
+	}
+}
+
+func (wm *WatchManager) watchRoleBindings(ctx context.Context) error {
+	authCtx, err := wm.authContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewRoleBindingServiceClient(wm.conn)
+	stream, err := client.WatchRoleBindings(authCtx, &pb.WatchRoleBindingsRequest{})
+	if err != nil {
+		return err
+	}
+
+	wm.logger.Info().Msg("role_bindings watch stream established")
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		wm.dispatchRoleBinding(ctx, RoleBindingWatchEvent{
+			Type:        protoEventType(event.Type),
+			ResourceID:  event.ResourceId,
+			RoleBinding: event.RoleBinding,
+		})
+	}
+}
+
+func (wm *WatchManager) watchApplications(ctx context.Context) error {
+	authCtx, err := wm.authContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewApplicationServiceClient(wm.conn)
+	stream, err := client.WatchApplications(authCtx, &pb.WatchApplicationsRequest{})
+	if err != nil {
+		return err
+	}
+
+	wm.logger.Info().Msg("applications watch stream established")
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		wm.dispatchApplication(ctx, ApplicationWatchEvent{
+			Type:        protoEventType(event.Type),
+			ResourceID:  event.ResourceId,
+			Application: event.Application,
+		})
+	}
+}
+
+func (wm *WatchManager) watchScheduledSessions(ctx context.Context) error {
+	authCtx, err := wm.authContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewScheduledSessionServiceClient(wm.conn)
+	stream, err := client.WatchScheduledSessions(authCtx, &pb.WatchScheduledSessionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	wm.logger.Info().Msg("scheduled_sessions watch stream established")
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		wm.dispatchScheduledSession(ctx, ScheduledSessionWatchEvent{
+			Type:             protoEventType(event.Type),
+			ResourceID:       event.ResourceId,
+			ScheduledSession: event.ScheduledSession,
+		})
+	}
+}
+
 func (wm *WatchManager) dispatchSession(ctx context.Context, event SessionWatchEvent) {
 	wm.mu.RLock()
 	handlers := wm.sessionHandlers
@@ -293,6 +532,61 @@ func (wm *WatchManager) dispatchProjectSettings(ctx context.Context, event Proje
 	}
 }
 
+func (wm *WatchManager) dispatchAgent(ctx context.Context, event AgentWatchEvent) {
+	wm.mu.RLock()
+	handlers := wm.agentHandlers
+	wm.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			wm.logger.Error().Err(err).Str("resource", "agents").Str("event_type", string(event.Type)).Str("resource_id", event.ResourceID).Msg("handler failed")
+		}
+	}
+}
+
+func (wm *WatchManager) dispatchCredential(ctx context.Context, event CredentialWatchEvent) {
+	wm.mu.RLock()
+	handlers := wm.credentialHandlers
+	wm.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			wm.logger.Error().Err(err).Str("resource", "credentials").Str("event_type", string(event.Type)).Str("resource_id", event.ResourceID).Msg("handler failed")
+		}
+	}
+}
+
+func (wm *WatchManager) dispatchRoleBinding(ctx context.Context, event RoleBindingWatchEvent) {
+	wm.mu.RLock()
+	handlers := wm.roleBindingHandlers
+	wm.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			wm.logger.Error().Err(err).Str("resource", "role_bindings").Str("event_type", string(event.Type)).Str("resource_id", event.ResourceID).Msg("handler failed")
+		}
+	}
+}
+
+func (wm *WatchManager) dispatchApplication(ctx context.Context, event ApplicationWatchEvent) {
+	wm.mu.RLock()
+	handlers := wm.applicationHandlers
+	wm.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			wm.logger.Error().Err(err).Str("resource", "applications").Str("event_type", string(event.Type)).Str("resource_id", event.ResourceID).Msg("handler failed")
+		}
+	}
+}
+
+func (wm *WatchManager) dispatchScheduledSession(ctx context.Context, event ScheduledSessionWatchEvent) {
+	wm.mu.RLock()
+	handlers := wm.scheduledSessionHandlers
+	wm.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			wm.logger.Error().Err(err).Str("resource", "scheduled_sessions").Str("event_type", string(event.Type)).Str("resource_id", event.ResourceID).Msg("handler failed")
+		}
+	}
+}
+
 func protoEventType(t pb.EventType) EventType {
 	switch t {
 	case pb.EventType_EVENT_TYPE_CREATED:
@@ -43,6 +43,20 @@ func (c *Client) SetToken(token string) {
 }
 
 func (c *Client) do(ctx context.Context, method, path string, body []byte, result interface{}, expectedStatuses ...int) error {
+	respBody, err := c.send(ctx, method, path, body, "application/json", expectedStatuses...)
+	if err != nil {
+		return err
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("unmarshal response: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) send(ctx context.Context, method, path string, body []byte, accept string, expectedStatuses ...int) ([]byte, error) {
 	reqURL := c.baseURL + "/api/ambient/v1" + path
 	var bodyReader io.Reader
 	if body != nil {
@@ -50,23 +64,23 @@ func (c *Client) do(ctx context.Context, method, path string, body []byte, resul
 	}
 	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Authorization", "Bearer "+c.Token())
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", accept)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("read response: %w", err)
+		return nil, fmt.Errorf("read response: %w", err)
 	}
 
 	ok := false
@@ -77,21 +91,24 @@ func (c *Client) do(ctx context.Context, method, path string, body []byte, resul
 		}
 	}
 	if !ok {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
-	}
-
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("unmarshal response: %w", err)
-		}
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
 	}
-	return nil
+	return respBody, nil
 }
 
 func (c *Client) Get(ctx context.Context, path string, result interface{}) error {
 	return c.do(ctx, http.MethodGet, path, nil, result, http.StatusOK)
 }
 
+// GetText fetches a plain-text resource such as an agent's start prompt.
+func (c *Client) GetText(ctx context.Context, path string) (string, error) {
+	b, err := c.send(ctx, http.MethodGet, path, nil, "text/plain", http.StatusOK)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func (c *Client) GetWithQuery(ctx context.Context, path string, params url.Values, result interface{}) error {
 	if len(params) > 0 {
 		path = path + "?" + params.Encode()
@@ -114,3 +131,15 @@ func (c *Client) Patch(ctx context.Context, path string, body interface{}, resul
 	}
 	return c.do(ctx, http.MethodPatch, path, b, result, http.StatusOK)
 }
+
+func (c *Client) Put(ctx context.Context, path string, body interface{}, result interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal body: %w", err)
+	}
+	return c.do(ctx, http.MethodPut, path, b, result, http.StatusOK)
+}
+
+func (c *Client) Delete(ctx context.Context, path string) error {
+	return c.do(ctx, http.MethodDelete, path, nil, nil, http.StatusNoContent)
+}
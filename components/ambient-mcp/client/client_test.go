@@ -131,3 +131,61 @@ func TestPost_SendsBody(t *testing.T) {
 		t.Errorf("result id = %q, want %q", result["id"], "123")
 	}
 }
+
+func TestPut_SendsBody(t *testing.T) {
+	var receivedBody map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want PUT", r.Method)
+		}
+		json.NewDecoder(r.Body).Decode(&receivedBody)
+		json.NewEncoder(w).Encode(map[string]string{"key": "k"})
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, "token")
+	var result map[string]string
+	if err := c.Put(context.Background(), "/items/k", map[string]string{"value": "v"}, &result); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if receivedBody["value"] != "v" {
+		t.Errorf("received body value = %q, want %q", receivedBody["value"], "v")
+	}
+}
+
+func TestDelete_ExpectsNoContent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, "token")
+	if err := c.Delete(context.Background(), "/items/k"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+}
+
+func TestGetText_ReturnsBody(t *testing.T) {
+	var receivedAccept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedAccept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("# Agent: reviewer\n"))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, "token")
+	got, err := c.GetText(context.Background(), "/projects/p/agents/a/start")
+	if err != nil {
+		t.Fatalf("GetText: %v", err)
+	}
+	if got != "# Agent: reviewer\n" {
+		t.Errorf("GetText = %q", got)
+	}
+	if receivedAccept != "text/plain" {
+		t.Errorf("Accept = %q, want text/plain", receivedAccept)
+	}
+}
@@ -61,7 +61,7 @@ func main() {
 		defer exchanger.Stop()
 	}
 
-	s := newServer(c, transport)
+	s := newServer(c)
 
 	switch transport {
 	case "stdio":
@@ -8,21 +8,30 @@ import (
 	"github.com/ambient-code/platform/components/ambient-mcp/tools"
 )
 
-func newServer(c *client.Client, transport string) *server.MCPServer {
+func newServer(c *client.Client) *server.MCPServer {
 	s := server.NewMCPServer(
 		"ambient-platform",
 		"1.0.0",
 		server.WithToolCapabilities(false),
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
 	)
 
-	registerSessionTools(s, c, transport)
+	registerSessionTools(s, c)
 	registerAgentTools(s, c)
 	registerProjectTools(s, c)
+	registerBlackboardTools(s, c)
+	registerInboxTools(s, c)
+	registerCredentialTools(s, c)
+	registerScheduledSessionTools(s, c)
+	registerRoleBindingTools(s, c)
+	registerResources(s, c)
+	registerPrompts(s, c)
 
 	return s
 }
 
-func registerSessionTools(s *server.MCPServer, c *client.Client, transport string) {
+func registerSessionTools(s *server.MCPServer, c *client.Client) {
 	s.AddTool(
 		mcp.NewTool("list_sessions",
 			mcp.WithDescription("List sessions visible to the caller, with optional filters."),
@@ -114,14 +123,14 @@ func registerSessionTools(s *server.MCPServer, c *client.Client, transport strin
 
 	s.AddTool(
 		mcp.NewTool("watch_session_messages",
-			mcp.WithDescription("Subscribes to a session's message stream. Returns a subscription_id immediately; messages are pushed as notifications/progress events."),
+			mcp.WithDescription("Subscribes to a session's message stream. Returns a subscription_id immediately; new messages and phase changes are pushed as notifications/progress events. Ends by itself when the session completes, fails or is stopped."),
 			mcp.WithString("session_id",
 				mcp.Description("ID of the session to watch."),
 				mcp.Required(),
 			),
 			mcp.WithNumber("after_seq", mcp.Description("Deliver only messages with seq > after_seq. Default: 0 (replay all).")),
 		),
-		tools.WatchSessionMessages(c, transport),
+		tools.WatchSessionMessages(c),
 	)
 
 	s.AddTool(
@@ -244,6 +253,17 @@ func registerProjectTools(s *server.MCPServer, c *client.Client) {
 		tools.GetProject(c),
 	)
 
+	s.AddTool(
+		mcp.NewTool("get_project_home",
+			mcp.WithDescription("Returns a project's dashboard in one call: agents with their current session phase, status and unread inbox count, session counts over the last 1h/24h/7d, upcoming scheduled-session firings and recent application sync results."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID (UUID)."),
+				mcp.Required(),
+			),
+		),
+		tools.GetProjectHome(c),
+	)
+
 	s.AddTool(
 		mcp.NewTool("patch_project_annotations",
 			mcp.WithDescription("Merges key-value annotation pairs into a Project's annotations. Project annotations are the widest-scope state store — visible to every agent and session in the project."),
@@ -259,3 +279,391 @@ func registerProjectTools(s *server.MCPServer, c *client.Client) {
 		tools.PatchProjectAnnotations(c),
 	)
 }
+
+func registerBlackboardTools(s *server.MCPServer, c *client.Client) {
+	s.AddTool(
+		mcp.NewTool("list_blackboard",
+			mcp.WithDescription("Lists live entries on a project's blackboard, the shared key/value store agents use to coordinate."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID whose blackboard to read."),
+				mcp.Required(),
+			),
+			mcp.WithString("prefix", mcp.Description("Only return keys starting with this prefix (e.g. 'plan.').")),
+		),
+		tools.ListBlackboard(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("get_blackboard_entry",
+			mcp.WithDescription("Returns one blackboard entry, including its version for compare-and-swap writes."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID whose blackboard to read."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("Entry key."),
+				mcp.Required(),
+			),
+		),
+		tools.GetBlackboardEntry(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("set_blackboard_entry",
+			mcp.WithDescription("Creates or replaces a blackboard entry. Pass expected_version to make the write a compare-and-swap; it fails if another writer got there first."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID whose blackboard to write."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("Entry key. Letters, digits, '.', '_', ':' and '-'."),
+				mcp.Required(),
+			),
+			mcp.WithString("value",
+				mcp.Description("Value to store (at most 64 KiB)."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("expected_version", mcp.Description("Write only if the entry is at this version. 0 means the key must not exist yet.")),
+			mcp.WithNumber("ttl_seconds", mcp.Description("Expire the entry after this many seconds. Omit to keep it until deleted.")),
+		),
+		tools.SetBlackboardEntry(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("delete_blackboard_entry",
+			mcp.WithDescription("Deletes a blackboard entry."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID whose blackboard to write."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("Entry key."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("expected_version", mcp.Description("Delete only if the entry is at this version.")),
+		),
+		tools.DeleteBlackboardEntry(c),
+	)
+}
+
+func registerInboxTools(s *server.MCPServer, c *client.Client) {
+	s.AddTool(
+		mcp.NewTool("list_inbox_messages",
+			mcp.WithDescription("Lists messages in an agent's inbox."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the agent belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id",
+				mcp.Description("Agent ID (UUID)."),
+				mcp.Required(),
+			),
+			mcp.WithString("search", mcp.Description("Search filter (e.g. \"read = false\").")),
+			mcp.WithNumber("page", mcp.Description("Page number (1-indexed). Default: 1.")),
+			mcp.WithNumber("size", mcp.Description("Page size. Default: 20. Max: 100.")),
+		),
+		tools.ListInboxMessages(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("send_inbox_message",
+			mcp.WithDescription("Sends a message to an agent's inbox. The agent sees unread messages the next time it starts."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the recipient agent belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id",
+				mcp.Description("Recipient agent ID (UUID)."),
+				mcp.Required(),
+			),
+			mcp.WithString("body",
+				mcp.Description("Message text."),
+				mcp.Required(),
+			),
+			mcp.WithString("from_agent_id", mcp.Description("Sending agent ID, when the sender is an agent.")),
+			mcp.WithString("from_name", mcp.Description("Display name of the sender.")),
+		),
+		tools.SendInboxMessage(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("mark_inbox_message_read",
+			mcp.WithDescription("Marks an inbox message as read."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the agent belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id",
+				mcp.Description("Agent ID (UUID) whose inbox holds the message."),
+				mcp.Required(),
+			),
+			mcp.WithString("message_id",
+				mcp.Description("Inbox message ID."),
+				mcp.Required(),
+			),
+		),
+		tools.MarkInboxMessageRead(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("delete_inbox_message",
+			mcp.WithDescription("Deletes an inbox message."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the agent belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id",
+				mcp.Description("Agent ID (UUID) whose inbox holds the message."),
+				mcp.Required(),
+			),
+			mcp.WithString("message_id",
+				mcp.Description("Inbox message ID."),
+				mcp.Required(),
+			),
+		),
+		tools.DeleteInboxMessage(c),
+	)
+}
+
+func registerCredentialTools(s *server.MCPServer, c *client.Client) {
+	s.AddTool(
+		mcp.NewTool("list_credentials",
+			mcp.WithDescription("Lists credentials visible to the caller in a project. Tokens are never returned."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID to list credentials for."),
+				mcp.Required(),
+			),
+			mcp.WithString("provider", mcp.Description("Filter by provider (e.g. 'github', 'gitlab', 'jira').")),
+			mcp.WithNumber("page", mcp.Description("Page number (1-indexed). Default: 1.")),
+			mcp.WithNumber("size", mcp.Description("Page size. Default: 20. Max: 100.")),
+		),
+		tools.ListCredentials(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("get_credential",
+			mcp.WithDescription("Returns metadata for a single credential. The token is never returned."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the credential belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("credential_id",
+				mcp.Description("Credential ID."),
+				mcp.Required(),
+			),
+		),
+		tools.GetCredential(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("list_agent_credentials",
+			mcp.WithDescription("Lists the credentials bound to an agent through role bindings, with the role each binding grants."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the agent belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id",
+				mcp.Description("Agent ID (UUID) or agent name."),
+				mcp.Required(),
+			),
+		),
+		tools.ListAgentCredentials(c),
+	)
+}
+
+func registerScheduledSessionTools(s *server.MCPServer, c *client.Client) {
+	s.AddTool(
+		mcp.NewTool("list_scheduled_sessions",
+			mcp.WithDescription("Lists scheduled sessions in a project."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID to list scheduled sessions for."),
+				mcp.Required(),
+			),
+			mcp.WithString("search", mcp.Description("Search filter (e.g. \"enabled = true\").")),
+			mcp.WithNumber("page", mcp.Description("Page number (1-indexed). Default: 1.")),
+			mcp.WithNumber("size", mcp.Description("Page size. Default: 20. Max: 100.")),
+		),
+		tools.ListScheduledSessions(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("get_scheduled_session",
+			mcp.WithDescription("Returns detail for a single scheduled session, including its next run time."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the scheduled session belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("scheduled_session_id",
+				mcp.Description("Scheduled session ID."),
+				mcp.Required(),
+			),
+		),
+		tools.GetScheduledSession(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("create_scheduled_session",
+			mcp.WithDescription("Creates a scheduled session that starts a new session on a cron schedule."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID in which to create the scheduled session."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("Scheduled session name."),
+				mcp.Required(),
+			),
+			mcp.WithString("schedule",
+				mcp.Description("Cron expression (e.g. '0 9 * * 1-5')."),
+				mcp.Required(),
+			),
+			mcp.WithString("agent_id", mcp.Description("Agent ID to run each session as.")),
+			mcp.WithString("session_prompt", mcp.Description("Task prompt for each session.")),
+			mcp.WithString("timezone", mcp.Description("IANA timezone for the schedule. Default: UTC.")),
+			mcp.WithString("description", mcp.Description("Human-readable description.")),
+			mcp.WithBoolean("enabled", mcp.Description("Whether the schedule is active. Default: true.")),
+		),
+		tools.CreateScheduledSession(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("suspend_scheduled_session",
+			mcp.WithDescription("Pauses a scheduled session. No further runs fire until it is resumed."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the scheduled session belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("scheduled_session_id",
+				mcp.Description("Scheduled session ID."),
+				mcp.Required(),
+			),
+		),
+		tools.SuspendScheduledSession(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("resume_scheduled_session",
+			mcp.WithDescription("Resumes a suspended scheduled session."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the scheduled session belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("scheduled_session_id",
+				mcp.Description("Scheduled session ID."),
+				mcp.Required(),
+			),
+		),
+		tools.ResumeScheduledSession(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("trigger_scheduled_session",
+			mcp.WithDescription("Fires a scheduled session immediately, outside its schedule. Returns the run and the session it started."),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID the scheduled session belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithString("scheduled_session_id",
+				mcp.Description("Scheduled session ID."),
+				mcp.Required(),
+			),
+		),
+		tools.TriggerScheduledSession(c),
+	)
+}
+
+func registerRoleBindingTools(s *server.MCPServer, c *client.Client) {
+	s.AddTool(
+		mcp.NewTool("list_role_bindings",
+			mcp.WithDescription("Lists role bindings visible to the caller, with optional filters."),
+			mcp.WithString("project_id", mcp.Description("Only bindings scoped to this project ID.")),
+			mcp.WithString("agent_id", mcp.Description("Only bindings for this agent ID.")),
+			mcp.WithString("user_id", mcp.Description("Only bindings for this user.")),
+			mcp.WithString("credential_id", mcp.Description("Only bindings granting access to this credential ID.")),
+			mcp.WithString("scope",
+				mcp.Description("Filter by binding scope."),
+				mcp.Enum("global", "project", "agent", "session", "credential"),
+			),
+			mcp.WithNumber("page", mcp.Description("Page number (1-indexed). Default: 1.")),
+			mcp.WithNumber("size", mcp.Description("Page size. Default: 20. Max: 100.")),
+		),
+		tools.ListRoleBindings(c),
+	)
+
+	s.AddTool(
+		mcp.NewTool("get_role_binding",
+			mcp.WithDescription("Returns a single role binding."),
+			mcp.WithString("role_binding_id",
+				mcp.Description("Role binding ID."),
+				mcp.Required(),
+			),
+		),
+		tools.GetRoleBinding(c),
+	)
+}
+
+func registerResources(s *server.MCPServer, c *client.Client) {
+	s.AddResource(
+		mcp.NewResource(tools.ProjectsURI, "Projects",
+			mcp.WithResourceDescription("Projects visible to the caller."),
+			mcp.WithMIMEType("application/json"),
+		),
+		tools.ReadProjects(c),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(tools.ProjectPromptURITemplate, "Project prompt",
+			mcp.WithTemplateDescription("The project's workspace prompt, injected into every session started in the project."),
+			mcp.WithTemplateMIMEType("text/markdown"),
+		),
+		tools.ReadProjectPrompt(c),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(tools.SessionURITemplate, "Session",
+			mcp.WithTemplateDescription("Full detail for a session. Use watch_session_messages to be notified of phase changes."),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		tools.ReadSession(c),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(tools.TranscriptURITemplate, "Session transcript",
+			mcp.WithTemplateDescription("A session's message log in seq order. Use watch_session_messages to be notified of new messages."),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		tools.ReadTranscript(c),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(tools.AgentURITemplate, "Agent",
+			mcp.WithTemplateDescription("Detail for an agent by ID or name."),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		tools.ReadAgent(c),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(tools.InboxURITemplate, "Agent inbox",
+			mcp.WithTemplateDescription("Messages waiting in an agent's inbox."),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		tools.ReadInbox(c),
+	)
+}
+
+func registerPrompts(s *server.MCPServer, c *client.Client) {
+	s.AddPrompt(
+		mcp.NewPrompt("agent_start",
+			mcp.WithPromptDescription("The prompt an agent would be started with now: persona, peers, project context and unread inbox."),
+			mcp.WithArgument("project_id",
+				mcp.ArgumentDescription("Project ID the agent belongs to."),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("agent_id",
+				mcp.ArgumentDescription("Agent ID (UUID) or agent name."),
+				mcp.RequiredArgument(),
+			),
+		),
+		tools.AgentStartPrompt(c),
+	)
+}
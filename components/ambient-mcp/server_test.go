@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/ambient/v1/sessions/s1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"id": "s1", "project_id": "p1", "phase": "Running"})
+	})
+	mux.HandleFunc("/api/ambient/v1/sessions/s1/messages", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]map[string]interface{}{{"id": "m1", "session_id": "s1", "seq": 1, "event_type": "user", "payload": "hi"}})
+	})
+	mux.HandleFunc("/api/ambient/v1/projects/p1/agents/a1/start", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("# Agent: a1"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func call(t *testing.T, c *client.Client, method string, params interface{}) map[string]interface{} {
+	t.Helper()
+	b, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	resp := newServer(c).HandleMessage(context.Background(), b)
+	out, _ := json.Marshal(resp)
+	var m map[string]interface{}
+	json.Unmarshal(out, &m)
+	return m
+}
+
+func TestReadResource_RoutesTemplates(t *testing.T) {
+	c := client.New(newTestServer(t).URL, "token")
+
+	for uri, want := range map[string]string{
+		"ambient://projects/p1/sessions/s1":            `"phase": "Running"`,
+		"ambient://projects/p1/sessions/s1/transcript": `"payload": "hi"`,
+	} {
+		resp := call(t, c, "resources/read", map[string]string{"uri": uri})
+		result, _ := resp["result"].(map[string]interface{})
+		if result == nil {
+			t.Fatalf("%s: no result: %v", uri, resp)
+		}
+		contents := result["contents"].([]interface{})
+		text := contents[0].(map[string]interface{})["text"].(string)
+		if !strings.Contains(text, want) {
+			t.Errorf("%s: got %s, want it to contain %s", uri, text, want)
+		}
+	}
+}
+
+func TestReadResource_RejectsSessionFromOtherProject(t *testing.T) {
+	c := client.New(newTestServer(t).URL, "token")
+
+	resp := call(t, c, "resources/read", map[string]string{"uri": "ambient://projects/p2/sessions/s1"})
+	if resp["error"] == nil {
+		t.Errorf("expected error reading a session through the wrong project, got %v", resp)
+	}
+}
+
+func TestGetPrompt_AgentStart(t *testing.T) {
+	c := client.New(newTestServer(t).URL, "token")
+
+	resp := call(t, c, "prompts/get", mcp.GetPromptParams{
+		Name:      "agent_start",
+		Arguments: map[string]string{"project_id": "p1", "agent_id": "a1"},
+	})
+	b, _ := json.Marshal(resp["result"])
+	if !strings.Contains(string(b), "# Agent: a1") {
+		t.Errorf("prompt result = %s", b)
+	}
+}
+
+func TestCallTool_ListAgentCredentials(t *testing.T) {
+	var gotSearch string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/ambient/v1/projects/p1/agents/reviewer", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"id": "a1", "name": "reviewer", "project_id": "p1"})
+	})
+	mux.HandleFunc("/api/ambient/v1/role_bindings", func(w http.ResponseWriter, r *http.Request) {
+		gotSearch = r.URL.Query().Get("search")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "RoleBindingList",
+			"items": []map[string]interface{}{
+				{"id": "rb1", "role_id": "r1", "scope": "credential", "agent_id": "a1", "credential_id": "c1"},
+				{"id": "rb2", "role_id": "r2", "scope": "agent", "agent_id": "a1", "credential_id": nil},
+			},
+		})
+	})
+	mux.HandleFunc("/api/ambient/v1/projects/p1/credentials/c1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"id": "c1", "name": "gh", "provider": "github", "token": "secret"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp := call(t, client.New(srv.URL, "token"), "tools/call", map[string]interface{}{
+		"name":      "list_agent_credentials",
+		"arguments": map[string]string{"project_id": "p1", "agent_id": "reviewer"},
+	})
+	b, _ := json.Marshal(resp["result"])
+	out := string(b)
+
+	if gotSearch != "agent_id = 'a1'" {
+		t.Errorf("role binding search = %q", gotSearch)
+	}
+	if !strings.Contains(out, `\"role_binding_id\": \"rb1\"`) || strings.Contains(out, "rb2") {
+		t.Errorf("expected only the credential binding, got %s", out)
+	}
+	if strings.Contains(out, "secret") {
+		t.Errorf("credential token leaked: %s", out)
+	}
+}
+
+func TestCallTool_RejectsQuotedFilter(t *testing.T) {
+	resp := call(t, client.New("http://unused", "token"), "tools/call", map[string]interface{}{
+		"name":      "list_role_bindings",
+		"arguments": map[string]string{"agent_id": "x' or '1'='1"},
+	})
+	b, _ := json.Marshal(resp["result"])
+	if !strings.Contains(string(b), "INVALID_REQUEST") {
+		t.Errorf("expected INVALID_REQUEST, got %s", b)
+	}
+}
+
+// recordedRequest is what a mock API handler saw for one call.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   map[string]interface{}
+}
+
+// newRecordingServer answers every request with status and response and
+// records it into got.
+func newRecordingServer(t *testing.T, got *recordedRequest, status int, response interface{}) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*got = recordedRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery}
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&got.Body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func callTool(t *testing.T, c *client.Client, name string, args map[string]interface{}) string {
+	t.Helper()
+	resp := call(t, c, "tools/call", map[string]interface{}{"name": name, "arguments": args})
+	b, _ := json.Marshal(resp["result"])
+	return string(b)
+}
+
+func TestCallTool_SendInboxMessage(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusCreated, map[string]string{"id": "m1", "agent_id": "a1", "body": "hello"})
+
+	out := callTool(t, client.New(srv.URL, "token"), "send_inbox_message", map[string]interface{}{
+		"project_id":    "p1",
+		"agent_id":      "a1",
+		"body":          "hello",
+		"from_agent_id": "a2",
+	})
+
+	if got.Method != http.MethodPost || got.Path != "/api/ambient/v1/projects/p1/agents/a1/inbox" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	if got.Body["body"] != "hello" || got.Body["from_agent_id"] != "a2" {
+		t.Errorf("request body = %v", got.Body)
+	}
+	if _, ok := got.Body["from_name"]; ok {
+		t.Errorf("expected unset from_name to be omitted, got %v", got.Body)
+	}
+	if !strings.Contains(out, `\"id\": \"m1\"`) {
+		t.Errorf("tool result = %s", out)
+	}
+}
+
+func TestCallTool_SendInboxMessageRequiresBody(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusCreated, nil)
+
+	out := callTool(t, client.New(srv.URL, "token"), "send_inbox_message", map[string]interface{}{
+		"project_id": "p1",
+		"agent_id":   "a1",
+	})
+
+	if !strings.Contains(out, "INVALID_REQUEST") {
+		t.Errorf("expected INVALID_REQUEST, got %s", out)
+	}
+	if got.Method != "" {
+		t.Errorf("expected no API call, got %s %s", got.Method, got.Path)
+	}
+}
+
+func TestCallTool_MarkInboxMessageRead(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusOK, map[string]interface{}{"id": "m1", "read": true})
+
+	out := callTool(t, client.New(srv.URL, "token"), "mark_inbox_message_read", map[string]interface{}{
+		"project_id": "p1",
+		"agent_id":   "a1",
+		"message_id": "m1",
+	})
+
+	if got.Method != http.MethodPatch || got.Path != "/api/ambient/v1/projects/p1/agents/a1/inbox/m1" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	if got.Body["read"] != true {
+		t.Errorf("request body = %v", got.Body)
+	}
+	if !strings.Contains(out, `\"read\": true`) {
+		t.Errorf("tool result = %s", out)
+	}
+}
+
+func TestCallTool_ListInboxMessages(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusOK, map[string]interface{}{
+		"kind":  "InboxMessageList",
+		"items": []map[string]interface{}{{"id": "m1", "body": "hello", "read": false}},
+	})
+
+	out := callTool(t, client.New(srv.URL, "token"), "list_inbox_messages", map[string]interface{}{
+		"project_id": "p1",
+		"agent_id":   "a1",
+		"size":       5,
+	})
+
+	if got.Method != http.MethodGet || got.Path != "/api/ambient/v1/projects/p1/agents/a1/inbox" || got.Query != "size=5" {
+		t.Errorf("request = %s %s?%s", got.Method, got.Path, got.Query)
+	}
+	if !strings.Contains(out, `\"body\": \"hello\"`) {
+		t.Errorf("tool result = %s", out)
+	}
+}
+
+func TestCallTool_CreateScheduledSession(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusCreated, map[string]interface{}{"id": "ss1", "name": "nightly", "enabled": false})
+
+	out := callTool(t, client.New(srv.URL, "token"), "create_scheduled_session", map[string]interface{}{
+		"project_id": "p1",
+		"name":       "nightly",
+		"schedule":   "0 2 * * *",
+		"agent_id":   "a1",
+		"timezone":   "UTC",
+		"enabled":    false,
+	})
+
+	if got.Method != http.MethodPost || got.Path != "/api/ambient/v1/projects/p1/scheduled-sessions" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	for field, want := range map[string]interface{}{
+		"name":     "nightly",
+		"schedule": "0 2 * * *",
+		"agent_id": "a1",
+		"timezone": "UTC",
+		"enabled":  false,
+	} {
+		if got.Body[field] != want {
+			t.Errorf("request body %s = %v, want %v", field, got.Body[field], want)
+		}
+	}
+	if _, ok := got.Body["session_prompt"]; ok {
+		t.Errorf("expected unset session_prompt to be omitted, got %v", got.Body)
+	}
+	if !strings.Contains(out, `\"id\": \"ss1\"`) {
+		t.Errorf("tool result = %s", out)
+	}
+}
+
+func TestCallTool_CreateScheduledSessionOmitsUnsetEnabled(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusCreated, map[string]interface{}{"id": "ss1"})
+
+	callTool(t, client.New(srv.URL, "token"), "create_scheduled_session", map[string]interface{}{
+		"project_id": "p1",
+		"name":       "nightly",
+		"schedule":   "0 2 * * *",
+	})
+
+	if _, ok := got.Body["enabled"]; ok {
+		t.Errorf("expected enabled to be left to the server default, got %v", got.Body)
+	}
+}
+
+func TestCallTool_SuspendScheduledSession(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusOK, map[string]interface{}{"id": "ss1", "enabled": false})
+
+	out := callTool(t, client.New(srv.URL, "token"), "suspend_scheduled_session", map[string]interface{}{
+		"project_id":           "p1",
+		"scheduled_session_id": "ss1",
+	})
+
+	if got.Method != http.MethodPost || got.Path != "/api/ambient/v1/projects/p1/scheduled-sessions/ss1/suspend" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	if !strings.Contains(out, `\"enabled\": false`) {
+		t.Errorf("tool result = %s", out)
+	}
+}
+
+func TestCallTool_SuspendScheduledSessionReportsFailure(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusNotFound, map[string]string{"code": "not-found", "reason": "no such schedule"})
+
+	out := callTool(t, client.New(srv.URL, "token"), "suspend_scheduled_session", map[string]interface{}{
+		"project_id":           "p1",
+		"scheduled_session_id": "missing",
+	})
+
+	if !strings.Contains(out, "SUSPEND_FAILED") {
+		t.Errorf("expected SUSPEND_FAILED, got %s", out)
+	}
+}
+
+func TestCallTool_ListRoleBindingsFilters(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusOK, map[string]interface{}{"kind": "RoleBindingList"})
+
+	callTool(t, client.New(srv.URL, "token"), "list_role_bindings", map[string]interface{}{
+		"project_id": "p1",
+		"scope":      "credential",
+	})
+
+	if got.Method != http.MethodGet || got.Path != "/api/ambient/v1/role_bindings" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	if got.Query != "search=project_id+%3D+%27p1%27+and+scope+%3D+%27credential%27" {
+		t.Errorf("query = %s", got.Query)
+	}
+}
+
+func TestCallTool_GetCredentialHidesToken(t *testing.T) {
+	var got recordedRequest
+	srv := newRecordingServer(t, &got, http.StatusOK, map[string]string{"id": "c1", "name": "gh", "provider": "github", "token": "secret"})
+
+	out := callTool(t, client.New(srv.URL, "token"), "get_credential", map[string]interface{}{
+		"project_id":    "p1",
+		"credential_id": "c1",
+	})
+
+	if got.Method != http.MethodGet || got.Path != "/api/ambient/v1/projects/p1/credentials/c1" {
+		t.Errorf("request = %s %s", got.Method, got.Path)
+	}
+	if !strings.Contains(out, `\"provider\": \"github\"`) || strings.Contains(out, "secret") {
+		t.Errorf("tool result = %s", out)
+	}
+}
@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+type blackboardEntryList struct {
+	Kind  string            `json:"kind"`
+	Total int               `json:"total"`
+	Items []blackboardEntry `json:"items"`
+}
+
+type blackboardEntry struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Version   int64  `json:"version"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+func blackboardPath(projectID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/blackboard"
+}
+
+// optionalInt reports a numeric argument only when the caller supplied it,
+// so that 0 stays distinguishable from "not set".
+func optionalInt(req mcp.CallToolRequest, name string) (int64, bool) {
+	if _, ok := req.GetArguments()[name]; !ok {
+		return 0, false
+	}
+	return int64(mcp.ParseInt(req, name, 0)), true
+}
+
+func ListBlackboard(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+
+		params := url.Values{}
+		if v := mcp.ParseString(req, "prefix", ""); v != "" {
+			params.Set("prefix", v)
+		}
+
+		var result blackboardEntryList
+		if err := c.GetWithQuery(ctx, blackboardPath(projectID), params, &result); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func GetBlackboardEntry(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		key := mcp.ParseString(req, "key", "")
+		if key == "" {
+			return errResult("INVALID_REQUEST", "key is required"), nil
+		}
+
+		var result blackboardEntry
+		if err := c.Get(ctx, blackboardPath(projectID)+"/"+url.PathEscape(key), &result); err != nil {
+			return errResult("ENTRY_NOT_FOUND", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func SetBlackboardEntry(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		key := mcp.ParseString(req, "key", "")
+		if key == "" {
+			return errResult("INVALID_REQUEST", "key is required"), nil
+		}
+		if _, ok := req.GetArguments()["value"]; !ok {
+			return errResult("INVALID_REQUEST", "value is required"), nil
+		}
+
+		body := map[string]interface{}{
+			"value": mcp.ParseString(req, "value", ""),
+		}
+		if v, ok := optionalInt(req, "expected_version"); ok {
+			body["expected_version"] = v
+		}
+		if v, ok := optionalInt(req, "ttl_seconds"); ok && v > 0 {
+			body["ttl_seconds"] = v
+		}
+
+		var result blackboardEntry
+		if err := c.Put(ctx, blackboardPath(projectID)+"/"+url.PathEscape(key), body, &result); err != nil {
+			return errResult("WRITE_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func DeleteBlackboardEntry(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		key := mcp.ParseString(req, "key", "")
+		if key == "" {
+			return errResult("INVALID_REQUEST", "key is required"), nil
+		}
+
+		path := blackboardPath(projectID) + "/" + url.PathEscape(key)
+		if v, ok := optionalInt(req, "expected_version"); ok {
+			path += "?expected_version=" + strconv.FormatInt(v, 10)
+		}
+		if err := c.Delete(ctx, path); err != nil {
+			return errResult("DELETE_FAILED", err.Error()), nil
+		}
+		return jsonResult(map[string]string{"key": key, "status": "deleted"})
+	}
+}
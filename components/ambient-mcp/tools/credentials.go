@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+type credentialList struct {
+	Kind  string       `json:"kind"`
+	Page  int          `json:"page"`
+	Size  int          `json:"size"`
+	Total int          `json:"total"`
+	Items []credential `json:"items"`
+}
+
+// credential deliberately has no token field: the tools only ever expose
+// credential metadata, never the secret.
+type credential struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Provider    string `json:"provider,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Labels      string `json:"labels,omitempty"`
+	Annotations string `json:"annotations,omitempty"`
+}
+
+func credentialsPath(projectID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/credentials"
+}
+
+func ListCredentials(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+
+		params := url.Values{}
+		if v := mcp.ParseString(req, "provider", ""); v != "" {
+			clause, err := searchEquals("provider", v)
+			if err != nil {
+				return errResult("INVALID_REQUEST", err.Error()), nil
+			}
+			params.Set("search", clause)
+		}
+		setPaging(req, params)
+
+		var result credentialList
+		if err := c.GetWithQuery(ctx, credentialsPath(projectID), params, &result); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func GetCredential(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		credID := mcp.ParseString(req, "credential_id", "")
+		if credID == "" {
+			return errResult("INVALID_REQUEST", "credential_id is required"), nil
+		}
+
+		var result credential
+		if err := c.Get(ctx, credentialsPath(projectID)+"/"+url.PathEscape(credID), &result); err != nil {
+			return errResult("CREDENTIAL_NOT_FOUND", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+// ListAgentCredentials resolves the credentials bound to an agent through
+// role bindings that name both the agent and a credential.
+func ListAgentCredentials(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		agentID := mcp.ParseString(req, "agent_id", "")
+		if agentID == "" {
+			return errResult("INVALID_REQUEST", "agent_id is required"), nil
+		}
+
+		var a agent
+		if err := c.Get(ctx, "/projects/"+url.PathEscape(projectID)+"/agents/"+url.PathEscape(agentID), &a); err != nil {
+			return errResult("AGENT_NOT_FOUND", err.Error()), nil
+		}
+
+		clause, err := searchEquals("agent_id", a.ID)
+		if err != nil {
+			return errResult("INVALID_REQUEST", err.Error()), nil
+		}
+		var bindings roleBindingList
+		if err := c.GetWithQuery(ctx, "/role_bindings", url.Values{"search": {clause}, "size": {"100"}}, &bindings); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+
+		type boundCredential struct {
+			credential
+			RoleID        string `json:"role_id"`
+			RoleBindingID string `json:"role_binding_id"`
+		}
+		items := []boundCredential{}
+		for _, b := range bindings.Items {
+			if b.CredentialID == "" {
+				continue
+			}
+			var cred credential
+			if err := c.Get(ctx, credentialsPath(projectID)+"/"+url.PathEscape(b.CredentialID), &cred); err != nil {
+				return errResult("CREDENTIAL_NOT_FOUND", err.Error()), nil
+			}
+			items = append(items, boundCredential{credential: cred, RoleID: b.RoleID, RoleBindingID: b.ID})
+		}
+
+		return jsonResult(map[string]interface{}{
+			"agent_id": a.ID,
+			"total":    len(items),
+			"items":    items,
+		})
+	}
+}
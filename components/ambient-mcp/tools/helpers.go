@@ -2,6 +2,9 @@ package tools
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -21,3 +24,22 @@ func errResult(code, reason string) *mcp.CallToolResult {
 	})
 	return mcp.NewToolResultError(string(b))
 }
+
+// setPaging copies the optional page and size arguments into params.
+func setPaging(req mcp.CallToolRequest, params url.Values) {
+	if page := mcp.ParseInt(req, "page", 0); page > 0 {
+		params.Set("page", fmt.Sprintf("%d", page))
+	}
+	if size := mcp.ParseInt(req, "size", 0); size > 0 {
+		params.Set("size", fmt.Sprintf("%d", size))
+	}
+}
+
+// searchEquals builds a search clause matching field exactly. Values are
+// interpolated into the server's search DSL, so quotes are rejected.
+func searchEquals(field, value string) (string, error) {
+	if strings.ContainsAny(value, `'"\`) {
+		return "", fmt.Errorf("%s must not contain quotes or backslashes", field)
+	}
+	return field + " = '" + value + "'", nil
+}
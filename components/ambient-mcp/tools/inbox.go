@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+type inboxMessageList struct {
+	Kind  string         `json:"kind"`
+	Page  int            `json:"page"`
+	Size  int            `json:"size"`
+	Total int            `json:"total"`
+	Items []inboxMessage `json:"items"`
+}
+
+type inboxMessage struct {
+	ID          string `json:"id,omitempty"`
+	AgentID     string `json:"agent_id,omitempty"`
+	FromAgentID string `json:"from_agent_id,omitempty"`
+	FromName    string `json:"from_name,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Read        *bool  `json:"read,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+func inboxPath(projectID, agentID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/agents/" + url.PathEscape(agentID) + "/inbox"
+}
+
+func ListInboxMessages(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		agentID := mcp.ParseString(req, "agent_id", "")
+		if agentID == "" {
+			return errResult("INVALID_REQUEST", "agent_id is required"), nil
+		}
+
+		params := url.Values{}
+		if v := mcp.ParseString(req, "search", ""); v != "" {
+			params.Set("search", v)
+		}
+		setPaging(req, params)
+
+		var result inboxMessageList
+		if err := c.GetWithQuery(ctx, inboxPath(projectID, agentID), params, &result); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func SendInboxMessage(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		agentID := mcp.ParseString(req, "agent_id", "")
+		if agentID == "" {
+			return errResult("INVALID_REQUEST", "agent_id is required"), nil
+		}
+		body := mcp.ParseString(req, "body", "")
+		if body == "" {
+			return errResult("INVALID_REQUEST", "body is required"), nil
+		}
+
+		msg := map[string]interface{}{"body": body}
+		if v := mcp.ParseString(req, "from_agent_id", ""); v != "" {
+			msg["from_agent_id"] = v
+		}
+		if v := mcp.ParseString(req, "from_name", ""); v != "" {
+			msg["from_name"] = v
+		}
+
+		var result inboxMessage
+		if err := c.Post(ctx, inboxPath(projectID, agentID), msg, &result, http.StatusCreated); err != nil {
+			return errResult("CREATE_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func MarkInboxMessageRead(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		agentID := mcp.ParseString(req, "agent_id", "")
+		if agentID == "" {
+			return errResult("INVALID_REQUEST", "agent_id is required"), nil
+		}
+		msgID := mcp.ParseString(req, "message_id", "")
+		if msgID == "" {
+			return errResult("INVALID_REQUEST", "message_id is required"), nil
+		}
+
+		var result inboxMessage
+		path := inboxPath(projectID, agentID) + "/" + url.PathEscape(msgID)
+		if err := c.Patch(ctx, path, map[string]interface{}{"read": true}, &result); err != nil {
+			return errResult("PATCH_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func DeleteInboxMessage(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		agentID := mcp.ParseString(req, "agent_id", "")
+		if agentID == "" {
+			return errResult("INVALID_REQUEST", "agent_id is required"), nil
+		}
+		msgID := mcp.ParseString(req, "message_id", "")
+		if msgID == "" {
+			return errResult("INVALID_REQUEST", "message_id is required"), nil
+		}
+
+		path := inboxPath(projectID, agentID) + "/" + url.PathEscape(msgID)
+		if err := c.Delete(ctx, path); err != nil {
+			return errResult("DELETE_FAILED", err.Error()), nil
+		}
+		return jsonResult(map[string]interface{}{"id": msgID, "status": "deleted"})
+	}
+}
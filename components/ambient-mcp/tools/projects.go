@@ -2,6 +2,7 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -63,6 +64,24 @@ func GetProject(c *client.Client) func(ctx context.Context, req mcp.CallToolRequ
 	}
 }
 
+// GetProjectHome returns the project's aggregate dashboard in one call, so
+// callers don't have to fan out across agents, inbox, sessions, scheduled
+// sessions and applications.
+func GetProjectHome(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+
+		var result json.RawMessage
+		if err := c.Get(ctx, "/projects/"+url.PathEscape(projectID)+"/home", &result); err != nil {
+			return errResult("PROJECT_NOT_FOUND", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
 func PatchProjectAnnotations(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		projectID := mcp.ParseString(req, "project_id", "")
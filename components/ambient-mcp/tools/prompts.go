@@ -0,0 +1,37 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+// AgentStartPrompt returns the prompt an agent would be started with right
+// now: its persona, peers, project context and unread inbox, as rendered by
+// the API server's start preview.
+func AgentStartPrompt(c *client.Client) func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		projectID := req.Params.Arguments["project_id"]
+		if projectID == "" {
+			return nil, fmt.Errorf("project_id is required")
+		}
+		agentID := req.Params.Arguments["agent_id"]
+		if agentID == "" {
+			return nil, fmt.Errorf("agent_id is required")
+		}
+
+		path := "/projects/" + url.PathEscape(projectID) + "/agents/" + url.PathEscape(agentID) + "/start"
+		text, err := c.GetText(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("preview start prompt for agent %s: %w", agentID, err)
+		}
+		return mcp.NewGetPromptResult(
+			"Start prompt for agent "+agentID,
+			[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text))},
+		), nil
+	}
+}
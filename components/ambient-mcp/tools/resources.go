@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+// URI templates for platform state published as MCP resources.
+const (
+	ProjectsURI              = "ambient://projects"
+	ProjectPromptURITemplate = "ambient://projects/{project_id}/prompt"
+	SessionURITemplate       = "ambient://projects/{project_id}/sessions/{session_id}"
+	TranscriptURITemplate    = "ambient://projects/{project_id}/sessions/{session_id}/transcript"
+	AgentURITemplate         = "ambient://projects/{project_id}/agents/{agent_id}"
+	InboxURITemplate         = "ambient://projects/{project_id}/agents/{agent_id}/inbox"
+)
+
+func SessionURI(projectID, sessionID string) string {
+	return "ambient://projects/" + url.PathEscape(projectID) + "/sessions/" + url.PathEscape(sessionID)
+}
+
+func TranscriptURI(projectID, sessionID string) string {
+	return SessionURI(projectID, sessionID) + "/transcript"
+}
+
+func ReadProjects(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		var result projectList
+		if err := c.GetWithQuery(ctx, "/projects", url.Values{"size": {"100"}}, &result); err != nil {
+			return nil, fmt.Errorf("list projects: %w", err)
+		}
+		return jsonContents(req.Params.URI, result)
+	}
+}
+
+func ReadProjectPrompt(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		projectID := uriVar(req, "project_id")
+		if projectID == "" {
+			return nil, fmt.Errorf("project_id is required")
+		}
+
+		var result project
+		if err := c.Get(ctx, "/projects/"+url.PathEscape(projectID), &result); err != nil {
+			return nil, fmt.Errorf("get project %s: %w", projectID, err)
+		}
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{URI: req.Params.URI, MIMEType: "text/markdown", Text: result.Prompt},
+		}, nil
+	}
+}
+
+func ReadSession(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		s, err := sessionInProject(ctx, c, uriVar(req, "project_id"), uriVar(req, "session_id"))
+		if err != nil {
+			return nil, err
+		}
+		return jsonContents(req.Params.URI, s)
+	}
+}
+
+func ReadTranscript(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		s, err := sessionInProject(ctx, c, uriVar(req, "project_id"), uriVar(req, "session_id"))
+		if err != nil {
+			return nil, err
+		}
+
+		var msgs []sessionMessage
+		if err := c.Get(ctx, "/sessions/"+url.PathEscape(s.ID)+"/messages", &msgs); err != nil {
+			return nil, fmt.Errorf("list messages for session %s: %w", s.ID, err)
+		}
+		if msgs == nil {
+			msgs = []sessionMessage{}
+		}
+		return jsonContents(req.Params.URI, msgs)
+	}
+}
+
+func ReadAgent(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		path, err := agentPath(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result agent
+		if err := c.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("get agent: %w", err)
+		}
+		return jsonContents(req.Params.URI, result)
+	}
+}
+
+func ReadInbox(c *client.Client) func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		path, err := agentPath(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result map[string]interface{}
+		if err := c.GetWithQuery(ctx, path+"/inbox", url.Values{"size": {"100"}}, &result); err != nil {
+			return nil, fmt.Errorf("list inbox: %w", err)
+		}
+		return jsonContents(req.Params.URI, result)
+	}
+}
+
+// sessionInProject fetches a session and checks it belongs to projectID, so a
+// resource URI cannot address a session through the wrong project.
+func sessionInProject(ctx context.Context, c *client.Client, projectID, sessionID string) (*session, error) {
+	if projectID == "" || sessionID == "" {
+		return nil, fmt.Errorf("project_id and session_id are required")
+	}
+
+	var s session
+	if err := c.Get(ctx, "/sessions/"+url.PathEscape(sessionID), &s); err != nil {
+		return nil, fmt.Errorf("get session %s: %w", sessionID, err)
+	}
+	if s.ProjectID != projectID {
+		return nil, fmt.Errorf("session %s not found in project %s", sessionID, projectID)
+	}
+	return &s, nil
+}
+
+func agentPath(req mcp.ReadResourceRequest) (string, error) {
+	projectID := uriVar(req, "project_id")
+	agentID := uriVar(req, "agent_id")
+	if projectID == "" || agentID == "" {
+		return "", fmt.Errorf("project_id and agent_id are required")
+	}
+	return "/projects/" + url.PathEscape(projectID) + "/agents/" + url.PathEscape(agentID), nil
+}
+
+// uriVar returns a variable matched from the resource URI template.
+func uriVar(req mcp.ReadResourceRequest, name string) string {
+	var raw string
+	switch v := req.Params.Arguments[name].(type) {
+	case string:
+		raw = v
+	case []string:
+		if len(v) > 0 {
+			raw = v[0]
+		}
+	}
+	if unescaped, err := url.PathUnescape(raw); err == nil {
+		return unescaped
+	}
+	return raw
+}
+
+func jsonContents(uri string, v interface{}) ([]mcp.ResourceContents, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %w", uri, err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(b)},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+type roleBindingList struct {
+	Kind  string        `json:"kind"`
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Total int           `json:"total"`
+	Items []roleBinding `json:"items"`
+}
+
+type roleBinding struct {
+	ID           string `json:"id,omitempty"`
+	RoleID       string `json:"role_id,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	ProjectID    string `json:"project_id,omitempty"`
+	AgentID      string `json:"agent_id,omitempty"`
+	SessionID    string `json:"session_id,omitempty"`
+	CredentialID string `json:"credential_id,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+}
+
+func ListRoleBindings(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var clauses []string
+		for _, field := range []string{"project_id", "agent_id", "user_id", "credential_id", "scope"} {
+			v := mcp.ParseString(req, field, "")
+			if v == "" {
+				continue
+			}
+			clause, err := searchEquals(field, v)
+			if err != nil {
+				return errResult("INVALID_REQUEST", err.Error()), nil
+			}
+			clauses = append(clauses, clause)
+		}
+
+		params := url.Values{}
+		if len(clauses) > 0 {
+			params.Set("search", strings.Join(clauses, " and "))
+		}
+		setPaging(req, params)
+
+		var result roleBindingList
+		if err := c.GetWithQuery(ctx, "/role_bindings", params, &result); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func GetRoleBinding(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id := mcp.ParseString(req, "role_binding_id", "")
+		if id == "" {
+			return errResult("INVALID_REQUEST", "role_binding_id is required"), nil
+		}
+
+		var result roleBinding
+		if err := c.Get(ctx, "/role_bindings/"+url.PathEscape(id), &result); err != nil {
+			return errResult("ROLE_BINDING_NOT_FOUND", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/ambient-code/platform/components/ambient-mcp/client"
+)
+
+type scheduledSessionList struct {
+	Kind  string             `json:"kind"`
+	Page  int                `json:"page"`
+	Size  int                `json:"size"`
+	Total int                `json:"total"`
+	Items []scheduledSession `json:"items"`
+}
+
+type scheduledSession struct {
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	ProjectID     string `json:"project_id,omitempty"`
+	AgentID       string `json:"agent_id,omitempty"`
+	Schedule      string `json:"schedule,omitempty"`
+	Timezone      string `json:"timezone,omitempty"`
+	Enabled       bool   `json:"enabled"`
+	SessionPrompt string `json:"session_prompt,omitempty"`
+	LastRunAt     string `json:"last_run_at,omitempty"`
+	NextRunAt     string `json:"next_run_at,omitempty"`
+}
+
+func scheduledSessionsPath(projectID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/scheduled-sessions"
+}
+
+func ListScheduledSessions(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+
+		params := url.Values{}
+		if v := mcp.ParseString(req, "search", ""); v != "" {
+			params.Set("search", v)
+		}
+		setPaging(req, params)
+
+		var result scheduledSessionList
+		if err := c.GetWithQuery(ctx, scheduledSessionsPath(projectID), params, &result); err != nil {
+			return errResult("LIST_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func GetScheduledSession(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		id := mcp.ParseString(req, "scheduled_session_id", "")
+		if id == "" {
+			return errResult("INVALID_REQUEST", "scheduled_session_id is required"), nil
+		}
+
+		var result scheduledSession
+		if err := c.Get(ctx, scheduledSessionsPath(projectID)+"/"+url.PathEscape(id), &result); err != nil {
+			return errResult("SCHEDULED_SESSION_NOT_FOUND", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+func CreateScheduledSession(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		name := mcp.ParseString(req, "name", "")
+		if name == "" {
+			return errResult("INVALID_REQUEST", "name is required"), nil
+		}
+		schedule := mcp.ParseString(req, "schedule", "")
+		if schedule == "" {
+			return errResult("INVALID_REQUEST", "schedule is required"), nil
+		}
+
+		body := map[string]interface{}{
+			"name":     name,
+			"schedule": schedule,
+		}
+		for _, field := range []string{"agent_id", "session_prompt", "timezone", "description"} {
+			if v := mcp.ParseString(req, field, ""); v != "" {
+				body[field] = v
+			}
+		}
+		if _, ok := req.GetArguments()["enabled"]; ok {
+			body["enabled"] = mcp.ParseBoolean(req, "enabled", true)
+		}
+
+		var result scheduledSession
+		if err := c.Post(ctx, scheduledSessionsPath(projectID), body, &result, http.StatusCreated); err != nil {
+			return errResult("CREATE_FAILED", err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
+
+// SuspendScheduledSession and ResumeScheduledSession pause and unpause a
+// schedule without losing its definition.
+func SuspendScheduledSession(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return scheduledSessionAction(c, "suspend", "SUSPEND_FAILED")
+}
+
+func ResumeScheduledSession(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return scheduledSessionAction(c, "resume", "RESUME_FAILED")
+}
+
+func TriggerScheduledSession(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return scheduledSessionAction(c, "trigger", "TRIGGER_FAILED")
+}
+
+func scheduledSessionAction(c *client.Client, action, failCode string) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectID := mcp.ParseString(req, "project_id", "")
+		if projectID == "" {
+			return errResult("INVALID_REQUEST", "project_id is required"), nil
+		}
+		id := mcp.ParseString(req, "scheduled_session_id", "")
+		if id == "" {
+			return errResult("INVALID_REQUEST", "scheduled_session_id is required"), nil
+		}
+
+		var result map[string]interface{}
+		path := scheduledSessionsPath(projectID) + "/" + url.PathEscape(id) + "/" + action
+		if err := c.Post(ctx, path, map[string]interface{}{}, &result, http.StatusOK); err != nil {
+			return errResult(failCode, err.Error()), nil
+		}
+		return jsonResult(result)
+	}
+}
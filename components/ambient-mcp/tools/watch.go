@@ -2,36 +2,74 @@ package tools
 
 import (
 	"context"
+	"net/url"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 
 	"github.com/ambient-code/platform/components/ambient-mcp/client"
 )
 
+// WatchPollInterval is how often an active watch polls for new messages.
+var WatchPollInterval = 2 * time.Second
+
 var (
 	subscriptionsMu sync.Mutex
-	subscriptions   = make(map[string]context.CancelFunc)
+	subscriptions   = make(map[string]*sessionWatch)
 )
 
-func WatchSessionMessages(c *client.Client, transport string) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// WatchSessionMessages polls a session's message log on behalf of the calling
+// MCP client. Each new message and each phase change is pushed as
+// notifications/progress. The watch ends by itself once the session reaches a
+// terminal phase.
+func WatchSessionMessages(c *client.Client) func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		if transport == "stdio" {
-			return errResult("TRANSPORT_NOT_SUPPORTED", "watch_session_messages requires SSE transport; caller is on stdio"), nil
-		}
-
 		sessionID := mcp.ParseString(req, "session_id", "")
 		if sessionID == "" {
 			return errResult("INVALID_REQUEST", "session_id is required"), nil
 		}
+		afterSeq := mcp.ParseInt(req, "after_seq", 0)
 
-		_ = c
-		subID := "sub_" + sessionID
+		srv := server.ServerFromContext(ctx)
+		clientSession := server.ClientSessionFromContext(ctx)
+		if srv == nil || clientSession == nil {
+			return errResult("TRANSPORT_NOT_SUPPORTED", "watch_session_messages requires a client session that accepts notifications"), nil
+		}
+
+		var s session
+		if err := c.Get(ctx, "/sessions/"+url.PathEscape(sessionID), &s); err != nil {
+			return errResult("SESSION_NOT_FOUND", err.Error()), nil
+		}
+
+		subID := "sub_" + clientSession.SessionID() + "_" + sessionID
+		watchCtx, cancel := context.WithCancel(context.Background())
+		w := &sessionWatch{
+			c:        c,
+			srv:      srv,
+			cancel:   cancel,
+			clientID: clientSession.SessionID(),
+			subID:    subID,
+			session:  s,
+			afterSeq: afterSeq,
+		}
+
+		subscriptionsMu.Lock()
+		if prev, ok := subscriptions[subID]; ok {
+			prev.cancel()
+		}
+		subscriptions[subID] = w
+		subscriptionsMu.Unlock()
+
+		go w.run(watchCtx)
 
 		return jsonResult(map[string]interface{}{
 			"subscription_id": subID,
 			"session_id":      sessionID,
-			"note":            "streaming subscription registered; messages delivered via notifications/progress",
+			"resources":       []string{SessionURI(s.ProjectID, s.ID), TranscriptURI(s.ProjectID, s.ID)},
+			"note":            "messages and phase changes delivered via notifications/progress",
 		})
 	}
 }
@@ -44,9 +82,9 @@ func UnwatchSessionMessages() func(ctx context.Context, req mcp.CallToolRequest)
 		}
 
 		subscriptionsMu.Lock()
-		cancel, ok := subscriptions[subID]
+		w, ok := subscriptions[subID]
 		if ok {
-			cancel()
+			w.cancel()
 			delete(subscriptions, subID)
 		}
 		subscriptionsMu.Unlock()
@@ -57,3 +95,87 @@ func UnwatchSessionMessages() func(ctx context.Context, req mcp.CallToolRequest)
 		return jsonResult(map[string]interface{}{"cancelled": true})
 	}
 }
+
+type sessionWatch struct {
+	c        *client.Client
+	srv      *server.MCPServer
+	cancel   context.CancelFunc
+	clientID string
+	subID    string
+	session  session
+	afterSeq int
+}
+
+func (w *sessionWatch) run(ctx context.Context) {
+	defer w.remove()
+
+	ticker := time.NewTicker(WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if !w.poll(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll delivers anything new since the last poll. It returns false once the
+// watch should stop: cancelled, the session has finished, or the client has
+// disconnected.
+func (w *sessionWatch) poll(ctx context.Context) bool {
+	var msgs []sessionMessage
+	path := "/sessions/" + url.PathEscape(w.session.ID) + "/messages"
+	if err := w.c.GetWithQuery(ctx, path, url.Values{"after_seq": {strconv.Itoa(w.afterSeq)}}, &msgs); err != nil {
+		return ctx.Err() == nil
+	}
+
+	for _, m := range msgs {
+		if m.Seq <= w.afterSeq {
+			continue
+		}
+		w.afterSeq = m.Seq
+		if !w.progress(map[string]any{"session_id": w.session.ID, "message": m}) {
+			return false
+		}
+	}
+
+	var current session
+	if err := w.c.Get(ctx, "/sessions/"+url.PathEscape(w.session.ID), &current); err != nil {
+		return ctx.Err() == nil
+	}
+	changed := current.Phase != w.session.Phase
+	w.session = current
+
+	switch current.Phase {
+	case "Completed", "Failed", "Stopped":
+		w.progress(map[string]any{"session_id": current.ID, "terminal": true, "phase": current.Phase})
+		return false
+	}
+	if changed && !w.progress(map[string]any{"session_id": current.ID, "phase": current.Phase}) {
+		return false
+	}
+	return true
+}
+
+func (w *sessionWatch) progress(progress map[string]any) bool {
+	return w.notify("notifications/progress", map[string]any{"progressToken": w.subID, "progress": progress})
+}
+
+func (w *sessionWatch) notify(method string, params map[string]any) bool {
+	return w.srv.SendNotificationToSpecificClient(w.clientID, method, params) == nil
+}
+
+func (w *sessionWatch) remove() {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	w.cancel()
+	// Only drop our own entry; a re-watch may have replaced it.
+	if subscriptions[w.subID] == w {
+		delete(subscriptions, w.subID)
+	}
+}
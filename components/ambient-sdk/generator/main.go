@@ -21,8 +21,33 @@ func main() {
 	tsOut := flag.String("ts-out", "", "output directory for TypeScript SDK")
 	protoPath := flag.String("proto", "", "path to .proto file (required for --grpc-python-out)")
 	grpcPythonOut := flag.String("grpc-python-out", "", "output directory for Python gRPC client")
+	protoDir := flag.String("proto-dir", "", "directory of .proto files (required for --grpc-go-out)")
+	grpcGoOut := flag.String("grpc-go-out", "", "Go SDK directory to generate gRPC watch clients into")
 	flag.Parse()
 
+	if *grpcGoOut != "" {
+		if *protoDir == "" {
+			log.Fatal("--proto-dir is required when --grpc-go-out is set")
+		}
+		pkg, err := parseProtoDir(*protoDir)
+		if err != nil {
+			log.Fatalf("parse protos: %v", err)
+		}
+		protoHash, err := hashProtoDir(*protoDir)
+		if err != nil {
+			log.Fatalf("hash protos: %v", err)
+		}
+		header := ProtoGeneratedHeader{
+			ProtoPath: *protoDir,
+			ProtoHash: protoHash,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}
+		if err := generateGRPCGo(pkg, *grpcGoOut, header); err != nil {
+			log.Fatalf("generate gRPC Go: %v", err)
+		}
+		fmt.Printf("Go gRPC watch clients generated in %s\n", *grpcGoOut)
+	}
+
 	if *grpcPythonOut != "" {
 		if *protoPath == "" {
 			log.Fatal("--proto is required when --grpc-python-out is set")
@@ -44,9 +69,10 @@ func main() {
 			log.Fatalf("generate gRPC Python: %v", err)
 		}
 		fmt.Printf("Python gRPC client generated in %s\n", *grpcPythonOut)
-		if *specPath == "" {
-			return
-		}
+	}
+
+	if (*grpcGoOut != "" || *grpcPythonOut != "") && *specPath == "" {
+		return
 	}
 
 	if *specPath == "" {
@@ -119,6 +145,7 @@ type ProtoRPC struct {
 	InputType       string
 	OutputType      string
 	ServerStreaming bool
+	Comment         string
 }
 
 type ProtoService struct {
@@ -267,12 +294,57 @@ func generateGRPCPython(spec *ProtoSpec, outDir string, header ProtoGeneratedHea
 	return nil
 }
 
+// generateGRPCGo writes typed Watch clients for every Watch RPC into the Go
+// SDK. Conversions target the structs already in outDir/types, so the
+// OpenAPI types must be generated first.
+func generateGRPCGo(pkg *ProtoPackage, outDir string, header ProtoGeneratedHeader) error {
+	typesDir := filepath.Join(outDir, "types")
+	clientDir := filepath.Join(outDir, "client")
+
+	sdk, err := readSDKTypes(typesDir)
+	if err != nil {
+		return fmt.Errorf("read SDK types: %w", err)
+	}
+	data, warnings := buildGRPCGoData(pkg, sdk, header)
+	for _, w := range warnings {
+		fmt.Printf("  warning: %s\n", w)
+	}
+	for _, w := range data.Watches {
+		fmt.Printf("  %s.%s → Watch().%s\n", w.Service, w.RPC, w.Method)
+	}
+
+	tmplDir := filepath.Join(getTemplateDir(), "grpc", "go")
+	files := []struct {
+		tmpl string
+		out  string
+	}{
+		{"watch.go.tmpl", filepath.Join(clientDir, "grpc_watch.go")},
+		{"watch_api.go.tmpl", filepath.Join(clientDir, "watch_api.go")},
+		{"watch_events.go.tmpl", filepath.Join(typesDir, "watch_events.go")},
+	}
+	for _, f := range files {
+		tmpl, err := loadTemplate(filepath.Join(tmplDir, f.tmpl))
+		if err != nil {
+			return fmt.Errorf("load %s: %w", f.tmpl, err)
+		}
+		if err := executeTemplate(tmpl, f.out, data); err != nil {
+			return fmt.Errorf("execute %s: %w", f.tmpl, err)
+		}
+	}
+	return nil
+}
+
 type goTemplateData struct {
 	Header   GeneratedHeader
 	Resource Resource
 	Spec     *Spec
 }
 
+type goValueTypesData struct {
+	Header GeneratedHeader
+	File   ValueTypeFile
+}
+
 type pythonTemplateData struct {
 	Header   GeneratedHeader
 	Resource Resource
@@ -329,6 +401,17 @@ func generateGo(spec *Spec, outDir string, header GeneratedHeader) error {
 		}
 	}
 
+	valueTypesTmpl, err := loadTemplate(filepath.Join(tmplDir, "value_types.go.tmpl"))
+	if err != nil {
+		return fmt.Errorf("load value types template: %w", err)
+	}
+	for _, f := range spec.ValueTypes {
+		data := goValueTypesData{Header: header, File: f}
+		if err := executeTemplate(valueTypesTmpl, filepath.Join(typesDir, f.Name+".go"), data); err != nil {
+			return fmt.Errorf("execute value types template for %s: %w", f.Name, err)
+		}
+	}
+
 	iteratorTmpl, err := loadTemplate(filepath.Join(tmplDir, "iterator.go.tmpl"))
 	if err != nil {
 		return fmt.Errorf("load iterator template: %w", err)
@@ -509,6 +592,16 @@ func loadTemplate(path string) (*template.Template, error) {
 		"pluralize":     pluralize,
 		"lowerFirst":    lowerFirst,
 		"tsDefault":     func(f Field) string { return tsDefault(f.Type, f.Format) },
+		"valueTypesNeedTime": func(types []ValueType) bool {
+			for _, t := range types {
+				for _, f := range t.Fields {
+					if f.Format == "date-time" {
+						return true
+					}
+				}
+			}
+			return false
+		},
 		"hasTimeImport": func(fields []Field) bool {
 			for _, f := range fields {
 				if f.Format == "date-time" {
@@ -44,9 +44,25 @@ type Field struct {
 	JSONTag    string
 }
 
+// ValueType is an object schema that is not a resource, such as a response
+// body. Only the Go SDK generates them.
+type ValueType struct {
+	Name   string
+	Embeds []string
+	Fields []Field
+}
+
+// ValueTypeFile holds the value types of one sub-spec, generated into one
+// file named after it.
+type ValueTypeFile struct {
+	Name  string
+	Types []ValueType
+}
+
 type Spec struct {
-	BasePath  string
-	Resources []Resource
+	BasePath   string
+	Resources  []Resource
+	ValueTypes []ValueTypeFile
 }
 
 func toGoName(snakeName string) string {
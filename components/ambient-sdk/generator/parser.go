@@ -10,14 +10,20 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-func discoverSubSpecs(specDir string) (map[string]string, map[string]string, error) {
+// discoverSubSpecs maps each resource to its sub-spec file and path segment,
+// and lists the sub-specs that define no resource, only value types. A
+// sub-spec whose schemas extend ObjectReference but that has no collection
+// path of its own, such as records listed under another resource, holds
+// value types too.
+func discoverSubSpecs(specDir string) (map[string]string, map[string]string, []string, error) {
 	entries, err := os.ReadDir(specDir)
 	if err != nil {
-		return nil, nil, fmt.Errorf("read spec dir: %w", err)
+		return nil, nil, nil, fmt.Errorf("read spec dir: %w", err)
 	}
 
 	resourceFiles := map[string]string{}
 	pathSegments := map[string]string{}
+	var valueFiles []string
 
 	for _, e := range entries {
 		name := e.Name()
@@ -31,21 +37,28 @@ func discoverSubSpecs(specDir string) (map[string]string, map[string]string, err
 		subPath := filepath.Join(specDir, name)
 		data, err := os.ReadFile(subPath)
 		if err != nil {
-			return nil, nil, fmt.Errorf("read %s: %w", name, err)
+			return nil, nil, nil, fmt.Errorf("read %s: %w", name, err)
 		}
 
 		var doc subSpecDoc
 		if err := yaml.Unmarshal(data, &doc); err != nil {
-			return nil, nil, fmt.Errorf("parse %s: %w", name, err)
+			return nil, nil, nil, fmt.Errorf("parse %s: %w", name, err)
 		}
 
 		resourceName := inferResourceName(doc.Components.Schemas)
 		if resourceName == "" {
 			continue
 		}
+		if !isResourceSchema(doc.Components.Schemas[resourceName]) {
+			valueFiles = append(valueFiles, name)
+			continue
+		}
 
 		pathSeg := inferPathSegment(doc.Paths, resourceName)
 		if pathSeg == "" {
+			if len(doc.Paths) == 0 {
+				valueFiles = append(valueFiles, name)
+			}
 			continue
 		}
 
@@ -53,7 +66,19 @@ func discoverSubSpecs(specDir string) (map[string]string, map[string]string, err
 		pathSegments[resourceName] = pathSeg
 	}
 
-	return resourceFiles, pathSegments, nil
+	sort.Strings(valueFiles)
+	return resourceFiles, pathSegments, valueFiles, nil
+}
+
+// isResourceSchema reports whether schema is a resource: an allOf that
+// extends ObjectReference. Other object schemas are value types.
+func isResourceSchema(schema interface{}) bool {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = schemaMap["allOf"]
+	return ok
 }
 
 func inferResourceName(schemas map[string]interface{}) string {
@@ -173,7 +198,7 @@ func parseSpec(specPath string) (*Spec, error) {
 
 	specDir := filepath.Dir(specPath)
 
-	resourceFiles, pathSegments, err := discoverSubSpecs(specDir)
+	resourceFiles, pathSegments, valueFiles, err := discoverSubSpecs(specDir)
 	if err != nil {
 		return nil, fmt.Errorf("discover sub-specs: %w", err)
 	}
@@ -203,9 +228,139 @@ func parseSpec(specPath string) (*Spec, error) {
 		return resources[i].Name < resources[j].Name
 	})
 
+	var valueTypes []ValueTypeFile
+	for _, file := range valueFiles {
+		subData, err := os.ReadFile(filepath.Join(specDir, file))
+		if err != nil {
+			return nil, fmt.Errorf("read sub-spec %s: %w", file, err)
+		}
+
+		var subDoc subSpecDoc
+		if err := yaml.Unmarshal(subData, &subDoc); err != nil {
+			return nil, fmt.Errorf("parse sub-spec %s: %w", file, err)
+		}
+
+		stem := strings.TrimSuffix(strings.TrimPrefix(file, "openapi."), ".yaml")
+		valueTypes = append(valueTypes, ValueTypeFile{
+			Name:  toSnakeCase(stem),
+			Types: extractValueTypes(&subDoc),
+		})
+	}
+
 	basePath := extractBasePath(mainDoc.Paths)
 
-	return &Spec{BasePath: basePath, Resources: resources}, nil
+	return &Spec{BasePath: basePath, Resources: resources, ValueTypes: valueTypes}, nil
+}
+
+// extractValueTypes turns every schema of a sub-spec into a value type. An
+// allOf embeds the schemas it references and adds the properties of the
+// rest.
+func extractValueTypes(doc *subSpecDoc) []ValueType {
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var types []ValueType
+	for _, name := range names {
+		schemaMap, ok := doc.Components.Schemas[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		vt := ValueType{Name: name}
+		parts := []map[string]interface{}{schemaMap}
+		if allOf, ok := schemaMap["allOf"].([]interface{}); ok {
+			parts = nil
+			for _, item := range allOf {
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if ref, ok := itemMap["$ref"].(string); ok {
+					vt.Embeds = append(vt.Embeds, embedName(refName(ref)))
+					continue
+				}
+				parts = append(parts, itemMap)
+			}
+		}
+
+		for _, part := range parts {
+			required := map[string]bool{}
+			if reqList, ok := part["required"].([]interface{}); ok {
+				for _, r := range reqList {
+					if s, ok := r.(string); ok {
+						required[s] = true
+					}
+				}
+			}
+			props, _ := part["properties"].(map[string]interface{})
+			for propName, propVal := range props {
+				propMap, ok := propVal.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				vt.Fields = append(vt.Fields, valueField(propName, propMap, required[propName]))
+			}
+		}
+
+		sort.Slice(vt.Fields, func(i, j int) bool {
+			return vt.Fields[i].Name < vt.Fields[j].Name
+		})
+		types = append(types, vt)
+	}
+	return types
+}
+
+// valueField is a value type property. Unlike resource fields, it may
+// reference another schema or be an array.
+func valueField(name string, prop map[string]interface{}, required bool) Field {
+	propType, _ := prop["type"].(string)
+	propFormat, _ := prop["format"].(string)
+	nullable, _ := prop["nullable"].(bool)
+
+	goType := toGoType(propType, propFormat, nullable)
+	if ref, ok := prop["$ref"].(string); ok {
+		goType = refName(ref)
+		if !required {
+			goType = "*" + goType
+		}
+	} else if propType == "array" {
+		items, _ := prop["items"].(map[string]interface{})
+		if ref, ok := items["$ref"].(string); ok {
+			goType = "[]" + refName(ref)
+		} else {
+			itemType, _ := items["type"].(string)
+			itemFormat, _ := items["format"].(string)
+			goType = "[]" + toGoType(itemType, itemFormat, false)
+		}
+	}
+
+	return Field{
+		Name:     name,
+		GoName:   toGoName(name),
+		Type:     propType,
+		Format:   propFormat,
+		GoType:   goType,
+		Required: required,
+		Nullable: nullable,
+		JSONTag:  jsonTag(name, required),
+	}
+}
+
+// refName is the schema name a local $ref points at.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// embedName maps a referenced base schema to the Go type that embeds it: the
+// List schema is generated as ListMeta.
+func embedName(schema string) string {
+	if schema == "List" {
+		return "ListMeta"
+	}
+	return schema
 }
 
 func extractResource(name, pathSegment string, doc *subSpecDoc) (*Resource, error) {
@@ -282,6 +437,9 @@ func resourcePlural(name string) string {
 			return name
 		}
 	}
+	if n := len(name); n > 1 && name[n-1] == 'y' && !strings.ContainsRune("aeiou", rune(name[n-2])) {
+		return name[:n-1] + "ies"
+	}
 	return name + "s"
 }
 
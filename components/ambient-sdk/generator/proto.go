@@ -0,0 +1,558 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ProtoField is one field of a proto message.
+type ProtoField struct {
+	Name     string
+	Type     string
+	Optional bool
+	Repeated bool
+	Comment  string
+}
+
+// ProtoMessage is a top-level proto message.
+type ProtoMessage struct {
+	Name   string
+	Fields []ProtoField
+}
+
+// ProtoPackage is every service and message in a directory of .proto files.
+type ProtoPackage struct {
+	Package  string
+	Services []ProtoService
+	Messages map[string]ProtoMessage
+}
+
+// parseProtoDir parses every .proto file in dir. Like parseProto it is a
+// line-based reader for the subset of proto3 the API server uses: top-level
+// messages and services, no nested types or oneofs.
+func parseProtoDir(dir string) (*ProtoPackage, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		return nil, fmt.Errorf("glob protos: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .proto files in %s", dir)
+	}
+	sort.Strings(files)
+
+	pkg := &ProtoPackage{Messages: map[string]ProtoMessage{}}
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", f, err)
+		}
+		if err := parseProtoFile(string(data), pkg); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", filepath.Base(f), err)
+		}
+	}
+	return pkg, nil
+}
+
+func parseProtoFile(content string, pkg *ProtoPackage) error {
+	var (
+		comment  []string
+		message  *ProtoMessage
+		service  *ProtoService
+		inEnum   bool
+		services []ProtoService
+	)
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "//"):
+			comment = append(comment, strings.TrimSpace(strings.TrimPrefix(trimmed, "//")))
+			continue
+		case trimmed == "":
+			comment = nil
+			continue
+		case strings.HasPrefix(trimmed, "package "):
+			pkg.Package = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(trimmed, "package "), ";"))
+		case strings.HasPrefix(trimmed, "message "):
+			parts := strings.Fields(trimmed)
+			message = &ProtoMessage{Name: parts[1]}
+			if strings.HasSuffix(trimmed, "{}") {
+				pkg.Messages[message.Name] = *message
+				message = nil
+			}
+		case strings.HasPrefix(trimmed, "enum "):
+			inEnum = true
+		case strings.HasPrefix(trimmed, "service "):
+			parts := strings.Fields(trimmed)
+			service = &ProtoService{Name: parts[1]}
+		case trimmed == "}":
+			switch {
+			case message != nil:
+				pkg.Messages[message.Name] = *message
+				message = nil
+			case service != nil:
+				services = append(services, *service)
+				service = nil
+			}
+			inEnum = false
+		case inEnum:
+		case message != nil:
+			if f, ok := parseFieldLine(trimmed); ok {
+				f.Comment = strings.Join(comment, " ")
+				message.Fields = append(message.Fields, f)
+			}
+		case service != nil && strings.HasPrefix(trimmed, "rpc "):
+			if rpc := parseRPCLine(trimmed); rpc != nil {
+				rpc.Comment = strings.Join(comment, " ")
+				service.RPCs = append(service.RPCs, *rpc)
+			}
+		}
+		comment = nil
+	}
+	for i := range services {
+		services[i].Package = pkg.Package
+	}
+	pkg.Services = append(pkg.Services, services...)
+	return nil
+}
+
+// parseFieldLine parses "[optional|repeated] type name = N;". Reserved
+// ranges and options are not fields.
+func parseFieldLine(line string) (ProtoField, bool) {
+	line = strings.TrimSuffix(strings.TrimSpace(strings.SplitN(line, "//", 2)[0]), ";")
+	parts := strings.Fields(line)
+	var f ProtoField
+	switch {
+	case len(parts) == 0, parts[0] == "reserved", parts[0] == "option":
+		return f, false
+	case parts[0] == "optional":
+		f.Optional = true
+		parts = parts[1:]
+	case parts[0] == "repeated":
+		f.Repeated = true
+		parts = parts[1:]
+	}
+	if len(parts) < 4 || parts[2] != "=" {
+		return f, false
+	}
+	f.Type, f.Name = parts[0], parts[1]
+	return f, true
+}
+
+// protoGoName is the Go field name protoc-gen-go gives a proto field.
+func protoGoName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && unicode.IsLower(r) {
+			r = unicode.ToUpper(r)
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func hashProtoDir(dir string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+	h := sha256.New()
+	for _, f := range files {
+		fh, err := os.Open(f)
+		if err != nil {
+			return "", err
+		}
+		if _, err := io.Copy(h, fh); err != nil {
+			_ = fh.Close()
+			return "", err
+		}
+		_ = fh.Close()
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// ── Go SDK types ─────────────────────────────────────────────────────────────
+
+// sdkField is a field of a struct in the Go SDK types package.
+type sdkField struct {
+	GoName string
+	GoType string
+}
+
+// sdkStruct is a struct in the Go SDK types package, keyed by JSON name.
+// Fields promoted from an embedded ObjectReference are included.
+type sdkStruct struct {
+	Name                  string
+	EmbedsObjectReference bool
+	Fields                map[string]sdkField
+}
+
+// readSDKTypes reads the structs declared in the Go SDK types package, so
+// conversions are generated against the types as they are, hand edits
+// included.
+func readSDKTypes(typesDir string) (map[string]*sdkStruct, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(typesDir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	structs := map[string]*sdkStruct{}
+	embeds := map[string][]string{}
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				s := &sdkStruct{Name: ts.Name.Name, Fields: map[string]sdkField{}}
+				for _, field := range st.Fields.List {
+					typ := exprString(field.Type)
+					if len(field.Names) == 0 {
+						embeds[s.Name] = append(embeds[s.Name], typ)
+						continue
+					}
+					jsonName := jsonTagName(field.Tag)
+					if jsonName == "" || jsonName == "-" {
+						continue
+					}
+					s.Fields[jsonName] = sdkField{GoName: field.Names[0].Name, GoType: typ}
+				}
+				structs[s.Name] = s
+			}
+		}
+	}
+
+	for name, embedded := range embeds {
+		for _, e := range embedded {
+			inner, ok := structs[e]
+			if !ok {
+				continue
+			}
+			if e == "ObjectReference" {
+				structs[name].EmbedsObjectReference = true
+			}
+			for k, v := range inner.Fields {
+				if _, ok := structs[name].Fields[k]; !ok {
+					structs[name].Fields[k] = v
+				}
+			}
+		}
+	}
+	return structs, nil
+}
+
+func exprString(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + exprString(t.X)
+	case *ast.SelectorExpr:
+		return exprString(t.X) + "." + t.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(t.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(t.Key) + "]" + exprString(t.Value)
+	default:
+		return ""
+	}
+}
+
+func jsonTagName(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+	raw, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(reflect.StructTag(raw).Get("json"), ",")
+	return name
+}
+
+// ── template data ────────────────────────────────────────────────────────────
+
+// GoWatchParam is a field of a Watch request, passed as a method parameter.
+type GoWatchParam struct {
+	Name       string
+	GoType     string
+	ProtoField string
+}
+
+// GoWatch is one typed Watch method on the generated WatchAPI.
+type GoWatch struct {
+	Method       string
+	RPC          string
+	Service      string
+	Request      string
+	Response     string
+	Event        string
+	Convert      string
+	Comment      string
+	Params       []GoWatchParam
+	ProjectParam string
+	// ResumeField is the request field set to the last ResumeFrom value
+	// seen before reconnecting, so a resumed stream does not repeat events.
+	ResumeField string
+	ResumeFrom  string
+}
+
+// GoEventField is a field of a generated watch event type.
+type GoEventField struct {
+	GoName  string
+	GoType  string
+	JSON    string
+	Comment string
+	Assign  string
+}
+
+// GoWatchEvent is a generated watch event type in the types package.
+type GoWatchEvent struct {
+	Name     string
+	Proto    string
+	Resource string
+	Convert  string
+	Fields   []GoEventField
+}
+
+// GoConverter converts a proto message into its Go SDK type.
+type GoConverter struct {
+	Func    string
+	Proto   string
+	Type    string
+	Assigns []string
+}
+
+type grpcGoTemplateData struct {
+	Header     ProtoGeneratedHeader
+	Watches    []GoWatch
+	Events     []GoWatchEvent
+	Converters []GoConverter
+}
+
+var protoScalarGoTypes = map[string]string{
+	"string": "string",
+	"bool":   "bool",
+	"int32":  "int32",
+	"int64":  "int64",
+	"uint32": "uint32",
+	"uint64": "uint64",
+	"double": "float64",
+	"float":  "float32",
+}
+
+var goNumericTypes = map[string]bool{
+	"int": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true,
+}
+
+// buildGRPCGoData works out a typed Watch method for every server-streaming
+// Watch RPC whose resource has a Go SDK type. Streams of FooWatchEvent get a
+// generated types.FooWatchEvent; streams of a resource yield the resource.
+func buildGRPCGoData(pkg *ProtoPackage, sdk map[string]*sdkStruct, header ProtoGeneratedHeader) (*grpcGoTemplateData, []string) {
+	data := &grpcGoTemplateData{Header: header}
+	var warnings []string
+	converters := map[string]GoConverter{}
+
+	converterFor := func(msg string) (string, bool) {
+		if c, ok := converters[msg]; ok {
+			return c.Func, true
+		}
+		pm, ok := pkg.Messages[msg]
+		if !ok {
+			return "", false
+		}
+		s, ok := sdk[msg]
+		if !ok {
+			return "", false
+		}
+		c := GoConverter{Func: lowerFirst(msg) + "FromProto", Proto: msg, Type: msg}
+		for _, f := range pm.Fields {
+			assign, ok := convertField(f, s, pkg)
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("%s.%s: no matching field in types.%s", msg, f.Name, msg))
+				continue
+			}
+			c.Assigns = append(c.Assigns, assign)
+		}
+		converters[msg] = c
+		return c.Func, true
+	}
+
+	for _, svc := range pkg.Services {
+		for _, rpc := range svc.RPCs {
+			if !rpc.ServerStreaming || !strings.HasPrefix(rpc.Name, "Watch") {
+				continue
+			}
+			req, resp := pkg.Messages[rpc.InputType], pkg.Messages[rpc.OutputType]
+			w := GoWatch{
+				Method:   strings.TrimPrefix(rpc.Name, "Watch"),
+				RPC:      rpc.Name,
+				Service:  svc.Name,
+				Request:  rpc.InputType,
+				Response: rpc.OutputType,
+				Comment:  rpc.Comment,
+			}
+
+			if strings.HasSuffix(resp.Name, "WatchEvent") {
+				ev, ok := buildWatchEvent(resp, converterFor)
+				if !ok {
+					warnings = append(warnings, fmt.Sprintf("skipping %s: no Go SDK type for its resource", rpc.Name))
+					continue
+				}
+				data.Events = append(data.Events, ev)
+				w.Event, w.Convert = "*types."+ev.Name, ev.Convert
+			} else {
+				fn, ok := converterFor(resp.Name)
+				if !ok {
+					warnings = append(warnings, fmt.Sprintf("skipping %s: no Go SDK type %s", rpc.Name, resp.Name))
+					continue
+				}
+				w.Event, w.Convert = "*types."+resp.Name, fn
+			}
+
+			for _, f := range req.Fields {
+				goType, ok := protoScalarGoTypes[f.Type]
+				if !ok || f.Repeated {
+					continue
+				}
+				p := GoWatchParam{Name: lowerFirst(toGoName(f.Name)), GoType: goType, ProtoField: protoGoName(f.Name)}
+				w.Params = append(w.Params, p)
+				if f.Name == "project_id" {
+					w.ProjectParam = p.Name
+				}
+				if f.Name == "after_seq" && hasField(resp, "seq") {
+					w.ResumeField, w.ResumeFrom = p.ProtoField, "Seq"
+				}
+			}
+			data.Watches = append(data.Watches, w)
+		}
+	}
+
+	sort.Slice(data.Watches, func(i, j int) bool { return data.Watches[i].Method < data.Watches[j].Method })
+	sort.Slice(data.Events, func(i, j int) bool { return data.Events[i].Name < data.Events[j].Name })
+	for _, c := range converters {
+		data.Converters = append(data.Converters, c)
+	}
+	sort.Slice(data.Converters, func(i, j int) bool { return data.Converters[i].Proto < data.Converters[j].Proto })
+	return data, warnings
+}
+
+func buildWatchEvent(msg ProtoMessage, converterFor func(string) (string, bool)) (GoWatchEvent, bool) {
+	ev := GoWatchEvent{Name: msg.Name, Proto: msg.Name, Convert: lowerFirst(msg.Name) + "FromProto"}
+	hasResource := false
+	for _, f := range msg.Fields {
+		field := GoEventField{GoName: toGoName(f.Name), JSON: f.Name, Comment: f.Comment}
+		getter := "pb.Get" + protoGoName(f.Name) + "()"
+		switch {
+		case f.Type == "EventType":
+			field.GoType = "string"
+			field.Assign = "eventTypeFromProto(" + getter + ")"
+			if field.Comment == "" {
+				field.Comment = "Type of the watch event (CREATED, UPDATED, DELETED)"
+			}
+		case f.Type == "string":
+			field.GoType = "string"
+			field.Assign = getter
+			if field.Comment == "" && f.Name == "resource_id" {
+				field.Comment = "ResourceID is the ID of the resource that changed"
+			}
+		default:
+			fn, ok := converterFor(f.Type)
+			if !ok {
+				return ev, false
+			}
+			hasResource = true
+			ev.Resource = f.Type
+			field.GoType = "*" + f.Type
+			field.JSON += ",omitempty"
+			field.Assign = fn + "(" + getter + ")"
+			if field.Comment == "" {
+				field.Comment = field.GoName + " as it is after the change"
+			}
+		}
+		ev.Fields = append(ev.Fields, field)
+	}
+	return ev, hasResource
+}
+
+// convertField returns the statement that copies proto field f into the SDK
+// struct s, or false when s has no field it can be copied into.
+func convertField(f ProtoField, s *sdkStruct, pkg *ProtoPackage) (string, bool) {
+	getter := "pb.Get" + protoGoName(f.Name) + "()"
+	if f.Type == "ObjectReference" && f.Name == "metadata" && s.EmbedsObjectReference {
+		return "out.ObjectReference = objectReferenceFromProto(" + getter + ")", true
+	}
+	target, ok := s.Fields[f.Name]
+	if !ok || f.Repeated {
+		return "", false
+	}
+	dst := "out." + target.GoName
+
+	if f.Type == "google.protobuf.Timestamp" {
+		if target.GoType != "*time.Time" {
+			return "", false
+		}
+		return dst + " = timeFromProto(" + getter + ")", true
+	}
+	if _, isMessage := pkg.Messages[f.Type]; isMessage {
+		return "", false
+	}
+	goType, ok := protoScalarGoTypes[f.Type]
+	if !ok {
+		return "", false
+	}
+	field := "pb." + protoGoName(f.Name)
+
+	switch {
+	case target.GoType == goType:
+		return dst + " = " + getter, true
+	case goNumericTypes[goType] && goNumericTypes[target.GoType]:
+		return dst + " = " + target.GoType + "(" + getter + ")", true
+	case target.GoType == "*"+goType && f.Optional:
+		return dst + " = " + field, true
+	case target.GoType == "*"+goType:
+		return dst + " = nonZero(" + getter + ")", true
+	}
+	return "", false
+}
+
+func hasField(msg ProtoMessage, name string) bool {
+	for _, f := range msg.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
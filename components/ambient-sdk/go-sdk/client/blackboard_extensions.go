@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+func (a *BlackboardEntryAPI) projectPath(projectID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/blackboard"
+}
+
+// ListByProject returns the live entries in a project whose key starts with
+// prefix, ordered by key.
+func (a *BlackboardEntryAPI) ListByProject(ctx context.Context, projectID, prefix string) (*types.BlackboardEntryList, error) {
+	path := a.projectPath(projectID)
+	if prefix != "" {
+		path += "?" + url.Values{"prefix": {prefix}}.Encode()
+	}
+	var result types.BlackboardEntryList
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (a *BlackboardEntryAPI) GetByProject(ctx context.Context, projectID, key string) (*types.BlackboardEntry, error) {
+	var result types.BlackboardEntry
+	path := a.projectPath(projectID) + "/" + url.PathEscape(key)
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Put creates or replaces the entry at key. With opts.ExpectedVersion set it
+// is a compare-and-swap and fails with 409 when the entry has moved on.
+func (a *BlackboardEntryAPI) Put(ctx context.Context, projectID, key, value string, opts *types.BlackboardWriteOptions) (*types.BlackboardEntry, error) {
+	req := struct {
+		Value string `json:"value"`
+		types.BlackboardWriteOptions
+	}{Value: value}
+	if opts != nil {
+		req.BlackboardWriteOptions = *opts
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal blackboard entry: %w", err)
+	}
+	var result types.BlackboardEntry
+	path := a.projectPath(projectID) + "/" + url.PathEscape(key)
+	if err := a.client.do(ctx, http.MethodPut, path, body, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteInProject deletes the entry at key. A non-nil expectedVersion makes
+// the delete conditional on the entry still being at that version.
+func (a *BlackboardEntryAPI) DeleteInProject(ctx context.Context, projectID, key string, expectedVersion *int) error {
+	path := a.projectPath(projectID) + "/" + url.PathEscape(key)
+	if expectedVersion != nil {
+		path += "?expected_version=" + strconv.Itoa(*expectedVersion)
+	}
+	return a.client.do(ctx, http.MethodDelete, path, nil, http.StatusNoContent, nil)
+}
+
+// BlackboardWatchOptions configures blackboard watching
+type BlackboardWatchOptions struct {
+	// KeyPrefix limits events to keys with this prefix
+	KeyPrefix string
+	// Replay sends the current entries as CREATED events before changes
+	Replay bool
+}
+
+// BlackboardWatcher provides real-time blackboard events
+type BlackboardWatcher = Watcher[*types.BlackboardWatchEvent]
+
+// Watch streams changes to a project's blackboard until ctx is cancelled or
+// Stop is called. Expired entries arrive as DELETED events. With Replay, a
+// reconnected stream replays the current entries again.
+func (a *BlackboardEntryAPI) Watch(ctx context.Context, projectID string, opts *BlackboardWatchOptions) (*BlackboardWatcher, error) {
+	if opts == nil {
+		opts = &BlackboardWatchOptions{}
+	}
+	return a.client.Watch().Blackboard(ctx, projectID, opts.KeyPrefix, opts.Replay, nil)
+}
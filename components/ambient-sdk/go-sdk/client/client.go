@@ -32,6 +32,7 @@ type Client struct {
 	logger             *slog.Logger
 	userAgent          string
 	insecureSkipVerify bool
+	grpcAddr           string
 }
 
 type ClientOption func(*Client)
@@ -75,6 +76,14 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
+// WithGRPCAddress sets the host:port used for watch streams instead of
+// deriving it from the base URL.
+func WithGRPCAddress(addr string) ClientOption {
+	return func(c *Client) {
+		c.grpcAddr = addr
+	}
+}
+
 func NewClient(baseURL, token, project string, opts ...ClientOption) (*Client, error) {
 	if token == "" {
 		return nil, fmt.Errorf("token is required")
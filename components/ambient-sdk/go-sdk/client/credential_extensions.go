@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -15,3 +17,26 @@ func (a *CredentialAPI) GetToken(ctx context.Context, id string) (*types.Credent
 	}
 	return &result, nil
 }
+
+// Rotate swaps the credential's token in place, keeping its ID.
+func (a *CredentialAPI) Rotate(ctx context.Context, id string, req *types.CredentialRotateRequest) (*types.Credential, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal rotate request: %w", err)
+	}
+	var result types.Credential
+	if err := a.client.do(ctx, http.MethodPost, "/credentials/"+url.PathEscape(id)+"/rotate", body, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EncryptionStatus reports how many stored secrets each key version still
+// encrypts. Platform admins only.
+func (a *CredentialAPI) EncryptionStatus(ctx context.Context) (*types.CredentialEncryptionStatus, error) {
+	var result types.CredentialEncryptionStatus
+	if err := a.client.do(ctx, http.MethodGet, "/credentials/encryption", nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
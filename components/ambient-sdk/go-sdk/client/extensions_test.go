@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
 )
@@ -327,6 +328,271 @@ func TestAgentStartInProject(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// Blackboard extensions
+// ---------------------------------------------------------------------------
+
+func TestBlackboardPutSendsCompareAndSwap(t *testing.T) {
+	want := &types.BlackboardEntry{Key: "plan.owner", Value: "runner-2", Version: 4}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if !strings.HasSuffix(r.URL.Path, "/projects/proj-a/blackboard/plan.owner") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatalf("unmarshal body: %v", err)
+		}
+		if req["value"] != "runner-2" || req["expected_version"] != float64(3) || req["ttl_seconds"] != float64(60) {
+			t.Errorf("unexpected body: %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(marshalJSON(t, want))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	expected := 3
+	got, err := c.BlackboardEntries().Put(context.Background(), "proj-a", "plan.owner", "runner-2", &types.BlackboardWriteOptions{
+		ExpectedVersion: &expected,
+		TTLSeconds:      60,
+	})
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if got.Version != 4 {
+		t.Errorf("expected version 4, got %d", got.Version)
+	}
+}
+
+func TestBlackboardPutCreateOnlySendsZeroVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"expected_version":0`) {
+			t.Errorf("expected_version 0 was dropped: %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"key":"k","value":"v","version":1}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	absent := 0
+	if _, err := c.BlackboardEntries().Put(context.Background(), "proj-a", "k", "v", &types.BlackboardWriteOptions{ExpectedVersion: &absent}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+}
+
+func TestBlackboardListByProjectPrefix(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("prefix"); got != "plan." {
+			t.Errorf("expected prefix 'plan.', got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"kind":"BlackboardEntryList","items":[{"key":"plan.a","value":"x","version":1}]}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.BlackboardEntries().ListByProject(context.Background(), "proj-a", "plan.")
+	if err != nil {
+		t.Fatalf("ListByProject: %v", err)
+	}
+	if len(got.Items) != 1 || got.Items[0].Key != "plan.a" {
+		t.Errorf("unexpected items: %+v", got.Items)
+	}
+}
+
+func TestBlackboardDeleteInProjectWithVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if got := r.URL.Query().Get("expected_version"); got != "2" {
+			t.Errorf("expected expected_version=2, got %q", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	v := 2
+	if err := c.BlackboardEntries().DeleteInProject(context.Background(), "proj-a", "k", &v); err != nil {
+		t.Fatalf("DeleteInProject: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ProjectDocument extensions
+// ---------------------------------------------------------------------------
+
+func TestProjectDocumentUpdateInProject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/projects/proj-a/documents/doc-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["expected_revision"] != float64(2) {
+			t.Errorf("expected expected_revision=2, got %v", body["expected_revision"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"doc-1","title":"Runbook","body":"v3","revision":3}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.ProjectDocuments().UpdateInProject(context.Background(), "proj-a", "doc-1", map[string]any{"body": "v3", "expected_revision": 2})
+	if err != nil {
+		t.Fatalf("UpdateInProject: %v", err)
+	}
+	if got.Revision != 3 {
+		t.Errorf("expected revision 3, got %d", got.Revision)
+	}
+}
+
+func TestProjectDocumentGetRevision(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/ambient/v1/projects/proj-a/documents/doc-1/revisions/1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"rev-1","document_id":"doc-1","revision":1,"title":"Runbook","body":"v1"}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.ProjectDocuments().GetRevision(context.Background(), "proj-a", "doc-1", 1)
+	if err != nil {
+		t.Fatalf("GetRevision: %v", err)
+	}
+	if got.Revision != 1 || got.Body != "v1" {
+		t.Errorf("unexpected revision: %+v", got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Project home
+// ---------------------------------------------------------------------------
+
+func TestProjectHome(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/projects/proj-a/home" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"project_id":"proj-a",` +
+			`"agents":[{"agent_id":"a-1","agent_name":"triage","session_phase":"Running","agent_status":"running","inbox_unread_count":2}],` +
+			`"session_counts":[{"window":"24h","running":1,"failed":1,"total":3}],` +
+			`"upcoming_scheduled_sessions":[{"id":"ss-1","name":"nightly","next_run_at":"2026-01-02T03:00:00Z"}],` +
+			`"recent_application_syncs":[{"id":"app-1","sync_status":"Synced"}]}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Projects().Home(context.Background(), "proj-a")
+	if err != nil {
+		t.Fatalf("Home: %v", err)
+	}
+	if len(got.Agents) != 1 || got.Agents[0].AgentStatus != "running" || got.Agents[0].InboxUnreadCount != 2 {
+		t.Errorf("unexpected agents: %+v", got.Agents)
+	}
+	if len(got.SessionCounts) != 1 || got.SessionCounts[0].Window != "24h" || got.SessionCounts[0].Failed != 1 {
+		t.Errorf("unexpected session counts: %+v", got.SessionCounts)
+	}
+	if len(got.UpcomingScheduledSessions) != 1 || got.UpcomingScheduledSessions[0].NextRunAt == nil {
+		t.Errorf("unexpected scheduled sessions: %+v", got.UpcomingScheduledSessions)
+	}
+	if len(got.RecentApplicationSyncs) != 1 || got.RecentApplicationSyncs[0].SyncStatus != "Synced" {
+		t.Errorf("unexpected application syncs: %+v", got.RecentApplicationSyncs)
+	}
+}
+
+func TestProjectModels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/projects/proj-a/models" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("provider"); got != "anthropic" {
+			t.Errorf("expected provider=anthropic, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"kind":"ModelList","default_model":"claude-sonnet-4-6","default_temperature":0.7,"default_max_tokens":4000,` +
+			`"items":[{"id":"claude-opus-4-6","label":"Claude Opus 4.6","provider":"anthropic","is_default":false},` +
+			`{"id":"claude-sonnet-4-6","label":"Claude Sonnet 4.6","provider":"anthropic","is_default":true}]}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Projects().Models(context.Background(), "proj-a", "anthropic")
+	if err != nil {
+		t.Fatalf("Models: %v", err)
+	}
+	if got.DefaultModel != "claude-sonnet-4-6" || got.DefaultTemperature != 0.7 || got.DefaultMaxTokens != 4000 {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+	if len(got.Items) != 2 || got.Items[0].ID != "claude-opus-4-6" || !got.Items[1].IsDefault {
+		t.Errorf("unexpected items: %+v", got.Items)
+	}
+}
+
+func TestProjectUsage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/ambient/v1/projects/proj-a/usage" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("group_by") != "agent" || q.Get("from") != "2026-10-01T00:00:00Z" || q.Has("project_id") || q.Has("to") {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"kind":"UsageSummary","group_by":"agent",` +
+			`"total":{"input_tokens":30,"output_tokens":5,"cache_read_tokens":0,"cache_write_tokens":0,"cost_usd":7,"entries":3},` +
+			`"items":[{"key":"agent-1","input_tokens":30,"output_tokens":5,"cache_read_tokens":0,"cache_write_tokens":0,"cost_usd":7,"entries":3}],` +
+			`"budget":{"monthly_budget_usd":10,"spent_usd":7,"period_start":"2026-10-01T00:00:00Z","exceeded":false}}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Projects().Usage(context.Background(), "proj-a", &types.UsageQuery{
+		ProjectID: "ignored",
+		GroupBy:   "agent",
+		From:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+	if got.Total.CostUsd != 7 || len(got.Items) != 1 || got.Items[0].Key != "agent-1" || got.Items[0].InputTokens != 30 {
+		t.Errorf("unexpected summary: %+v", got)
+	}
+	if got.Budget == nil || got.Budget.SpentUsd != 7 || got.Budget.Exceeded {
+		t.Errorf("unexpected budget: %+v", got.Budget)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Credential GetToken
 // ---------------------------------------------------------------------------
@@ -418,11 +684,11 @@ func TestClientProjectAccessor(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestScheduledSessionRunsInProject(t *testing.T) {
-	want := &types.SessionList{
-		ListMeta: types.ListMeta{Kind: "SessionList", Page: 1, Size: 10, Total: 2},
-		Items: []types.Session{
-			{ObjectReference: types.ObjectReference{ID: "sess-run-1"}, Name: "run-1"},
-			{ObjectReference: types.ObjectReference{ID: "sess-run-2"}, Name: "run-2"},
+	want := &types.ScheduledSessionRunList{
+		ListMeta: types.ListMeta{Kind: "ScheduledSessionRunList", Page: 1, Size: 10, Total: 2},
+		Items: []types.ScheduledSessionRun{
+			{ObjectReference: types.ObjectReference{ID: "run-1"}, Status: "Failed", Trigger: "schedule", Error: "agent has no prompt"},
+			{ObjectReference: types.ObjectReference{ID: "run-2"}, Status: "Started", Trigger: "manual", SessionID: "sess-run-2"},
 		},
 	}
 
@@ -450,7 +716,7 @@ func TestScheduledSessionRunsInProject(t *testing.T) {
 	if len(got.Items) != 2 {
 		t.Errorf("expected 2 runs, got %d", len(got.Items))
 	}
-	if got.Items[0].ID != "sess-run-1" {
+	if got.Items[0].ID != "run-1" || got.Items[0].Status != "Failed" || got.Items[0].Error == "" {
 		t.Errorf("unexpected first run: %+v", got.Items[0])
 	}
 }
@@ -597,3 +863,105 @@ func TestScheduledSessionPatch_OmitEmpty(t *testing.T) {
 }
 
 func strPtr(s string) *string { return &s }
+
+// ---------------------------------------------------------------------------
+// Role simulation
+// ---------------------------------------------------------------------------
+
+func TestRoleSimulate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/roles/role-1/simulate" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req types.RoleSimulationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if req.UserID != "alice" || req.Resource != "agent" || req.Action != "start" || req.ProjectID != "proj-a" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"role_id":"role-1","user_id":"alice","permission":"agent:start","role_grants":true,"allowed":false}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Roles().Simulate(context.Background(), "role-1", &types.RoleSimulationRequest{
+		UserID: "alice", Resource: "agent", Action: "start", ProjectID: "proj-a",
+	})
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	if !got.RoleGrants || got.Allowed || got.Permission != "agent:start" {
+		t.Errorf("unexpected simulation: %+v", got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Credential rotation
+// ---------------------------------------------------------------------------
+
+func TestCredentialRotate(t *testing.T) {
+	expiresAt := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/credentials/cred-1/rotate" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req types.CredentialRotateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if req.Token != "new-token" || req.ExpiresAt == nil || !req.ExpiresAt.Equal(expiresAt) {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"cred-1","name":"gh","provider":"github","expires_at":"2026-12-01T00:00:00Z","rotated_at":"2026-10-17T00:00:00Z"}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Credentials().Rotate(context.Background(), "cred-1", &types.CredentialRotateRequest{
+		Token: "new-token", ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if got.ID != "cred-1" || got.RotatedAt == nil || got.ExpiresAt == nil || !got.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("unexpected credential: %+v", got)
+	}
+}
+
+func TestCredentialEncryptionStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/ambient/v1/credentials/encryption" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"enabled":true,"active_version":2,"keyring_versions":[1,2],"versions":[{"version":1,"in_keyring":true,"tokens":3,"oauth_grants":1},{"version":2,"in_keyring":true,"tokens":5,"oauth_grants":0}],"plaintext":{"tokens":0,"oauth_grants":0},"remaining":4}`))
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv)
+	got, err := c.Credentials().EncryptionStatus(context.Background())
+	if err != nil {
+		t.Fatalf("EncryptionStatus: %v", err)
+	}
+	if got.ActiveVersion != 2 || got.Remaining != 4 || len(got.Versions) != 2 {
+		t.Fatalf("unexpected status: %+v", got)
+	}
+	if v := got.Versions[0]; v.Version != 1 || v.Tokens != 3 || v.OAuthGrants != 1 || !v.InKeyring {
+		t.Errorf("unexpected version count: %+v", v)
+	}
+}
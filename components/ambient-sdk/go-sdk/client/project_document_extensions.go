@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+func (a *ProjectDocumentAPI) projectPath(projectID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/documents"
+}
+
+func (a *ProjectDocumentAPI) ListByProject(ctx context.Context, projectID string, opts *types.ListOptions) (*types.ProjectDocumentList, error) {
+	var result types.ProjectDocumentList
+	if err := a.client.doWithQuery(ctx, http.MethodGet, a.projectPath(projectID), nil, http.StatusOK, &result, opts); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (a *ProjectDocumentAPI) GetByProject(ctx context.Context, projectID, id string) (*types.ProjectDocument, error) {
+	var result types.ProjectDocument
+	path := a.projectPath(projectID) + "/" + url.PathEscape(id)
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (a *ProjectDocumentAPI) CreateInProject(ctx context.Context, projectID string, resource *types.ProjectDocument) (*types.ProjectDocument, error) {
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("marshal project document: %w", err)
+	}
+	var result types.ProjectDocument
+	if err := a.client.do(ctx, http.MethodPost, a.projectPath(projectID), body, http.StatusCreated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateInProject patches a document. Include "expected_revision" in patch
+// to fail with 409 if someone else changed the document first.
+func (a *ProjectDocumentAPI) UpdateInProject(ctx context.Context, projectID, id string, patch map[string]any) (*types.ProjectDocument, error) {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("marshal patch: %w", err)
+	}
+	var result types.ProjectDocument
+	path := a.projectPath(projectID) + "/" + url.PathEscape(id)
+	if err := a.client.do(ctx, http.MethodPatch, path, body, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (a *ProjectDocumentAPI) DeleteInProject(ctx context.Context, projectID, id string) error {
+	return a.client.do(ctx, http.MethodDelete, a.projectPath(projectID)+"/"+url.PathEscape(id), nil, http.StatusNoContent, nil)
+}
+
+// Revisions returns the revision history of a document, newest first.
+func (a *ProjectDocumentAPI) Revisions(ctx context.Context, projectID, id string) (*types.ProjectDocumentRevisionList, error) {
+	var result types.ProjectDocumentRevisionList
+	path := a.projectPath(projectID) + "/" + url.PathEscape(id) + "/revisions"
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (a *ProjectDocumentAPI) GetRevision(ctx context.Context, projectID, id string, revision int) (*types.ProjectDocumentRevision, error) {
+	var result types.ProjectDocumentRevision
+	path := a.projectPath(projectID) + "/" + url.PathEscape(id) + "/revisions/" + strconv.Itoa(revision)
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
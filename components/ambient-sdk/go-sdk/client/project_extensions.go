@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
 )
@@ -23,3 +25,44 @@ func (a *ProjectAPI) PatchAnnotations(ctx context.Context, id string, annotation
 	}
 	return a.Update(ctx, id, map[string]any{"annotations": string(b)})
 }
+
+// Home returns the project's aggregate dashboard: agents with their current
+// session phase and unread inbox counts, session counts over trailing
+// windows, upcoming scheduled sessions and recent application syncs.
+func (a *ProjectAPI) Home(ctx context.Context, id string) (*types.ProjectHome, error) {
+	var result types.ProjectHome
+	if err := a.client.do(ctx, http.MethodGet, "/projects/"+url.PathEscape(id)+"/home", nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Models lists the catalog models the project may use. A non-empty provider
+// restricts the list to that provider and makes its default the default.
+func (a *ProjectAPI) Models(ctx context.Context, id, provider string) (*types.ModelList, error) {
+	path := "/projects/" + url.PathEscape(id) + "/models"
+	if provider != "" {
+		path += "?" + url.Values{"provider": {provider}}.Encode()
+	}
+	var result types.ModelList
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Usage rolls up the project's token usage and cost, with its budget status
+// when it has a monthly budget. q.ProjectID is ignored.
+func (a *ProjectAPI) Usage(ctx context.Context, id string, q *types.UsageQuery) (*types.UsageSummary, error) {
+	values := q.Values()
+	values.Del("project_id")
+	path := "/projects/" + url.PathEscape(id) + "/usage"
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+	var result types.UsageSummary
+	if err := a.client.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+// Simulate asks the server whether req.UserID may perform req.Action on
+// req.Resource in the given scope, and whether the role alone covers it.
+func (a *RoleAPI) Simulate(ctx context.Context, id string, req *types.RoleSimulationRequest) (*types.RoleSimulation, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal simulation request: %w", err)
+	}
+	var result types.RoleSimulation
+	path := "/roles/" + url.PathEscape(id) + "/simulate"
+	if err := a.client.do(ctx, http.MethodPost, path, body, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -83,8 +83,8 @@ func (a *ScheduledSessionAPI) TriggerInProject(ctx context.Context, projectID, i
 	return a.client.do(ctx, http.MethodPost, path, nil, http.StatusOK, nil)
 }
 
-func (a *ScheduledSessionAPI) RunsInProject(ctx context.Context, projectID, id string, opts *types.ListOptions) (*types.SessionList, error) {
-	var result types.SessionList
+func (a *ScheduledSessionAPI) RunsInProject(ctx context.Context, projectID, id string, opts *types.ListOptions) (*types.ScheduledSessionRunList, error) {
+	var result types.ScheduledSessionRunList
 	path := a.projectPath(projectID) + "/" + url.PathEscape(id) + "/runs"
 	if err := a.client.doWithQuery(ctx, http.MethodGet, path, nil, http.StatusOK, &result, opts); err != nil {
 		return nil, err
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ambient-code/platform/components/ambient-sdk/go-sdk/types"
+)
+
+// Usage rolls up token usage and cost across the platform. Restricted to
+// platform admins.
+func (c *Client) Usage(ctx context.Context, q *types.UsageQuery) (*types.UsageSummary, error) {
+	path := "/usage"
+	if values := q.Values(); len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+	var result types.UsageSummary
+	if err := c.do(ctx, http.MethodGet, path, nil, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
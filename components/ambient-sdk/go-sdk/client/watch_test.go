@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ambient_v1 "github.com/ambient-code/platform/components/ambient-api-server/pkg/api/grpc/ambient/v1"
+)
+
+type fakeSessionService struct {
+	ambient_v1.UnimplementedSessionServiceServer
+
+	mu         sync.Mutex
+	watchCalls int
+	afterSeqs  []int64
+	auth       []string
+	projects   []string
+	denied     bool
+}
+
+func (s *fakeSessionService) record(ctx context.Context) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.auth = append(s.auth, md.Get("authorization")...)
+	s.projects = append(s.projects, md.Get("x-ambient-project")...)
+	s.watchCalls++
+	return s.watchCalls
+}
+
+func (s *fakeSessionService) WatchSessions(_ *ambient_v1.WatchSessionsRequest, stream grpc.ServerStreamingServer[ambient_v1.SessionWatchEvent]) error {
+	call := s.record(stream.Context())
+	if s.denied {
+		return status.Error(codes.PermissionDenied, "denied")
+	}
+	if call == 1 {
+		_ = stream.Send(&ambient_v1.SessionWatchEvent{
+			Type:       ambient_v1.EventType_EVENT_TYPE_CREATED,
+			ResourceId: "s1",
+			Session: &ambient_v1.Session{
+				Metadata:  &ambient_v1.ObjectReference{Id: "s1", Kind: "Session", CreatedAt: timestamppb.New(time.Unix(1700000000, 0))},
+				Name:      "first",
+				Timeout:   intPtr32(300),
+				StartTime: timestamppb.New(time.Unix(1700000100, 0)),
+			},
+		})
+		return status.Error(codes.Unavailable, "server restarting")
+	}
+	_ = stream.Send(&ambient_v1.SessionWatchEvent{Type: ambient_v1.EventType_EVENT_TYPE_DELETED, ResourceId: "s1"})
+	<-stream.Context().Done()
+	return nil
+}
+
+func (s *fakeSessionService) WatchSessionMessages(req *ambient_v1.WatchSessionMessagesRequest, stream grpc.ServerStreamingServer[ambient_v1.SessionMessage]) error {
+	s.mu.Lock()
+	s.afterSeqs = append(s.afterSeqs, req.GetAfterSeq())
+	call := len(s.afterSeqs)
+	s.mu.Unlock()
+
+	if call == 1 {
+		for seq := req.GetAfterSeq() + 1; seq <= 2; seq++ {
+			_ = stream.Send(&ambient_v1.SessionMessage{SessionId: req.GetSessionId(), Seq: seq, EventType: "assistant"})
+		}
+		return nil
+	}
+	_ = stream.Send(&ambient_v1.SessionMessage{SessionId: req.GetSessionId(), Seq: req.GetAfterSeq() + 1, EventType: "assistant"})
+	<-stream.Context().Done()
+	return nil
+}
+
+func intPtr32(v int32) *int32 { return &v }
+
+func newWatchTestClient(t *testing.T, svc *fakeSessionService) *Client {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	ambient_v1.RegisterSessionServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	c, err := NewClient("http://localhost:8080", testToken, testProject, WithGRPCAddress(lis.Addr().String()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func nextEvent[E any](t *testing.T, w *Watcher[E]) E {
+	t.Helper()
+	select {
+	case ev, ok := <-w.Events():
+		if !ok {
+			t.Fatal("events channel closed")
+		}
+		return ev
+	case err := <-w.Errors():
+		t.Fatalf("watch error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	var zero E
+	return zero
+}
+
+func TestWatchSessions_ConvertsAndReconnects(t *testing.T) {
+	svc := &fakeSessionService{}
+	c := newWatchTestClient(t, svc)
+
+	w, err := c.Watch().Sessions(context.Background(), &WatchOptions{MaxBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+
+	ev := nextEvent(t, w)
+	if !ev.IsCreated() || ev.ResourceID != "s1" {
+		t.Fatalf("first event = %+v, want CREATED s1", ev)
+	}
+	if ev.Session == nil || ev.Session.ID != "s1" || ev.Session.Name != "first" || ev.Session.Timeout != 300 {
+		t.Fatalf("session not converted: %+v", ev.Session)
+	}
+	if ev.Session.CreatedAt == nil || !ev.Session.CreatedAt.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("CreatedAt = %v", ev.Session.CreatedAt)
+	}
+	if ev.Session.StartTime == nil || !ev.Session.StartTime.Equal(time.Unix(1700000100, 0)) {
+		t.Errorf("StartTime = %v", ev.Session.StartTime)
+	}
+
+	ev = nextEvent(t, w)
+	if !ev.IsDeleted() || ev.Session != nil {
+		t.Fatalf("event after reconnect = %+v, want DELETED without session", ev)
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if svc.watchCalls != 2 {
+		t.Errorf("watch calls = %d, want 2", svc.watchCalls)
+	}
+	for i := range svc.auth {
+		if svc.auth[i] != "Bearer "+testToken || svc.projects[i] != testProject {
+			t.Errorf("call %d metadata = %q %q", i, svc.auth[i], svc.projects[i])
+		}
+	}
+}
+
+func TestWatchSessions_NonRetryableErrorStops(t *testing.T) {
+	svc := &fakeSessionService{denied: true}
+	c := newWatchTestClient(t, svc)
+
+	w, err := c.Watch().Sessions(context.Background(), &WatchOptions{MaxBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+
+	select {
+	case err := <-w.Errors():
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("error = %v, want PermissionDenied", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+	select {
+	case <-w.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher did not stop")
+	}
+}
+
+func TestWatchSessionMessages_ResumesAfterLastSeq(t *testing.T) {
+	svc := &fakeSessionService{}
+	c := newWatchTestClient(t, svc)
+
+	w, err := c.Watch().SessionMessages(context.Background(), "sess-1", 0, &WatchOptions{MaxBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+
+	for want := 1; want <= 3; want++ {
+		msg := nextEvent(t, w)
+		if msg.Seq != want || msg.SessionID != "sess-1" {
+			t.Fatalf("message = %+v, want seq %d", msg, want)
+		}
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if len(svc.afterSeqs) != 2 || svc.afterSeqs[0] != 0 || svc.afterSeqs[1] != 2 {
+		t.Errorf("after_seq per call = %v, want [0 2]", svc.afterSeqs)
+	}
+}
@@ -5,6 +5,8 @@ go 1.25.0
 require (
 	github.com/ambient-code/platform/components/ambient-api-server v0.0.0-20260304211549-047314a7664b
 	google.golang.org/grpc v1.79.3
+	google.golang.org/protobuf v1.36.11
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -12,5 +14,6 @@ require (
 	golang.org/x/sys v0.45.0 // indirect
 	golang.org/x/text v0.37.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
-	google.golang.org/protobuf v1.36.11 // indirect
 )
+
+replace github.com/ambient-code/platform/components/ambient-api-server => ../../ambient-api-server
@@ -0,0 +1,217 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type kustomization struct {
+	Kind      string      `yaml:"kind"`
+	Resources []string    `yaml:"resources"`
+	Bases     []string    `yaml:"bases"`
+	Patches   []kustPatch `yaml:"patches"`
+}
+
+type kustPatch struct {
+	Path   string     `yaml:"path"`
+	Target kustTarget `yaml:"target"`
+}
+
+type kustTarget struct {
+	Kind string `yaml:"kind"`
+	Name string `yaml:"name"`
+}
+
+func kustomizationFile(dir string) string {
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml"} {
+		p := filepath.Join(dir, name)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// LoadKustomize renders a Kustomize-style directory: bases first, then
+// resources (deduplicated by kind+name, later wins), then patches.
+func LoadKustomize(dir string) ([]Resource, error) {
+	kustFile := kustomizationFile(dir)
+	if kustFile == "" {
+		return nil, fmt.Errorf("no kustomization.yaml found in %s", dir)
+	}
+
+	data, err := os.ReadFile(kustFile)
+	if err != nil {
+		return nil, err
+	}
+	var kust kustomization
+	if err := yaml.Unmarshal(data, &kust); err != nil {
+		return nil, fmt.Errorf("parse kustomization: %w", err)
+	}
+
+	var docs []Resource
+
+	for _, base := range kust.Bases {
+		basePath := filepath.Join(dir, base)
+		baseDocs, err := LoadKustomize(basePath)
+		if err != nil {
+			return nil, fmt.Errorf("base %s: %w", base, err)
+		}
+		docs = append(docs, baseDocs...)
+	}
+
+	for _, res := range kust.Resources {
+		resPath := filepath.Join(dir, res)
+		info, err := os.Stat(resPath)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", res, err)
+		}
+		var resDocs []Resource
+		if info.IsDir() {
+			resDocs, err = LoadKustomize(resPath)
+		} else {
+			resDocs, err = LoadFile(resPath)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", res, err)
+		}
+		docs = mergeResources(docs, resDocs)
+	}
+
+	for _, patch := range kust.Patches {
+		patchDocs, err := LoadFile(filepath.Join(dir, patch.Path))
+		if err != nil {
+			return nil, fmt.Errorf("patch %s: %w", patch.Path, err)
+		}
+		for _, p := range patchDocs {
+			docs = applyPatch(docs, p, patch.Target)
+		}
+	}
+
+	return docs, nil
+}
+
+// mergeResources adds incoming into docs, deduplicating by kind+name (later wins).
+func mergeResources(docs, incoming []Resource) []Resource {
+	idx := make(map[string]int, len(docs))
+	for i, d := range docs {
+		idx[d.Key()] = i
+	}
+	for _, inc := range incoming {
+		key := inc.Key()
+		if i, exists := idx[key]; exists {
+			docs[i] = inc
+		} else {
+			idx[key] = len(docs)
+			docs = append(docs, inc)
+		}
+	}
+	return docs
+}
+
+// applyPatch merges patch into all matching resources (strategic merge).
+func applyPatch(docs []Resource, patch Resource, target kustTarget) []Resource {
+	for i := range docs {
+		if !matchesTarget(docs[i], target) {
+			continue
+		}
+		docs[i] = strategicMerge(docs[i], patch)
+	}
+	return docs
+}
+
+func matchesTarget(doc Resource, target kustTarget) bool {
+	if target.Kind != "" && !strings.EqualFold(doc.Kind, target.Kind) {
+		return false
+	}
+	if target.Name != "" && doc.Name != target.Name {
+		return false
+	}
+	return true
+}
+
+// strategicMerge applies patch onto base: scalars overwrite, maps merge.
+func strategicMerge(base, patch Resource) Resource {
+	if patch.Name != "" {
+		base.Name = patch.Name
+	}
+	if patch.Description != "" {
+		base.Description = patch.Description
+	}
+	if patch.Prompt != "" {
+		base.Prompt = patch.Prompt
+	}
+	if patch.Provider != "" {
+		base.Provider = patch.Provider
+	}
+	if patch.Token != "" {
+		base.Token = patch.Token
+	}
+	if patch.URL != "" {
+		base.URL = patch.URL
+	}
+	if patch.Email != "" {
+		base.Email = patch.Email
+	}
+	mergeString(&base.Schedule, patch.Schedule)
+	mergeString(&base.Timezone, patch.Timezone)
+	mergeString(&base.Agent, patch.Agent)
+	mergeString(&base.RunnerType, patch.RunnerType)
+	mergeString(&base.SourceRepoURL, patch.SourceRepoURL)
+	mergeString(&base.SourcePath, patch.SourcePath)
+	mergeString(&base.SourceTargetRevision, patch.SourceTargetRevision)
+	mergeString(&base.DestinationProject, patch.DestinationProject)
+	mergeString(&base.DestinationAmbientURL, patch.DestinationAmbientURL)
+	mergeString(&base.Credential, patch.Credential)
+	mergePtr(&base.Enabled, patch.Enabled)
+	mergePtr(&base.Timeout, patch.Timeout)
+	mergePtr(&base.InactivityTimeout, patch.InactivityTimeout)
+	mergePtr(&base.StopOnRunFinished, patch.StopOnRunFinished)
+	mergePtr(&base.InactivityTimeoutSeconds, patch.InactivityTimeoutSeconds)
+	mergePtr(&base.MonthlyBudgetUsd, patch.MonthlyBudgetUsd)
+	mergePtr(&base.AutoSync, patch.AutoSync)
+	mergePtr(&base.AutoPrune, patch.AutoPrune)
+	mergePtr(&base.SelfHeal, patch.SelfHeal)
+	mergePtr(&base.RetryLimit, patch.RetryLimit)
+	// Structured settings are replaced as a whole, not merged.
+	mergeAny(&base.GroupAccess, patch.GroupAccess)
+	mergeAny(&base.Repositories, patch.Repositories)
+	mergeAny(&base.ModelOverrides, patch.ModelOverrides)
+	mergeAny(&base.ResourceLimits, patch.ResourceLimits)
+	mergeAny(&base.SyncOptions, patch.SyncOptions)
+	for k, v := range patch.Labels {
+		if base.Labels == nil {
+			base.Labels = make(map[string]string)
+		}
+		base.Labels[k] = v
+	}
+	for k, v := range patch.Annotations {
+		if base.Annotations == nil {
+			base.Annotations = make(map[string]string)
+		}
+		base.Annotations[k] = v
+	}
+	return base
+}
+
+func mergeString(dst *string, v string) {
+	if v != "" {
+		*dst = v
+	}
+}
+
+func mergePtr[T any](dst **T, v *T) {
+	if v != nil {
+		*dst = v
+	}
+}
+
+func mergeAny(dst *any, v any) {
+	if v != nil {
+		*dst = v
+	}
+}
@@ -0,0 +1,188 @@
+// Package manifest parses the declarative Project, Agent, Credential,
+// RoleBinding, ScheduledSession, ProjectSettings and Application documents
+// understood by acpctl apply and the GitOps application syncer.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KindProject     = "Project"
+	KindAgent       = "Agent"
+	KindCredential  = "Credential"
+	KindRoleBinding = "RoleBinding"
+
+	KindScheduledSession = "ScheduledSession"
+	KindProjectSettings  = "ProjectSettings"
+	KindApplication      = "Application"
+)
+
+// Resource is a parsed YAML document from a manifest file.
+type Resource struct {
+	Kind        string            `yaml:"kind" json:"kind"`
+	Name        string            `yaml:"name" json:"name,omitempty"`
+	Description string            `yaml:"description" json:"description,omitempty"`
+	Prompt      string            `yaml:"prompt" json:"prompt,omitempty"`
+	Labels      map[string]string `yaml:"labels" json:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations" json:"annotations,omitempty"`
+	Inbox       []InboxSeed       `yaml:"inbox" json:"inbox,omitempty"`
+	Provider    string            `yaml:"provider" json:"provider,omitempty"`
+	Token       string            `yaml:"token" json:"token,omitempty"`
+	URL         string            `yaml:"url" json:"url,omitempty"`
+	Email       string            `yaml:"email" json:"email,omitempty"`
+	Role        string            `yaml:"role" json:"role,omitempty"`
+	Scope       string            `yaml:"scope" json:"scope,omitempty"`
+	ScopeID     string            `yaml:"scope_id" json:"scope_id,omitempty"`
+	UserID      string            `yaml:"user_id" json:"user_id,omitempty"`
+
+	// ScheduledSession. Prompt is the session prompt and Agent the name of
+	// an agent in the same project.
+	Schedule          string `yaml:"schedule" json:"schedule,omitempty"`
+	Timezone          string `yaml:"timezone" json:"timezone,omitempty"`
+	Agent             string `yaml:"agent" json:"agent,omitempty"`
+	Enabled           *bool  `yaml:"enabled" json:"enabled,omitempty"`
+	Timeout           *int32 `yaml:"timeout" json:"timeout,omitempty"`
+	InactivityTimeout *int32 `yaml:"inactivity_timeout" json:"inactivity_timeout,omitempty"`
+	RunnerType        string `yaml:"runner_type" json:"runner_type,omitempty"`
+	StopOnRunFinished *bool  `yaml:"stop_on_run_finished" json:"stop_on_run_finished,omitempty"`
+
+	// ProjectSettings. The structured fields may be written as YAML or as a
+	// JSON string; they are stored as JSON.
+	GroupAccess              any      `yaml:"group_access" json:"group_access,omitempty"`
+	Repositories             any      `yaml:"repositories" json:"repositories,omitempty"`
+	ModelOverrides           any      `yaml:"model_overrides" json:"model_overrides,omitempty"`
+	ResourceLimits           any      `yaml:"resource_limits" json:"resource_limits,omitempty"`
+	InactivityTimeoutSeconds *int     `yaml:"inactivity_timeout_seconds" json:"inactivity_timeout_seconds,omitempty"`
+	MonthlyBudgetUsd         *float64 `yaml:"monthly_budget_usd" json:"monthly_budget_usd,omitempty"`
+
+	// Application. Credential is a credential name or ID.
+	SourceRepoURL         string `yaml:"source_repo_url" json:"source_repo_url,omitempty"`
+	SourcePath            string `yaml:"source_path" json:"source_path,omitempty"`
+	SourceTargetRevision  string `yaml:"source_target_revision" json:"source_target_revision,omitempty"`
+	DestinationProject    string `yaml:"destination_project" json:"destination_project,omitempty"`
+	DestinationAmbientURL string `yaml:"destination_ambient_url" json:"destination_ambient_url,omitempty"`
+	Credential            string `yaml:"credential" json:"credential,omitempty"`
+	AutoSync              *bool  `yaml:"auto_sync" json:"auto_sync,omitempty"`
+	AutoPrune             *bool  `yaml:"auto_prune" json:"auto_prune,omitempty"`
+	SelfHeal              *bool  `yaml:"self_heal" json:"self_heal,omitempty"`
+	RetryLimit            *int32 `yaml:"retry_limit" json:"retry_limit,omitempty"`
+	SyncOptions           any    `yaml:"sync_options" json:"sync_options,omitempty"`
+}
+
+// InboxSeed is a message delivered to an Agent's inbox the first time it is applied.
+type InboxSeed struct {
+	FromName string `yaml:"from_name" json:"from_name"`
+	Body     string `yaml:"body" json:"body"`
+}
+
+// Key identifies a resource by lower-cased kind and name.
+func (r Resource) Key() string {
+	return strings.ToLower(r.Kind) + "/" + r.DisplayName()
+}
+
+// DisplayName returns the name shown for the resource in output. RoleBindings
+// have no name of their own and are shown as user→scope_id.
+func (r Resource) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	if strings.EqualFold(r.Kind, KindRoleBinding) {
+		return RoleBindingDisplayName(r)
+	}
+	return r.Kind
+}
+
+// RoleBindingDisplayName returns the user→scope_id label for a RoleBinding document.
+func RoleBindingDisplayName(r Resource) string {
+	return r.UserID + "→" + r.ScopeID
+}
+
+// Load reads path as a Kustomize directory when it contains a
+// kustomization.yaml, and otherwise as a file or directory of manifests.
+func Load(path string) ([]Resource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() && kustomizationFile(path) != "" {
+		return LoadKustomize(path)
+	}
+	return LoadFile(path)
+}
+
+// LoadFile reads a single manifest file, or every *.yaml / *.yml file in a
+// directory (kustomization files excluded).
+func LoadFile(path string) ([]Resource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return LoadDir(path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+func LoadDir(dir string) ([]Resource, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var all []Resource
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		if !strings.HasSuffix(name, ".yaml") && !strings.HasSuffix(name, ".yml") {
+			continue
+		}
+		if name == "kustomization.yaml" || name == "kustomization.yml" {
+			continue
+		}
+		docs, err := LoadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		all = append(all, docs...)
+	}
+	return all, nil
+}
+
+// Parse decodes one or more YAML documents separated by ---. Documents
+// without a kind are ignored.
+func Parse(r io.Reader) ([]Resource, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var docs []Resource
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc Resource
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("parse YAML: %w", err)
+		}
+		if doc.Kind == "" {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -0,0 +1,146 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParse_MultiDocumentSkipsKindless(t *testing.T) {
+	docs, err := Parse(strings.NewReader(`
+kind: Agent
+name: lead
+prompt: lead the team
+inbox:
+  - from_name: bootstrap
+    body: hello
+---
+# comment only
+name: orphan
+---
+kind: RoleBinding
+role: credential:token-reader
+scope: credential
+scope_id: my-pat
+user_id: lead
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 docs, got %d", len(docs))
+	}
+	if docs[0].Inbox[0].FromName != "bootstrap" {
+		t.Errorf("inbox not parsed: %+v", docs[0].Inbox)
+	}
+	if got := docs[1].Key(); got != "rolebinding/lead→my-pat" {
+		t.Errorf("Key() = %q", got)
+	}
+}
+
+func TestLoad_KustomizeOverlay(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "base", "kustomization.yaml"), "resources:\n  - lead.yaml\n  - dev.yaml\n")
+	writeFile(t, filepath.Join(root, "base", "lead.yaml"), "kind: Agent\nname: lead\nprompt: base\nlabels:\n  tier: base\n")
+	writeFile(t, filepath.Join(root, "base", "dev.yaml"), "kind: Agent\nname: dev\nprompt: dev\n")
+	writeFile(t, filepath.Join(root, "overlay", "kustomization.yaml"),
+		"bases:\n  - ../base\npatches:\n  - path: lead-patch.yaml\n    target:\n      kind: Agent\n      name: lead\n")
+	writeFile(t, filepath.Join(root, "overlay", "lead-patch.yaml"), "kind: Agent\nprompt: overlay\nlabels:\n  env: prod\n")
+
+	docs, err := Load(filepath.Join(root, "overlay"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 docs, got %d", len(docs))
+	}
+	lead := docs[0]
+	if lead.Prompt != "overlay" {
+		t.Errorf("patch prompt not applied: %q", lead.Prompt)
+	}
+	if lead.Labels["tier"] != "base" || lead.Labels["env"] != "prod" {
+		t.Errorf("labels not merged: %v", lead.Labels)
+	}
+	if docs[1].Prompt != "dev" {
+		t.Errorf("untargeted doc patched: %q", docs[1].Prompt)
+	}
+}
+
+func TestLoad_OverlayMergesScheduledSessionAndApplication(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "base", "kustomization.yaml"), "resources:\n  - resources.yaml\n")
+	writeFile(t, filepath.Join(root, "base", "resources.yaml"), `kind: ScheduledSession
+name: nightly
+agent: lead
+schedule: "0 2 * * *"
+enabled: false
+---
+kind: ProjectSettings
+model_overrides:
+  claude-opus-4-6: true
+---
+kind: Application
+name: fleet
+source_repo_url: https://github.com/org/fleet
+auto_sync: false
+sync_options:
+  - CreateNamespace=true
+`)
+	writeFile(t, filepath.Join(root, "overlay", "kustomization.yaml"),
+		"bases:\n  - ../base\npatches:\n  - path: patch.yaml\n    target:\n      kind: ScheduledSession\n      name: nightly\n  - path: app.yaml\n    target:\n      kind: Application\n      name: fleet\n")
+	writeFile(t, filepath.Join(root, "overlay", "patch.yaml"), "kind: ScheduledSession\nenabled: true\ntimezone: Europe/Berlin\n")
+	writeFile(t, filepath.Join(root, "overlay", "app.yaml"), "kind: Application\nauto_sync: true\nretry_limit: 3\n")
+
+	docs, err := Load(filepath.Join(root, "overlay"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(docs) != 3 {
+		t.Fatalf("expected 3 docs, got %d", len(docs))
+	}
+	ss := docs[0]
+	if ss.Schedule != "0 2 * * *" || ss.Agent != "lead" || ss.Timezone != "Europe/Berlin" {
+		t.Errorf("scheduled session not merged: %+v", ss)
+	}
+	if ss.Enabled == nil || !*ss.Enabled {
+		t.Errorf("enabled: false not overridden by patch")
+	}
+	if got := docs[1].Key(); got != "projectsettings/ProjectSettings" {
+		t.Errorf("Key() = %q", got)
+	}
+	if _, ok := docs[1].ModelOverrides.(map[string]any); !ok {
+		t.Errorf("model_overrides not parsed as a map: %#v", docs[1].ModelOverrides)
+	}
+	app := docs[2]
+	if app.AutoSync == nil || !*app.AutoSync || app.RetryLimit == nil || *app.RetryLimit != 3 {
+		t.Errorf("application not merged: %+v", app)
+	}
+	if app.SourceRepoURL != "https://github.com/org/fleet" || app.SyncOptions == nil {
+		t.Errorf("application base fields lost: %+v", app)
+	}
+}
+
+func TestLoad_PlainDirectoryIgnoresNonYAML(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.yaml"), "kind: Project\nname: p\n")
+	writeFile(t, filepath.Join(root, "README.md"), "kind: Agent\nname: nope\n")
+
+	docs, err := Load(root)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(docs) != 1 || docs[0].Kind != KindProject {
+		t.Fatalf("unexpected docs: %+v", docs)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// BlackboardWriteOptions makes a blackboard write conditional or expiring.
+type BlackboardWriteOptions struct {
+	// ExpectedVersion, when set, only writes if the entry is at this
+	// version; 0 requires the key to be absent.
+	ExpectedVersion *int `json:"expected_version,omitempty"`
+	// TTLSeconds expires the entry after this many seconds; 0 never expires.
+	TTLSeconds int `json:"ttl_seconds,omitempty"`
+}
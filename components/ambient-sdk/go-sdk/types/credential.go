@@ -8,19 +8,28 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 type Credential struct {
 	ObjectReference
 
-	Annotations string `json:"annotations,omitempty"`
-	Description string `json:"description,omitempty"`
-	Email       string `json:"email,omitempty"`
-	Labels      string `json:"labels,omitempty"`
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	Token       string `json:"token,omitempty"`
-	URL         string `json:"url,omitempty"`
+	Annotations      string     `json:"annotations,omitempty"`
+	AuthType         string     `json:"auth_type,omitempty"`
+	Description      string     `json:"description,omitempty"`
+	Email            string     `json:"email,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	ExpiryNotifiedAt *time.Time `json:"expiry_notified_at,omitempty"`
+	Labels           string     `json:"labels,omitempty"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+	Name             string     `json:"name"`
+	OauthClient      string     `json:"oauth_client,omitempty"`
+	OauthScopes      string     `json:"oauth_scopes,omitempty"`
+	Provider         string     `json:"provider"`
+	RefreshToken     string     `json:"refresh_token,omitempty"`
+	RotatedAt        *time.Time `json:"rotated_at,omitempty"`
+	Token            string     `json:"token,omitempty"`
+	URL              string     `json:"url,omitempty"`
 }
 
 type CredentialList struct {
@@ -47,6 +56,11 @@ func (b *CredentialBuilder) Annotations(v string) *CredentialBuilder {
 	return b
 }
 
+func (b *CredentialBuilder) AuthType(v string) *CredentialBuilder {
+	b.resource.AuthType = v
+	return b
+}
+
 func (b *CredentialBuilder) Description(v string) *CredentialBuilder {
 	b.resource.Description = v
 	return b
@@ -57,6 +71,11 @@ func (b *CredentialBuilder) Email(v string) *CredentialBuilder {
 	return b
 }
 
+func (b *CredentialBuilder) ExpiresAt(v time.Time) *CredentialBuilder {
+	b.resource.ExpiresAt = &v
+	return b
+}
+
 func (b *CredentialBuilder) Labels(v string) *CredentialBuilder {
 	b.resource.Labels = v
 	return b
@@ -67,11 +86,26 @@ func (b *CredentialBuilder) Name(v string) *CredentialBuilder {
 	return b
 }
 
+func (b *CredentialBuilder) OauthClient(v string) *CredentialBuilder {
+	b.resource.OauthClient = v
+	return b
+}
+
+func (b *CredentialBuilder) OauthScopes(v string) *CredentialBuilder {
+	b.resource.OauthScopes = v
+	return b
+}
+
 func (b *CredentialBuilder) Provider(v string) *CredentialBuilder {
 	b.resource.Provider = v
 	return b
 }
 
+func (b *CredentialBuilder) RefreshToken(v string) *CredentialBuilder {
+	b.resource.RefreshToken = v
+	return b
+}
+
 func (b *CredentialBuilder) Token(v string) *CredentialBuilder {
 	b.resource.Token = v
 	return b
@@ -118,6 +152,11 @@ func (b *CredentialPatchBuilder) Email(v string) *CredentialPatchBuilder {
 	return b
 }
 
+func (b *CredentialPatchBuilder) ExpiresAt(v *time.Time) *CredentialPatchBuilder {
+	b.patch["expires_at"] = v
+	return b
+}
+
 func (b *CredentialPatchBuilder) Labels(v string) *CredentialPatchBuilder {
 	b.patch["labels"] = v
 	return b
@@ -128,11 +167,26 @@ func (b *CredentialPatchBuilder) Name(v string) *CredentialPatchBuilder {
 	return b
 }
 
+func (b *CredentialPatchBuilder) OauthClient(v string) *CredentialPatchBuilder {
+	b.patch["oauth_client"] = v
+	return b
+}
+
+func (b *CredentialPatchBuilder) OauthScopes(v string) *CredentialPatchBuilder {
+	b.patch["oauth_scopes"] = v
+	return b
+}
+
 func (b *CredentialPatchBuilder) Provider(v string) *CredentialPatchBuilder {
 	b.patch["provider"] = v
 	return b
 }
 
+func (b *CredentialPatchBuilder) RefreshToken(v string) *CredentialPatchBuilder {
+	b.patch["refresh_token"] = v
+	return b
+}
+
 func (b *CredentialPatchBuilder) Token(v string) *CredentialPatchBuilder {
 	b.patch["token"] = v
 	return b
@@ -1,7 +1,42 @@
 package types
 
+import "time"
+
 type CredentialTokenResponse struct {
-	CredentialID string `json:"credential_id"`
-	Provider     string `json:"provider"`
-	Token        string `json:"token"`
+	CredentialID string     `json:"credential_id"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	Provider     string     `json:"provider"`
+	Token        string     `json:"token"`
+}
+
+// CredentialRotateRequest replaces a credential's token. ExpiresAt belongs
+// to the new token; leaving it nil clears the old token's expiry.
+type CredentialRotateRequest struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Token     string     `json:"token"`
+}
+
+// CredentialSecretCount counts stored secrets of each kind.
+type CredentialSecretCount struct {
+	OAuthGrants int64 `json:"oauth_grants"`
+	Tokens      int64 `json:"tokens"`
+}
+
+// CredentialKeyVersionCount counts the secrets encrypted under one key
+// version. InKeyring is false for a version the server cannot decrypt.
+type CredentialKeyVersionCount struct {
+	CredentialSecretCount
+	InKeyring bool `json:"in_keyring"`
+	Version   int  `json:"version"`
+}
+
+// CredentialEncryptionStatus reports which key versions stored secrets use.
+// A retired key can be removed once no secrets remain on it.
+type CredentialEncryptionStatus struct {
+	ActiveVersion   int                         `json:"active_version,omitempty"`
+	Enabled         bool                        `json:"enabled"`
+	KeyringVersions []int                       `json:"keyring_versions"`
+	Plaintext       CredentialSecretCount       `json:"plaintext"`
+	Remaining       int64                       `json:"remaining"`
+	Versions        []CredentialKeyVersionCount `json:"versions"`
 }
@@ -0,0 +1,19 @@
+package types
+
+// ProjectDocumentRevision is a document as it was written at one revision.
+type ProjectDocumentRevision struct {
+	ObjectReference
+
+	Body        string `json:"body,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	DocumentID  string `json:"document_id,omitempty"`
+	Labels      string `json:"labels,omitempty"`
+	Revision    int32  `json:"revision,omitempty"`
+	Title       string `json:"title,omitempty"`
+	UpdatedBy   string `json:"updated_by,omitempty"`
+}
+
+type ProjectDocumentRevisionList struct {
+	ListMeta
+	Items []ProjectDocumentRevision `json:"items"`
+}
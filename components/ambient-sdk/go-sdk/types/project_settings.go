@@ -1,7 +1,7 @@
 // Code generated by ambient-sdk-generator from openapi.yaml — DO NOT EDIT.
 // Source: ../../ambient-api-server/openapi/openapi.yaml
-// Spec SHA256: aa4fadb1f795265128f058fdc5ffa2bd25396c8516537ea97ba3671dc007b8fc
-// Generated: 2026-06-11T02:31:17Z
+// Spec SHA256: 93c63fb77e9a5e6a40b7fe05eb4bd0e831072ce91e69b557e0234fb15c598532
+// Generated: 2026-10-17T05:40:50Z
 
 package types
 
@@ -13,9 +13,13 @@ import (
 type ProjectSettings struct {
 	ObjectReference
 
-	GroupAccess  string `json:"group_access,omitempty"`
-	ProjectID    string `json:"project_id"`
-	Repositories string `json:"repositories,omitempty"`
+	GroupAccess              string  `json:"group_access,omitempty"`
+	InactivityTimeoutSeconds int32   `json:"inactivity_timeout_seconds,omitempty"`
+	ModelOverrides           string  `json:"model_overrides,omitempty"`
+	MonthlyBudgetUsd         float64 `json:"monthly_budget_usd,omitempty"`
+	ProjectID                string  `json:"project_id"`
+	Repositories             string  `json:"repositories,omitempty"`
+	ResourceLimits           string  `json:"resource_limits,omitempty"`
 }
 
 type ProjectSettingsList struct {
@@ -42,6 +46,21 @@ func (b *ProjectSettingsBuilder) GroupAccess(v string) *ProjectSettingsBuilder {
 	return b
 }
 
+func (b *ProjectSettingsBuilder) InactivityTimeoutSeconds(v int32) *ProjectSettingsBuilder {
+	b.resource.InactivityTimeoutSeconds = v
+	return b
+}
+
+func (b *ProjectSettingsBuilder) ModelOverrides(v string) *ProjectSettingsBuilder {
+	b.resource.ModelOverrides = v
+	return b
+}
+
+func (b *ProjectSettingsBuilder) MonthlyBudgetUsd(v float64) *ProjectSettingsBuilder {
+	b.resource.MonthlyBudgetUsd = v
+	return b
+}
+
 func (b *ProjectSettingsBuilder) ProjectID(v string) *ProjectSettingsBuilder {
 	b.resource.ProjectID = v
 	return b
@@ -52,6 +71,11 @@ func (b *ProjectSettingsBuilder) Repositories(v string) *ProjectSettingsBuilder
 	return b
 }
 
+func (b *ProjectSettingsBuilder) ResourceLimits(v string) *ProjectSettingsBuilder {
+	b.resource.ResourceLimits = v
+	return b
+}
+
 func (b *ProjectSettingsBuilder) Build() (*ProjectSettings, error) {
 	if b.resource.ProjectID == "" {
 		b.errors = append(b.errors, fmt.Errorf("project_id is required"))
@@ -75,6 +99,21 @@ func (b *ProjectSettingsPatchBuilder) GroupAccess(v string) *ProjectSettingsPatc
 	return b
 }
 
+func (b *ProjectSettingsPatchBuilder) InactivityTimeoutSeconds(v int32) *ProjectSettingsPatchBuilder {
+	b.patch["inactivity_timeout_seconds"] = v
+	return b
+}
+
+func (b *ProjectSettingsPatchBuilder) ModelOverrides(v string) *ProjectSettingsPatchBuilder {
+	b.patch["model_overrides"] = v
+	return b
+}
+
+func (b *ProjectSettingsPatchBuilder) MonthlyBudgetUsd(v float64) *ProjectSettingsPatchBuilder {
+	b.patch["monthly_budget_usd"] = v
+	return b
+}
+
 func (b *ProjectSettingsPatchBuilder) ProjectID(v string) *ProjectSettingsPatchBuilder {
 	b.patch["project_id"] = v
 	return b
@@ -85,6 +124,11 @@ func (b *ProjectSettingsPatchBuilder) Repositories(v string) *ProjectSettingsPat
 	return b
 }
 
+func (b *ProjectSettingsPatchBuilder) ResourceLimits(v string) *ProjectSettingsPatchBuilder {
+	b.patch["resource_limits"] = v
+	return b
+}
+
 func (b *ProjectSettingsPatchBuilder) Build() map[string]any {
 	return b.patch
 }
@@ -1,7 +1,7 @@
 // Code generated by ambient-sdk-generator from openapi.yaml — DO NOT EDIT.
 // Source: ../../ambient-api-server/openapi/openapi.yaml
-// Spec SHA256: aa4fadb1f795265128f058fdc5ffa2bd25396c8516537ea97ba3671dc007b8fc
-// Generated: 2026-06-11T02:31:17Z
+// Spec SHA256: 93c63fb77e9a5e6a40b7fe05eb4bd0e831072ce91e69b557e0234fb15c598532
+// Generated: 2026-10-17T05:40:50Z
 
 package types
 
@@ -16,6 +16,7 @@ type Role struct {
 	BuiltIn     bool   `json:"built_in,omitempty"`
 	Description string `json:"description,omitempty"`
 	DisplayName string `json:"display_name,omitempty"`
+	Level       int32  `json:"level,omitempty"`
 	Name        string `json:"name"`
 	Permissions string `json:"permissions,omitempty"`
 }
@@ -54,6 +55,11 @@ func (b *RoleBuilder) DisplayName(v string) *RoleBuilder {
 	return b
 }
 
+func (b *RoleBuilder) Level(v int32) *RoleBuilder {
+	b.resource.Level = v
+	return b
+}
+
 func (b *RoleBuilder) Name(v string) *RoleBuilder {
 	b.resource.Name = v
 	return b
@@ -97,6 +103,11 @@ func (b *RolePatchBuilder) DisplayName(v string) *RolePatchBuilder {
 	return b
 }
 
+func (b *RolePatchBuilder) Level(v int32) *RolePatchBuilder {
+	b.patch["level"] = v
+	return b
+}
+
 func (b *RolePatchBuilder) Name(v string) *RolePatchBuilder {
 	b.patch["name"] = v
 	return b
@@ -0,0 +1,23 @@
+package types
+
+// RoleSimulationRequest asks whether a user may perform an action on a
+// resource in the given scope.
+type RoleSimulationRequest struct {
+	Action       string `json:"action"`
+	AgentID      string `json:"agent_id,omitempty"`
+	CredentialID string `json:"credential_id,omitempty"`
+	ProjectID    string `json:"project_id,omitempty"`
+	Resource     string `json:"resource"`
+	SessionID    string `json:"session_id,omitempty"`
+	UserID       string `json:"user_id"`
+}
+
+// RoleSimulation reports whether the role's permissions cover the request
+// (RoleGrants) and whether the user's current bindings allow it (Allowed).
+type RoleSimulation struct {
+	Allowed    bool   `json:"allowed"`
+	Permission string `json:"permission"`
+	RoleGrants bool   `json:"role_grants"`
+	RoleID     string `json:"role_id"`
+	UserID     string `json:"user_id"`
+}
@@ -13,3 +13,8 @@ type ScheduledSessionPatch struct {
 	Timeout           *int32  `json:"timeout,omitempty"`
 	Timezone          *string `json:"timezone,omitempty"`
 }
+
+func (l *ScheduledSessionRunList) GetItems() []ScheduledSessionRun { return l.Items }
+func (l *ScheduledSessionRunList) GetTotal() int                   { return l.Total }
+func (l *ScheduledSessionRunList) GetPage() int                    { return l.Page }
+func (l *ScheduledSessionRunList) GetSize() int                    { return l.Size }
@@ -42,6 +42,7 @@ type Session struct {
 	SdkRestartCount      int        `json:"sdk_restart_count,omitempty"`
 	SdkSessionID         string     `json:"sdk_session_id,omitempty"`
 	StartTime            *time.Time `json:"start_time,omitempty"`
+	StoppedReason        string     `json:"stopped_reason,omitempty"`
 	Timeout              int        `json:"timeout,omitempty"`
 	WorkflowID           string     `json:"workflow_id,omitempty"`
 }
@@ -315,6 +316,11 @@ func (b *SessionStatusPatchBuilder) StartTime(v *time.Time) *SessionStatusPatchB
 	return b
 }
 
+func (b *SessionStatusPatchBuilder) StoppedReason(v string) *SessionStatusPatchBuilder {
+	b.patch["stopped_reason"] = v
+	return b
+}
+
 func (b *SessionStatusPatchBuilder) Build() map[string]any {
 	return b.patch
 }
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 type StartRequest struct {
 	Prompt string `json:"prompt,omitempty"`
 }
@@ -10,14 +12,47 @@ type StartResponse struct {
 }
 
 type ProjectHome struct {
-	ProjectID string             `json:"project_id,omitempty"`
-	Agents    []ProjectHomeAgent `json:"agents,omitempty"`
+	Agents                    []ProjectHomeAgent            `json:"agents,omitempty"`
+	GeneratedAt               *time.Time                    `json:"generated_at,omitempty"`
+	ProjectID                 string                        `json:"project_id,omitempty"`
+	RecentApplicationSyncs    []ProjectHomeApplicationSync  `json:"recent_application_syncs,omitempty"`
+	SessionCounts             []ProjectHomeSessionCount     `json:"session_counts,omitempty"`
+	UpcomingScheduledSessions []ProjectHomeScheduledSession `json:"upcoming_scheduled_sessions,omitempty"`
 }
 
 type ProjectHomeAgent struct {
 	AgentID          string `json:"agent_id,omitempty"`
 	AgentName        string `json:"agent_name,omitempty"`
-	SessionPhase     string `json:"session_phase,omitempty"`
+	AgentStatus      string `json:"agent_status,omitempty"`
+	CurrentSessionID string `json:"current_session_id,omitempty"`
 	InboxUnreadCount int    `json:"inbox_unread_count,omitempty"`
+	SessionPhase     string `json:"session_phase,omitempty"`
 	Summary          string `json:"summary,omitempty"`
 }
+
+type ProjectHomeSessionCount struct {
+	Completed int    `json:"completed,omitempty"`
+	Failed    int    `json:"failed,omitempty"`
+	Running   int    `json:"running,omitempty"`
+	Total     int    `json:"total,omitempty"`
+	Window    string `json:"window,omitempty"`
+}
+
+type ProjectHomeScheduledSession struct {
+	AgentID   string     `json:"agent_id,omitempty"`
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	Schedule  string     `json:"schedule,omitempty"`
+}
+
+type ProjectHomeApplicationSync struct {
+	HealthStatus     string     `json:"health_status,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	LastSyncedAt     *time.Time `json:"last_synced_at,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	OperationMessage string     `json:"operation_message,omitempty"`
+	OperationPhase   string     `json:"operation_phase,omitempty"`
+	SyncRevision     string     `json:"sync_revision,omitempty"`
+	SyncStatus       string     `json:"sync_status,omitempty"`
+}
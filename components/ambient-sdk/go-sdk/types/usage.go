@@ -0,0 +1,48 @@
+package types
+
+import (
+	"net/url"
+	"time"
+)
+
+// UsageQuery filters and groups a usage rollup. Empty fields do not
+// filter; From is inclusive and To exclusive. ProjectID is ignored by
+// project rollups.
+type UsageQuery struct {
+	ProjectID string
+	AgentID   string
+	SessionID string
+	UserID    string
+	Model     string
+	From      time.Time
+	To        time.Time
+	// GroupBy is one of model (the default), session, agent, project or user.
+	GroupBy string
+}
+
+// Values encodes the query as URL parameters.
+func (q *UsageQuery) Values() url.Values {
+	v := url.Values{}
+	if q == nil {
+		return v
+	}
+	for key, value := range map[string]string{
+		"project_id": q.ProjectID,
+		"agent_id":   q.AgentID,
+		"session_id": q.SessionID,
+		"user_id":    q.UserID,
+		"model":      q.Model,
+		"group_by":   q.GroupBy,
+	} {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	if !q.From.IsZero() {
+		v.Set("from", q.From.UTC().Format(time.RFC3339))
+	}
+	if !q.To.IsZero() {
+		v.Set("to", q.To.UTC().Format(time.RFC3339))
+	}
+	return v
+}
@@ -9,9 +9,11 @@ from .client import AmbientClient
 from ._base import APIError, ListOptions
 from .agent import Agent, AgentPatch
 from .application import Application, ApplicationPatch
+from .blackboard_entry import BlackboardEntry, BlackboardEntryPatch
 from .credential import Credential, CredentialPatch
 from .inbox_message import InboxMessage, InboxMessagePatch
 from .project import Project, ProjectPatch
+from .project_document import ProjectDocument, ProjectDocumentPatch
 from .project_settings import ProjectSettings, ProjectSettingsPatch
 from .role import Role, RolePatch
 from .role_binding import RoleBinding, RoleBindingPatch
@@ -30,12 +32,16 @@ __all__ = [
     "AgentPatch",
     "Application",
     "ApplicationPatch",
+    "BlackboardEntry",
+    "BlackboardEntryPatch",
     "Credential",
     "CredentialPatch",
     "InboxMessage",
     "InboxMessagePatch",
     "Project",
     "ProjectPatch",
+    "ProjectDocument",
+    "ProjectDocumentPatch",
     "ProjectSettings",
     "ProjectSettingsPatch",
     "Role",
@@ -18,9 +18,11 @@ from ._base import APIError, ListOptions
 if TYPE_CHECKING:
     from ._agent_api import AgentAPI
     from ._application_api import ApplicationAPI
+    from ._blackboard_entry_api import BlackboardEntryAPI
     from ._credential_api import CredentialAPI
     from ._inbox_message_api import InboxMessageAPI
     from ._project_api import ProjectAPI
+    from ._project_document_api import ProjectDocumentAPI
     from ._project_settings_api import ProjectSettingsAPI
     from ._role_api import RoleAPI
     from ._role_binding_api import RoleBindingAPI
@@ -63,9 +65,11 @@ class AmbientClient:
         # Initialize API interfaces
         self._agent_api: Optional[AgentAPI] = None
         self._application_api: Optional[ApplicationAPI] = None
+        self._blackboard_entry_api: Optional[BlackboardEntryAPI] = None
         self._credential_api: Optional[CredentialAPI] = None
         self._inbox_message_api: Optional[InboxMessageAPI] = None
         self._project_api: Optional[ProjectAPI] = None
+        self._project_document_api: Optional[ProjectDocumentAPI] = None
         self._project_settings_api: Optional[ProjectSettingsAPI] = None
         self._role_api: Optional[RoleAPI] = None
         self._role_binding_api: Optional[RoleBindingAPI] = None
@@ -200,6 +204,13 @@ class AmbientClient:
             self._application_api = ApplicationAPI(self)
         return self._application_api
     @property
+    def blackboard_entries(self) -> BlackboardEntryAPI:
+        """Get the BlackboardEntry API interface."""
+        if self._blackboard_entry_api is None:
+            from ._blackboard_entry_api import BlackboardEntryAPI
+            self._blackboard_entry_api = BlackboardEntryAPI(self)
+        return self._blackboard_entry_api
+    @property
     def credentials(self) -> CredentialAPI:
         """Get the Credential API interface."""
         if self._credential_api is None:
@@ -221,6 +232,13 @@ class AmbientClient:
             self._project_api = ProjectAPI(self)
         return self._project_api
     @property
+    def project_documents(self) -> ProjectDocumentAPI:
+        """Get the ProjectDocument API interface."""
+        if self._project_document_api is None:
+            from ._project_document_api import ProjectDocumentAPI
+            self._project_document_api = ProjectDocumentAPI(self)
+        return self._project_document_api
+    @property
     def project_settings(self) -> ProjectSettingsAPI:
         """Get the ProjectSettings API interface."""
         if self._project_settings_api is None:
@@ -20,11 +20,19 @@ class Credential:
     created_at: Optional[datetime] = None
     updated_at: Optional[datetime] = None
     annotations: str = ""
+    auth_type: str = ""
     description: str = ""
     email: str = ""
+    expires_at: Optional[datetime] = None
+    expiry_notified_at: Optional[datetime] = None
     labels: str = ""
+    last_used_at: Optional[datetime] = None
     name: str = ""
+    oauth_client: str = ""
+    oauth_scopes: str = ""
     provider: str = ""
+    refresh_token: str = ""
+    rotated_at: Optional[datetime] = None
     token: str = ""
     url: str = ""
 
@@ -37,11 +45,19 @@ class Credential:
             created_at=_parse_datetime(data.get("created_at")),
             updated_at=_parse_datetime(data.get("updated_at")),
             annotations=data.get("annotations", ""),
+            auth_type=data.get("auth_type", ""),
             description=data.get("description", ""),
             email=data.get("email", ""),
+            expires_at=_parse_datetime(data.get("expires_at")),
+            expiry_notified_at=_parse_datetime(data.get("expiry_notified_at")),
             labels=data.get("labels", ""),
+            last_used_at=_parse_datetime(data.get("last_used_at")),
             name=data.get("name", ""),
+            oauth_client=data.get("oauth_client", ""),
+            oauth_scopes=data.get("oauth_scopes", ""),
             provider=data.get("provider", ""),
+            refresh_token=data.get("refresh_token", ""),
+            rotated_at=_parse_datetime(data.get("rotated_at")),
             token=data.get("token", ""),
             url=data.get("url", ""),
         )
@@ -79,6 +95,10 @@ class CredentialBuilder:
         self._data["annotations"] = value
         return self
 
+    def auth_type(self, value: str) -> CredentialBuilder:
+        self._data["auth_type"] = value
+        return self
+
     def description(self, value: str) -> CredentialBuilder:
         self._data["description"] = value
         return self
@@ -87,6 +107,10 @@ class CredentialBuilder:
         self._data["email"] = value
         return self
 
+    def expires_at(self, value: Optional[datetime]) -> CredentialBuilder:
+        self._data["expires_at"] = value
+        return self
+
     def labels(self, value: str) -> CredentialBuilder:
         self._data["labels"] = value
         return self
@@ -95,10 +119,22 @@ class CredentialBuilder:
         self._data["name"] = value
         return self
 
+    def oauth_client(self, value: str) -> CredentialBuilder:
+        self._data["oauth_client"] = value
+        return self
+
+    def oauth_scopes(self, value: str) -> CredentialBuilder:
+        self._data["oauth_scopes"] = value
+        return self
+
     def provider(self, value: str) -> CredentialBuilder:
         self._data["provider"] = value
         return self
 
+    def refresh_token(self, value: str) -> CredentialBuilder:
+        self._data["refresh_token"] = value
+        return self
+
     def token(self, value: str) -> CredentialBuilder:
         self._data["token"] = value
         return self
@@ -132,6 +168,10 @@ class CredentialPatch:
         self._data["email"] = value
         return self
 
+    def expires_at(self, value: Optional[datetime]) -> CredentialPatch:
+        self._data["expires_at"] = value
+        return self
+
     def labels(self, value: str) -> CredentialPatch:
         self._data["labels"] = value
         return self
@@ -140,10 +180,22 @@ class CredentialPatch:
         self._data["name"] = value
         return self
 
+    def oauth_client(self, value: str) -> CredentialPatch:
+        self._data["oauth_client"] = value
+        return self
+
+    def oauth_scopes(self, value: str) -> CredentialPatch:
+        self._data["oauth_scopes"] = value
+        return self
+
     def provider(self, value: str) -> CredentialPatch:
         self._data["provider"] = value
         return self
 
+    def refresh_token(self, value: str) -> CredentialPatch:
+        self._data["refresh_token"] = value
+        return self
+
     def token(self, value: str) -> CredentialPatch:
         self._data["token"] = value
         return self
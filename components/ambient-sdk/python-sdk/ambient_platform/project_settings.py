@@ -20,8 +20,12 @@ class ProjectSettings:
     created_at: Optional[datetime] = None
     updated_at: Optional[datetime] = None
     group_access: str = ""
+    inactivity_timeout_seconds: int = 0
+    model_overrides: str = ""
+    monthly_budget_usd: float = 0.0
     project_id: str = ""
     repositories: str = ""
+    resource_limits: str = ""
 
     @classmethod
     def from_dict(cls, data: dict) -> ProjectSettings:
@@ -32,8 +36,12 @@ class ProjectSettings:
             created_at=_parse_datetime(data.get("created_at")),
             updated_at=_parse_datetime(data.get("updated_at")),
             group_access=data.get("group_access", ""),
+            inactivity_timeout_seconds=data.get("inactivity_timeout_seconds", 0),
+            model_overrides=data.get("model_overrides", ""),
+            monthly_budget_usd=data.get("monthly_budget_usd", 0.0),
             project_id=data.get("project_id", ""),
             repositories=data.get("repositories", ""),
+            resource_limits=data.get("resource_limits", ""),
         )
 
     @classmethod
@@ -69,6 +77,18 @@ class ProjectSettingsBuilder:
         self._data["group_access"] = value
         return self
 
+    def inactivity_timeout_seconds(self, value: int) -> ProjectSettingsBuilder:
+        self._data["inactivity_timeout_seconds"] = value
+        return self
+
+    def model_overrides(self, value: str) -> ProjectSettingsBuilder:
+        self._data["model_overrides"] = value
+        return self
+
+    def monthly_budget_usd(self, value: float) -> ProjectSettingsBuilder:
+        self._data["monthly_budget_usd"] = value
+        return self
+
     def project_id(self, value: str) -> ProjectSettingsBuilder:
         self._data["project_id"] = value
         return self
@@ -77,6 +97,10 @@ class ProjectSettingsBuilder:
         self._data["repositories"] = value
         return self
 
+    def resource_limits(self, value: str) -> ProjectSettingsBuilder:
+        self._data["resource_limits"] = value
+        return self
+
     def build(self) -> dict:
         if "project_id" not in self._data:
             raise ValueError("project_id is required")
@@ -92,6 +116,18 @@ class ProjectSettingsPatch:
         self._data["group_access"] = value
         return self
 
+    def inactivity_timeout_seconds(self, value: int) -> ProjectSettingsPatch:
+        self._data["inactivity_timeout_seconds"] = value
+        return self
+
+    def model_overrides(self, value: str) -> ProjectSettingsPatch:
+        self._data["model_overrides"] = value
+        return self
+
+    def monthly_budget_usd(self, value: float) -> ProjectSettingsPatch:
+        self._data["monthly_budget_usd"] = value
+        return self
+
     def project_id(self, value: str) -> ProjectSettingsPatch:
         self._data["project_id"] = value
         return self
@@ -100,5 +136,9 @@ class ProjectSettingsPatch:
         self._data["repositories"] = value
         return self
 
+    def resource_limits(self, value: str) -> ProjectSettingsPatch:
+        self._data["resource_limits"] = value
+        return self
+
     def to_dict(self) -> dict:
         return dict(self._data)
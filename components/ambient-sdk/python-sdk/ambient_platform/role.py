@@ -22,6 +22,7 @@ class Role:
     built_in: bool = False
     description: str = ""
     display_name: str = ""
+    level: int = 0
     name: str = ""
     permissions: str = ""
 
@@ -36,6 +37,7 @@ class Role:
             built_in=data.get("built_in", False),
             description=data.get("description", ""),
             display_name=data.get("display_name", ""),
+            level=data.get("level", 0),
             name=data.get("name", ""),
             permissions=data.get("permissions", ""),
         )
@@ -81,6 +83,10 @@ class RoleBuilder:
         self._data["display_name"] = value
         return self
 
+    def level(self, value: int) -> RoleBuilder:
+        self._data["level"] = value
+        return self
+
     def name(self, value: str) -> RoleBuilder:
         self._data["name"] = value
         return self
@@ -112,6 +118,10 @@ class RolePatch:
         self._data["display_name"] = value
         return self
 
+    def level(self, value: int) -> RolePatch:
+        self._data["level"] = value
+        return self
+
     def name(self, value: str) -> RolePatch:
         self._data["name"] = value
         return self
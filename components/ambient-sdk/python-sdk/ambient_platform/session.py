@@ -47,6 +47,7 @@ class Session:
     sdk_restart_count: int = 0
     sdk_session_id: str = ""
     start_time: Optional[datetime] = None
+    stopped_reason: str = ""
     timeout: int = 0
     workflow_id: str = ""
 
@@ -86,6 +87,7 @@ class Session:
             sdk_restart_count=data.get("sdk_restart_count", 0),
             sdk_session_id=data.get("sdk_session_id", ""),
             start_time=_parse_datetime(data.get("start_time")),
+            stopped_reason=data.get("stopped_reason", ""),
             timeout=data.get("timeout", 0),
             workflow_id=data.get("workflow_id", ""),
         )
@@ -315,5 +317,9 @@ class SessionStatusPatch:
         self._data["start_time"] = value
         return self
 
+    def stopped_reason(self, value: str) -> SessionStatusPatch:
+        self._data["stopped_reason"] = value
+        return self
+
     def to_dict(self) -> dict:
         return dict(self._data)
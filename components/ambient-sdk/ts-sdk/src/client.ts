@@ -6,9 +6,11 @@
 import type { AmbientClientConfig } from './base';
 import { AgentAPI } from './agent_api';
 import { ApplicationAPI } from './application_api';
+import { BlackboardEntryAPI } from './blackboard_entry_api';
 import { CredentialAPI } from './credential_api';
 import { InboxMessageAPI } from './inbox_message_api';
 import { ProjectAPI } from './project_api';
+import { ProjectDocumentAPI } from './project_document_api';
 import { ProjectSettingsAPI } from './project_settings_api';
 import { RoleAPI } from './role_api';
 import { RoleBindingAPI } from './role_binding_api';
@@ -23,9 +25,11 @@ export class AmbientClient {
 
   readonly agents: AgentAPI;
   readonly applications: ApplicationAPI;
+  readonly blackboardEntries: BlackboardEntryAPI;
   readonly credentials: CredentialAPI;
   readonly inboxMessages: InboxMessageAPI;
   readonly projects: ProjectAPI;
+  readonly projectDocuments: ProjectDocumentAPI;
   readonly projectSettings: ProjectSettingsAPI;
   readonly roles: RoleAPI;
   readonly roleBindings: RoleBindingAPI;
@@ -70,9 +74,11 @@ export class AmbientClient {
 
     this.agents = new AgentAPI(this.config);
     this.applications = new ApplicationAPI(this.config);
+    this.blackboardEntries = new BlackboardEntryAPI(this.config);
     this.credentials = new CredentialAPI(this.config);
     this.inboxMessages = new InboxMessageAPI(this.config);
     this.projects = new ProjectAPI(this.config);
+    this.projectDocuments = new ProjectDocumentAPI(this.config);
     this.projectSettings = new ProjectSettingsAPI(this.config);
     this.roles = new RoleAPI(this.config);
     this.roleBindings = new RoleBindingAPI(this.config);
@@ -7,11 +7,19 @@ import type { ObjectReference, ListMeta } from './base';
 
 export type Credential = ObjectReference & {
   annotations: string;
+  auth_type: string;
   description: string;
   email: string;
+  expires_at: string;
+  expiry_notified_at: string;
   labels: string;
+  last_used_at: string;
   name: string;
+  oauth_client: string;
+  oauth_scopes: string;
   provider: string;
+  refresh_token: string;
+  rotated_at: string;
   token: string;
   url: string;
 };
@@ -22,11 +30,16 @@ export type CredentialList = ListMeta & {
 
 export type CredentialCreateRequest = {
   annotations?: string;
+  auth_type?: string;
   description?: string;
   email?: string;
+  expires_at?: string;
   labels?: string;
   name: string;
+  oauth_client?: string;
+  oauth_scopes?: string;
   provider: string;
+  refresh_token?: string;
   token?: string;
   url?: string;
 };
@@ -35,9 +48,13 @@ export type CredentialPatchRequest = {
   annotations?: string;
   description?: string;
   email?: string;
+  expires_at?: string;
   labels?: string;
   name?: string;
+  oauth_client?: string;
+  oauth_scopes?: string;
   provider?: string;
+  refresh_token?: string;
   token?: string;
   url?: string;
 };
@@ -51,6 +68,11 @@ export class CredentialBuilder {
     return this;
   }
 
+  authType(value: string): this {
+    this.data['auth_type'] = value;
+    return this;
+  }
+
   description(value: string): this {
     this.data['description'] = value;
     return this;
@@ -61,6 +83,11 @@ export class CredentialBuilder {
     return this;
   }
 
+  expiresAt(value: string): this {
+    this.data['expires_at'] = value;
+    return this;
+  }
+
   labels(value: string): this {
     this.data['labels'] = value;
     return this;
@@ -71,11 +98,26 @@ export class CredentialBuilder {
     return this;
   }
 
+  oauthClient(value: string): this {
+    this.data['oauth_client'] = value;
+    return this;
+  }
+
+  oauthScopes(value: string): this {
+    this.data['oauth_scopes'] = value;
+    return this;
+  }
+
   provider(value: string): this {
     this.data['provider'] = value;
     return this;
   }
 
+  refreshToken(value: string): this {
+    this.data['refresh_token'] = value;
+    return this;
+  }
+
   token(value: string): this {
     this.data['token'] = value;
     return this;
@@ -116,6 +158,11 @@ export class CredentialPatchBuilder {
     return this;
   }
 
+  expiresAt(value: string): this {
+    this.data['expires_at'] = value;
+    return this;
+  }
+
   labels(value: string): this {
     this.data['labels'] = value;
     return this;
@@ -126,11 +173,26 @@ export class CredentialPatchBuilder {
     return this;
   }
 
+  oauthClient(value: string): this {
+    this.data['oauth_client'] = value;
+    return this;
+  }
+
+  oauthScopes(value: string): this {
+    this.data['oauth_scopes'] = value;
+    return this;
+  }
+
   provider(value: string): this {
     this.data['provider'] = value;
     return this;
   }
 
+  refreshToken(value: string): this {
+    this.data['refresh_token'] = value;
+    return this;
+  }
+
   token(value: string): this {
     this.data['token'] = value;
     return this;
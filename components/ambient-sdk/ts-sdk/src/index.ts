@@ -15,6 +15,10 @@ export type { Application, ApplicationList, ApplicationCreateRequest, Applicatio
 export { ApplicationBuilder, ApplicationPatchBuilder } from './application';
 export { ApplicationAPI } from './application_api';
 
+export type { BlackboardEntry, BlackboardEntryList, BlackboardEntryCreateRequest, BlackboardEntryPatchRequest } from './blackboard_entry';
+export { BlackboardEntryBuilder, BlackboardEntryPatchBuilder } from './blackboard_entry';
+export { BlackboardEntryAPI } from './blackboard_entry_api';
+
 export type { Credential, CredentialList, CredentialCreateRequest, CredentialPatchRequest } from './credential';
 export { CredentialBuilder, CredentialPatchBuilder } from './credential';
 export { CredentialAPI } from './credential_api';
@@ -27,6 +31,10 @@ export type { Project, ProjectList, ProjectCreateRequest, ProjectPatchRequest }
 export { ProjectBuilder, ProjectPatchBuilder } from './project';
 export { ProjectAPI } from './project_api';
 
+export type { ProjectDocument, ProjectDocumentList, ProjectDocumentCreateRequest, ProjectDocumentPatchRequest } from './project_document';
+export { ProjectDocumentBuilder, ProjectDocumentPatchBuilder } from './project_document';
+export { ProjectDocumentAPI } from './project_document_api';
+
 export type { ProjectSettings, ProjectSettingsList, ProjectSettingsCreateRequest, ProjectSettingsPatchRequest } from './project_settings';
 export { ProjectSettingsBuilder, ProjectSettingsPatchBuilder } from './project_settings';
 export { ProjectSettingsAPI } from './project_settings_api';
@@ -7,8 +7,12 @@ import type { ObjectReference, ListMeta } from './base';
 
 export type ProjectSettings = ObjectReference & {
   group_access: string;
+  inactivity_timeout_seconds: number;
+  model_overrides: string;
+  monthly_budget_usd: number;
   project_id: string;
   repositories: string;
+  resource_limits: string;
 };
 
 export type ProjectSettingsList = ListMeta & {
@@ -17,14 +21,22 @@ export type ProjectSettingsList = ListMeta & {
 
 export type ProjectSettingsCreateRequest = {
   group_access?: string;
+  inactivity_timeout_seconds?: number;
+  model_overrides?: string;
+  monthly_budget_usd?: number;
   project_id: string;
   repositories?: string;
+  resource_limits?: string;
 };
 
 export type ProjectSettingsPatchRequest = {
   group_access?: string;
+  inactivity_timeout_seconds?: number;
+  model_overrides?: string;
+  monthly_budget_usd?: number;
   project_id?: string;
   repositories?: string;
+  resource_limits?: string;
 };
 
 export class ProjectSettingsBuilder {
@@ -36,6 +48,21 @@ export class ProjectSettingsBuilder {
     return this;
   }
 
+  inactivityTimeoutSeconds(value: number): this {
+    this.data['inactivity_timeout_seconds'] = value;
+    return this;
+  }
+
+  modelOverrides(value: string): this {
+    this.data['model_overrides'] = value;
+    return this;
+  }
+
+  monthlyBudgetUsd(value: number): this {
+    this.data['monthly_budget_usd'] = value;
+    return this;
+  }
+
   projectId(value: string): this {
     this.data['project_id'] = value;
     return this;
@@ -46,6 +73,11 @@ export class ProjectSettingsBuilder {
     return this;
   }
 
+  resourceLimits(value: string): this {
+    this.data['resource_limits'] = value;
+    return this;
+  }
+
   build(): ProjectSettingsCreateRequest {
     if (!this.data['project_id']) {
       throw new Error('project_id is required');
@@ -63,6 +95,21 @@ export class ProjectSettingsPatchBuilder {
     return this;
   }
 
+  inactivityTimeoutSeconds(value: number): this {
+    this.data['inactivity_timeout_seconds'] = value;
+    return this;
+  }
+
+  modelOverrides(value: string): this {
+    this.data['model_overrides'] = value;
+    return this;
+  }
+
+  monthlyBudgetUsd(value: number): this {
+    this.data['monthly_budget_usd'] = value;
+    return this;
+  }
+
   projectId(value: string): this {
     this.data['project_id'] = value;
     return this;
@@ -73,6 +120,11 @@ export class ProjectSettingsPatchBuilder {
     return this;
   }
 
+  resourceLimits(value: string): this {
+    this.data['resource_limits'] = value;
+    return this;
+  }
+
   build(): ProjectSettingsPatchRequest {
     return this.data as ProjectSettingsPatchRequest;
   }
@@ -9,6 +9,7 @@ export type Role = ObjectReference & {
   built_in: boolean;
   description: string;
   display_name: string;
+  level: number;
   name: string;
   permissions: string;
 };
@@ -21,6 +22,7 @@ export type RoleCreateRequest = {
   built_in?: boolean;
   description?: string;
   display_name?: string;
+  level?: number;
   name: string;
   permissions?: string;
 };
@@ -29,6 +31,7 @@ export type RolePatchRequest = {
   built_in?: boolean;
   description?: string;
   display_name?: string;
+  level?: number;
   name?: string;
   permissions?: string;
 };
@@ -52,6 +55,11 @@ export class RoleBuilder {
     return this;
   }
 
+  level(value: number): this {
+    this.data['level'] = value;
+    return this;
+  }
+
   name(value: string): this {
     this.data['name'] = value;
     return this;
@@ -89,6 +97,11 @@ export class RolePatchBuilder {
     return this;
   }
 
+  level(value: number): this {
+    this.data['level'] = value;
+    return this;
+  }
+
   name(value: string): this {
     this.data['name'] = value;
     return this;
@@ -34,6 +34,7 @@ export type Session = ObjectReference & {
   sdk_restart_count: number;
   sdk_session_id: string;
   start_time: string;
+  stopped_reason: string;
   timeout: number;
   workflow_id: string;
 };
@@ -93,6 +94,7 @@ export type SessionStatusPatchRequest = {
   sdk_restart_count?: number;
   sdk_session_id?: string;
   start_time?: string;
+  stopped_reason?: string;
 };
 
 export class SessionBuilder {
@@ -340,6 +342,11 @@ export class SessionStatusPatchBuilder {
     return this;
   }
 
+  stoppedReason(value: string): this {
+    this.data['stopped_reason'] = value;
+    return this;
+  }
+
   build(): SessionStatusPatchRequest {
     return this.data as SessionStatusPatchRequest;
   }
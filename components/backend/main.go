@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"ambient-code-backend/cmd"
 	"ambient-code-backend/featureflags"
@@ -19,6 +22,7 @@ import (
 	"ambient-code-backend/websocket"
 
 	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
 )
 
 // Build-time metadata (set via -ldflags -X during build)
@@ -50,6 +54,52 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// configureEventStore selects where AG-UI events are persisted.
+// AGUI_EVENT_STORE=jsonl (default) keeps per-session files under
+// STATE_BASE_DIR; AGUI_EVENT_STORE=postgres uses AGUI_EVENT_STORE_DSN so the
+// backend can run multiple replicas.  AGUI_EVENT_ARCHIVE=s3 additionally
+// archives compacted runs to the S3 bucket used for pre-uploads.
+func configureEventStore() error {
+	var store websocket.EventStore = websocket.JSONLEventStore{}
+
+	switch kind := getEnvOrDefault("AGUI_EVENT_STORE", "jsonl"); kind {
+	case "jsonl":
+	case "postgres":
+		dsn := os.Getenv("AGUI_EVENT_STORE_DSN")
+		if dsn == "" {
+			return fmt.Errorf("AGUI_EVENT_STORE_DSN must be set when AGUI_EVENT_STORE=postgres")
+		}
+		db, err := sql.Open("postgres", dsn)
+		if err != nil {
+			return fmt.Errorf("open postgres: %w", err)
+		}
+		pg := websocket.NewPostgresEventStore(db)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := pg.EnsureSchema(ctx); err != nil {
+			return err
+		}
+		store = pg
+	default:
+		return fmt.Errorf("unknown AGUI_EVENT_STORE %q (want jsonl or postgres)", kind)
+	}
+
+	switch archive := os.Getenv("AGUI_EVENT_ARCHIVE"); archive {
+	case "":
+	case "s3":
+		if handlers.S3Storage == nil {
+			return fmt.Errorf("AGUI_EVENT_ARCHIVE=s3 requires S3 storage to be configured")
+		}
+		store = websocket.NewArchiveEventStore(store, handlers.S3Storage)
+	default:
+		return fmt.Errorf("unknown AGUI_EVENT_ARCHIVE %q (want s3)", archive)
+	}
+
+	websocket.SetEventStore(store)
+	log.Printf("AG-UI event store: %s (archive: %s)", getEnvOrDefault("AGUI_EVENT_STORE", "jsonl"), getEnvOrDefault("AGUI_EVENT_ARCHIVE", "none"))
+	return nil
+}
+
 func main() {
 	// Load environment from .env in development if present
 	_ = godotenv.Overload(".env.local")
@@ -202,6 +252,9 @@ func main() {
 
 	// Initialize websocket package
 	websocket.StateBaseDir = server.StateBaseDir
+	if err := configureEventStore(); err != nil {
+		log.Fatalf("Failed to configure AG-UI event store: %v", err)
+	}
 	handlers.DeriveAgentStatusFromEvents = websocket.DeriveAgentStatus
 
 	// Normal server mode
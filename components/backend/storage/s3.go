@@ -152,3 +152,19 @@ func (s *S3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
 	}
 	return true, nil
 }
+
+// GetObject opens the object at the given key for reading. The caller must
+// close the returned reader.
+func (s *S3Client) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 key %q: %w", key, err)
+	}
+	// minio defers the request until the first read; stat surfaces a
+	// missing key here instead of on the caller's first Read.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, fmt.Errorf("failed to get S3 key %q: %w", key, err)
+	}
+	return obj, nil
+}
@@ -2,19 +2,25 @@
 //
 // agui_store.go — Event persistence, compaction, and replay.
 //
-// Write path:  append every event to agui-events.jsonl.
+// Write path:  append every event to the session's event log.
 // Read path:   load + compact events for reconnect replay.
 // Compaction:  Go port of @ag-ui/client compactEvents — concatenates
 //
 //	TEXT_MESSAGE_CONTENT and TOOL_CALL_ARGS deltas.
+//
+// Storage goes through the EventStore interface (event_store.go).  The
+// functions in this file that touch agui-events.jsonl directly implement
+// the default JSONLEventStore.
 package websocket
 
 import (
 	"ambient-code-backend/types"
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
@@ -162,37 +168,20 @@ func getWriteMutex(sessionID string) *sync.Mutex {
 	return &entry.mu
 }
 
-// persistEvent appends a single AG-UI event to the session's JSONL log.
-// Writes are serialised per-session via a mutex to prevent interleaving.
+// persistEvent appends a single AG-UI event to the session's event log and
+// schedules compaction when a run ends.
 func persistEvent(sessionID string, event map[string]interface{}) {
-	dir, ok := sessionDirPath(sessionID)
-	if !ok {
+	if !isValidSessionName(sessionID) {
 		log.Printf("AGUI Store: persist rejected - invalid session ID: %s", sessionID)
 		return
 	}
-	path := filepath.Join(dir, "agui-events.jsonl")
-	_ = ensureDir(dir)
-
-	data, err := json.Marshal(event)
-	if err != nil {
-		log.Printf("AGUI Store: failed to marshal event: %v", err)
-		return
-	}
 
-	mu := getWriteMutex(sessionID)
-	mu.Lock()
-	defer mu.Unlock()
-
-	f, err := openFileAppend(path)
-	if err != nil {
-		log.Printf("AGUI Store: failed to open event log: %v", err)
+	ctx, cancel := context.WithTimeout(context.Background(), eventStoreTimeout)
+	defer cancel()
+	if err := getEventStore().Append(ctx, sessionID, event); err != nil {
+		log.Printf("AGUI Store: failed to persist event for %s: %v", sessionID, err)
 		return
 	}
-	defer f.Close()
-
-	if _, err := f.Write(append(data, '\n')); err != nil {
-		log.Printf("AGUI Store: failed to write event: %v", err)
-	}
 
 	// Compact finished runs immediately to snapshot-only events
 	eventType, _ := event["type"].(string)
@@ -204,7 +193,11 @@ func persistEvent(sessionID string, event map[string]interface{}) {
 		case compactionSem <- struct{}{}:
 			go func() {
 				defer func() { <-compactionSem }()
-				compactFinishedRun(sessionID)
+				ctx, cancel := context.WithTimeout(context.Background(), eventStoreTimeout)
+				defer cancel()
+				if err := getEventStore().CompactFinishedRun(ctx, sessionID); err != nil {
+					log.Printf("AGUI Store: compaction failed for %s: %v", sessionID, err)
+				}
 			}()
 		default:
 			log.Printf("AGUI Store: compaction skipped for %s (too many in-flight)", sessionID)
@@ -212,6 +205,37 @@ func persistEvent(sessionID string, event map[string]interface{}) {
 	}
 }
 
+// appendJSONLEvent appends a single AG-UI event to the session's JSONL log.
+// Writes are serialised per-session via a mutex to prevent interleaving.
+func appendJSONLEvent(sessionID string, event map[string]interface{}) error {
+	dir, ok := sessionDirPath(sessionID)
+	if !ok {
+		return fmt.Errorf("invalid session ID: %s", sessionID)
+	}
+	path := filepath.Join(dir, "agui-events.jsonl")
+	_ = ensureDir(dir)
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	mu := getWriteMutex(sessionID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	f, err := openFileAppend(path)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	return nil
+}
+
 // ─── Read path ───────────────────────────────────────────────────────
 
 const (
@@ -339,10 +363,10 @@ func loadEvents(sessionID string) []map[string]interface{} {
 	return merged
 }
 
-// scanJSONL reads all JSONL events from an already-open file handle.
-func scanJSONL(f *os.File) []map[string]interface{} {
+// scanJSONL reads all JSONL events from an already-open reader.
+func scanJSONL(r io.Reader) []map[string]interface{} {
 	var events []map[string]interface{}
-	scanner := bufio.NewScanner(f)
+	scanner := bufio.NewScanner(r)
 	scanner.Buffer(make([]byte, 0, scannerInitialBufferSize), scannerMaxLineSize)
 	for scanner.Scan() {
 		line := scanner.Bytes()
@@ -424,14 +448,79 @@ func fastExtractType(line []byte) string {
 	return string(rest[:end])
 }
 
-// DeriveAgentStatus reads a session's event log and returns the agent
-// status derived from the last significant events.
+// DeriveAgentStatus reads the tail of a session's event log and returns the
+// agent status derived from the last significant events.
 //
 // Returns "" if the status cannot be determined (no events, file missing, etc.).
 func DeriveAgentStatus(sessionID string) string {
+	if !isValidSessionName(sessionID) {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventStoreTimeout)
+	defer cancel()
+	events, err := getEventStore().Recent(ctx, sessionID, agentStatusScanEvents)
+	if err != nil {
+		log.Printf("AGUI Store: failed to read recent events for %s: %v", sessionID, err)
+		return ""
+	}
+	return deriveAgentStatusFromEvents(events)
+}
+
+// deriveAgentStatusFromEvents scans events (oldest first) backwards.  We only
+// care about lifecycle and AskUserQuestion events.
+//
+//	RUN_STARTED                       → "working"
+//	RUN_FINISHED / RUN_ERROR          → "idle", unless same run had AskUserQuestion
+//	TOOL_CALL_START (AskUserQuestion) → "waiting_input"
+func deriveAgentStatusFromEvents(events []map[string]interface{}) string {
+	var runEndRunID string // set when we hit RUN_FINISHED/RUN_ERROR and need to look deeper
+	for i := len(events) - 1; i >= 0; i-- {
+		evt := events[i]
+		if evt == nil {
+			continue
+		}
+		evtType, _ := evt["type"].(string)
+
+		switch evtType {
+		case types.EventTypeRunStarted:
+			if runEndRunID != "" {
+				// We were scanning for an AskUserQuestion but hit RUN_STARTED first → idle
+				return types.AgentStatusIdle
+			}
+			return types.AgentStatusWorking
+
+		case types.EventTypeRunFinished, types.EventTypeRunError:
+			if runEndRunID == "" {
+				// First run-end seen; scan deeper within this run for AskUserQuestion
+				runEndRunID, _ = evt["runId"].(string)
+			}
+
+		case types.EventTypeToolCallStart:
+			if runEndRunID != "" {
+				// Only relevant if we're scanning within the ended run
+				if evtRunID, _ := evt["runId"].(string); evtRunID != "" && evtRunID != runEndRunID {
+					return types.AgentStatusIdle
+				}
+			}
+			if toolName, _ := evt["toolCallName"].(string); isAskUserQuestionToolCall(toolName) {
+				return types.AgentStatusWaitingInput
+			}
+		}
+	}
+
+	if runEndRunID != "" {
+		return types.AgentStatusIdle
+	}
+	return ""
+}
+
+// loadRecentJSONLEvents returns up to limit events from the tail of the
+// session's JSONL log, oldest first.
+func loadRecentJSONLEvents(sessionID string, limit int) ([]map[string]interface{}, error) {
 	path, ok := sessionEventsPath(sessionID)
 	if !ok {
-		return ""
+		return nil, fmt.Errorf("invalid session ID: %s", sessionID)
 	}
 
 	// Read only the tail of the file to avoid loading entire event log into memory.
@@ -441,13 +530,16 @@ func DeriveAgentStatus(sessionID string) string {
 
 	file, err := os.Open(path)
 	if err != nil {
-		return ""
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
 	}
 	defer file.Close()
 
 	stat, err := file.Stat()
 	if err != nil {
-		return ""
+		return nil, err
 	}
 
 	fileSize := stat.Size()
@@ -455,22 +547,21 @@ func DeriveAgentStatus(sessionID string) string {
 
 	if fileSize <= maxTailBytes {
 		// File is small, read it all
-		data, err = os.ReadFile(path)
+		data, err = io.ReadAll(file)
 		if err != nil {
-			return ""
+			return nil, err
 		}
 	} else {
 		// File is large, seek to tail and read last N bytes
 		offset := fileSize - maxTailBytes
-		_, err = file.Seek(offset, 0)
-		if err != nil {
-			return ""
+		if _, err := file.Seek(offset, 0); err != nil {
+			return nil, err
 		}
 
 		data = make([]byte, maxTailBytes)
-		n, err := file.Read(data)
-		if err != nil {
-			return ""
+		n, err := io.ReadFull(file, data)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
 		}
 		data = data[:n]
 
@@ -481,68 +572,44 @@ func DeriveAgentStatus(sessionID string) string {
 	}
 
 	lines := splitLines(data)
-
-	// Scan backwards.  We only care about lifecycle and AskUserQuestion events.
-	//   RUN_STARTED                       → "working"
-	//   RUN_FINISHED / RUN_ERROR          → "idle", unless same run had AskUserQuestion
-	//   TOOL_CALL_START (AskUserQuestion) → "waiting_input"
-	var runEndRunID string // set when we hit RUN_FINISHED/RUN_ERROR and need to look deeper
-	for i := len(lines) - 1; i >= 0; i-- {
-		if len(lines[i]) == 0 {
-			continue
-		}
+	if limit > 0 && len(lines) > limit {
+		lines = lines[len(lines)-limit:]
+	}
+	events := make([]map[string]interface{}, 0, len(lines))
+	for _, line := range lines {
 		var evt map[string]interface{}
-		if err := json.Unmarshal(lines[i], &evt); err != nil {
+		if err := json.Unmarshal(line, &evt); err != nil {
 			continue
 		}
-		evtType, _ := evt["type"].(string)
-
-		switch evtType {
-		case types.EventTypeRunStarted:
-			if runEndRunID != "" {
-				// We were scanning for an AskUserQuestion but hit RUN_STARTED first → idle
-				return types.AgentStatusIdle
-			}
-			return types.AgentStatusWorking
-
-		case types.EventTypeRunFinished, types.EventTypeRunError:
-			if runEndRunID == "" {
-				// First run-end seen; scan deeper within this run for AskUserQuestion
-				runEndRunID, _ = evt["runId"].(string)
-			}
-
-		case types.EventTypeToolCallStart:
-			if runEndRunID != "" {
-				// Only relevant if we're scanning within the ended run
-				if evtRunID, _ := evt["runId"].(string); evtRunID != "" && evtRunID != runEndRunID {
-					return types.AgentStatusIdle
-				}
-			}
-			if toolName, _ := evt["toolCallName"].(string); isAskUserQuestionToolCall(toolName) {
-				return types.AgentStatusWaitingInput
-			}
-		}
-	}
-
-	if runEndRunID != "" {
-		return types.AgentStatusIdle
+		events = append(events, evt)
 	}
-	return ""
+	return events, nil
 }
 
 // ─── Snapshot compaction (AG-UI serialization spec) ──────────────────
 //
 // See: https://docs.ag-ui.com/concepts/serialization
 
-// loadEventsForReplay loads events for SSE replay.
+// loadEventsForReplay loads events for SSE replay from the event store.
 //
-// For finished runs, the file is already compacted to snapshot-only events
-// by compactFinishedRun(), so we just read and return.
+// For finished runs, the log is already compacted to snapshot-only events
+// by CompactFinishedRun, so we just read and return.
 //
-// For active runs, the file contains streaming events which are necessary
+// For active runs, the log contains streaming events which are necessary
 // for real-time SSE connections.
 func loadEventsForReplay(sessionID string) []map[string]interface{} {
-	events := loadEvents(sessionID)
+	if !isValidSessionName(sessionID) {
+		log.Printf("AGUI Store: load rejected - invalid session ID: %s", sessionID)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventStoreTimeout)
+	defer cancel()
+	events, err := getEventStore().Load(ctx, sessionID)
+	if err != nil {
+		log.Printf("AGUI Store: failed to load events for %s: %v", sessionID, err)
+		return nil
+	}
 	if len(events) > 0 {
 		// Check if finished or active
 		last := events[len(events)-1]
@@ -597,38 +664,8 @@ func compactFinishedRun(sessionID string) {
 		return
 	}
 
-	// Filter to snapshot-only events
-	var snapshots []map[string]interface{}
-	hasMessagesSnapshot := false
-
-	for _, evt := range events {
-		eventType, _ := evt["type"].(string)
-		switch eventType {
-		case types.EventTypeMessagesSnapshot:
-			hasMessagesSnapshot = true
-			snapshots = append(snapshots, evt)
-		case types.EventTypeStateSnapshot:
-			snapshots = append(snapshots, evt)
-		case types.EventTypeRunStarted, types.EventTypeRunFinished, types.EventTypeRunError,
-			types.EventTypeStepStarted, types.EventTypeStepFinished:
-			snapshots = append(snapshots, evt)
-		case types.EventTypeToolCallStart:
-			// Preserve AskUserQuestion tool calls — DeriveAgentStatus() needs them
-			// to detect waiting_input status after compaction.
-			if toolName, _ := evt["toolCallName"].(string); isAskUserQuestionToolCall(toolName) {
-				snapshots = append(snapshots, evt)
-			}
-		case types.EventTypeRaw, types.EventTypeCustom, types.EventTypeMeta:
-			// Preserve custom events that aren't included in MESSAGES_SNAPSHOT
-			snapshots = append(snapshots, evt)
-		case types.EventTypeActivitySnapshot:
-			// Preserve frontend durable UI state (ACTIVITY_DELTA can be discarded, snapshot is canonical)
-			snapshots = append(snapshots, evt)
-		}
-	}
-
-	// If no MESSAGES_SNAPSHOT found, session is corrupted - keep raw events
-	if !hasMessagesSnapshot {
+	snapshots, ok := snapshotEvents(events)
+	if !ok {
 		log.Printf("AGUI Store: no MESSAGES_SNAPSHOT found for %s - session corrupted, keeping raw events", sessionID)
 		return
 	}
@@ -688,6 +725,48 @@ func compactFinishedRun(sessionID string) {
 	log.Printf("AGUI Store: successfully compacted %s to snapshot-only events", sessionID)
 }
 
+// snapshotEvents filters a finished run's events down to the ones kept by
+// compaction.  It returns false if there is no MESSAGES_SNAPSHOT, in which
+// case the run is considered corrupted and the raw events must be kept.
+func snapshotEvents(events []map[string]interface{}) ([]map[string]interface{}, bool) {
+	var snapshots []map[string]interface{}
+	hasMessagesSnapshot := false
+
+	for _, evt := range events {
+		if keepOnCompaction(evt) {
+			snapshots = append(snapshots, evt)
+		}
+		if eventType, _ := evt["type"].(string); eventType == types.EventTypeMessagesSnapshot {
+			hasMessagesSnapshot = true
+		}
+	}
+	return snapshots, hasMessagesSnapshot
+}
+
+// keepOnCompaction reports whether an event survives compaction.
+func keepOnCompaction(evt map[string]interface{}) bool {
+	eventType, _ := evt["type"].(string)
+	switch eventType {
+	case types.EventTypeMessagesSnapshot, types.EventTypeStateSnapshot:
+		return true
+	case types.EventTypeRunStarted, types.EventTypeRunFinished, types.EventTypeRunError,
+		types.EventTypeStepStarted, types.EventTypeStepFinished:
+		return true
+	case types.EventTypeToolCallStart:
+		// Preserve AskUserQuestion tool calls — DeriveAgentStatus() needs them
+		// to detect waiting_input status after compaction.
+		toolName, _ := evt["toolCallName"].(string)
+		return isAskUserQuestionToolCall(toolName)
+	case types.EventTypeRaw, types.EventTypeCustom, types.EventTypeMeta:
+		// Preserve custom events that aren't included in MESSAGES_SNAPSHOT
+		return true
+	case types.EventTypeActivitySnapshot:
+		// Preserve frontend durable UI state (ACTIVITY_DELTA can be discarded, snapshot is canonical)
+		return true
+	}
+	return false
+}
+
 // ─── Timestamp sanitization ──────────────────────────────────────────
 
 // sanitizeEventTimestamp ensures the "timestamp" field in an event map
@@ -0,0 +1,84 @@
+// event_store.go — Storage backends for AG-UI event logs.
+//
+// persistEvent, loadEventsForReplay and DeriveAgentStatus go through the
+// EventStore set at startup.  The default JSONL store keeps one
+// agui-events.jsonl per session under StateBaseDir, which pins sessions to a
+// single pod (or a RWX volume).  PostgresEventStore lets any replica serve
+// any session; ArchiveEventStore adds an S3 cold tier on top of either.
+//
+// Live broadcast (subscribeLive/publishLine) is still per-replica: a
+// reconnect that lands on another replica replays from the store and then
+// sees events once the next run is proxied through it.
+package websocket
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// EventStore persists and replays AG-UI events for a session.
+type EventStore interface {
+	// Append adds a single event to the end of the session's log.
+	Append(ctx context.Context, sessionID string, event map[string]interface{}) error
+	// Load returns the events used for reconnect replay, oldest first.
+	// Implementations may bound the result for very long logs as long as
+	// snapshot and lifecycle events are kept (see loadEvents).
+	Load(ctx context.Context, sessionID string) ([]map[string]interface{}, error)
+	// Recent returns up to limit of the most recent events, oldest first.
+	// A limit <= 0 lets the implementation pick its own bound.
+	Recent(ctx context.Context, sessionID string, limit int) ([]map[string]interface{}, error)
+	// CompactFinishedRun reduces the log to snapshot-only events after a
+	// run ends (see snapshotEvents).
+	CompactFinishedRun(ctx context.Context, sessionID string) error
+}
+
+const (
+	// eventStoreTimeout bounds each store call made from the proxy path.
+	eventStoreTimeout = 30 * time.Second
+
+	// agentStatusScanEvents is how many recent events DeriveAgentStatus
+	// inspects.  AskUserQuestion survives compaction, so this only needs
+	// to cover the current run's tail.
+	agentStatusScanEvents = 1000
+)
+
+var (
+	eventStoreMu sync.RWMutex
+	eventStore   EventStore = JSONLEventStore{}
+)
+
+// SetEventStore replaces the store used for AG-UI event persistence.
+// Call once at startup before serving requests.
+func SetEventStore(store EventStore) {
+	eventStoreMu.Lock()
+	defer eventStoreMu.Unlock()
+	eventStore = store
+}
+
+func getEventStore() EventStore {
+	eventStoreMu.RLock()
+	defer eventStoreMu.RUnlock()
+	return eventStore
+}
+
+// JSONLEventStore keeps each session's events in
+// {StateBaseDir}/sessions/{sessionID}/agui-events.jsonl.
+type JSONLEventStore struct{}
+
+func (JSONLEventStore) Append(_ context.Context, sessionID string, event map[string]interface{}) error {
+	return appendJSONLEvent(sessionID, event)
+}
+
+func (JSONLEventStore) Load(_ context.Context, sessionID string) ([]map[string]interface{}, error) {
+	return loadEvents(sessionID), nil
+}
+
+func (JSONLEventStore) Recent(_ context.Context, sessionID string, limit int) ([]map[string]interface{}, error) {
+	return loadRecentJSONLEvents(sessionID, limit)
+}
+
+func (JSONLEventStore) CompactFinishedRun(_ context.Context, sessionID string) error {
+	compactFinishedRun(sessionID)
+	return nil
+}
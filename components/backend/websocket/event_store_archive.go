@@ -0,0 +1,115 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+)
+
+// archiveKeyPrefix is where compacted session logs live in the bucket.
+const archiveKeyPrefix = "agui-events/"
+
+// ObjectStore is the subset of storage.S3Client used by ArchiveEventStore.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	ObjectExists(ctx context.Context, key string) (bool, error)
+}
+
+// ArchiveEventStore adds an object-storage archival tier to a primary
+// store.  Each compacted run is uploaded as JSONL; reads fall back to the
+// archive when the primary has nothing for the session (pod-local disk on
+// another replica, or rows pruned from Postgres).
+type ArchiveEventStore struct {
+	primary EventStore
+	objects ObjectStore
+}
+
+// NewArchiveEventStore wraps primary with an archive in objects.
+func NewArchiveEventStore(primary EventStore, objects ObjectStore) *ArchiveEventStore {
+	return &ArchiveEventStore{primary: primary, objects: objects}
+}
+
+func archiveKey(sessionID string) string {
+	return archiveKeyPrefix + sessionID + ".jsonl"
+}
+
+func (s *ArchiveEventStore) Append(ctx context.Context, sessionID string, event map[string]interface{}) error {
+	return s.primary.Append(ctx, sessionID, event)
+}
+
+func (s *ArchiveEventStore) Load(ctx context.Context, sessionID string) ([]map[string]interface{}, error) {
+	events, err := s.primary.Load(ctx, sessionID)
+	if err != nil || len(events) > 0 {
+		return events, err
+	}
+	return s.loadArchive(ctx, sessionID)
+}
+
+func (s *ArchiveEventStore) Recent(ctx context.Context, sessionID string, limit int) ([]map[string]interface{}, error) {
+	events, err := s.primary.Recent(ctx, sessionID, limit)
+	if err != nil || len(events) > 0 {
+		return events, err
+	}
+	events, err = s.loadArchive(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
+
+// CompactFinishedRun compacts the primary and then uploads the compacted
+// log.  Archive failures are logged but don't fail compaction: the primary
+// still holds the events.
+func (s *ArchiveEventStore) CompactFinishedRun(ctx context.Context, sessionID string) error {
+	if err := s.primary.CompactFinishedRun(ctx, sessionID); err != nil {
+		return err
+	}
+
+	events, err := s.primary.Load(ctx, sessionID)
+	if err != nil {
+		log.Printf("AGUI Store: archive skipped for %s: %v", sessionID, err)
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, evt := range events {
+		data, err := json.Marshal(evt)
+		if err != nil {
+			continue
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if err := s.objects.PutObject(ctx, archiveKey(sessionID), &buf, int64(buf.Len()), "application/x-ndjson"); err != nil {
+		log.Printf("AGUI Store: archive upload failed for %s: %v", sessionID, err)
+	}
+	return nil
+}
+
+func (s *ArchiveEventStore) loadArchive(ctx context.Context, sessionID string) ([]map[string]interface{}, error) {
+	key := archiveKey(sessionID)
+	exists, err := s.objects.ObjectExists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	r, err := s.objects.GetObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return scanJSONL(r), nil
+}
@@ -0,0 +1,190 @@
+package websocket
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"ambient-code-backend/types"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// replayMaxEvents bounds reconnect replay from Postgres the same way
+// replayMaxTailBytes bounds it for JSONL: older events are dropped except
+// for the snapshot and lifecycle types in headScanEventTypes.
+const replayMaxEvents = 10000
+
+// PostgresEventStore keeps AG-UI events in an agui_events table with the
+// same shape as ambient-api-server's session_messages, so any backend
+// replica can append to and replay any session.
+type PostgresEventStore struct {
+	db *sql.DB
+}
+
+// NewPostgresEventStore returns a store backed by db.  Call EnsureSchema
+// before first use.
+func NewPostgresEventStore(db *sql.DB) *PostgresEventStore {
+	return &PostgresEventStore{db: db}
+}
+
+// EnsureSchema creates the agui_events table and index if missing.
+func (s *PostgresEventStore) EnsureSchema(ctx context.Context) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS agui_events (
+			id         VARCHAR(36) PRIMARY KEY,
+			session_id VARCHAR(255) NOT NULL,
+			seq        BIGSERIAL UNIQUE NOT NULL,
+			event_type VARCHAR(255) NOT NULL,
+			payload    TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_agui_events_session_seq ON agui_events(session_id, seq)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create agui_events schema: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *PostgresEventStore) Append(ctx context.Context, sessionID string, event map[string]interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	eventType, _ := event["type"].(string)
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO agui_events (id, session_id, event_type, payload) VALUES ($1, $2, $3, $4)`,
+		uuid.NewString(), sessionID, eventType, string(data))
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %w", err)
+	}
+	return nil
+}
+
+// Load returns the last replayMaxEvents events plus any older snapshot and
+// lifecycle events, oldest first.
+func (s *PostgresEventStore) Load(ctx context.Context, sessionID string) ([]map[string]interface{}, error) {
+	headTypes := make([]string, 0, len(headScanEventTypes))
+	for t := range headScanEventTypes {
+		headTypes = append(headTypes, t)
+	}
+
+	return s.query(ctx, `
+		SELECT payload FROM agui_events
+		WHERE session_id = $1
+		  AND (seq >= COALESCE((
+		          SELECT seq FROM agui_events WHERE session_id = $1
+		          ORDER BY seq DESC OFFSET $2 LIMIT 1), 0)
+		       OR event_type = ANY($3))
+		ORDER BY seq`,
+		sessionID, replayMaxEvents-1, pq.Array(headTypes))
+}
+
+func (s *PostgresEventStore) Recent(ctx context.Context, sessionID string, limit int) ([]map[string]interface{}, error) {
+	if limit <= 0 {
+		limit = agentStatusScanEvents
+	}
+	return s.query(ctx, `
+		SELECT payload FROM (
+			SELECT seq, payload FROM agui_events
+			WHERE session_id = $1
+			ORDER BY seq DESC LIMIT $2
+		) recent ORDER BY seq`,
+		sessionID, limit)
+}
+
+// CompactFinishedRun deletes every event up to the newest one read that
+// snapshotEvents would drop.  Events appended while compaction runs have a
+// higher seq and are left alone.
+func (s *PostgresEventStore) CompactFinishedRun(ctx context.Context, sessionID string) error {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT seq, payload FROM agui_events WHERE session_id = $1 ORDER BY seq`, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to read events for compaction: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		kept                []int64
+		total               int
+		maxSeq              int64
+		hasMessagesSnapshot bool
+	)
+	for rows.Next() {
+		var seq int64
+		var payload string
+		if err := rows.Scan(&seq, &payload); err != nil {
+			return fmt.Errorf("failed to scan event: %w", err)
+		}
+		total++
+		maxSeq = seq
+
+		var evt map[string]interface{}
+		if err := json.Unmarshal([]byte(payload), &evt); err != nil {
+			// Malformed rows are dropped, matching scanJSONL.
+			continue
+		}
+		if keepOnCompaction(evt) {
+			kept = append(kept, seq)
+		}
+		if eventType, _ := evt["type"].(string); eventType == types.EventTypeMessagesSnapshot {
+			hasMessagesSnapshot = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read events for compaction: %w", err)
+	}
+	if total == 0 {
+		return nil
+	}
+	if !hasMessagesSnapshot {
+		log.Printf("AGUI Store: no MESSAGES_SNAPSHOT found for %s - session corrupted, keeping raw events", sessionID)
+		return nil
+	}
+
+	log.Printf("AGUI Store: compacting %s from %d raw events → %d snapshot events", sessionID, total, len(kept))
+
+	if kept == nil {
+		kept = []int64{}
+	}
+	_, err = s.db.ExecContext(ctx,
+		`DELETE FROM agui_events WHERE session_id = $1 AND seq <= $2 AND NOT (seq = ANY($3))`,
+		sessionID, maxSeq, pq.Array(kept))
+	if err != nil {
+		return fmt.Errorf("failed to delete compacted events: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresEventStore) query(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []map[string]interface{}
+	for rows.Next() {
+		var payload string
+		if err := rows.Scan(&payload); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		var evt map[string]interface{}
+		if err := json.Unmarshal([]byte(payload), &evt); err != nil {
+			log.Printf("AGUI Store: skipping malformed event payload: %v", err)
+			continue
+		}
+		events = append(events, evt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,241 @@
+package websocket
+
+import (
+	"ambient-code-backend/types"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+)
+
+// memoryEventStore is an in-memory EventStore for tests.
+type memoryEventStore struct {
+	events    map[string][]map[string]interface{}
+	compacted []string
+}
+
+func newMemoryEventStore() *memoryEventStore {
+	return &memoryEventStore{events: map[string][]map[string]interface{}{}}
+}
+
+func (m *memoryEventStore) Append(_ context.Context, sessionID string, event map[string]interface{}) error {
+	m.events[sessionID] = append(m.events[sessionID], event)
+	return nil
+}
+
+func (m *memoryEventStore) Load(_ context.Context, sessionID string) ([]map[string]interface{}, error) {
+	return m.events[sessionID], nil
+}
+
+func (m *memoryEventStore) Recent(_ context.Context, sessionID string, limit int) ([]map[string]interface{}, error) {
+	events := m.events[sessionID]
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
+
+func (m *memoryEventStore) CompactFinishedRun(_ context.Context, sessionID string) error {
+	m.compacted = append(m.compacted, sessionID)
+	if snapshots, ok := snapshotEvents(m.events[sessionID]); ok {
+		m.events[sessionID] = snapshots
+	}
+	return nil
+}
+
+// memoryObjectStore is an in-memory ObjectStore for tests.
+type memoryObjectStore map[string][]byte
+
+func (m memoryObjectStore) PutObject(_ context.Context, key string, reader io.Reader, _ int64, _ string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	m[key] = data
+	return nil
+}
+
+func (m memoryObjectStore) GetObject(_ context.Context, key string) (io.ReadCloser, error) {
+	data, ok := m[key]
+	if !ok {
+		return nil, fmt.Errorf("no such key %q", key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m memoryObjectStore) ObjectExists(_ context.Context, key string) (bool, error) {
+	_, ok := m[key]
+	return ok, nil
+}
+
+func useEventStore(t *testing.T, store EventStore) {
+	t.Helper()
+	prev := getEventStore()
+	SetEventStore(store)
+	t.Cleanup(func() { SetEventStore(prev) })
+}
+
+func finishedRun(runID string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{"type": types.EventTypeRunStarted, "runId": runID},
+		{"type": types.EventTypeTextMessageStart, "messageId": "m1"},
+		{"type": types.EventTypeTextMessageContent, "messageId": "m1", "delta": "hi"},
+		{"type": types.EventTypeTextMessageEnd, "messageId": "m1"},
+		{"type": types.EventTypeMessagesSnapshot, "messages": []interface{}{}},
+		{"type": types.EventTypeRunFinished, "runId": runID},
+	}
+}
+
+func TestDeriveAgentStatus_UsesEventStore(t *testing.T) {
+	store := newMemoryEventStore()
+	useEventStore(t, store)
+
+	store.events["s1"] = []map[string]interface{}{
+		{"type": types.EventTypeRunStarted, "runId": "r1"},
+		{"type": types.EventTypeToolCallStart, "runId": "r1", "toolCallName": "AskUserQuestion"},
+		{"type": types.EventTypeRunFinished, "runId": "r1"},
+	}
+	if got := DeriveAgentStatus("s1"); got != types.AgentStatusWaitingInput {
+		t.Errorf("DeriveAgentStatus = %q, want %q", got, types.AgentStatusWaitingInput)
+	}
+
+	store.events["s2"] = []map[string]interface{}{{"type": types.EventTypeRunStarted, "runId": "r2"}}
+	if got := DeriveAgentStatus("s2"); got != types.AgentStatusWorking {
+		t.Errorf("DeriveAgentStatus = %q, want %q", got, types.AgentStatusWorking)
+	}
+}
+
+func TestPersistEvent_UsesEventStore(t *testing.T) {
+	store := newMemoryEventStore()
+	useEventStore(t, store)
+
+	for _, evt := range finishedRun("r1")[:5] {
+		persistEvent("s1", evt)
+	}
+	if len(store.compacted) != 0 {
+		t.Fatalf("compaction ran before run finished")
+	}
+
+	events := loadEventsForReplay("s1")
+	if len(events) != 5 {
+		t.Fatalf("loadEventsForReplay returned %d events, want 5", len(events))
+	}
+}
+
+func TestArchiveEventStore_FallsBackToArchive(t *testing.T) {
+	ctx := context.Background()
+	primary := newMemoryEventStore()
+	objects := memoryObjectStore{}
+	store := NewArchiveEventStore(primary, objects)
+
+	for _, evt := range finishedRun("r1") {
+		if err := store.Append(ctx, "s1", evt); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+	if err := store.CompactFinishedRun(ctx, "s1"); err != nil {
+		t.Fatalf("CompactFinishedRun: %v", err)
+	}
+	if _, ok := objects[archiveKey("s1")]; !ok {
+		t.Fatalf("compacted log was not archived")
+	}
+
+	// Simulate a replica that never saw the session locally.
+	delete(primary.events, "s1")
+
+	events, err := store.Load(ctx, "s1")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Load returned %d events, want 3 (RUN_STARTED, MESSAGES_SNAPSHOT, RUN_FINISHED)", len(events))
+	}
+
+	recent, err := store.Recent(ctx, "s1", 1)
+	if err != nil {
+		t.Fatalf("Recent: %v", err)
+	}
+	if len(recent) != 1 || recent[0]["type"] != types.EventTypeRunFinished {
+		t.Errorf("Recent = %v, want last RUN_FINISHED", recent)
+	}
+
+	if events, err := store.Load(ctx, "missing"); err != nil || events != nil {
+		t.Errorf("Load(missing) = %v, %v; want nil, nil", events, err)
+	}
+}
+
+func TestPostgresEventStore_CompactFinishedRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"seq", "payload"})
+	for i, evt := range finishedRun("r1") {
+		data, _ := json.Marshal(evt)
+		rows.AddRow(int64(i+1), string(data))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT seq, payload FROM agui_events WHERE session_id = $1 ORDER BY seq`)).
+		WithArgs("s1").
+		WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM agui_events WHERE session_id = $1 AND seq <= $2 AND NOT (seq = ANY($3))`)).
+		WithArgs("s1", int64(6), pq.Array([]int64{1, 5, 6})).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	if err := NewPostgresEventStore(db).CompactFinishedRun(context.Background(), "s1"); err != nil {
+		t.Fatalf("CompactFinishedRun: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPostgresEventStore_CompactKeepsRunWithoutSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	data, _ := json.Marshal(map[string]interface{}{"type": types.EventTypeRunFinished, "runId": "r1"})
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT seq, payload FROM agui_events`)).
+		WithArgs("s1").
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "payload"}).AddRow(int64(1), string(data)))
+
+	if err := NewPostgresEventStore(db).CompactFinishedRun(context.Background(), "s1"); err != nil {
+		t.Fatalf("CompactFinishedRun: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPostgresEventStore_RecentReturnsOldestFirst(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT payload FROM \(`).
+		WithArgs("s1", agentStatusScanEvents).
+		WillReturnRows(sqlmock.NewRows([]string{"payload"}).
+			AddRow(`{"type":"RUN_STARTED","runId":"r1"}`).
+			AddRow(`not json`).
+			AddRow(`{"type":"RUN_FINISHED","runId":"r1"}`))
+
+	events, err := NewPostgresEventStore(db).Recent(context.Background(), "s1", 0)
+	if err != nil {
+		t.Fatalf("Recent: %v", err)
+	}
+	if len(events) != 2 || events[1]["type"] != types.EventTypeRunFinished {
+		t.Errorf("Recent = %v", events)
+	}
+}
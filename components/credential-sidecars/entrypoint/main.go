@@ -1,7 +1,9 @@
 package main
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +13,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -46,56 +49,106 @@ func main() {
 		os.Exit(1)
 	}
 
+	var version string
 	if apiURL != "" && provider != "" {
-		if err := fetchAndSetCredential(bearerToken, apiURL, provider); err != nil {
+		version, err = fetchAndSetCredential(bearerToken, apiURL, provider)
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "credential fetch failed for %s: %v\n", provider, err)
 			os.Exit(1)
 		}
 	}
 
+	args := os.Args[1:]
+	if os.Getenv("PLATFORM_MODE") == "mpp" && provider == "kubeconfig" {
+		args = injectMPPConfig(args)
+	}
+	sup := &supervisor{args: args}
+	refresher := &credentialRefresher{
+		fetch: func(token string) (string, error) {
+			return fetchAndSetCredential(token, apiURL, provider)
+		},
+		version:  version,
+		child:    sup,
+		provider: provider,
+		command:  args[0],
+	}
+
+	// Every token refresh re-reads the credential, so a rotation reaches
+	// the sidecar within one refresh period.
 	exchanger.OnRefresh(func(newToken string) {
-		if apiURL != "" && provider != "" {
-			if err := fetchAndSetCredential(newToken, apiURL, provider); err != nil {
-				fmt.Fprintf(os.Stderr, "credential refresh failed: %v\n", err)
-			}
+		if apiURL == "" || provider == "" {
+			return
 		}
+		refresher.refresh(newToken)
 	})
 	exchanger.StartBackgroundRefresh()
-	defer exchanger.Stop()
 
-	args := os.Args[1:]
-	if os.Getenv("PLATFORM_MODE") == "mpp" && provider == "kubeconfig" {
-		args = injectMPPConfig(args)
+	code := sup.run()
+	exchanger.Stop()
+	os.Exit(code)
+}
+
+// child is the wrapped command as the credential refresh sees it.
+type child interface {
+	// restart stops the command and starts it again with the environment
+	// as it is now.
+	restart()
+}
+
+// credentialRefresher re-reads the credential on each token refresh. The
+// child only sees its environment at start, so it is restarted when the
+// credential changed; an unchanged or failed read leaves it running.
+type credentialRefresher struct {
+	fetch    func(bearerToken string) (string, error)
+	version  string
+	child    child
+	provider string
+	command  string
+}
+
+func (r *credentialRefresher) refresh(bearerToken string) {
+	fresh, err := r.fetch(bearerToken)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "credential refresh failed: %v\n", err)
+		return
+	}
+	if fresh == r.version {
+		return
 	}
-	runSubprocess(args)
+	r.version = fresh
+	fmt.Fprintf(os.Stderr, "credential for %s changed; restarting %s\n", r.provider, r.command)
+	r.child.restart()
 }
 
-func fetchAndSetCredential(bearerToken, apiURL, provider string) error {
+// fetchAndSetCredential exports the credential into the environment and
+// returns a digest of what was exported, so callers can tell a rotation
+// from an unchanged refresh without holding the token itself.
+func fetchAndSetCredential(bearerToken, apiURL, provider string) (string, error) {
 	parsed, err := url.Parse(apiURL)
 	if err != nil {
-		return fmt.Errorf("parse API URL: %w", err)
+		return "", fmt.Errorf("parse API URL: %w", err)
 	}
 	hostname := parsed.Hostname()
 	if !strings.HasSuffix(hostname, ".svc.cluster.local") &&
 		!strings.HasSuffix(hostname, ".svc") &&
 		hostname != "localhost" &&
 		hostname != "127.0.0.1" {
-		return fmt.Errorf("refusing to send credentials to external host: %s", hostname)
+		return "", fmt.Errorf("refusing to send credentials to external host: %s", hostname)
 	}
 
 	credentialIDs := map[string]string{}
 	if raw := os.Getenv("CREDENTIAL_IDS"); raw != "" {
 		if err := json.Unmarshal([]byte(raw), &credentialIDs); err != nil {
-			return fmt.Errorf("parse CREDENTIAL_IDS: %w", err)
+			return "", fmt.Errorf("parse CREDENTIAL_IDS: %w", err)
 		}
 	}
 
 	credID := credentialIDs[provider]
 	if credID == "" {
-		return fmt.Errorf("no credential ID for provider %s in CREDENTIAL_IDS", provider)
+		return "", fmt.Errorf("no credential ID for provider %s in CREDENTIAL_IDS", provider)
 	}
 	if !isValidCredentialID(credID) {
-		return fmt.Errorf("invalid credential ID for provider %s", provider)
+		return "", fmt.Errorf("invalid credential ID for provider %s", provider)
 	}
 
 	baseURL := strings.TrimRight(apiURL, "/")
@@ -104,7 +157,7 @@ func fetchAndSetCredential(bearerToken, apiURL, provider string) error {
 	credTokenURL := fmt.Sprintf("%s/api/ambient/v1/credentials/%s/token", baseURL, url.PathEscape(credID))
 	tokenData, err := fetchJSON(client, credTokenURL, bearerToken)
 	if err != nil {
-		return fmt.Errorf("credential token fetch: %w", err)
+		return "", fmt.Errorf("credential token fetch: %w", err)
 	}
 
 	metaData, err := fetchJSON(client, fmt.Sprintf("%s/api/ambient/v1/credentials/%s", baseURL, url.PathEscape(credID)), bearerToken)
@@ -119,8 +172,22 @@ func fetchAndSetCredential(bearerToken, apiURL, provider string) error {
 		}
 	}
 
+	if expiresAt, ok := tokenData["expires_at"].(string); ok && expiresAt != "" {
+		fmt.Fprintf(os.Stderr, "credential for %s expires at %s\n", provider, expiresAt)
+	}
+
 	setCredentialEnv(provider, tokenData)
-	return nil
+	return credentialVersion(tokenData), nil
+}
+
+// credentialVersion digests the fields setCredentialEnv exports.
+func credentialVersion(data map[string]interface{}) string {
+	h := sha256.New()
+	for _, k := range []string{"token", "apiToken", "accessToken", "url", "email"} {
+		v, _ := data[k].(string)
+		fmt.Fprintf(h, "%s=%s\n", k, v)
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 func fetchJSON(client *http.Client, fetchURL, bearerToken string) (map[string]interface{}, error) {
@@ -215,30 +282,89 @@ kind = "Namespace"
 	return append([]string{args[0], "--config", configPath}, args[1:]...)
 }
 
-func runSubprocess(args []string) {
-	cmd := exec.Command(args[0], args[1:]...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
-	cmd.Env = os.Environ()
+// supervisor runs the wrapped command and restarts it on request with the
+// current environment. run returns the command's exit status when the
+// command ends on its own or after a termination signal.
+type supervisor struct {
+	args []string
 
-	if err := cmd.Start(); err != nil {
-		fmt.Fprintf(os.Stderr, "failed to start %s: %v\n", args[0], err)
-		os.Exit(1)
-	}
+	mu         sync.Mutex
+	cmd        *exec.Cmd
+	restarting bool
+	stopping   bool
+}
+
+var _ child = (*supervisor)(nil)
 
+func (s *supervisor) run() int {
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(sig)
 	go func() {
-		s := <-sig
-		_ = cmd.Process.Signal(s)
+		s.stop(<-sig)
 	}()
 
-	if err := cmd.Wait(); err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			os.Exit(exitErr.ExitCode())
+	for {
+		cmd := exec.Command(s.args[0], s.args[1:]...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Stdin = os.Stdin
+		cmd.Env = os.Environ()
+
+		s.mu.Lock()
+		if s.stopping {
+			s.mu.Unlock()
+			return 0
 		}
-		fmt.Fprintf(os.Stderr, "subprocess failed: %v\n", err)
-		os.Exit(1)
+		if err := cmd.Start(); err != nil {
+			s.mu.Unlock()
+			fmt.Fprintf(os.Stderr, "failed to start %s: %v\n", s.args[0], err)
+			return 1
+		}
+		s.cmd = cmd
+		s.restarting = false
+		s.mu.Unlock()
+
+		err := cmd.Wait()
+
+		s.mu.Lock()
+		restart := s.restarting && !s.stopping
+		s.mu.Unlock()
+		if restart {
+			continue
+		}
+
+		if err != nil {
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				return exitErr.ExitCode()
+			}
+			fmt.Fprintf(os.Stderr, "subprocess failed: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+}
+
+// restart stops the running command so run starts it again with the
+// environment as it is now.
+func (s *supervisor) restart() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cmd == nil || s.cmd.Process == nil || s.restarting || s.stopping {
+		return
+	}
+	s.restarting = true
+	_ = s.cmd.Process.Signal(syscall.SIGTERM)
+}
+
+// stop forwards sig to the running command and keeps run from starting it
+// again.
+func (s *supervisor) stop(sig os.Signal) {
+	s.mu.Lock()
+	s.stopping = true
+	cmd := s.cmd
+	s.mu.Unlock()
+	if cmd != nil && cmd.Process != nil {
+		_ = cmd.Process.Signal(sig)
 	}
 }
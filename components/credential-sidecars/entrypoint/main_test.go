@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeChild counts restarts instead of running a command.
+type fakeChild struct{ restarts int }
+
+func (c *fakeChild) restart() { c.restarts++ }
+
+// fetchSequence returns a fetch func yielding versions (or errors) in order.
+func fetchSequence(results ...interface{}) func(string) (string, error) {
+	return func(string) (string, error) {
+		next := results[0]
+		results = results[1:]
+		if err, ok := next.(error); ok {
+			return "", err
+		}
+		return next.(string), nil
+	}
+}
+
+func TestRefresh_RotatedCredentialRestartsChildOnce(t *testing.T) {
+	c := &fakeChild{}
+	r := &credentialRefresher{fetch: fetchSequence("v2", "v2"), version: "v1", child: c}
+
+	r.refresh("token")
+	r.refresh("token")
+
+	if c.restarts != 1 {
+		t.Errorf("restarts = %d, want 1", c.restarts)
+	}
+	if r.version != "v2" {
+		t.Errorf("version = %q, want v2", r.version)
+	}
+}
+
+func TestRefresh_UnchangedCredentialDoesNotRestart(t *testing.T) {
+	c := &fakeChild{}
+	r := &credentialRefresher{fetch: fetchSequence("v1", "v1"), version: "v1", child: c}
+
+	r.refresh("token")
+	r.refresh("token")
+
+	if c.restarts != 0 {
+		t.Errorf("restarts = %d, want 0", c.restarts)
+	}
+}
+
+func TestRefresh_FailedFetchLeavesChildRunning(t *testing.T) {
+	c := &fakeChild{}
+	r := &credentialRefresher{fetch: fetchSequence(errors.New("HTTP 503"), "v1"), version: "v1", child: c}
+
+	r.refresh("token")
+	r.refresh("token")
+
+	if c.restarts != 0 {
+		t.Errorf("restarts = %d, want 0", c.restarts)
+	}
+	if r.version != "v1" {
+		t.Errorf("version = %q, want v1", r.version)
+	}
+}
+
+func TestCredentialVersion_ChangesWithExportedFields(t *testing.T) {
+	base := map[string]interface{}{"token": "abc", "url": "https://jira.example.com"}
+	same := map[string]interface{}{"token": "abc", "url": "https://jira.example.com", "expires_at": "2026-10-18T00:00:00Z"}
+	rotated := map[string]interface{}{"token": "xyz", "url": "https://jira.example.com"}
+
+	if credentialVersion(base) != credentialVersion(same) {
+		t.Error("fields that are not exported should not change the version")
+	}
+	if credentialVersion(base) == credentialVersion(rotated) {
+		t.Error("a rotated token should change the version")
+	}
+}
+
+// waitForLines polls path until it has n lines.
+func waitForLines(t *testing.T, path string, n int) []string {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if data, err := os.ReadFile(path); err == nil {
+			if lines := strings.Fields(string(data)); len(lines) >= n {
+				return lines
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%s did not reach %d lines", path, n)
+	return nil
+}
+
+func TestSupervisor_RestartRerunsCommandWithCurrentEnv(t *testing.T) {
+	starts := filepath.Join(t.TempDir(), "starts")
+	t.Setenv("CREDENTIAL_TEST_TOKEN", "old")
+	sup := &supervisor{args: []string{"sh", "-c", `echo "$CREDENTIAL_TEST_TOKEN" >> "$0"; exec sleep 30`, starts}}
+
+	done := make(chan int, 1)
+	go func() { done <- sup.run() }()
+	waitForLines(t, starts, 1)
+
+	os.Setenv("CREDENTIAL_TEST_TOKEN", "new")
+	sup.restart()
+	if lines := waitForLines(t, starts, 2); lines[0] != "old" || lines[1] != "new" {
+		t.Errorf("command saw %v, want [old new]", lines)
+	}
+
+	sup.stop(syscall.SIGTERM)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("run did not return after stop")
+	}
+}
@@ -22,11 +22,8 @@ func setupTestRouter() *gin.Engine {
 	v1 := r.Group("/v1")
 	v1.Use(AuthMiddleware())
 	v1.Use(LoggingMiddleware())
-	{
-		v1.GET("/sessions", ListSessions)
-		v1.POST("/sessions", CreateSession)
-		v1.GET("/sessions/:id", GetSession)
-		v1.DELETE("/sessions/:id", DeleteSession)
+	for _, rt := range Routes() {
+		v1.Handle(rt.Method, rt.Path, rt.HandlerChain()...)
 	}
 
 	return r
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxAGUIRunBodyBytes caps the AG-UI run payload accepted from clients.
+const MaxAGUIRunBodyBytes = 1 << 20
+
+// sessionPath validates the project and session ID and returns the backend
+// path for the session. It writes a 400 and returns false on invalid input.
+func sessionPath(c *gin.Context) (string, bool) {
+	project := GetProject(c)
+	if !ValidateProjectName(project) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project name"})
+		return "", false
+	}
+	sessionID := c.Param("id")
+	if !ValidateSessionID(sessionID) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID"})
+		return "", false
+	}
+	return fmt.Sprintf("/api/projects/%s/agentic-sessions/%s", project, sessionID), true
+}
+
+// StartSession handles POST /v1/sessions/:id/start
+func StartSession(c *gin.Context) {
+	sessionLifecycle(c, "start")
+}
+
+// StopSession handles POST /v1/sessions/:id/stop
+func StopSession(c *gin.Context) {
+	sessionLifecycle(c, "stop")
+}
+
+func sessionLifecycle(c *gin.Context, action string) {
+	path, ok := sessionPath(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ProxyRequest(c, http.MethodPost, path+"/"+action, nil)
+	if err != nil {
+		log.Printf("Backend request failed for %s session %s: %v", action, c.Param("id"), err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Backend unavailable"})
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("Failed to read backend response: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		forwardErrorResponse(c, resp.StatusCode, body)
+		return
+	}
+
+	var backendResp map[string]interface{}
+	if err := json.Unmarshal(body, &backendResp); err != nil {
+		log.Printf("Failed to parse backend response: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	// The backend answers with the updated session, or with a bare message
+	// when there is nothing left to act on (e.g. stopping a deleted session).
+	if _, hasMetadata := backendResp["metadata"]; !hasMetadata {
+		c.JSON(resp.StatusCode, backendResp)
+		return
+	}
+	c.JSON(http.StatusAccepted, transformSession(backendResp))
+}
+
+// RunAGUI handles POST /v1/sessions/:id/agui/run
+//
+// The AG-UI RunAgentInput is passed through unchanged; the backend starts the
+// run asynchronously and its events are delivered on /agui/events.
+func RunAGUI(c *gin.Context) {
+	path, ok := sessionPath(c)
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, MaxAGUIRunBodyBytes))
+	if err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body too large"})
+		return
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(body, &input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Request body must be a JSON object"})
+		return
+	}
+
+	resp, err := ProxyRequest(c, http.MethodPost, path+"/agui/run", body)
+	if err != nil {
+		log.Printf("Backend request failed for AG-UI run on session %s: %v", c.Param("id"), err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Backend unavailable"})
+		return
+	}
+	defer resp.Body.Close()
+
+	respondPassthrough(c, resp)
+}
+
+// StreamAGUIEvents handles GET /v1/sessions/:id/agui/events
+//
+// The backend replays persisted events and then tails live ones; the stream
+// is relayed to the client as-is until either side disconnects.
+func StreamAGUIEvents(c *gin.Context) {
+	path, ok := sessionPath(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ProxyStream(c, path+"/agui/events")
+	if err != nil {
+		log.Printf("Backend stream failed for session %s: %v", c.Param("id"), err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Backend unavailable"})
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		forwardErrorResponse(c, resp.StatusCode, body)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+	c.Writer.Flush()
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, readErr := resp.Body.Read(buf)
+		if n > 0 {
+			if _, err := c.Writer.Write(buf[:n]); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+		if readErr != nil {
+			return
+		}
+	}
+}
+
+// GetWorkspaceFile handles GET /v1/sessions/:id/workspace/*path
+func GetWorkspaceFile(c *gin.Context) {
+	path, ok := sessionPath(c)
+	if !ok {
+		return
+	}
+	filePath := strings.TrimPrefix(c.Param("path"), "/")
+	if !ValidateWorkspacePath(filePath) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid workspace path"})
+		return
+	}
+
+	segments := strings.Split(filePath, "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+
+	resp, err := ProxyRequest(c, http.MethodGet, path+"/workspace/"+strings.Join(segments, "/"), nil)
+	if err != nil {
+		log.Printf("Backend request failed for workspace file on session %s: %v", c.Param("id"), err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Backend unavailable"})
+		return
+	}
+	defer resp.Body.Close()
+
+	respondPassthrough(c, resp)
+}
+
+// ExportSession handles GET /v1/sessions/:id/export
+func ExportSession(c *gin.Context) {
+	path, ok := sessionPath(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ProxyRequest(c, http.MethodGet, path+"/export", nil)
+	if err != nil {
+		log.Printf("Backend request failed for export of session %s: %v", c.Param("id"), err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Backend unavailable"})
+		return
+	}
+	defer resp.Body.Close()
+
+	respondPassthrough(c, resp)
+}
+
+// respondPassthrough relays a backend response body verbatim on success,
+// preserving its content type and download disposition. Errors are
+// normalised through forwardErrorResponse.
+func respondPassthrough(c *gin.Context, resp *http.Response) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("Failed to read backend response: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		forwardErrorResponse(c, resp.StatusCode, body)
+		return
+	}
+
+	if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+		c.Header("Content-Disposition", disposition)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+	c.Data(resp.StatusCode, contentType, body)
+}
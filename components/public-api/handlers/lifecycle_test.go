@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withBackend points the handlers at a mock backend for the duration of a
+// test and starts it with fresh rate limit buckets.
+func withBackend(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	limiters.Range(func(key, value interface{}) bool {
+		limiters.Delete(key)
+		return true
+	})
+	originalQuotas := Quotas
+	Quotas = NewRateLimiter(NewMemoryLimiterStore(), DefaultRateLimitConfig())
+	t.Cleanup(func() { Quotas = originalQuotas })
+
+	backend := httptest.NewServer(handler)
+	t.Cleanup(backend.Close)
+
+	originalURL := BackendURL
+	BackendURL = backend.URL
+	t.Cleanup(func() { BackendURL = originalURL })
+}
+
+func newAuthedRequest(method, target string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Authorization", "Bearer test-token")
+	req.Header.Set("X-Ambient-Project", "test-project")
+	return req
+}
+
+func TestStartStopSession(t *testing.T) {
+	for _, action := range []string{"start", "stop"} {
+		t.Run(action, func(t *testing.T) {
+			var gotMethod, gotPath string
+			withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+				gotMethod, gotPath = r.Method, r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"metadata": map[string]interface{}{"name": "session-1"},
+					"status":   map[string]interface{}{"phase": "Pending"},
+				})
+			})
+
+			w := httptest.NewRecorder()
+			setupTestRouter().ServeHTTP(w, newAuthedRequest(http.MethodPost, "/v1/sessions/session-1/"+action, nil))
+
+			if w.Code != http.StatusAccepted {
+				t.Fatalf("status = %d, want 202: %s", w.Code, w.Body.String())
+			}
+			if gotMethod != http.MethodPost || gotPath != "/api/projects/test-project/agentic-sessions/session-1/"+action {
+				t.Errorf("backend got %s %s", gotMethod, gotPath)
+			}
+			var resp map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			if resp["id"] != "session-1" || resp["status"] != "pending" {
+				t.Errorf("unexpected response %v", resp)
+			}
+		})
+	}
+}
+
+func TestStartSession_ForwardsBackendError(t *testing.T) {
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Session not found"})
+	})
+
+	w := httptest.NewRecorder()
+	setupTestRouter().ServeHTTP(w, newAuthedRequest(http.MethodPost, "/v1/sessions/missing/start", nil))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", w.Code)
+	}
+}
+
+func TestRunAGUI(t *testing.T) {
+	var gotBody string
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"threadId": "session-1", "runId": "run-1"})
+	})
+
+	router := setupTestRouter()
+
+	w := httptest.NewRecorder()
+	input := `{"messages":[{"id":"m1","role":"user","content":"hello"}]}`
+	router.ServeHTTP(w, newAuthedRequest(http.MethodPost, "/v1/sessions/session-1/agui/run", strings.NewReader(input)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if gotBody != input {
+		t.Errorf("backend got body %q, want it passed through unchanged", gotBody)
+	}
+	if !strings.Contains(w.Body.String(), `"runId":"run-1"`) {
+		t.Errorf("unexpected response %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newAuthedRequest(http.MethodPost, "/v1/sessions/session-1/agui/run", strings.NewReader(`not json`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid JSON: status = %d, want 400", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	big := `{"messages":"` + strings.Repeat("x", MaxAGUIRunBodyBytes) + `"}`
+	router.ServeHTTP(w, newAuthedRequest(http.MethodPost, "/v1/sessions/session-1/agui/run", strings.NewReader(big)))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized body: status = %d, want 413", w.Code)
+	}
+}
+
+func TestStreamAGUIEvents(t *testing.T) {
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "text/event-stream" {
+			t.Errorf("Accept = %q, want text/event-stream", r.Header.Get("Accept"))
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		for i := 1; i <= 3; i++ {
+			fmt.Fprintf(w, "data: {\"type\":\"TEXT_MESSAGE_CONTENT\",\"delta\":\"%d\"}\n\n", i)
+			w.(http.Flusher).Flush()
+		}
+	})
+
+	// Serve over a real listener so the response is actually streamed.
+	gateway := httptest.NewServer(setupTestRouter())
+	defer gateway.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, gateway.URL+"/v1/sessions/session-1/agui/events", nil)
+	req.Header.Set("Authorization", "Bearer test-token")
+	req.Header.Set("X-Ambient-Project", "test-project")
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("stream request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("got %d %q, want 200 text/event-stream", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "data: ") {
+			events = append(events, line)
+		}
+	}
+	if len(events) != 3 {
+		t.Errorf("got %d events, want 3: %v", len(events), events)
+	}
+}
+
+func TestGetWorkspaceFile(t *testing.T) {
+	var gotPath string
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("package main\n"))
+	})
+
+	router := setupTestRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newAuthedRequest(http.MethodGet, "/v1/sessions/session-1/workspace/repo/my%20file.go", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if w.Body.String() != "package main\n" || w.Header().Get("Content-Type") != "text/plain" {
+		t.Errorf("got %q (%s)", w.Body.String(), w.Header().Get("Content-Type"))
+	}
+	if gotPath != "/api/projects/test-project/agentic-sessions/session-1/workspace/repo/my%20file.go" {
+		t.Errorf("backend got path %s", gotPath)
+	}
+
+	for _, bad := range []string{"/v1/sessions/session-1/workspace/repo/..%2F..%2Fetc/passwd", "/v1/sessions/session-1/workspace/a//b"} {
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, newAuthedRequest(http.MethodGet, bad, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", bad, w.Code)
+		}
+	}
+}
+
+func TestExportSession_PreservesDisposition(t *testing.T) {
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", `attachment; filename="session-1-export.json"`)
+		w.Write([]byte(`{"sessionId":"session-1"}`))
+	})
+
+	w := httptest.NewRecorder()
+	setupTestRouter().ServeHTTP(w, newAuthedRequest(http.MethodGet, "/v1/sessions/session-1/export", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="session-1-export.json"` {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+}
+
+func TestLifecycleRoutes_RejectInvalidSessionID(t *testing.T) {
+	withBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("backend should not be called, got %s %s", r.Method, r.URL.Path)
+	})
+
+	router := setupTestRouter()
+	for _, target := range []string{
+		"/v1/sessions/Bad_ID/start",
+		"/v1/sessions/Bad_ID/agui/events",
+		"/v1/sessions/Bad_ID/export",
+	} {
+		method := http.MethodGet
+		if strings.HasSuffix(target, "/start") {
+			method = http.MethodPost
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newAuthedRequest(method, target, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", target, w.Code)
+		}
+	}
+}
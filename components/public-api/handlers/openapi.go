@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ambient-code-public-api/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIVersion is reported in the gateway's OpenAPI document.
+const APIVersion = "1.0.0"
+
+// ginParamRegex matches gin path parameters (:id) and wildcards (*path).
+var ginParamRegex = regexp.MustCompile(`[:*]([A-Za-z_][A-Za-z0-9_]*)`)
+
+// OpenAPISpec builds the gateway's OpenAPI 3 document from the route table.
+func OpenAPISpec() map[string]interface{} {
+	schemas := map[string]interface{}{}
+	schemaRef(reflect.TypeOf(types.ErrorResponse{}), schemas)
+	paths := map[string]interface{}{}
+
+	for _, rt := range Routes() {
+		oaPath, params := openAPIPath("/v1" + rt.Path)
+		item, _ := paths[oaPath].(map[string]interface{})
+		if item == nil {
+			item = map[string]interface{}{}
+			paths[oaPath] = item
+		}
+
+		parameters := []interface{}{
+			map[string]interface{}{"$ref": "#/components/parameters/Project"},
+		}
+		for _, p := range params {
+			parameters = append(parameters, map[string]interface{}{
+				"name":     p,
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string"},
+			})
+		}
+
+		op := map[string]interface{}{
+			"operationId": rt.OperationID,
+			"summary":     rt.Summary,
+			"tags":        []string{"sessions"},
+			"parameters":  parameters,
+			"responses":   operationResponses(rt, schemas),
+		}
+		if rt.Request != nil {
+			op["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schemaRef(reflect.TypeOf(rt.Request), schemas)},
+				},
+			}
+		}
+		op["x-rate-limit-operation"] = rt.Operation
+		item[strings.ToLower(rt.Method)] = op
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Ambient Code Public API",
+			"version":     APIVersion,
+			"description": "Versioned gateway API for the Ambient Code Platform. Requests are proxied to the backend with the caller's token.",
+		},
+		"servers":  []interface{}{map[string]interface{}{"url": "/"}},
+		"security": []interface{}{map[string]interface{}{"bearerAuth": []string{}}},
+		"paths":    paths,
+		"components": map[string]interface{}{
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{"type": "http", "scheme": "bearer"},
+			},
+			"parameters": map[string]interface{}{
+				"Project": map[string]interface{}{
+					"name":        "X-Ambient-Project",
+					"in":          "header",
+					"required":    false,
+					"description": "Target project. Optional for project-scoped access keys; must match the token's project when both are set.",
+					"schema":      map[string]interface{}{"type": "string"},
+				},
+			},
+			"schemas": schemas,
+		},
+	}
+}
+
+// OpenAPIHandler serves the gateway's OpenAPI document as JSON.
+func OpenAPIHandler() gin.HandlerFunc {
+	spec := OpenAPISpec()
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	}
+}
+
+func operationResponses(rt Route, schemas map[string]interface{}) map[string]interface{} {
+	success := map[string]interface{}{"description": rt.Response.Description}
+	contentType := rt.Response.ContentType
+	if contentType == "" && rt.Response.Body != nil {
+		contentType = "application/json"
+	}
+	if contentType != "" {
+		var schema interface{} = map[string]interface{}{"type": "string"}
+		if rt.Response.Body != nil {
+			schema = schemaRef(reflect.TypeOf(rt.Response.Body), schemas)
+		} else if contentType == "application/octet-stream" {
+			schema = map[string]interface{}{"type": "string", "format": "binary"}
+		} else if contentType == "application/json" {
+			schema = map[string]interface{}{"type": "object"}
+		}
+		success["content"] = map[string]interface{}{contentType: map[string]interface{}{"schema": schema}}
+	}
+
+	errorResponse := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"description": description,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{"$ref": "#/components/schemas/ErrorResponse"},
+				},
+			},
+		}
+	}
+
+	responses := map[string]interface{}{
+		strconv.Itoa(rt.Response.Status): success,
+		"400":                            errorResponse("Invalid input"),
+		"401":                            errorResponse("Missing or invalid authorization"),
+		"429":                            errorResponse("Rate limit exceeded"),
+		"502":                            errorResponse("Backend unavailable"),
+	}
+	if strings.Contains(rt.Path, ":id") {
+		responses["404"] = errorResponse("Session not found")
+	}
+	return responses
+}
+
+// openAPIPath converts a gin path into OpenAPI syntax and returns its
+// parameter names in order.
+func openAPIPath(ginPath string) (string, []string) {
+	var params []string
+	out := ginParamRegex.ReplaceAllStringFunc(ginPath, func(m string) string {
+		name := m[1:]
+		params = append(params, name)
+		return "{" + name + "}"
+	})
+	return out, params
+}
+
+// schemaRef registers the named struct type in schemas and returns a $ref
+// to it. Non-struct types are inlined.
+func schemaRef(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.Name() == "" {
+		return schemaFor(t, schemas)
+	}
+	if _, ok := schemas[t.Name()]; !ok {
+		schemas[t.Name()] = schemaFor(t, schemas)
+	}
+	return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+}
+
+// schemaFor derives a JSON schema from a Go type using its json tags;
+// `binding:"required"` marks required properties.
+func schemaFor(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": schemaRef(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": true}
+	case reflect.Interface:
+		return map[string]interface{}{}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			properties[name] = schemaRef(f.Type, schemas)
+			if strings.Contains(f.Tag.Get("binding"), "required") {
+				required = append(required, name)
+			}
+		}
+		schema := map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			sort.Strings(required)
+			schema["required"] = required
+		}
+		return schema
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestOpenAPISpec_CoversRouteTable(t *testing.T) {
+	spec := OpenAPISpec()
+	paths := spec["paths"].(map[string]interface{})
+
+	for _, rt := range Routes() {
+		oaPath, params := openAPIPath("/v1" + rt.Path)
+		item, ok := paths[oaPath].(map[string]interface{})
+		if !ok {
+			t.Errorf("path %s missing from OpenAPI document", oaPath)
+			continue
+		}
+		op, ok := item[strings.ToLower(rt.Method)].(map[string]interface{})
+		if !ok {
+			t.Errorf("%s %s missing from OpenAPI document", rt.Method, oaPath)
+			continue
+		}
+		if op["operationId"] != rt.OperationID {
+			t.Errorf("%s %s operationId = %v, want %s", rt.Method, oaPath, op["operationId"], rt.OperationID)
+		}
+		// Project header plus one entry per path parameter
+		if got := len(op["parameters"].([]interface{})); got != len(params)+1 {
+			t.Errorf("%s %s has %d parameters, want %d", rt.Method, oaPath, got, len(params)+1)
+		}
+	}
+}
+
+func TestOpenAPISpec_UniqueOperationIDs(t *testing.T) {
+	seen := map[string]bool{}
+	for _, rt := range Routes() {
+		if rt.OperationID == "" {
+			t.Errorf("%s %s has no operationId", rt.Method, rt.Path)
+		}
+		if seen[rt.OperationID] {
+			t.Errorf("duplicate operationId %s", rt.OperationID)
+		}
+		seen[rt.OperationID] = true
+	}
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantPath   string
+		wantParams []string
+	}{
+		{"/v1/sessions", "/v1/sessions", nil},
+		{"/v1/sessions/:id/start", "/v1/sessions/{id}/start", []string{"id"}},
+		{"/v1/sessions/:id/workspace/*path", "/v1/sessions/{id}/workspace/{path}", []string{"id", "path"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			path, params := openAPIPath(tt.input)
+			if path != tt.wantPath || strings.Join(params, ",") != strings.Join(tt.wantParams, ",") {
+				t.Errorf("openAPIPath(%q) = %q %v, want %q %v", tt.input, path, params, tt.wantPath, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestOpenAPISpec_SchemasFromTypes(t *testing.T) {
+	schemas := OpenAPISpec()["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	create, ok := schemas["CreateSessionRequest"].(map[string]interface{})
+	if !ok {
+		t.Fatal("CreateSessionRequest schema missing")
+	}
+	required := create["required"].([]string)
+	if len(required) != 1 || required[0] != "task" {
+		t.Errorf("CreateSessionRequest required = %v, want [task]", required)
+	}
+	if _, ok := schemas["Repo"]; !ok {
+		t.Error("nested Repo schema missing")
+	}
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	r := gin.New()
+	r.GET("/openapi.json", OpenAPIHandler())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+	if doc["openapi"] != "3.0.3" {
+		t.Errorf("openapi = %v, want 3.0.3", doc["openapi"])
+	}
+}
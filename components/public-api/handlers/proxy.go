@@ -24,6 +24,15 @@ var (
 	HTTPClient = &http.Client{
 		Timeout: BackendTimeout,
 	}
+
+	// StreamingHTTPClient is used for long-lived backend streams (SSE). It has
+	// no overall timeout; the stream ends when the client disconnects.
+	StreamingHTTPClient = &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			ResponseHeaderTimeout: BackendTimeout,
+		},
+	}
 )
 
 func getTimeoutFromEnv(key string, defaultValue time.Duration) time.Duration {
@@ -105,3 +114,26 @@ func ProxyAndRespond(c *gin.Context, method, path string, body []byte) {
 	// Forward response
 	c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), respBody)
 }
+
+// ProxyStream opens a long-lived GET stream to the backend. The request is
+// bound to the client's context, so it is torn down when the client goes away.
+func ProxyStream(c *gin.Context, path string) (*http.Response, error) {
+	fullURL := fmt.Sprintf("%s%s", BackendURL, path)
+
+	req, err := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if token := GetToken(c); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := StreamingHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("backend stream failed: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-yaml"
+)
+
+// Operation classes. Each route belongs to exactly one; tiers assign a
+// separate bucket per class so expensive operations cannot use up the budget
+// for cheap reads and vice versa.
+const (
+	OpRead             = "read"
+	OpWrite            = "write"
+	OpSessionCreate    = "session_create"
+	OpSessionLifecycle = "session_lifecycle"
+	OpAGUIRun          = "agui_run"
+	OpAGUIEvents       = "agui_events"
+	OpWorkspace        = "workspace"
+	OpExport           = "export"
+)
+
+// DefaultTierName is the tier applied to projects without an assignment.
+const DefaultTierName = "default"
+
+// TierLimits holds the limits of one tier, keyed by operation class.
+//
+// Subject limits apply per caller and are charged on every request. The
+// caller is the user or service account the token authenticates as when a
+// SubjectResolver is configured, and the token itself otherwise.
+// Project limits are quotas shared by everyone in the project; they are
+// checked up front but only charged for successful requests, so callers
+// that fail authentication in the backend cannot drain a project's quota.
+type TierLimits struct {
+	Subject map[string]Limit `yaml:"subject,omitempty" json:"subject,omitempty"`
+	Project map[string]Limit `yaml:"project,omitempty" json:"project,omitempty"`
+}
+
+// RateLimitConfig is the gateway's rate limit configuration, typically
+// mounted from a ConfigMap and pointed to by RATE_LIMIT_CONFIG.
+type RateLimitConfig struct {
+	// Tiers by name. The "default" tier is merged over the built-in
+	// defaults; other tiers inherit any class they leave out from it.
+	Tiers map[string]TierLimits `yaml:"tiers" json:"tiers"`
+	// Projects assigns projects to tiers.
+	Projects map[string]string `yaml:"projects,omitempty" json:"projects,omitempty"`
+}
+
+// DefaultRateLimitConfig returns the built-in configuration.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Tiers: map[string]TierLimits{
+			DefaultTierName: {
+				Subject: map[string]Limit{
+					OpRead:             {Requests: 50, Per: time.Second, Burst: 100},
+					OpWrite:            {Requests: 10, Per: time.Second, Burst: 20},
+					OpSessionCreate:    {Requests: 30, Per: time.Hour, Burst: 10},
+					OpSessionLifecycle: {Requests: 1, Per: time.Second, Burst: 5},
+					OpAGUIRun:          {Requests: 2, Per: time.Second, Burst: 10},
+					OpAGUIEvents:       {Requests: 1, Per: 2 * time.Second, Burst: 5},
+					OpWorkspace:        {Requests: 10, Per: time.Second, Burst: 20},
+					OpExport:           {Requests: 1, Per: 5 * time.Second, Burst: 2},
+				},
+				Project: map[string]Limit{
+					OpSessionCreate: {Requests: 100, Per: time.Hour},
+				},
+			},
+		},
+	}
+}
+
+// ParseRateLimitConfig parses and validates a YAML (or JSON) configuration.
+func ParseRateLimitConfig(data []byte) (*RateLimitConfig, error) {
+	var cfg RateLimitConfig
+	if err := yaml.UnmarshalWithOptions(data, &cfg, yaml.Strict()); err != nil {
+		return nil, fmt.Errorf("invalid rate limit config: %w", err)
+	}
+
+	builtin := DefaultRateLimitConfig().Tiers[DefaultTierName]
+	if cfg.Tiers == nil {
+		cfg.Tiers = map[string]TierLimits{}
+	}
+	cfg.Tiers[DefaultTierName] = mergeTier(cfg.Tiers[DefaultTierName], builtin)
+	for name, tier := range cfg.Tiers {
+		if name != DefaultTierName {
+			cfg.Tiers[name] = mergeTier(tier, cfg.Tiers[DefaultTierName])
+		}
+	}
+
+	for name, tier := range cfg.Tiers {
+		for scope, limits := range map[string]map[string]Limit{"subject": tier.Subject, "project": tier.Project} {
+			for op, l := range limits {
+				if !l.valid() {
+					return nil, fmt.Errorf("invalid rate limit config: tier %q %s limit for %q needs positive requests and per", name, scope, op)
+				}
+			}
+		}
+	}
+	for project, tier := range cfg.Projects {
+		if _, ok := cfg.Tiers[tier]; !ok {
+			return nil, fmt.Errorf("invalid rate limit config: project %q references unknown tier %q", project, tier)
+		}
+	}
+	return &cfg, nil
+}
+
+// mergeTier fills classes missing from tier with those from base.
+func mergeTier(tier, base TierLimits) TierLimits {
+	merged := TierLimits{Subject: map[string]Limit{}, Project: map[string]Limit{}}
+	for op, l := range base.Subject {
+		merged.Subject[op] = l
+	}
+	for op, l := range tier.Subject {
+		merged.Subject[op] = l
+	}
+	for op, l := range base.Project {
+		merged.Project[op] = l
+	}
+	for op, l := range tier.Project {
+		merged.Project[op] = l
+	}
+	return merged
+}
+
+// tierFor returns the tier name and limits for a project.
+func (c *RateLimitConfig) tierFor(project string) (string, TierLimits) {
+	name := DefaultTierName
+	if assigned, ok := c.Projects[project]; ok {
+		name = assigned
+	}
+	return name, c.Tiers[name]
+}
+
+// RateLimiter enforces per-subject and per-project limits from a hot
+// reloadable configuration.
+type RateLimiter struct {
+	store    LimiterStore
+	subjects SubjectResolver
+	config   atomic.Pointer[RateLimitConfig]
+	now      func() time.Time
+}
+
+// NewRateLimiter creates a limiter over store with the given configuration.
+func NewRateLimiter(store LimiterStore, cfg *RateLimitConfig) *RateLimiter {
+	rl := &RateLimiter{store: store, now: time.Now}
+	rl.config.Store(cfg)
+	return rl
+}
+
+// SetConfig atomically replaces the configuration. Bucket state is kept, so
+// a reload does not reset anyone's usage.
+func (rl *RateLimiter) SetConfig(cfg *RateLimitConfig) {
+	rl.config.Store(cfg)
+}
+
+// Config returns the active configuration.
+func (rl *RateLimiter) Config() *RateLimitConfig {
+	return rl.config.Load()
+}
+
+// Quotas is the gateway-wide limiter used by QuotaMiddleware.
+var Quotas = NewRateLimiter(NewMemoryLimiterStore(), DefaultRateLimitConfig())
+
+// SetLimiterStore swaps the store behind Quotas. It must be called before
+// the server starts handling requests.
+func SetLimiterStore(store LimiterStore) {
+	Quotas.store = store
+}
+
+// SetSubjectResolver makes Quotas key subject limits on the identity tokens
+// resolve to. It must be called before the server starts handling requests.
+func SetSubjectResolver(resolver SubjectResolver) {
+	Quotas.subjects = resolver
+}
+
+// QuotaMiddleware enforces the subject and project limits for operation.
+// It must run after AuthMiddleware.
+func QuotaMiddleware(operation string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		Quotas.handle(c, operation)
+	}
+}
+
+// check is a single bucket evaluation.
+type check struct {
+	key      string
+	limit    Limit
+	decision Decision
+}
+
+func (rl *RateLimiter) handle(c *gin.Context, operation string) {
+	project := GetProject(c)
+	_, tier := rl.Config().tierFor(project)
+	now := rl.now()
+	ctx := c.Request.Context()
+
+	var checks []*check
+	if l, ok := tier.Subject[operation]; ok {
+		checks = append(checks, &check{key: "subject:" + rl.subjectKey(c) + ":" + operation, limit: l})
+	}
+	var projectCheck *check
+	if l, ok := tier.Project[operation]; ok {
+		projectCheck = &check{key: "project:" + project + ":" + operation, limit: l}
+		checks = append(checks, projectCheck)
+	}
+	if len(checks) == 0 {
+		c.Next()
+		return
+	}
+
+	var denied *check
+	for _, chk := range checks {
+		cost := 1
+		if chk == projectCheck {
+			cost = 0 // charged after the request succeeds
+		}
+		d, err := rl.store.Take(ctx, chk.key, chk.limit, cost, now)
+		if err != nil {
+			// Fail open: an unavailable store must not take the API down.
+			log.Printf("Rate limit store error for %s: %v", operation, err)
+			c.Next()
+			return
+		}
+		chk.decision = d
+		if !d.Allowed && (denied == nil || d.RetryAfter > denied.decision.RetryAfter) {
+			denied = chk
+		}
+	}
+
+	setRateLimitHeaders(c, checks)
+
+	if denied != nil {
+		retryAfter := ceilSeconds(denied.decision.RetryAfter)
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error":       "Rate limit exceeded",
+			"retry_after": fmt.Sprintf("%ds", retryAfter),
+		})
+		c.Abort()
+		return
+	}
+
+	c.Next()
+
+	if projectCheck != nil && c.Writer.Status() < http.StatusBadRequest {
+		if _, err := rl.store.Take(ctx, projectCheck.key, projectCheck.limit, 1, rl.now()); err != nil {
+			log.Printf("Rate limit store error charging project quota for %s: %v", operation, err)
+		}
+	}
+}
+
+// setRateLimitHeaders reports the most constrained bucket using the IETF
+// RateLimit header fields.
+func setRateLimitHeaders(c *gin.Context, checks []*check) {
+	sort.SliceStable(checks, func(i, j int) bool {
+		return checks[i].decision.Remaining < checks[j].decision.Remaining
+	})
+	tightest := checks[0]
+	remaining := tightest.decision.Remaining
+	if remaining < 0 {
+		remaining = 0
+	}
+	c.Header("RateLimit-Limit", strconv.Itoa(tightest.decision.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(tightest.decision.Reset)))
+	c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", tightest.limit.Requests, ceilSeconds(tightest.limit.Per)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
+
+// subjectKey identifies the caller for subject limits. With a resolver, all
+// tokens of one user or service account share a bucket. Tokens that cannot
+// be resolved fall back to their fingerprint; unverified JWT claims are never
+// used, since that would let anyone drain another user's bucket.
+func (rl *RateLimiter) subjectKey(c *gin.Context) string {
+	token := GetToken(c)
+	if rl.subjects != nil {
+		subject, err := rl.subjects.Resolve(c.Request.Context(), token)
+		if err != nil {
+			log.Printf("Rate limit subject resolution failed, limiting by token: %v", err)
+		} else if subject != "" {
+			return "user:" + subject
+		}
+	}
+	return "token:" + tokenFingerprint(token)
+}
+
+// tokenFingerprint identifies a token without keeping it in limiter state.
+func tokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// LoadRateLimitConfig reads the configuration at path and applies it to Quotas.
+func LoadRateLimitConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read rate limit config: %w", err)
+	}
+	cfg, err := ParseRateLimitConfig(data)
+	if err != nil {
+		return err
+	}
+	Quotas.SetConfig(cfg)
+	return nil
+}
+
+// WatchRateLimitConfig starts polling path in the background and reloads the
+// configuration when it changes, until ctx is done. ConfigMap volumes update
+// files by swapping a symlink, which changes the stat result, so polling
+// works without inotify. Invalid updates are logged and the previous
+// configuration stays active.
+func WatchRateLimitConfig(ctx context.Context, path string, interval time.Duration) {
+	last := configStamp(path)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stamp := configStamp(path)
+				if stamp == last {
+					continue
+				}
+				last = stamp
+				if err := LoadRateLimitConfig(path); err != nil {
+					log.Printf("Keeping previous rate limit config: %v", err)
+					continue
+				}
+				log.Printf("Reloaded rate limit config from %s", path)
+			}
+		}
+	}()
+}
+
+func configStamp(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", info.ModTime().UnixNano(), info.Size())
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setupQuotaRouter serves a single route behind AuthMiddleware and the
+// given limiter. The handler answers with status.
+func setupQuotaRouter(rl *RateLimiter, operation string, status *int) *gin.Engine {
+	r := gin.New()
+	r.Use(AuthMiddleware())
+	r.POST("/op", func(c *gin.Context) { rl.handle(c, operation) }, func(c *gin.Context) {
+		c.Status(*status)
+	})
+	return r
+}
+
+func doQuotaRequest(r *gin.Engine, token, project string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/op", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Ambient-Project", project)
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func mustParseConfig(t *testing.T, data string) *RateLimitConfig {
+	t.Helper()
+	cfg, err := ParseRateLimitConfig([]byte(data))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	return cfg
+}
+
+func TestQuota_SubjectBucketsAreKeyedByToken(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      read: {requests: 1, per: 1h, burst: 2}
+`)
+	status := http.StatusOK
+	r := setupQuotaRouter(NewRateLimiter(NewMemoryLimiterStore(), cfg), OpRead, &status)
+
+	for i := 0; i < 2; i++ {
+		if w := doQuotaRequest(r, "alice", "proj"); w.Code != http.StatusOK {
+			t.Fatalf("request %d got %d, want 200", i, w.Code)
+		}
+	}
+	w := doQuotaRequest(r, "alice", "proj")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("got %d, want 429", w.Code)
+	}
+	if w.Header().Get("Retry-After") != "3600" {
+		t.Errorf("Retry-After = %q, want 3600", w.Header().Get("Retry-After"))
+	}
+	if w.Header().Get("RateLimit-Remaining") != "0" {
+		t.Errorf("RateLimit-Remaining = %q, want 0", w.Header().Get("RateLimit-Remaining"))
+	}
+
+	// Same client IP, different caller: separate bucket
+	if w := doQuotaRequest(r, "bob", "proj"); w.Code != http.StatusOK {
+		t.Errorf("other subject got %d, want 200", w.Code)
+	}
+}
+
+// staticResolver resolves tokens from a fixed map.
+type staticResolver struct {
+	users map[string]string
+	err   error
+}
+
+func (r staticResolver) Resolve(_ context.Context, token string) (string, error) {
+	return r.users[token], r.err
+}
+
+func TestQuota_SubjectBucketsAreSharedByAUsersTokens(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      read: {requests: 1, per: 1h, burst: 1}
+`)
+	rl := NewRateLimiter(NewMemoryLimiterStore(), cfg)
+	rl.subjects = staticResolver{users: map[string]string{"alice-1": "alice", "alice-2": "alice", "bob-1": "bob"}}
+	status := http.StatusOK
+	r := setupQuotaRouter(rl, OpRead, &status)
+
+	if w := doQuotaRequest(r, "alice-1", "proj"); w.Code != http.StatusOK {
+		t.Fatalf("first request got %d, want 200", w.Code)
+	}
+	// A second token of the same user draws from the same bucket
+	if w := doQuotaRequest(r, "alice-2", "proj"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("second token of the same user got %d, want 429", w.Code)
+	}
+	if w := doQuotaRequest(r, "bob-1", "proj"); w.Code != http.StatusOK {
+		t.Errorf("other user got %d, want 200", w.Code)
+	}
+	// Unresolvable tokens are limited individually
+	if w := doQuotaRequest(r, "unknown", "proj"); w.Code != http.StatusOK {
+		t.Errorf("unresolved token got %d, want 200", w.Code)
+	}
+}
+
+func TestQuota_SubjectFallsBackToTokenWhenResolutionFails(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      read: {requests: 1, per: 1h, burst: 1}
+`)
+	rl := NewRateLimiter(NewMemoryLimiterStore(), cfg)
+	rl.subjects = staticResolver{err: context.DeadlineExceeded}
+	status := http.StatusOK
+	r := setupQuotaRouter(rl, OpRead, &status)
+
+	if w := doQuotaRequest(r, "tok-1", "proj"); w.Code != http.StatusOK {
+		t.Fatalf("first request got %d, want 200", w.Code)
+	}
+	if w := doQuotaRequest(r, "tok-1", "proj"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("same token got %d, want 429", w.Code)
+	}
+	if w := doQuotaRequest(r, "tok-2", "proj"); w.Code != http.StatusOK {
+		t.Errorf("other token got %d, want 200", w.Code)
+	}
+}
+
+func TestQuota_ProjectQuotaChargedOnlyOnSuccess(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      session_create: {requests: 100, per: 1s}
+    project:
+      session_create: {requests: 2, per: 1h}
+`)
+	status := http.StatusUnauthorized
+	r := setupQuotaRouter(NewRateLimiter(NewMemoryLimiterStore(), cfg), OpSessionCreate, &status)
+
+	// Failed requests do not use up the project's quota
+	for i := 0; i < 5; i++ {
+		doQuotaRequest(r, "intruder", "proj")
+	}
+
+	status = http.StatusCreated
+	for i := 0; i < 2; i++ {
+		w := doQuotaRequest(r, "alice", "proj")
+		if w.Code != http.StatusCreated {
+			t.Fatalf("create %d got %d, want 201", i, w.Code)
+		}
+	}
+	if w := doQuotaRequest(r, "bob", "proj"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("create beyond project quota got %d, want 429", w.Code)
+	}
+	if w := doQuotaRequest(r, "bob", "other-proj"); w.Code != http.StatusCreated {
+		t.Errorf("other project got %d, want 201", w.Code)
+	}
+}
+
+func TestQuota_ProjectTiers(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    project:
+      session_create: {requests: 1, per: 1h}
+  premium:
+    project:
+      session_create: {requests: 20, per: 1h}
+projects:
+  team-x: premium
+`)
+	status := http.StatusCreated
+	r := setupQuotaRouter(NewRateLimiter(NewMemoryLimiterStore(), cfg), OpSessionCreate, &status)
+
+	for i := 0; i < 5; i++ {
+		if w := doQuotaRequest(r, "alice", "team-x"); w.Code != http.StatusCreated {
+			t.Fatalf("premium create %d got %d", i, w.Code)
+		}
+	}
+	doQuotaRequest(r, "alice", "team-y")
+	if w := doQuotaRequest(r, "alice", "team-y"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("default tier create got %d, want 429", w.Code)
+	}
+}
+
+func TestQuota_ReadsAndCreatesUseSeparateBuckets(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      read: {requests: 1, per: 1h}
+      session_create: {requests: 1, per: 1h}
+`)
+	rl := NewRateLimiter(NewMemoryLimiterStore(), cfg)
+	status := http.StatusOK
+	reads := setupQuotaRouter(rl, OpRead, &status)
+	creates := setupQuotaRouter(rl, OpSessionCreate, &status)
+
+	doQuotaRequest(reads, "alice", "proj")
+	if w := doQuotaRequest(reads, "alice", "proj"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second read got %d, want 429", w.Code)
+	}
+	if w := doQuotaRequest(creates, "alice", "proj"); w.Code != http.StatusOK {
+		t.Errorf("create after exhausting reads got %d, want 200", w.Code)
+	}
+}
+
+func TestQuota_Headers(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  default:
+    subject:
+      read: {requests: 10, per: 1m}
+`)
+	status := http.StatusOK
+	r := setupQuotaRouter(NewRateLimiter(NewMemoryLimiterStore(), cfg), OpRead, &status)
+
+	w := doQuotaRequest(r, "alice", "proj")
+	want := map[string]string{
+		"RateLimit-Limit":     "10",
+		"RateLimit-Remaining": "9",
+		"RateLimit-Reset":     "6",
+		"RateLimit-Policy":    "10;w=60",
+	}
+	for header, value := range want {
+		if got := w.Header().Get(header); got != value {
+			t.Errorf("%s = %q, want %q", header, got, value)
+		}
+	}
+}
+
+func TestParseRateLimitConfig(t *testing.T) {
+	cfg := mustParseConfig(t, `
+tiers:
+  premium:
+    subject:
+      read: {requests: 500, per: 1s}
+projects:
+  team-x: premium
+`)
+	premium := cfg.Tiers["premium"]
+	if premium.Subject[OpRead].Requests != 500 {
+		t.Errorf("premium read = %+v, want 500/s", premium.Subject[OpRead])
+	}
+	// Classes left out inherit from the default tier, which inherits the
+	// built-in defaults.
+	if premium.Subject[OpExport] != DefaultRateLimitConfig().Tiers[DefaultTierName].Subject[OpExport] {
+		t.Errorf("premium export = %+v, want built-in default", premium.Subject[OpExport])
+	}
+	if name, _ := cfg.tierFor("team-x"); name != "premium" {
+		t.Errorf("tierFor(team-x) = %s, want premium", name)
+	}
+	if name, _ := cfg.tierFor("anything"); name != DefaultTierName {
+		t.Errorf("tierFor(anything) = %s, want default", name)
+	}
+
+	for _, bad := range []string{
+		"tiers: {default: {subject: {read: {requests: 0, per: 1s}}}}",
+		"tiers: {default: {subject: {read: {requests: 1}}}}",
+		"projects: {team-x: missing}",
+		"tiers: {default: {subjects: {}}}",
+	} {
+		if _, err := ParseRateLimitConfig([]byte(bad)); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestWatchRateLimitConfig_ReloadsOnChange(t *testing.T) {
+	original := Quotas
+	Quotas = NewRateLimiter(NewMemoryLimiterStore(), DefaultRateLimitConfig())
+	defer func() { Quotas = original }()
+
+	path := filepath.Join(t.TempDir(), "limits.yaml")
+	write := func(data string) {
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("tiers: {default: {subject: {read: {requests: 7, per: 1s}}}}")
+	if err := LoadRateLimitConfig(path); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	WatchRateLimitConfig(ctx, path, 10*time.Millisecond)
+
+	readLimit := func() int { return Quotas.Config().Tiers[DefaultTierName].Subject[OpRead].Requests }
+
+	write("tiers: {default: {subject: {read: {requests: 9, per: 1s}}}} # changed")
+	waitFor(t, func() bool { return readLimit() == 9 })
+
+	// An invalid update keeps the previous configuration
+	write("tiers: {default: {subject: {read: {requests: -1, per: 1s}}}}")
+	time.Sleep(50 * time.Millisecond)
+	if got := readLimit(); got != 9 {
+		t.Errorf("read limit after invalid update = %d, want 9", got)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestTokenFingerprint(t *testing.T) {
+	a, b := tokenFingerprint("token-a"), tokenFingerprint("token-b")
+	if a == b || len(a) != 32 || strings.Contains(a, "token") {
+		t.Errorf("fingerprints %q %q", a, b)
+	}
+}
@@ -55,7 +55,9 @@ func getBurstFromEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
-// RateLimitMiddleware returns a middleware that rate limits requests per IP
+// RateLimitMiddleware returns a middleware that rate limits requests per IP.
+// It is a coarse flood guard in front of authentication; per-caller and
+// per-project limits are enforced by QuotaMiddleware (quota.go).
 func RateLimitMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Skip rate limiting for health/ready endpoints
@@ -73,6 +75,7 @@ func RateLimitMiddleware() gin.HandlerFunc {
 
 		// Check if request is allowed
 		if !limiter.Allow() {
+			c.Header("Retry-After", "1")
 			c.JSON(http.StatusTooManyRequests, gin.H{
 				"error":       "Rate limit exceeded",
 				"retry_after": "1s",
@@ -121,5 +124,12 @@ func cleanupStaleLimiters() {
 			}
 			return true
 		})
+
+		if store, ok := Quotas.store.(*MemoryLimiterStore); ok {
+			store.Prune(cutoff)
+		}
+		if resolver, ok := Quotas.subjects.(*TokenReviewResolver); ok {
+			resolver.Prune(time.Now())
+		}
 	}
 }
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: Requests tokens are refilled every Per, and at
+// most Burst tokens accumulate. Burst defaults to Requests.
+type Limit struct {
+	Requests int           `yaml:"requests" json:"requests"`
+	Per      time.Duration `yaml:"per" json:"per"`
+	Burst    int           `yaml:"burst,omitempty" json:"burst,omitempty"`
+}
+
+// capacity returns the bucket size.
+func (l Limit) capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// interval returns the time it takes to refill one token.
+func (l Limit) interval() time.Duration {
+	return l.Per / time.Duration(l.Requests)
+}
+
+// valid reports whether the limit can be enforced.
+func (l Limit) valid() bool {
+	return l.Requests > 0 && l.Per > 0 && l.Burst >= 0
+}
+
+// Decision is the outcome of a bucket check.
+type Decision struct {
+	Allowed bool
+	// Limit is the bucket capacity.
+	Limit int
+	// Remaining is the number of whole tokens left after the check.
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the next token is available; zero when
+	// Allowed.
+	RetryAfter time.Duration
+}
+
+// LimiterStore holds token bucket state. The only implementation is the
+// in-memory store, so each gateway replica enforces its limits independently.
+type LimiterStore interface {
+	// Take consumes cost tokens from the bucket at key if at least
+	// max(cost, 1) tokens are available. A cost of zero only checks.
+	Take(ctx context.Context, key string, limit Limit, cost int, now time.Time) (Decision, error)
+}
+
+// bucketState is the GCRA representation of a token bucket: the theoretical
+// arrival time after which the bucket is full.
+type bucketState struct {
+	tat time.Time
+}
+
+// MemoryLimiterStore is an in-process LimiterStore.
+type MemoryLimiterStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucketState
+}
+
+// NewMemoryLimiterStore creates an empty in-memory store.
+func NewMemoryLimiterStore() *MemoryLimiterStore {
+	return &MemoryLimiterStore{buckets: map[string]*bucketState{}}
+}
+
+// Take implements LimiterStore.
+func (s *MemoryLimiterStore) Take(_ context.Context, key string, limit Limit, cost int, now time.Time) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.buckets[key]
+	if !ok {
+		state = &bucketState{tat: now}
+		s.buckets[key] = state
+	}
+	decision, tat := gcra(state.tat, limit, cost, now)
+	state.tat = tat
+	return decision, nil
+}
+
+// Prune drops buckets that have been full since before cutoff.
+func (s *MemoryLimiterStore) Prune(cutoff time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, state := range s.buckets {
+		if state.tat.Before(cutoff) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// gcra applies the generic cell rate algorithm to a bucket whose theoretical
+// arrival time is tat, returning the decision and the new tat.
+func gcra(tat time.Time, limit Limit, cost int, now time.Time) (Decision, time.Time) {
+	interval := limit.interval()
+	capacity := limit.capacity()
+	tolerance := interval * time.Duration(capacity)
+
+	if tat.Before(now) {
+		tat = now
+	}
+
+	need := cost
+	if need < 1 {
+		need = 1
+	}
+	newTAT := tat.Add(interval * time.Duration(need))
+	allowAt := newTAT.Add(-tolerance)
+
+	decision := Decision{Limit: capacity}
+	if allowAt.After(now) {
+		decision.RetryAfter = allowAt.Sub(now)
+		decision.Remaining = remainingTokens(tat, now, interval, tolerance)
+		decision.Reset = tat.Sub(now)
+		return decision, tat
+	}
+
+	decision.Allowed = true
+	if cost > 0 {
+		tat = tat.Add(interval * time.Duration(cost))
+	}
+	decision.Remaining = remainingTokens(tat, now, interval, tolerance)
+	decision.Reset = tat.Sub(now)
+	return decision, tat
+}
+
+func remainingTokens(tat, now time.Time, interval, tolerance time.Duration) int {
+	used := tat.Sub(now)
+	return int(math.Floor(float64(tolerance-used) / float64(interval)))
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterStore_TakeAndRefill(t *testing.T) {
+	store := NewMemoryLimiterStore()
+	ctx := context.Background()
+	limit := Limit{Requests: 2, Per: time.Second, Burst: 3}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		d, _ := store.Take(ctx, "k", limit, 1, now)
+		if !d.Allowed {
+			t.Fatalf("take %d denied within burst", i)
+		}
+		if d.Remaining != 2-i {
+			t.Errorf("take %d remaining = %d, want %d", i, d.Remaining, 2-i)
+		}
+	}
+
+	d, _ := store.Take(ctx, "k", limit, 1, now)
+	if d.Allowed {
+		t.Fatal("take beyond burst allowed")
+	}
+	if d.RetryAfter != 500*time.Millisecond {
+		t.Errorf("retry after = %s, want 500ms", d.RetryAfter)
+	}
+
+	// One token refills every 500ms
+	d, _ = store.Take(ctx, "k", limit, 1, now.Add(500*time.Millisecond))
+	if !d.Allowed {
+		t.Fatal("take after refill denied")
+	}
+
+	// Other keys are independent
+	if d, _ := store.Take(ctx, "other", limit, 1, now); !d.Allowed {
+		t.Error("independent key denied")
+	}
+}
+
+func TestMemoryLimiterStore_ZeroCostOnlyChecks(t *testing.T) {
+	store := NewMemoryLimiterStore()
+	ctx := context.Background()
+	limit := Limit{Requests: 1, Per: time.Hour}
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if d, _ := store.Take(ctx, "k", limit, 0, now); !d.Allowed || d.Remaining != 1 {
+			t.Fatalf("check %d: allowed=%v remaining=%d, want true/1", i, d.Allowed, d.Remaining)
+		}
+	}
+	if d, _ := store.Take(ctx, "k", limit, 1, now); !d.Allowed {
+		t.Fatal("charge denied")
+	}
+	if d, _ := store.Take(ctx, "k", limit, 0, now); d.Allowed {
+		t.Error("check after exhausting quota allowed")
+	}
+}
+
+func TestMemoryLimiterStore_Prune(t *testing.T) {
+	store := NewMemoryLimiterStore()
+	now := time.Now()
+	store.Take(context.Background(), "k", Limit{Requests: 1, Per: time.Second}, 1, now)
+
+	store.Prune(now)
+	if len(store.buckets) != 1 {
+		t.Fatal("pruned a bucket that is still refilling")
+	}
+	store.Prune(now.Add(time.Minute))
+	if len(store.buckets) != 0 {
+		t.Error("did not prune a full bucket")
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ambient-code-public-api/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Route describes one authenticated /v1 endpoint. The route table is the
+// single source for both router registration (main.go) and the gateway's
+// OpenAPI document (openapi.go), so the two cannot drift.
+type Route struct {
+	Method  string
+	Path    string // gin syntax, relative to /v1
+	Handler gin.HandlerFunc
+
+	OperationID string
+	Summary     string
+
+	// Operation is the rate limit class (Op* in quota.go) the route is
+	// charged against.
+	Operation string
+
+	// Request is the JSON request body model, if any.
+	Request interface{}
+	// Response describes the success response.
+	Response RouteResponse
+}
+
+// RouteResponse describes a route's success response for the OpenAPI document.
+type RouteResponse struct {
+	Status      int
+	Description string
+	// ContentType defaults to application/json when Body is set.
+	ContentType string
+	// Body is the JSON response model; nil for opaque or empty bodies.
+	Body interface{}
+}
+
+// HandlerChain returns the gin handlers for the route, including its
+// rate limit check.
+func (rt Route) HandlerChain() []gin.HandlerFunc {
+	return []gin.HandlerFunc{QuotaMiddleware(rt.Operation), rt.Handler}
+}
+
+// Routes returns the /v1 route table.
+func Routes() []Route {
+	return []Route{
+		{
+			Method: http.MethodGet, Path: "/sessions", Handler: ListSessions,
+			OperationID: "listSessions", Summary: "List sessions",
+			Operation: OpRead,
+			Response:  RouteResponse{Status: http.StatusOK, Description: "Sessions in the project", Body: types.SessionListResponse{}},
+		},
+		{
+			Method: http.MethodPost, Path: "/sessions", Handler: CreateSession,
+			OperationID: "createSession", Summary: "Create session",
+			Operation: OpSessionCreate,
+			Request:   types.CreateSessionRequest{},
+			Response:  RouteResponse{Status: http.StatusCreated, Description: "Session created", Body: types.CreateSessionResponse{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/sessions/:id", Handler: GetSession,
+			OperationID: "getSession", Summary: "Get session details",
+			Operation: OpRead,
+			Response:  RouteResponse{Status: http.StatusOK, Description: "The session", Body: types.SessionResponse{}},
+		},
+		{
+			Method: http.MethodDelete, Path: "/sessions/:id", Handler: DeleteSession,
+			OperationID: "deleteSession", Summary: "Delete session",
+			Operation: OpWrite,
+			Response:  RouteResponse{Status: http.StatusNoContent, Description: "Session deleted"},
+		},
+		{
+			Method: http.MethodPost, Path: "/sessions/:id/start", Handler: StartSession,
+			OperationID: "startSession", Summary: "Start (or resume) a session",
+			Operation: OpSessionLifecycle,
+			Response:  RouteResponse{Status: http.StatusAccepted, Description: "Start requested", Body: types.SessionResponse{}},
+		},
+		{
+			Method: http.MethodPost, Path: "/sessions/:id/stop", Handler: StopSession,
+			OperationID: "stopSession", Summary: "Stop a running session",
+			Operation: OpSessionLifecycle,
+			Response:  RouteResponse{Status: http.StatusAccepted, Description: "Stop requested", Body: types.SessionResponse{}},
+		},
+		{
+			Method: http.MethodPost, Path: "/sessions/:id/agui/run", Handler: RunAGUI,
+			OperationID: "runSessionAGUI", Summary: "Send messages to the session as an AG-UI run",
+			Operation: OpAGUIRun,
+			Request:   types.AGUIRunRequest{},
+			Response:  RouteResponse{Status: http.StatusOK, Description: "Run accepted", Body: types.AGUIRunResponse{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/sessions/:id/agui/events", Handler: StreamAGUIEvents,
+			OperationID: "streamSessionAGUIEvents", Summary: "Stream AG-UI events (replay, then live)",
+			Operation: OpAGUIEvents,
+			Response:  RouteResponse{Status: http.StatusOK, Description: "Server-sent AG-UI event stream", ContentType: "text/event-stream"},
+		},
+		{
+			Method: http.MethodGet, Path: "/sessions/:id/workspace/*path", Handler: GetWorkspaceFile,
+			OperationID: "getSessionWorkspaceFile", Summary: "Read a file from the session workspace",
+			Operation: OpWorkspace,
+			Response:  RouteResponse{Status: http.StatusOK, Description: "File contents", ContentType: "application/octet-stream"},
+		},
+		{
+			Method: http.MethodGet, Path: "/sessions/:id/export", Handler: ExportSession,
+			OperationID: "exportSession", Summary: "Export the session transcript",
+			Operation: OpExport,
+			Response:  RouteResponse{Status: http.StatusOK, Description: "Session export (JSON attachment)", ContentType: "application/json"},
+		},
+	}
+}
@@ -179,9 +179,10 @@ func CreateSession(c *gin.Context) {
 	}
 
 	// Return simplified response
-	c.JSON(http.StatusCreated, gin.H{
-		"id":      backendResp["name"],
-		"message": "Session created",
+	name, _ := backendResp["name"].(string)
+	c.JSON(http.StatusCreated, types.CreateSessionResponse{
+		ID:      name,
+		Message: "Session created",
 	})
 }
 
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// In-cluster service account files used to call the Kubernetes API.
+const (
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// SubjectResolver maps a bearer token to the identity it authenticates as,
+// so subject limits follow the user or service account instead of each of
+// their tokens.
+type SubjectResolver interface {
+	// Resolve returns the username the token authenticates as, or "" when
+	// the token is not valid.
+	Resolve(ctx context.Context, token string) (string, error)
+}
+
+// TokenReviewResolver resolves tokens with the Kubernetes TokenReview API,
+// which verifies them the same way the backend does. Results, including
+// rejected tokens, are cached for ttl so a review is not made per request.
+type TokenReviewResolver struct {
+	url     string
+	client  *http.Client
+	saToken func() (string, error)
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSubject
+}
+
+type cachedSubject struct {
+	subject string
+	expires time.Time
+}
+
+// NewTokenReviewResolver creates a resolver that posts TokenReviews to the
+// API server at apiURL, authenticating with the token saToken returns.
+func NewTokenReviewResolver(apiURL string, client *http.Client, saToken func() (string, error), ttl time.Duration) *TokenReviewResolver {
+	return &TokenReviewResolver{
+		url:     strings.TrimSuffix(apiURL, "/") + "/apis/authentication.k8s.io/v1/tokenreviews",
+		client:  client,
+		saToken: saToken,
+		ttl:     ttl,
+		now:     time.Now,
+		cache:   map[string]cachedSubject{},
+	}
+}
+
+// NewInClusterTokenReviewResolver creates a resolver for the cluster the
+// gateway runs in, using its mounted service account.
+func NewInClusterTokenReviewResolver(ttl time.Duration) (*TokenReviewResolver, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("not running in a cluster: KUBERNETES_SERVICE_HOST/PORT not set")
+	}
+	ca, err := os.ReadFile(serviceAccountCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates in %s", serviceAccountCAPath)
+	}
+	client := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}
+	// Projected service account tokens rotate, so read the file per review.
+	saToken := func() (string, error) {
+		data, err := os.ReadFile(serviceAccountTokenPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read service account token: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return NewTokenReviewResolver("https://"+net.JoinHostPort(host, port), client, saToken, ttl), nil
+}
+
+type tokenReview struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Spec       tokenReviewSpec   `json:"spec"`
+	Status     tokenReviewStatus `json:"status,omitempty"`
+}
+
+type tokenReviewSpec struct {
+	Token string `json:"token"`
+}
+
+type tokenReviewStatus struct {
+	Authenticated bool `json:"authenticated"`
+	User          struct {
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+// Resolve implements SubjectResolver.
+func (r *TokenReviewResolver) Resolve(ctx context.Context, token string) (string, error) {
+	key := tokenFingerprint(token)
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && r.now().Before(cached.expires) {
+		return cached.subject, nil
+	}
+
+	subject, err := r.review(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.cache[key] = cachedSubject{subject: subject, expires: r.now().Add(r.ttl)}
+	r.mu.Unlock()
+	return subject, nil
+}
+
+func (r *TokenReviewResolver) review(ctx context.Context, token string) (string, error) {
+	saToken, err := r.saToken()
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(tokenReview{
+		APIVersion: "authentication.k8s.io/v1",
+		Kind:       "TokenReview",
+		Spec:       tokenReviewSpec{Token: token},
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+saToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token review failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token review failed: %s", resp.Status)
+	}
+
+	var result tokenReview
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("invalid token review response: %w", err)
+	}
+	if !result.Status.Authenticated {
+		return "", nil
+	}
+	return result.Status.User.Username, nil
+}
+
+// Prune drops cached results that expired before now.
+func (r *TokenReviewResolver) Prune(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, cached := range r.cache {
+		if !now.Before(cached.expires) {
+			delete(r.cache, key)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTokenReviewServer answers TokenReviews from users, a map of token to
+// username; unknown tokens are not authenticated.
+func newTokenReviewServer(t *testing.T, users map[string]string, reviews *int) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*reviews++
+		if r.URL.Path != "/apis/authentication.k8s.io/v1/tokenreviews" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer gateway-sa" {
+			t.Errorf("expected gateway service account token, got %q", r.Header.Get("Authorization"))
+		}
+		var review tokenReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			t.Fatalf("decode review: %v", err)
+		}
+		if username, ok := users[review.Spec.Token]; ok {
+			review.Status.Authenticated = true
+			review.Status.User.Username = username
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func gatewayToken() (string, error) { return "gateway-sa", nil }
+
+func TestTokenReviewResolver_ResolvesAndCaches(t *testing.T) {
+	reviews := 0
+	srv := newTokenReviewServer(t, map[string]string{"tok-a": "alice"}, &reviews)
+	resolver := NewTokenReviewResolver(srv.URL, srv.Client(), gatewayToken, time.Minute)
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		subject, err := resolver.Resolve(context.Background(), "tok-a")
+		if err != nil {
+			t.Fatalf("resolve: %v", err)
+		}
+		if subject != "alice" {
+			t.Errorf("subject = %q, want alice", subject)
+		}
+	}
+	if reviews != 1 {
+		t.Errorf("expected cached result to be reused, got %d reviews", reviews)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := resolver.Resolve(context.Background(), "tok-a"); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if reviews != 2 {
+		t.Errorf("expected expired result to be reviewed again, got %d reviews", reviews)
+	}
+}
+
+func TestTokenReviewResolver_UnauthenticatedToken(t *testing.T) {
+	reviews := 0
+	srv := newTokenReviewServer(t, nil, &reviews)
+	resolver := NewTokenReviewResolver(srv.URL, srv.Client(), gatewayToken, time.Minute)
+
+	subject, err := resolver.Resolve(context.Background(), "forged")
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if subject != "" {
+		t.Errorf("subject = %q, want empty for an unauthenticated token", subject)
+	}
+}
+
+func TestTokenReviewResolver_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+	resolver := NewTokenReviewResolver(srv.URL, srv.Client(), gatewayToken, time.Minute)
+
+	if _, err := resolver.Resolve(context.Background(), "tok"); err == nil {
+		t.Fatal("expected an error when the review is refused")
+	}
+	if len(resolver.cache) != 0 {
+		t.Error("expected failed reviews not to be cached")
+	}
+}
+
+func TestTokenReviewResolver_Prune(t *testing.T) {
+	reviews := 0
+	srv := newTokenReviewServer(t, map[string]string{"tok-a": "alice"}, &reviews)
+	resolver := NewTokenReviewResolver(srv.URL, srv.Client(), gatewayToken, time.Minute)
+
+	if _, err := resolver.Resolve(context.Background(), "tok-a"); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	resolver.Prune(time.Now())
+	if len(resolver.cache) != 1 {
+		t.Fatal("expected unexpired entry to be kept")
+	}
+	resolver.Prune(time.Now().Add(2 * time.Minute))
+	if len(resolver.cache) != 0 {
+		t.Error("expected expired entry to be pruned")
+	}
+}
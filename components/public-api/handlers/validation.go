@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"regexp"
+	"strings"
 )
 
 // kubernetesNameRegex matches valid Kubernetes resource names.
@@ -32,3 +33,29 @@ func ValidateSessionID(sessionID string) bool {
 func ValidateProjectName(project string) bool {
 	return IsValidKubernetesName(project)
 }
+
+// maxWorkspacePathLength bounds workspace file paths accepted from clients.
+const maxWorkspacePathLength = 1024
+
+// ValidateWorkspacePath validates a file path inside a session workspace.
+// Paths must be relative, must not escape the workspace with "..", and must
+// not contain control characters or backslashes.
+func ValidateWorkspacePath(p string) bool {
+	if p == "" || len(p) > maxWorkspacePathLength {
+		return false
+	}
+	if strings.HasPrefix(p, "/") || strings.ContainsAny(p, "\\") {
+		return false
+	}
+	for _, r := range p {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	for _, seg := range strings.Split(p, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,9 @@
 package handlers
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestIsValidKubernetesName(t *testing.T) {
 	tests := []struct {
@@ -86,3 +89,33 @@ func TestValidateProjectName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWorkspacePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{"simple file", "README.md", true},
+		{"nested file", "repos/app/main.go", true},
+		{"dotfile", "repo/.gitignore", true},
+		{"empty", "", false},
+		{"absolute", "/etc/passwd", false},
+		{"parent traversal", "repo/../../etc/passwd", false},
+		{"current dir segment", "./README.md", false},
+		{"empty segment", "repo//main.go", false},
+		{"trailing slash", "repo/", false},
+		{"backslash", "repo\\main.go", false},
+		{"control character", "repo/a\x00b", false},
+		{"too long", strings.Repeat("a", maxWorkspacePathLength+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateWorkspacePath(tt.path)
+			if result != tt.expected {
+				t.Errorf("ValidateWorkspacePath(%q) = %v, want %v", tt.path, result, tt.expected)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"time"
@@ -32,11 +34,40 @@ import (
 )
 
 func main() {
+	// `public-api openapi` prints the OpenAPI document and exits, so SDK
+	// tooling can consume it without running the server.
+	if len(os.Args) > 1 && os.Args[1] == "openapi" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(handlers.OpenAPISpec()); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to encode OpenAPI document: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Validate required environment variables
 	if err := validateConfig(); err != nil {
 		observability.Logger.Fatal().Err(err).Msg("Configuration validation failed")
 	}
 
+	// Load per-caller and per-project rate limits (if configured)
+	if path := os.Getenv("RATE_LIMIT_CONFIG"); path != "" {
+		if err := handlers.LoadRateLimitConfig(path); err != nil {
+			observability.Logger.Fatal().Err(err).Str("path", path).Msg("Failed to load rate limit config")
+		}
+		handlers.WatchRateLimitConfig(context.Background(), path, getDurationFromEnv("RATE_LIMIT_CONFIG_RELOAD", 30*time.Second))
+	}
+
+	// Key per-caller limits on the user or service account a token belongs
+	// to. Outside a cluster there is nothing to verify tokens against, so
+	// each token gets its own budget.
+	if resolver, err := handlers.NewInClusterTokenReviewResolver(getDurationFromEnv("RATE_LIMIT_SUBJECT_CACHE_TTL", time.Minute)); err != nil {
+		observability.Logger.Warn().Err(err).Msg("Rate limiting per token: cannot resolve token subjects")
+	} else {
+		handlers.SetSubjectResolver(resolver)
+	}
+
 	// Initialize OpenTelemetry (if enabled)
 	shutdown := observability.InitTracer()
 	defer shutdown()
@@ -56,7 +87,7 @@ func main() {
 		AllowOrigins:     getAllowedOrigins(),
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Ambient-Project"},
-		ExposeHeaders:    []string{"Content-Length", "Content-Type"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Type", "Content-Disposition"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -85,17 +116,16 @@ func main() {
 	// Metrics endpoint (Prometheus format)
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
+	// OpenAPI document, generated from the v1 route table
+	r.GET("/openapi.json", handlers.OpenAPIHandler())
+
 	// v1 API routes
 	// IMPORTANT: AuthMiddleware must run BEFORE LoggingMiddleware
 	// to ensure we only log authenticated requests with valid project context
 	v1 := r.Group("/v1")
 	v1.Use(handlers.AuthMiddleware())
-	{
-		// Sessions
-		v1.GET("/sessions", handlers.ListSessions)
-		v1.POST("/sessions", handlers.CreateSession)
-		v1.GET("/sessions/:id", handlers.GetSession)
-		v1.DELETE("/sessions/:id", handlers.DeleteSession)
+	for _, rt := range handlers.Routes() {
+		v1.Handle(rt.Method, rt.Path, rt.HandlerChain()...)
 	}
 
 	// Get port from environment or default to 8081
@@ -133,6 +163,16 @@ func validateConfig() error {
 	return nil
 }
 
+// getDurationFromEnv parses a Go duration from the environment
+func getDurationFromEnv(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultValue
+}
+
 // getAllowedOrigins returns the list of allowed CORS origins
 func getAllowedOrigins() []string {
 	// Check for explicit CORS origins
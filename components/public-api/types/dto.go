@@ -25,6 +25,12 @@ type CreateSessionRequest struct {
 	Repos []Repo `json:"repos,omitempty"`
 }
 
+// CreateSessionResponse is the response for creating a session
+type CreateSessionResponse struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
 // Repo represents a repository configuration
 type Repo struct {
 	URL    string `json:"url" binding:"required"`
@@ -36,3 +42,23 @@ type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message,omitempty"`
 }
+
+// AGUIRunRequest is the AG-UI RunAgentInput accepted by /agui/run. Messages,
+// tools and context are passed through to the runner unchanged.
+type AGUIRunRequest struct {
+	ThreadID       string                   `json:"threadId,omitempty"`
+	RunID          string                   `json:"runId,omitempty"`
+	ParentRunID    string                   `json:"parentRunId,omitempty"`
+	Messages       []map[string]interface{} `json:"messages,omitempty"`
+	State          map[string]interface{}   `json:"state,omitempty"`
+	Tools          []map[string]interface{} `json:"tools,omitempty"`
+	Context        interface{}              `json:"context,omitempty"`
+	ForwardedProps map[string]interface{}   `json:"forwardedProps,omitempty"`
+}
+
+// AGUIRunResponse identifies the run started by /agui/run
+type AGUIRunResponse struct {
+	ThreadID    string `json:"threadId"`
+	RunID       string `json:"runId"`
+	ParentRunID string `json:"parentRunId,omitempty"`
+}